@@ -0,0 +1,92 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ApplicationUserTokenSpec defines the desired state of ApplicationUserToken
+type ApplicationUserTokenSpec struct {
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// Identifier of the organization the application user belongs to
+	OrganizationID string `json:"organizationId"`
+
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// Identifier of the application user
+	UserID string `json:"userId"`
+
+	// The Secret and the key to store the token in. The Secret is created if it doesn't exist.
+	// It can be the authSecretRef of other resources, so they get the new token right away
+	TokenSecretRef AuthSecretReference `json:"tokenSecretRef"`
+
+	// +kubebuilder:default="720h"
+	// How often the token is rotated, e.g. 720h. The token expires in two rotation periods,
+	// so it stays valid for a while if the rotation fails
+	RotationPeriod metav1.Duration `json:"rotationPeriod,omitempty"`
+
+	// Authentication reference to Aiven token in a secret.
+	// The token must be allowed to manage the application user tokens
+	AuthSecretRef AuthSecretReference `json:"authSecretRef,omitempty"`
+}
+
+// ApplicationUserTokenStatus defines the observed state of ApplicationUserToken
+type ApplicationUserTokenStatus struct {
+	// Conditions represent the latest available observations of an ApplicationUserToken state
+	Conditions []metav1.Condition `json:"conditions"`
+
+	// Prefix of the current token, it identifies the token in the Aiven Console
+	TokenPrefix string `json:"tokenPrefix,omitempty"`
+
+	// When the current token was created
+	LastRotationTime *metav1.Time `json:"lastRotationTime,omitempty"`
+
+	// Link to the application user in the Aiven Console
+	ConsoleURL string `json:"consoleURL,omitempty"`
+
+	SyncStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// ApplicationUserToken is the Schema for the applicationusertokens API.
+// It rotates the API token of an organization application user on a schedule
+// +kubebuilder:printcolumn:name="User",type="string",JSONPath=".spec.userId"
+// +kubebuilder:printcolumn:name="Token Prefix",type="string",JSONPath=".status.tokenPrefix"
+// +kubebuilder:printcolumn:name="Last Rotation",type="date",JSONPath=".status.lastRotationTime"
+type ApplicationUserToken struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ApplicationUserTokenSpec   `json:"spec,omitempty"`
+	Status ApplicationUserTokenStatus `json:"status,omitempty"`
+}
+
+func (in *ApplicationUserToken) AuthSecretRef() AuthSecretReference {
+	return in.Spec.AuthSecretRef
+}
+
+func (in *ApplicationUserToken) GetSyncStatus() *SyncStatus {
+	return &in.Status.SyncStatus
+}
+
+// UpdateConsoleURL sets the link to the application user in the Aiven Console
+func (in *ApplicationUserToken) UpdateConsoleURL() {
+	in.Status.ConsoleURL = consoleURL("account", in.Spec.OrganizationID, "admin", "application-users", in.Spec.UserID)
+}
+
+// +kubebuilder:object:root=true
+
+// ApplicationUserTokenList contains a list of ApplicationUserToken
+type ApplicationUserTokenList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ApplicationUserToken `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ApplicationUserToken{}, &ApplicationUserTokenList{})
+}
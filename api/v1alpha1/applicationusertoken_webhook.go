@@ -0,0 +1,71 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package v1alpha1
+
+import (
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// minTokenRotationPeriod prevents flooding the organization with tokens
+const minTokenRotationPeriod = time.Hour
+
+// log is for logging in this package.
+var applicationusertokenlog = logf.Log.WithName("applicationusertoken-resource")
+
+func (in *ApplicationUserToken) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(in).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/mutate-aiven-io-v1alpha1-applicationusertoken,mutating=true,failurePolicy=fail,groups=aiven.io,resources=applicationusertokens,verbs=create;update,versions=v1alpha1,name=mapplicationusertoken.kb.io,sideEffects=none,admissionReviewVersions=v1
+
+var _ webhook.Defaulter = &ApplicationUserToken{}
+
+// Default implements webhook.Defaulter so a webhook will be registered for the type
+func (in *ApplicationUserToken) Default() {
+	applicationusertokenlog.Info("default", "name", in.Name)
+}
+
+//+kubebuilder:webhook:verbs=create;update,path=/validate-aiven-io-v1alpha1-applicationusertoken,mutating=false,failurePolicy=fail,groups=aiven.io,resources=applicationusertokens,versions=v1alpha1,name=vapplicationusertoken.kb.io,sideEffects=none,admissionReviewVersions=v1
+
+var _ webhook.Validator = &ApplicationUserToken{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (in *ApplicationUserToken) ValidateCreate() error {
+	applicationusertokenlog.Info("validate create", "name", in.Name)
+
+	return in.Spec.Validate()
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (in *ApplicationUserToken) ValidateUpdate(old runtime.Object) error {
+	applicationusertokenlog.Info("validate update", "name", in.Name)
+
+	return in.Spec.Validate()
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (in *ApplicationUserToken) ValidateDelete() error {
+	applicationusertokenlog.Info("validate delete", "name", in.Name)
+
+	return nil
+}
+
+// Validate checks the token target and the rotation period
+func (in *ApplicationUserTokenSpec) Validate() error {
+	if !in.TokenSecretRef.IsValid() {
+		return fmt.Errorf("tokenSecretRef name and key are required")
+	}
+
+	if in.RotationPeriod.Duration < minTokenRotationPeriod {
+		return fmt.Errorf("rotationPeriod must be at least %s", minTokenRotationPeriod)
+	}
+	return nil
+}
@@ -0,0 +1,112 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AWSPrivateLinkSpec defines the desired state of AWSPrivateLink
+type AWSPrivateLinkSpec struct {
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Format="^[a-zA-Z0-9_-]*$"
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// Project of the service
+	Project string `json:"project"`
+
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// Service to enable AWS PrivateLink for. The service must be in a project VPC in AWS
+	ServiceName string `json:"serviceName"`
+
+	// Service resource of the serviceName. A service in another namespace must be shared with a ReferenceGrant.
+	// Its authSecretRef is used if the resource doesn't set one
+	ServiceRef *ServiceReference `json:"serviceRef,omitempty"`
+
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	// +kubebuilder:validation:XValidation:rule="self.all(p, p.matches('^arn:aws[a-z-]*:iam::[0-9]{12}:.+$'))",message="Principals must be IAM ARNs, e.g. arn:aws:iam::012345678901:root"
+	// ARNs of the AWS principals allowed to create VPC endpoints to the service, e.g. arn:aws:iam::012345678901:root
+	Principals []string `json:"principals"`
+
+	// Authentication reference to Aiven token in a secret
+	AuthSecretRef AuthSecretReference `json:"authSecretRef,omitempty"`
+}
+
+// AWSPrivateLinkStatus defines the observed state of AWSPrivateLink
+type AWSPrivateLinkStatus struct {
+	// Conditions represent the latest available observations of an AWSPrivateLink state
+	Conditions []metav1.Condition `json:"conditions"`
+
+	// State of the PrivateLink, e.g. creating or active
+	State string `json:"state,omitempty"`
+
+	// ID of the VPC endpoint service of the PrivateLink
+	AWSServiceID string `json:"awsServiceId,omitempty"`
+
+	// Name of the VPC endpoint service of the PrivateLink. Create the VPC endpoints to it
+	AWSServiceName string `json:"awsServiceName,omitempty"`
+
+	// Link to the service in the Aiven Console
+	ConsoleURL string `json:"consoleURL,omitempty"`
+
+	SyncStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// AWSPrivateLink is the Schema for the awsprivatelinks API.
+// It enables AWS PrivateLink for a service, so the allowed principals can connect to it from their VPCs
+// +kubebuilder:printcolumn:name="Project",type="string",JSONPath=".spec.project"
+// +kubebuilder:printcolumn:name="Service Name",type="string",JSONPath=".spec.serviceName"
+// +kubebuilder:printcolumn:name="State",type="string",JSONPath=".status.state"
+// +kubebuilder:printcolumn:name="AWS Service Name",type="string",JSONPath=".status.awsServiceName"
+type AWSPrivateLink struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AWSPrivateLinkSpec   `json:"spec,omitempty"`
+	Status AWSPrivateLinkStatus `json:"status,omitempty"`
+}
+
+func (in *AWSPrivateLink) AuthSecretRef() AuthSecretReference {
+	return in.Spec.AuthSecretRef
+}
+
+func (in *AWSPrivateLink) GetSyncStatus() *SyncStatus {
+	return &in.Status.SyncStatus
+}
+
+// UpdateConsoleURL sets the link to the service in the Aiven Console
+func (in *AWSPrivateLink) UpdateConsoleURL() {
+	in.Status.ConsoleURL = serviceConsoleURL(in.Spec.Project, in.Spec.ServiceName)
+}
+
+func (in *AWSPrivateLink) GetServiceRef() *ServiceReference {
+	return in.Spec.ServiceRef
+}
+
+func (in *AWSPrivateLink) GetProjectAndServiceName() (string, string) {
+	return in.Spec.Project, in.Spec.ServiceName
+}
+
+func (in *AWSPrivateLink) GetRefs() []*ResourceReferenceObject {
+	if in.Spec.ServiceRef == nil {
+		return nil
+	}
+	return []*ResourceReferenceObject{in.Spec.ServiceRef.Service(in.GetNamespace())}
+}
+
+// +kubebuilder:object:root=true
+
+// AWSPrivateLinkList contains a list of AWSPrivateLink
+type AWSPrivateLinkList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AWSPrivateLink `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&AWSPrivateLink{}, &AWSPrivateLinkList{})
+}
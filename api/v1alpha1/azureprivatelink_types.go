@@ -0,0 +1,133 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AzurePrivateLinkSpec defines the desired state of AzurePrivateLink
+type AzurePrivateLinkSpec struct {
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Format="^[a-zA-Z0-9_-]*$"
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// Project of the service
+	Project string `json:"project"`
+
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// Service to enable Azure Private Link for. The service must be in a project VPC in Azure
+	ServiceName string `json:"serviceName"`
+
+	// Service resource of the serviceName. A service in another namespace must be shared with a ReferenceGrant.
+	// Its authSecretRef is used if the resource doesn't set one
+	ServiceRef *ServiceReference `json:"serviceRef,omitempty"`
+
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	// +kubebuilder:validation:XValidation:rule="self.all(s, s.matches('^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$'))",message="Subscription IDs must be UUIDs"
+	// IDs of the Azure subscriptions allowed to create private endpoints to the service
+	SubscriptionIDs []string `json:"subscriptionIds"`
+
+	// Authentication reference to Aiven token in a secret
+	AuthSecretRef AuthSecretReference `json:"authSecretRef,omitempty"`
+}
+
+// AzurePrivateLinkStatus defines the observed state of AzurePrivateLink
+type AzurePrivateLinkStatus struct {
+	// Conditions represent the latest available observations of an AzurePrivateLink state
+	Conditions []metav1.Condition `json:"conditions"`
+
+	// State of the Private Link, e.g. creating or active
+	State string `json:"state,omitempty"`
+
+	// Resource ID of the Private Link service
+	AzureServiceID string `json:"azureServiceId,omitempty"`
+
+	// Alias of the Private Link service. Create the private endpoints to it
+	AzureServiceAlias string `json:"azureServiceAlias,omitempty"`
+
+	// Why the Private Link is not active, if Aiven tells
+	Message string `json:"message,omitempty"`
+
+	// The connections of the private endpoints to the service
+	Connections []AzurePrivateLinkConnection `json:"connections,omitempty"`
+
+	// Link to the service in the Aiven Console
+	ConsoleURL string `json:"consoleURL,omitempty"`
+
+	SyncStatus `json:",inline"`
+}
+
+// AzurePrivateLinkConnection is the connection of a private endpoint to the service
+type AzurePrivateLinkConnection struct {
+	// Aiven ID of the connection
+	ID string `json:"id"`
+
+	// Resource ID of the private endpoint
+	PrivateEndpointID string `json:"privateEndpointId"`
+
+	// State of the connection: pending-user-approval, user-approved, connected or active
+	State string `json:"state"`
+
+	// IP address of the private endpoint
+	UserIPAddress string `json:"userIpAddress,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// AzurePrivateLink is the Schema for the azureprivatelinks API.
+// It enables Azure Private Link for a service, so the allowed subscriptions can connect to it from their virtual networks
+// +kubebuilder:printcolumn:name="Project",type="string",JSONPath=".spec.project"
+// +kubebuilder:printcolumn:name="Service Name",type="string",JSONPath=".spec.serviceName"
+// +kubebuilder:printcolumn:name="State",type="string",JSONPath=".status.state"
+// +kubebuilder:printcolumn:name="Azure Service Alias",type="string",JSONPath=".status.azureServiceAlias"
+type AzurePrivateLink struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AzurePrivateLinkSpec   `json:"spec,omitempty"`
+	Status AzurePrivateLinkStatus `json:"status,omitempty"`
+}
+
+func (in *AzurePrivateLink) AuthSecretRef() AuthSecretReference {
+	return in.Spec.AuthSecretRef
+}
+
+func (in *AzurePrivateLink) GetSyncStatus() *SyncStatus {
+	return &in.Status.SyncStatus
+}
+
+// UpdateConsoleURL sets the link to the service in the Aiven Console
+func (in *AzurePrivateLink) UpdateConsoleURL() {
+	in.Status.ConsoleURL = serviceConsoleURL(in.Spec.Project, in.Spec.ServiceName)
+}
+
+func (in *AzurePrivateLink) GetServiceRef() *ServiceReference {
+	return in.Spec.ServiceRef
+}
+
+func (in *AzurePrivateLink) GetProjectAndServiceName() (string, string) {
+	return in.Spec.Project, in.Spec.ServiceName
+}
+
+func (in *AzurePrivateLink) GetRefs() []*ResourceReferenceObject {
+	if in.Spec.ServiceRef == nil {
+		return nil
+	}
+	return []*ResourceReferenceObject{in.Spec.ServiceRef.Service(in.GetNamespace())}
+}
+
+// +kubebuilder:object:root=true
+
+// AzurePrivateLinkList contains a list of AzurePrivateLink
+type AzurePrivateLinkList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AzurePrivateLink `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&AzurePrivateLink{}, &AzurePrivateLinkList{})
+}
@@ -0,0 +1,135 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AzureVNetPeeringConnectionSpec defines the desired state of AzureVNetPeeringConnection
+// +kubebuilder:validation:XValidation:rule="has(self.vpcId) != has(self.projectVPCRef)",message="Set either vpcId or projectVPCRef"
+type AzureVNetPeeringConnectionSpec struct {
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Format="^[a-zA-Z0-9_-]*$"
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// The project the VPC belongs to
+	Project string `json:"project"`
+
+	// +kubebuilder:validation:MaxLength=36
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// Identifier of the Aiven project VPC
+	VPCID string `json:"vpcId,omitempty"`
+
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// ProjectVPC resource to use its ID as vpcId. The peering connection waits for the VPC to be ACTIVE
+	ProjectVPCRef *ResourceReference `json:"projectVPCRef,omitempty"`
+
+	// +kubebuilder:validation:Pattern="^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$"
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// Azure tenant ID of the peer virtual network
+	AzureTenantID string `json:"azureTenantId"`
+
+	// +kubebuilder:validation:Pattern="^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$"
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// Azure subscription ID of the peer virtual network
+	AzureSubscriptionID string `json:"azureSubscriptionId"`
+
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=90
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// Azure resource group of the peer virtual network
+	ResourceGroup string `json:"resourceGroup"`
+
+	// +kubebuilder:validation:MinLength=2
+	// +kubebuilder:validation:MaxLength=64
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// Name of the peer virtual network
+	VNetName string `json:"vnetName"`
+
+	// +kubebuilder:validation:Pattern="^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$"
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// Application ID of the Azure application that is allowed to peer the virtual network.
+	// Aiven signs in to the tenant with it to create its side of the peering
+	AzureAppID string `json:"azureAppId"`
+
+	// Authentication reference to Aiven token in a secret
+	AuthSecretRef AuthSecretReference `json:"authSecretRef,omitempty"`
+}
+
+// AzureVNetPeeringConnectionStatus defines the observed state of AzureVNetPeeringConnection
+type AzureVNetPeeringConnectionStatus struct {
+	// Conditions represent the latest available observations of an AzureVNetPeeringConnection state.
+	// The PeeringPending condition tells which step of the peering is pending
+	Conditions []metav1.Condition `json:"conditions"`
+
+	// State of the peering connection, e.g. PENDING_PEER or ACTIVE
+	State string `json:"state"`
+
+	// Identifier of the Aiven project VPC of the peering connection
+	VPCID string `json:"vpcId,omitempty"`
+
+	// Resource ID of the Aiven virtual network. Create the peering from the peer virtual network to it
+	ToNetworkID string `json:"toNetworkId,omitempty"`
+
+	// Azure tenant ID of the Aiven virtual network
+	ToTenantID string `json:"toTenantId,omitempty"`
+
+	// What to do to make the peering connection active, or why it failed
+	Message string `json:"message,omitempty"`
+
+	// Link to the VPCs of the project in the Aiven Console
+	ConsoleURL string `json:"consoleURL,omitempty"`
+
+	SyncStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// AzureVNetPeeringConnection is the Schema for the azurevnetpeeringconnections API.
+// It peers an Aiven project VPC with a virtual network in Azure
+// +kubebuilder:printcolumn:name="Project",type="string",JSONPath=".spec.project"
+// +kubebuilder:printcolumn:name="Resource Group",type="string",JSONPath=".spec.resourceGroup"
+// +kubebuilder:printcolumn:name="VNet",type="string",JSONPath=".spec.vnetName"
+// +kubebuilder:printcolumn:name="State",type="string",JSONPath=".status.state"
+// +kubebuilder:printcolumn:name="VPC ID",type="string",JSONPath=".status.vpcId",priority=1
+type AzureVNetPeeringConnection struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AzureVNetPeeringConnectionSpec   `json:"spec,omitempty"`
+	Status AzureVNetPeeringConnectionStatus `json:"status,omitempty"`
+}
+
+func (in *AzureVNetPeeringConnection) AuthSecretRef() AuthSecretReference {
+	return in.Spec.AuthSecretRef
+}
+
+func (in *AzureVNetPeeringConnection) GetSyncStatus() *SyncStatus {
+	return &in.Status.SyncStatus
+}
+
+// UpdateConsoleURL sets the link to the VPCs of the project in the Aiven Console
+func (in *AzureVNetPeeringConnection) UpdateConsoleURL() {
+	in.Status.ConsoleURL = consoleURL("project", in.Spec.Project, "vpcs")
+}
+
+func (in *AzureVNetPeeringConnection) GetRefs() []*ResourceReferenceObject {
+	if in.Spec.ProjectVPCRef == nil {
+		return nil
+	}
+	return []*ResourceReferenceObject{in.Spec.ProjectVPCRef.ProjectVPC(in.GetNamespace())}
+}
+
+// +kubebuilder:object:root=true
+
+// AzureVNetPeeringConnectionList contains a list of AzureVNetPeeringConnection
+type AzureVNetPeeringConnectionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AzureVNetPeeringConnection `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&AzureVNetPeeringConnection{}, &AzureVNetPeeringConnectionList{})
+}
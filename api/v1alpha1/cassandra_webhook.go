@@ -4,11 +4,15 @@ package v1alpha1
 
 import (
 	"errors"
+	"fmt"
+	"strconv"
 
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
+
+	cassandrauserconfig "github.com/aiven/aiven-operator/api/v1alpha1/userconfigs/cassandra"
 )
 
 // log is for logging in this package.
@@ -51,6 +55,21 @@ func (in *Cassandra) ValidateUpdate(old runtime.Object) error {
 		return errors.New("cannot update a Cassandra service, connInfoSecretTarget.name field is immutable and cannot be updated")
 	}
 
+	err := ValidateDownsize(in, old.(*Cassandra).Status.DiskSpace, old.(*Cassandra).Spec.Plan, in.Spec.Plan, old.(*Cassandra).Spec.DiskSpace, in.Spec.DiskSpace)
+	if err != nil {
+		return err
+	}
+
+	err = ValidateAdditionalDiskDownsize(in, old.(*Cassandra).Spec.AdditionalDiskSpace, in.Spec.AdditionalDiskSpace)
+	if err != nil {
+		return err
+	}
+
+	err = validateCassandraUpgrade(old.(*Cassandra).Spec.UserConfig, in.Spec.UserConfig)
+	if err != nil {
+		return err
+	}
+
 	return in.Spec.Validate()
 }
 
@@ -64,3 +83,32 @@ func (in *Cassandra) ValidateDelete() error {
 
 	return nil
 }
+
+// validateCassandraUpgrade rejects the version changes Aiven can't do:
+// downgrades, and upgrades while the service is in the sstableloader migration mode.
+// The migration mode itself can only be enabled on creation
+func validateCassandraUpgrade(old, new *cassandrauserconfig.CassandraUserConfig) error {
+	if new == nil {
+		return nil
+	}
+
+	oldMigrate := old != nil && old.MigrateSstableloader != nil && *old.MigrateSstableloader
+	newMigrate := new.MigrateSstableloader != nil && *new.MigrateSstableloader
+	if newMigrate && !oldMigrate {
+		return errors.New("cannot update a Cassandra service, migrate_sstableloader can only be enabled when the service is created")
+	}
+
+	if old == nil || old.CassandraVersion == nil || new.CassandraVersion == nil {
+		return nil
+	}
+
+	oldVersion, _ := strconv.Atoi(*old.CassandraVersion)
+	newVersion, _ := strconv.Atoi(*new.CassandraVersion)
+	switch {
+	case newVersion < oldVersion:
+		return fmt.Errorf("cannot update a Cassandra service, cassandra_version can't be downgraded from %d to %d", oldVersion, newVersion)
+	case newVersion > oldVersion && newMigrate:
+		return errors.New("cannot update a Cassandra service, disable migrate_sstableloader when the migration is done before upgrading cassandra_version")
+	}
+	return nil
+}
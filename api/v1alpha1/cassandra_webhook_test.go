@@ -0,0 +1,49 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package v1alpha1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	cassandrauserconfig "github.com/aiven/aiven-operator/api/v1alpha1/userconfigs/cassandra"
+)
+
+func TestValidateCassandraUpgrade(t *testing.T) {
+	config := func(version string, migrate bool) *cassandrauserconfig.CassandraUserConfig {
+		c := &cassandrauserconfig.CassandraUserConfig{MigrateSstableloader: &migrate}
+		if version != "" {
+			c.CassandraVersion = &version
+		}
+		return c
+	}
+
+	cases := []struct {
+		name     string
+		old, new *cassandrauserconfig.CassandraUserConfig
+		valid    bool
+	}{
+		{name: "no config", old: nil, new: nil, valid: true},
+		{name: "same version", old: config("4", false), new: config("4", false), valid: true},
+		{name: "upgrade", old: config("3", false), new: config("4", false), valid: true},
+		{name: "downgrade", old: config("4", false), new: config("3", false), valid: false},
+		{name: "version set later", old: config("", false), new: config("4", false), valid: true},
+		{name: "enables migration", old: config("4", false), new: config("4", true), valid: false},
+		{name: "enables migration on config added", old: nil, new: config("4", true), valid: false},
+		{name: "keeps migration", old: config("3", true), new: config("3", true), valid: true},
+		{name: "disables migration", old: config("3", true), new: config("3", false), valid: true},
+		{name: "upgrade while migrating", old: config("3", true), new: config("4", true), valid: false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := validateCassandraUpgrade(c.old, c.new)
+			if c.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 )
 
 // ClickhouseSpec defines the desired state of Clickhouse
+// +kubebuilder:validation:XValidation:rule="!has(self.disk_space) || !has(self.additionalDiskSpace)",message="disk_space and additionalDiskSpace are mutually exclusive"
 type ClickhouseSpec struct {
 	ServiceCommonSpec `json:",inline"`
 
@@ -16,6 +17,12 @@ type ClickhouseSpec struct {
 	// The disk space of the service, possible values depend on the service type, the cloud provider and the project. Reducing will result in the service re-balancing.
 	DiskSpace string `json:"disk_space,omitempty"`
 
+	// +kubebuilder:validation:Format="^[1-9][0-9]*(GiB|G)*"
+	// The disk space added to the disk space of the plan, e.g. 30GiB. Must be a multiple of the disk space step of the plan,
+	// and fit its maximum disk space. The disk space of the plan and the additional one are in status.diskSpace.
+	// Can't be set with disk_space. Reducing will result in the service re-balancing.
+	AdditionalDiskSpace string `json:"additionalDiskSpace,omitempty"`
+
 	// Authentication reference to Aiven token in a secret
 	AuthSecretRef AuthSecretReference `json:"authSecretRef,omitempty"`
 
@@ -30,6 +37,8 @@ type ClickhouseSpec struct {
 //+kubebuilder:subresource:status
 
 // Clickhouse is the Schema for the clickhouses API
+// +kubebuilder:printcolumn:name="Endpoint",type="string",JSONPath=".status.connectionInfo.endpoint"
+// +kubebuilder:validation:XValidation:rule="size(self.metadata.name) <= 63 && self.metadata.name.matches('^[a-z][-a-z0-9]*$')",message="Service name must be at most 63 characters of lowercase letters, digits and dashes, and start with a letter"
 type Clickhouse struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
@@ -51,6 +60,15 @@ func (in *Clickhouse) AuthSecretRef() AuthSecretReference {
 	return in.Spec.AuthSecretRef
 }
 
+func (in *Clickhouse) GetSyncStatus() *SyncStatus {
+	return &in.Status.SyncStatus
+}
+
+// UpdateConsoleURL sets the link to the service in the Aiven Console
+func (in *Clickhouse) UpdateConsoleURL() {
+	in.Status.ConsoleURL = serviceConsoleURL(in.Spec.Project, in.Name, "overview")
+}
+
 func (in *Clickhouse) GetRefs() []*ResourceReferenceObject {
 	return in.Spec.GetRefs(in.GetNamespace())
 }
@@ -52,6 +52,16 @@ func (r *Clickhouse) ValidateUpdate(old runtime.Object) error {
 		return errors.New("cannot update a Clickhouse service, connInfoSecretTarget.name field is immutable and cannot be updated")
 	}
 
+	err := ValidateDownsize(r, old.(*Clickhouse).Status.DiskSpace, old.(*Clickhouse).Spec.Plan, r.Spec.Plan, old.(*Clickhouse).Spec.DiskSpace, r.Spec.DiskSpace)
+	if err != nil {
+		return err
+	}
+
+	err = ValidateAdditionalDiskDownsize(r, old.(*Clickhouse).Spec.AdditionalDiskSpace, r.Spec.AdditionalDiskSpace)
+	if err != nil {
+		return err
+	}
+
 	return r.Spec.Validate()
 }
 
@@ -0,0 +1,153 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ClickhouseGrantSpec defines the desired state of ClickhouseGrant
+type ClickhouseGrantSpec struct {
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Format="^[a-zA-Z0-9_-]*$"
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// Project to link the grants to
+	Project string `json:"project"`
+
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// Service to link the grants to
+	ServiceName string `json:"serviceName"`
+
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// The user or the role the privileges and the roles are granted to.
+	// The resource manages all the grants of the grantee, the ones that are not listed are revoked
+	Grantee ClickhouseGrantee `json:"grantee"`
+
+	// +kubebuilder:validation:MaxItems=256
+	// Privileges granted on the databases and the tables
+	PrivilegeGrants []ClickhousePrivilegeGrant `json:"privilegeGrants,omitempty"`
+
+	// +kubebuilder:validation:MaxItems=256
+	// Roles granted to the grantee
+	RoleGrants []ClickhouseRoleGrant `json:"roleGrants,omitempty"`
+
+	// +kubebuilder:validation:MaxItems=256
+	// ClickhouseRole resources of the roles the grant uses, e.g. the grantee role or the granted roles.
+	// The grants are applied once the roles are created
+	RoleRefs []ResourceReference `json:"roleRefs,omitempty"`
+
+	// Authentication reference to Aiven token in a secret
+	AuthSecretRef AuthSecretReference `json:"authSecretRef,omitempty"`
+}
+
+// ClickhouseGrantee is a user or a role of the service
+// +kubebuilder:validation:XValidation:rule="has(self.user) != has(self.role)",message="Exactly one of user or role must be set"
+type ClickhouseGrantee struct {
+	// +kubebuilder:validation:MaxLength=64
+	// Name of the user
+	User string `json:"user,omitempty"`
+
+	// +kubebuilder:validation:MaxLength=255
+	// Name of the role
+	Role string `json:"role,omitempty"`
+}
+
+// ClickhousePrivilegeGrant grants a privilege on a database or a table
+// +kubebuilder:validation:XValidation:rule="self.database != '*' || ((!has(self.table) || self.table == '*') && !has(self.columns))",message="A privilege on all the databases can't have a table or columns"
+type ClickhousePrivilegeGrant struct {
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=64
+	// +kubebuilder:validation:Pattern="^[A-Za-z]+( [A-Za-z]+)*$"
+	// Privilege as named in the system.grants table, e.g. SELECT, INSERT or ALTER UPDATE
+	Privilege string `json:"privilege"`
+
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=255
+	// Database the privilege is granted on, * for all the databases, e.g. for the global privileges like CREATE USER
+	Database string `json:"database"`
+
+	// +kubebuilder:validation:MaxLength=255
+	// Table the privilege is granted on, all the tables of the database if not set
+	Table string `json:"table,omitempty"`
+
+	// Columns the privilege is granted on, all the columns of the table if not set
+	Columns []string `json:"columns,omitempty"`
+
+	// Allows the grantee to grant the privilege to the others
+	WithGrantOption bool `json:"withGrantOption,omitempty"`
+}
+
+// ClickhouseRoleGrant grants a role
+type ClickhouseRoleGrant struct {
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=255
+	// Name of the role
+	Role string `json:"role"`
+
+	// Allows the grantee to grant the role to the others
+	WithAdminOption bool `json:"withAdminOption,omitempty"`
+}
+
+// ClickhouseGrantStatus defines the observed state of ClickhouseGrant
+type ClickhouseGrantStatus struct {
+	// Conditions represent the latest available observations of an ClickhouseGrant state
+	Conditions []metav1.Condition `json:"conditions"`
+
+	// Link to the users of the service in the Aiven Console
+	ConsoleURL string `json:"consoleURL,omitempty"`
+
+	SyncStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// ClickhouseGrant is the Schema for the clickhousegrants API.
+// It manages the privileges and the roles granted to a user or a role of a ClickHouse service
+// +kubebuilder:printcolumn:name="Service Name",type="string",JSONPath=".spec.serviceName"
+// +kubebuilder:printcolumn:name="Project",type="string",JSONPath=".spec.project"
+// +kubebuilder:printcolumn:name="User",type="string",JSONPath=".spec.grantee.user"
+// +kubebuilder:printcolumn:name="Role",type="string",JSONPath=".spec.grantee.role"
+type ClickhouseGrant struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ClickhouseGrantSpec   `json:"spec,omitempty"`
+	Status ClickhouseGrantStatus `json:"status,omitempty"`
+}
+
+func (in *ClickhouseGrant) AuthSecretRef() AuthSecretReference {
+	return in.Spec.AuthSecretRef
+}
+
+func (in *ClickhouseGrant) GetSyncStatus() *SyncStatus {
+	return &in.Status.SyncStatus
+}
+
+// UpdateConsoleURL sets the link to the users of the service in the Aiven Console
+func (in *ClickhouseGrant) UpdateConsoleURL() {
+	in.Status.ConsoleURL = serviceConsoleURL(in.Spec.Project, in.Spec.ServiceName, "users")
+}
+
+// GetRefs returns the ClickhouseRoles the grant waits for
+func (in *ClickhouseGrant) GetRefs() []*ResourceReferenceObject {
+	refs := make([]*ResourceReferenceObject, 0, len(in.Spec.RoleRefs))
+	for i := range in.Spec.RoleRefs {
+		refs = append(refs, in.Spec.RoleRefs[i].ClickhouseRole(in.GetNamespace()))
+	}
+	return refs
+}
+
+// +kubebuilder:object:root=true
+
+// ClickhouseGrantList contains a list of ClickhouseGrant
+type ClickhouseGrantList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClickhouseGrant `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ClickhouseGrant{}, &ClickhouseGrantList{})
+}
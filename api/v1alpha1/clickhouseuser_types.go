@@ -36,6 +36,11 @@ type ClickhouseUserStatus struct {
 	// Conditions represent the latest available observations of an ClickhouseUser state
 	// +kubebuilder:validation:type=array
 	Conditions []metav1.Condition `json:"conditions"`
+
+	// Link to the users of the service in the Aiven Console
+	ConsoleURL string `json:"consoleURL,omitempty"`
+
+	SyncStatus `json:",inline"`
 }
 
 //+kubebuilder:object:root=true
@@ -45,6 +50,7 @@ type ClickhouseUserStatus struct {
 // +kubebuilder:printcolumn:name="Service Name",type="string",JSONPath=".spec.serviceName"
 // +kubebuilder:printcolumn:name="Project",type="string",JSONPath=".spec.project"
 // +kubebuilder:printcolumn:name="Connection Information Secret",type="string",JSONPath=".spec.connInfoSecretTarget.name"
+// +kubebuilder:validation:XValidation:rule="size(self.metadata.name) <= 64 && self.metadata.name.matches('^[a-zA-Z0-9_][a-zA-Z0-9_.-]*$')",message="User name must be at most 64 characters of letters, digits, dots, underscores and dashes, and not start with a dot or a dash"
 type ClickhouseUser struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
@@ -57,6 +63,15 @@ func (u ClickhouseUser) AuthSecretRef() AuthSecretReference {
 	return u.Spec.AuthSecretRef
 }
 
+func (u *ClickhouseUser) GetSyncStatus() *SyncStatus {
+	return &u.Status.SyncStatus
+}
+
+// UpdateConsoleURL sets the link to the users of the service in the Aiven Console
+func (u *ClickhouseUser) UpdateConsoleURL() {
+	u.Status.ConsoleURL = serviceConsoleURL(u.Spec.Project, u.Spec.ServiceName, "users")
+}
+
 //+kubebuilder:object:root=true
 
 // ClickhouseUserList contains a list of ClickhouseUser
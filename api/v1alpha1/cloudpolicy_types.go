@@ -0,0 +1,53 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package v1alpha1
+
+import (
+	"path"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// CloudPolicySpec defines the desired state of CloudPolicy
+type CloudPolicySpec struct {
+	// +kubebuilder:validation:MinItems=1
+	// Clouds the services of the namespace may use, e.g. google-europe-west1.
+	// The `*` wildcard matches any part of the name, e.g. aws-eu-* or *-europe-*
+	AllowedClouds []string `json:"allowedClouds"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:printcolumn:name="Allowed Clouds",type="string",JSONPath=".spec.allowedClouds"
+
+// CloudPolicy is the Schema for the cloudpolicies API.
+// It limits the clouds the services of its namespace may use, e.g. for data residency.
+// A service must be allowed by every policy of its namespace
+type CloudPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec CloudPolicySpec `json:"spec,omitempty"`
+}
+
+// Allows returns true if the cloud matches one of the allowed clouds
+func (in *CloudPolicy) Allows(cloud string) bool {
+	for _, pattern := range in.Spec.AllowedClouds {
+		if ok, _ := path.Match(pattern, cloud); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// +kubebuilder:object:root=true
+
+// CloudPolicyList contains a list of CloudPolicy
+type CloudPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CloudPolicy `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&CloudPolicy{}, &CloudPolicyList{})
+}
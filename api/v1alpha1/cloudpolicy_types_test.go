@@ -0,0 +1,36 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package v1alpha1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCloudPolicyAllows(t *testing.T) {
+	policy := &CloudPolicy{
+		Spec: CloudPolicySpec{
+			AllowedClouds: []string{"google-europe-west1", "aws-eu-*", "*-europe-north1"},
+		},
+	}
+
+	cases := []struct {
+		cloud  string
+		allows bool
+	}{
+		{cloud: "google-europe-west1", allows: true},
+		{cloud: "google-europe-west4", allows: false},
+		{cloud: "aws-eu-central-1", allows: true},
+		{cloud: "aws-us-east-1", allows: false},
+		{cloud: "google-europe-north1", allows: true},
+		{cloud: "azure-europe-north1", allows: true},
+		{cloud: "", allows: false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.cloud, func(t *testing.T) {
+			assert.Equal(t, c.allows, policy.Allows(c.cloud))
+		})
+	}
+}
@@ -3,7 +3,10 @@ package v1alpha1
 import (
 	"errors"
 	"fmt"
+	"net/url"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/docker/go-units"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -26,10 +29,45 @@ func (r AuthSecretReference) IsValid() bool {
 	return len(r.Name) > 0 && len(r.Key) > 0
 }
 
+// SecretKeyReference references a key of a Secret in the same namespace
+type SecretKeyReference struct {
+	// +kubebuilder:validation:MinLength=1
+	// Name of the Secret
+	Name string `json:"name"`
+
+	// +kubebuilder:validation:MinLength=1
+	// Key of the value in the Secret
+	Key string `json:"key"`
+}
+
 // ConnInfoSecretTarget contains information secret name
 type ConnInfoSecretTarget struct {
 	// Name of the Secret resource to be created
 	Name string `json:"name"`
+
+	// Don't embed the project CA certificate into the secret.
+	// Use the CA bundle maintained by the Project kind instead
+	OmitCACert bool `json:"omitCaCert,omitempty"`
+
+	// Also stores the client certificate under the `tls.crt`, `tls.key` and `ca.crt` keys,
+	// the same way cert-manager does, so existing mounting conventions work unchanged
+	TLSKeys bool `json:"tlsKeys,omitempty"`
+
+	// +kubebuilder:validation:Enum=clientProperties;librdkafka;pgpass
+	// Also stores the credentials as a ready to use client configuration, only applicable to ServiceUser.
+	// `clientProperties` adds Kafka Java client `client.properties` key, `librdkafka` adds `librdkafka.json` key
+	// with librdkafka configuration properties, `pgpass` adds PostgreSQL `.pgpass` key
+	Format string `json:"format,omitempty"`
+
+	// Stores the certificates and keys in a separate Secret with this name, only applicable to Kafka and ServiceUser.
+	// Keeps each Secret small and allows granting access to the credentials and to the certificates separately
+	CertSecretName string `json:"certSecretName,omitempty"`
+}
+
+// ConfigMapTarget contains information ConfigMap name
+type ConfigMapTarget struct {
+	// Name of the ConfigMap resource to be created
+	Name string `json:"name"`
 }
 
 // ServiceStatus defines the observed state of service
@@ -39,6 +77,189 @@ type ServiceStatus struct {
 
 	// Service state
 	State string `json:"state"`
+
+	// Connection information summary, the credentials are kept in the connection secret only
+	ConnectionInfo *ServiceConnectionInfo `json:"connectionInfo,omitempty"`
+
+	// Progress of the data migration or rebalance in percent, e.g. during a version or plan change.
+	// Not set when there is none
+	MigrationProgress *int `json:"migrationProgress,omitempty"`
+
+	// The latest operation requested from Aiven, e.g. a fork, migration or upgrade
+	LastOperation *ServiceOperation `json:"lastOperation,omitempty"`
+
+	// +kubebuilder:validation:MaxItems=10
+	// The latest requests that changed the service on Aiven side, the oldest first
+	AppliedRequests []AppliedRequest `json:"appliedRequests,omitempty"`
+
+	// Link to the service in the Aiven Console
+	ConsoleURL string `json:"consoleURL,omitempty"`
+
+	// Cloud the service runs in
+	CloudName string `json:"cloudName,omitempty"`
+
+	// The plan and user config changes are postponed until the time because of a maintenance freeze
+	ChangesFrozenUntil *metav1.Time `json:"changesFrozenUntil,omitempty"`
+
+	// The end of the current or the next maintenance window of the service.
+	// The operator resyncs the service right after it, to pick up the changes of the maintenance
+	MaintenanceWindowEnd *metav1.Time `json:"maintenanceWindowEnd,omitempty"`
+
+	// The custom cloud (BYOC) the service runs in, not set for the Aiven clouds
+	CustomCloud *ServiceCustomCloud `json:"customCloud,omitempty"`
+
+	// The disk space of the plan and the additional disk space of the service
+	DiskSpace *ServiceDiskSpace `json:"diskSpace,omitempty"`
+
+	// The changes the operator would make on Aiven side to match the spec, e.g. after the service was changed in the Aiven Console.
+	// The operator applies them on the next spec change only, or when the aiven.io/reconcile-now annotation is set
+	Diff *ServiceDiff `json:"diff,omitempty"`
+
+	// The notifications Aiven shows for the service, e.g. the end of life of its version
+	Notifications []ServiceNotification `json:"notifications,omitempty"`
+
+	SyncStatus `json:",inline"`
+}
+
+// ServiceDiff lists the fields that differ between the spec and the service on Aiven side.
+// The secret values are redacted
+type ServiceDiff struct {
+	// The fields that differ, up to 20 of them
+	Fields []ServiceFieldDiff `json:"fields"`
+
+	// Number of the fields that differ, it is greater than the number of the listed ones when the list is truncated
+	Total int `json:"total"`
+}
+
+// ServiceFieldDiff is a field that differs between the spec and the service on Aiven side
+type ServiceFieldDiff struct {
+	// The field, e.g. plan or user_config.pg.work_mem
+	Field string `json:"field"`
+
+	// The value in the spec as JSON, truncated
+	Declared string `json:"declared"`
+
+	// The value on Aiven side as JSON, truncated
+	Actual string `json:"actual"`
+}
+
+// ServiceCustomCloud is a cloud the customer brings to Aiven (BYOC)
+type ServiceCustomCloud struct {
+	// Name of the custom cloud
+	CloudName string `json:"cloudName"`
+
+	// Description of the custom cloud, e.g. its provider and region
+	Description string `json:"description,omitempty"`
+
+	// Cloud provider, e.g. aws or google
+	Provider string `json:"provider,omitempty"`
+
+	// Geographical region, e.g. europe
+	GeoRegion string `json:"geoRegion,omitempty"`
+}
+
+// ServiceDiskSpace is the disk space of the service, split into the disk space of the plan and the additional one
+type ServiceDiskSpace struct {
+	// The plan the disk space of the plan is of
+	Plan string `json:"plan"`
+
+	// The disk space the plan comes with, in MB
+	PlanMB int `json:"planMB"`
+
+	// The disk space added to the one of the plan, in MB
+	AdditionalMB int `json:"additionalMB"`
+
+	// The disk space of the service, in MB
+	TotalMB int `json:"totalMB"`
+}
+
+// SyncStatus tells when the operator last reconciled the resource successfully,
+// so the resources that haven't synced for a while can be found
+type SyncStatus struct {
+	// Time of the last successful reconciliation
+	LastSyncTime *metav1.Time `json:"lastSyncTime,omitempty"`
+
+	// Duration of the last successful reconciliation, e.g. 1.5s
+	LastSyncDuration string `json:"lastSyncDuration,omitempty"`
+
+	// Version of the operator that did the last successful reconciliation
+	OperatorVersion string `json:"operatorVersion,omitempty"`
+}
+
+// ServiceOperation identifies an operation requested from Aiven, so it can be correlated with Aiven support tooling
+type ServiceOperation struct {
+	// +kubebuilder:validation:Enum=create;fork;update;migration;upgrade
+	// Operation type
+	Type string `json:"type"`
+
+	// Identifier of the Aiven API request, if the API returned one
+	RequestID string `json:"requestId,omitempty"`
+
+	// Time the operation was requested
+	Time metav1.Time `json:"time"`
+}
+
+// ServiceNotification is a notice about the service from Aiven
+type ServiceNotification struct {
+	// Type of the notification, e.g. service_end_of_life
+	Type string `json:"type"`
+
+	// Level of the notification, e.g. notice or warning
+	Level string `json:"level"`
+
+	// Message of the notification
+	Message string `json:"message"`
+}
+
+// AppliedRequest is a request the operator sent to Aiven to change the service
+type AppliedRequest struct {
+	// Time the request was sent
+	Time metav1.Time `json:"time"`
+
+	// Generation of the resource the request applies
+	Generation int64 `json:"generation"`
+
+	// HTTP method of the request
+	Method string `json:"method"`
+
+	// Path of the request, e.g. /v1/project/my-project/service/my-pg
+	Path string `json:"path"`
+
+	// SHA-256 of the request body. The same hash means the same config was sent
+	Hash string `json:"hash,omitempty"`
+
+	// Identifier of the Aiven API request, if the API returned one
+	RequestID string `json:"requestId,omitempty"`
+}
+
+// ServiceConnectionInfo describes how to connect to the service
+type ServiceConnectionInfo struct {
+	// Host and port of the service
+	Endpoint string `json:"endpoint,omitempty"`
+
+	// Scheme of the service URI, e.g. postgres or rediss
+	Scheme string `json:"scheme,omitempty"`
+
+	// Number of the service components, like the schema registry or the REST API of Kafka
+	Components int `json:"components,omitempty"`
+
+	// Hosts and ports of the service components
+	Endpoints []ServiceEndpoint `json:"endpoints,omitempty"`
+}
+
+// ServiceEndpoint is a host and port a service component listens on
+type ServiceEndpoint struct {
+	// Component name, e.g. kafka or schema_registry
+	Component string `json:"component"`
+
+	// Host name of the component
+	Host string `json:"host"`
+
+	// Port of the component
+	Port int `json:"port"`
+
+	// Network route of the endpoint, e.g. dynamic, public or privatelink
+	Route string `json:"route,omitempty"`
 }
 
 type ServiceCommonSpec struct {
@@ -53,9 +274,15 @@ type ServiceCommonSpec struct {
 	Plan string `json:"plan,omitempty"`
 
 	// +kubebuilder:validation:MaxLength=256
-	// Cloud the service runs in.
+	// Cloud the service runs in. The custom clouds (BYOC) of the project start with "custom-"
 	CloudName string `json:"cloudName,omitempty"`
 
+	// +kubebuilder:validation:MaxItems=10
+	// Clouds to create the service in, in the order of preference, when the plan is not available in cloudName,
+	// e.g. because of the capacity issues of a region. The service stays in any of the clouds once created.
+	// The cloud the service runs in is in status.cloudName
+	CloudFallbacks []string `json:"cloudFallbacks,omitempty"`
+
 	// +kubebuilder:validation:MaxLength=36
 	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
 	// Identifier of the VPC the service should be in, if any.
@@ -65,6 +292,10 @@ type ServiceCommonSpec struct {
 	// ProjectVPCRef reference to ProjectVPC resource to use its ID as ProjectVPCID automatically
 	ProjectVPCRef *ResourceReference `json:"projectVPCRef,omitempty"`
 
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// OrganizationVPCRef reference to OrganizationVPC resource to use its ID as ProjectVPCID automatically
+	OrganizationVPCRef *ResourceReference `json:"organizationVPCRef,omitempty"`
+
 	// +kubebuilder:validation:Enum=monday;tuesday;wednesday;thursday;friday;saturday;sunday
 	// Day of week when maintenance operations should be performed. One monday, tuesday, wednesday, etc.
 	MaintenanceWindowDow string `json:"maintenanceWindowDow,omitempty"`
@@ -79,9 +310,86 @@ type ServiceCommonSpec struct {
 	// Tags are key-value pairs that allow you to categorize services.
 	Tags map[string]string `json:"tags,omitempty"`
 
+	// Team owning the service. It is added to the tags of the service and to the events of the resource,
+	// so the alerts can be routed to the owner
+	Owner *ServiceOwner `json:"owner,omitempty"`
+
 	// +kubebuilder:validation:MaxItems=1
 	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
 	ServiceIntegrations []*ServiceIntegrationItem `json:"serviceIntegrations,omitempty"`
+
+	// Time ranges the plan and user config changes, like version upgrades, are postponed in, e.g. the end of a quarter.
+	// The other changes are applied as usual
+	MaintenanceFreeze []MaintenanceFreeze `json:"maintenanceFreeze,omitempty"`
+}
+
+// ServiceOwner is the contact of the team owning the service
+type ServiceOwner struct {
+	// +kubebuilder:validation:MaxLength=64
+	// +kubebuilder:validation:Pattern="^[a-zA-Z0-9_-]+$"
+	// Name of the team
+	Team string `json:"team"`
+
+	// +kubebuilder:validation:MaxLength=64
+	// +kubebuilder:validation:Pattern="^#[a-z0-9_-]+$"
+	// Slack channel of the team, e.g. #team-payments
+	Slack string `json:"slack,omitempty"`
+
+	// +kubebuilder:validation:MaxLength=64
+	// +kubebuilder:validation:Pattern="^[^@ ]+@[^@ ]+$"
+	// Email of the team
+	Email string `json:"email,omitempty"`
+}
+
+// Tags returns the tags of the owner, added to the tags of the service
+func (in *ServiceOwner) Tags() map[string]string {
+	tags := map[string]string{"owner-team": in.Team}
+	if in.Slack != "" {
+		tags["owner-slack"] = in.Slack
+	}
+	if in.Email != "" {
+		tags["owner-email"] = in.Email
+	}
+	return tags
+}
+
+// String returns the contacts of the owner, e.g. "team payments, slack #payments"
+func (in *ServiceOwner) String() string {
+	s := "team " + in.Team
+	if in.Slack != "" {
+		s += ", slack " + in.Slack
+	}
+	if in.Email != "" {
+		s += ", email " + in.Email
+	}
+	return s
+}
+
+// MaintenanceFreeze is a time range the operator doesn't change the plan and the user config of the service in
+type MaintenanceFreeze struct {
+	// Start of the freeze, e.g. 2022-12-15T00:00:00Z
+	Start metav1.Time `json:"start"`
+
+	// End of the freeze, the postponed changes are applied after it
+	End metav1.Time `json:"end"`
+
+	// +kubebuilder:validation:MaxLength=256
+	// Why the changes are frozen, shown in the ChangesFrozen condition
+	Reason string `json:"reason,omitempty"`
+}
+
+// ActiveMaintenanceFreeze returns the freeze the time is in, the one that ends last if they overlap
+func (in *ServiceCommonSpec) ActiveMaintenanceFreeze(now time.Time) *MaintenanceFreeze {
+	var active *MaintenanceFreeze
+	for i, f := range in.MaintenanceFreeze {
+		if now.Before(f.Start.Time) || !now.Before(f.End.Time) {
+			continue
+		}
+		if active == nil || f.End.After(active.End.Time) {
+			active = &in.MaintenanceFreeze[i]
+		}
+	}
+	return active
 }
 
 // Validate runs complex validation on ServiceCommonSpec
@@ -90,6 +398,9 @@ func (in *ServiceCommonSpec) Validate() error {
 	if in.ProjectVPCID != "" && in.ProjectVPCRef != nil {
 		return fmt.Errorf("please set ProjectVPCID or ProjectVPCRef, not both")
 	}
+	if in.OrganizationVPCRef != nil && (in.ProjectVPCID != "" || in.ProjectVPCRef != nil) {
+		return fmt.Errorf("please set OrganizationVPCRef or ProjectVPCID or ProjectVPCRef, not several")
+	}
 	return nil
 }
 
@@ -98,6 +409,9 @@ func (in *ServiceCommonSpec) GetRefs(namespace string) (refs []*ResourceReferenc
 	if in.ProjectVPCRef != nil {
 		refs = append(refs, in.ProjectVPCRef.ProjectVPC(namespace))
 	}
+	if in.OrganizationVPCRef != nil {
+		refs = append(refs, in.OrganizationVPCRef.OrganizationVPC(namespace))
+	}
 	return refs
 }
 
@@ -136,11 +450,61 @@ func (in *ResourceReference) ref(kind string, objNamespace string) *ResourceRefe
 	}
 }
 
+// ClickhouseRole returns reference ClickhouseRole kind
+func (in *ResourceReference) ClickhouseRole(objNamespace string) *ResourceReferenceObject {
+	return in.ref("ClickhouseRole", objNamespace)
+}
+
+// FlinkApplication returns reference FlinkApplication kind
+func (in *ResourceReference) FlinkApplication(objNamespace string) *ResourceReferenceObject {
+	return in.ref("FlinkApplication", objNamespace)
+}
+
 // ProjectVPC returns reference ProjectVPC kind
 func (in *ResourceReference) ProjectVPC(objNamespace string) *ResourceReferenceObject {
 	return in.ref("ProjectVPC", objNamespace)
 }
 
+// OrganizationVPC returns reference OrganizationVPC kind
+func (in *ResourceReference) OrganizationVPC(objNamespace string) *ResourceReferenceObject {
+	return in.ref("OrganizationVPC", objNamespace)
+}
+
+// ServiceReference refers to a service resource, the resource referring to it waits for the service to be running.
+// A service in another namespace must be shared with a ReferenceGrant in its namespace
+type ServiceReference struct {
+	// +kubebuilder:validation:Enum=Cassandra;Clickhouse;Dragonfly;Grafana;Kafka;KafkaConnect;M3Aggregator;M3DB;MySQL;OpenSearch;PostgreSQL;Redis;Thanos;Valkey
+	// Kind of the service
+	Kind string `json:"kind"`
+
+	// +kubebuilder:validation:MinLength=1
+	// Name of the service resource
+	Name string `json:"name"`
+
+	// +kubebuilder:validation:MinLength=1
+	// Namespace of the service resource, the namespace of the referring resource by default
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// Service returns reference to the service kind
+func (in *ServiceReference) Service(objNamespace string) *ResourceReferenceObject {
+	r := ResourceReference{Name: in.Name, Namespace: in.Namespace}
+	return r.ref(in.Kind, objNamespace)
+}
+
+// validateKind returns an error if the referenced service is not of the kinds the resource is applicable to
+func (in *ServiceReference) validateKind(kinds ...string) error {
+	if in == nil {
+		return nil
+	}
+	for _, k := range kinds {
+		if in.Kind == k {
+			return nil
+		}
+	}
+	return fmt.Errorf("serviceRef kind must be one of %s, got %q", strings.Join(kinds, ", "), in.Kind)
+}
+
 // ResourceReferenceObject is a composite "key" to resource
 // GroupVersionKind is for resource "type": GroupVersionKind{Group: "aiven.io", Version: "v1alpha1", Kind: "Kafka"}
 // NamespacedName is for specific instance: NamespacedName{Name: "my-kafka", Namespace: "default"}
@@ -149,6 +513,125 @@ type ResourceReferenceObject struct {
 	NamespacedName   types.NamespacedName
 }
 
+// aivenConsoleURL is the base URL of the Aiven Console links in the statuses
+const aivenConsoleURL = "https://console.aiven.io"
+
+// consoleURL joins the escaped path segments to the Aiven Console URL
+func consoleURL(segments ...string) string {
+	u := aivenConsoleURL
+	for _, s := range segments {
+		u += "/" + url.PathEscape(s)
+	}
+	return u
+}
+
+// serviceConsoleURL links to a page of the service, e.g. its topics
+func serviceConsoleURL(project, service string, page ...string) string {
+	return consoleURL(append([]string{"project", project, "services", service}, page...)...)
+}
+
+// ConfirmDownsizeAnnotation confirms an update that reduces the service plan or disk space.
+// The operator removes it once the update is applied, so every downsize is confirmed on its own
+const ConfirmDownsizeAnnotation = "aiven.io/confirm-downsize"
+
+// servicePlanTiers orders the plan tiers, the lower tiers have fewer nodes and less or no high availability
+var servicePlanTiers = map[string]int{
+	"hobbyist": 0,
+	"startup":  1,
+	"business": 2,
+	"premium":  3,
+}
+
+// parseServicePlan splits the plan name into the tier, node count and size: "business-4", "premium-6x-16".
+// The node count is 0 when the name doesn't tell it
+func parseServicePlan(name string) (tier string, nodes, size int) {
+	parts := strings.Split(name, "-")
+	for _, p := range parts[1:] {
+		if n, err := strconv.Atoi(strings.TrimSuffix(p, "x")); err == nil {
+			if strings.HasSuffix(p, "x") {
+				nodes = n
+			} else {
+				size = n
+			}
+		}
+	}
+	return parts[0], nodes, size
+}
+
+// DownsizeImpact describes how the update of the plan and disk space reduces the service,
+// empty if it doesn't.
+// The webhooks can't call Aiven API, so this is a heuristic: the plans are compared by their names,
+// and a plan with a name that doesn't follow the tier-size pattern is always reported.
+// The disk space of the plan is known from the status of the service while the plan stays the same, nil if not known
+func DownsizeImpact(oldPlan, newPlan, oldDisk, newDisk string, planDisk *ServiceDiskSpace) []string {
+	impact := make([]string, 0)
+	if oldPlan != "" && newPlan != "" && oldPlan != newPlan {
+		oldTier, oldNodes, oldSize := parseServicePlan(oldPlan)
+		newTier, newNodes, newSize := parseServicePlan(newPlan)
+		oldOrder, oldOk := servicePlanTiers[oldTier]
+		newOrder, newOk := servicePlanTiers[newTier]
+		if !oldOk || !newOk {
+			impact = append(impact, fmt.Sprintf("plan %s can't be compared with %s by name, it may have fewer or smaller nodes", newPlan, oldPlan))
+		} else {
+			if newOrder < oldOrder {
+				impact = append(impact, fmt.Sprintf("plan tier is reduced from %s to %s, the service gets fewer nodes and may lose high availability", oldTier, newTier))
+			}
+			if oldNodes > 0 && newNodes > 0 && newNodes < oldNodes {
+				impact = append(impact, fmt.Sprintf("node count is reduced from %d to %d", oldNodes, newNodes))
+			}
+			if oldTier == newTier && newSize < oldSize {
+				impact = append(impact, fmt.Sprintf("plan is reduced from %s to %s, the nodes get less CPU, memory and disk space", oldPlan, newPlan))
+			}
+		}
+	}
+
+	// An empty value means the disk space of the plan
+	if oldDisk != "" {
+		oldMB := ConvertDiscSpace(oldDisk)
+		switch {
+		case newDisk != "":
+			if ConvertDiscSpace(newDisk) < oldMB {
+				impact = append(impact, fmt.Sprintf("disk space is reduced from %s to %s, the data must fit the new size", oldDisk, newDisk))
+			}
+		case planDisk != nil && planDisk.Plan == newPlan:
+			if planDisk.PlanMB < oldMB {
+				impact = append(impact, fmt.Sprintf("disk space is reduced from %s to %dMB of plan %s, the data must fit the new size", oldDisk, planDisk.PlanMB, newPlan))
+			}
+		default:
+			impact = append(impact, fmt.Sprintf("disk space goes back to the one of plan %s, which is not known yet and may be less than %s", newPlan, oldDisk))
+		}
+	}
+	return impact
+}
+
+// ValidateDownsize rejects updates that reduce the service, unless confirmed with ConfirmDownsizeAnnotation.
+// The planDisk is the disk space of the plan from the status of the old object
+func ValidateDownsize(o metav1.Object, planDisk *ServiceDiskSpace, oldPlan, newPlan, oldDisk, newDisk string) error {
+	impact := DownsizeImpact(oldPlan, newPlan, oldDisk, newDisk, planDisk)
+	if len(impact) == 0 || o.GetAnnotations()[ConfirmDownsizeAnnotation] == "true" {
+		return nil
+	}
+	return fmt.Errorf(
+		"the update downsizes the service: %s. Set the %q annotation to \"true\" to confirm",
+		strings.Join(impact, "; "), ConfirmDownsizeAnnotation,
+	)
+}
+
+// ValidateAdditionalDiskDownsize rejects updates that reduce the additional disk space, unless confirmed with ConfirmDownsizeAnnotation
+func ValidateAdditionalDiskDownsize(o metav1.Object, oldDisk, newDisk string) error {
+	if oldDisk == "" || ConvertDiscSpace(newDisk) >= ConvertDiscSpace(oldDisk) || o.GetAnnotations()[ConfirmDownsizeAnnotation] == "true" {
+		return nil
+	}
+	to := newDisk
+	if to == "" {
+		to = "none"
+	}
+	return fmt.Errorf(
+		"the update downsizes the service: additional disk space is reduced from %s to %s, the data must fit the new size. Set the %q annotation to \"true\" to confirm",
+		oldDisk, to, ConfirmDownsizeAnnotation,
+	)
+}
+
 func ConvertDiscSpace(v string) int {
 	if v == "" {
 		return 0
@@ -167,6 +650,16 @@ func FindProjectVPC(refs []client.Object) *ProjectVPC {
 	return nil
 }
 
+// FindOrganizationVPC returns OrganizationVPC from reference list
+func FindOrganizationVPC(refs []client.Object) *OrganizationVPC {
+	for _, o := range refs {
+		if p, ok := o.(*OrganizationVPC); ok {
+			return p
+		}
+	}
+	return nil
+}
+
 // ErrorSubstrChecker returns error checker for containing given substrings
 func ErrorSubstrChecker(substrings ...string) func(error) bool {
 	return func(err error) bool {
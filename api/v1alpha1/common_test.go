@@ -0,0 +1,99 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package v1alpha1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestDownsizeImpact(t *testing.T) {
+	planDisk := &ServiceDiskSpace{Plan: "startup-4", PlanMB: 81920, TotalMB: 102400}
+	cases := []struct {
+		name                               string
+		oldPlan, newPlan, oldDisk, newDisk string
+		planDisk                           *ServiceDiskSpace
+		impact                             int
+	}{
+		{name: "upgrade", oldPlan: "startup-4", newPlan: "business-4", impact: 0},
+		{name: "bigger size", oldPlan: "business-4", newPlan: "business-8", impact: 0},
+		{name: "lower tier", oldPlan: "business-4", newPlan: "startup-8", impact: 1},
+		{name: "smaller size", oldPlan: "business-8", newPlan: "business-4", impact: 1},
+		{name: "fewer nodes", oldPlan: "premium-6x-8", newPlan: "premium-3x-8", impact: 1},
+		{name: "fewer and smaller nodes", oldPlan: "premium-6x-16", newPlan: "premium-3x-8", impact: 2},
+		{name: "unknown plan", oldPlan: "business-4", newPlan: "custom-4", impact: 1},
+		{name: "plan default disk not known", oldPlan: "startup-4", newPlan: "startup-4", oldDisk: "100GiB", impact: 1},
+		{name: "smaller plan default disk", oldPlan: "startup-4", newPlan: "startup-4", oldDisk: "100GiB", planDisk: planDisk, impact: 1},
+		{name: "bigger plan default disk", oldPlan: "startup-4", newPlan: "startup-4", oldDisk: "50GiB", planDisk: planDisk, impact: 0},
+		{name: "plan default disk of another plan", oldPlan: "startup-4", newPlan: "startup-8", oldDisk: "50GiB", planDisk: planDisk, impact: 1},
+		{name: "bigger disk", oldDisk: "100GiB", newDisk: "200GiB", impact: 0},
+		{name: "smaller disk and plan", oldPlan: "business-8", newPlan: "business-4", oldDisk: "200GiB", newDisk: "100GiB", impact: 2},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assert.Len(t, DownsizeImpact(c.oldPlan, c.newPlan, c.oldDisk, c.newDisk, c.planDisk), c.impact)
+		})
+	}
+}
+
+func TestValidateDownsize(t *testing.T) {
+	o := &metav1.ObjectMeta{}
+	assert.ErrorContains(t, ValidateDownsize(o, nil, "business-4", "startup-4", "", ""), ConfirmDownsizeAnnotation)
+
+	o.Annotations = map[string]string{ConfirmDownsizeAnnotation: "true"}
+	assert.NoError(t, ValidateDownsize(o, nil, "business-4", "startup-4", "", ""))
+}
+
+func TestUpdateConsoleURL(t *testing.T) {
+	kafka := &Kafka{
+		ObjectMeta: metav1.ObjectMeta{Name: "kafka"},
+		Spec:       KafkaSpec{ServiceCommonSpec: ServiceCommonSpec{Project: "my-project"}},
+	}
+	kafka.UpdateConsoleURL()
+	assert.Equal(t, "https://console.aiven.io/project/my-project/services/kafka/overview", kafka.Status.ConsoleURL)
+
+	topic := &KafkaTopic{
+		ObjectMeta: metav1.ObjectMeta{Name: "orders.v1"},
+		Spec:       KafkaTopicSpec{Project: "my-project", ServiceName: "kafka"},
+	}
+	topic.UpdateConsoleURL()
+	assert.Equal(t, "https://console.aiven.io/project/my-project/services/kafka/topics/orders.v1", topic.Status.ConsoleURL)
+
+	user := &ApplicationUserToken{
+		Spec: ApplicationUserTokenSpec{OrganizationID: "org1", UserID: "u 1"},
+	}
+	user.UpdateConsoleURL()
+	assert.Equal(t, "https://console.aiven.io/account/org1/admin/application-users/u%201", user.Status.ConsoleURL)
+}
+
+func TestActiveMaintenanceFreeze(t *testing.T) {
+	day := func(d int) metav1.Time {
+		return metav1.NewTime(time.Date(2022, 12, d, 0, 0, 0, 0, time.UTC))
+	}
+	spec := &ServiceCommonSpec{
+		MaintenanceFreeze: []MaintenanceFreeze{
+			{Start: day(10), End: day(20), Reason: "first"},
+			{Start: day(15), End: day(25), Reason: "overlapping"},
+		},
+	}
+
+	assert.Nil(t, spec.ActiveMaintenanceFreeze(day(9).Time))
+	assert.Equal(t, "first", spec.ActiveMaintenanceFreeze(day(10).Time).Reason)
+	assert.Equal(t, "overlapping", spec.ActiveMaintenanceFreeze(day(16).Time).Reason, "the one that ends last")
+	assert.Equal(t, "overlapping", spec.ActiveMaintenanceFreeze(day(20).Time).Reason)
+	assert.Nil(t, spec.ActiveMaintenanceFreeze(day(25).Time), "the end is not included")
+}
+
+func TestValidateAdditionalDiskDownsize(t *testing.T) {
+	o := &metav1.ObjectMeta{}
+	assert.NoError(t, ValidateAdditionalDiskDownsize(o, "", "30GiB"))
+	assert.NoError(t, ValidateAdditionalDiskDownsize(o, "30GiB", "40GiB"))
+	assert.ErrorContains(t, ValidateAdditionalDiskDownsize(o, "30GiB", ""), "additional disk space is reduced from 30GiB to none")
+
+	o.Annotations = map[string]string{ConfirmDownsizeAnnotation: "true"}
+	assert.NoError(t, ValidateAdditionalDiskDownsize(o, "30GiB", "10GiB"))
+}
@@ -45,6 +45,11 @@ type ConnectionPoolSpec struct {
 type ConnectionPoolStatus struct {
 	// Conditions represent the latest available observations of an ConnectionPool state
 	Conditions []metav1.Condition `json:"conditions"`
+
+	// Link to the connection pools of the service in the Aiven Console
+	ConsoleURL string `json:"consoleURL,omitempty"`
+
+	SyncStatus `json:",inline"`
 }
 
 // +kubebuilder:object:root=true
@@ -57,6 +62,7 @@ type ConnectionPoolStatus struct {
 // +kubebuilder:printcolumn:name="Username",type="string",JSONPath=".spec.username"
 // +kubebuilder:printcolumn:name="Pool Size",type="string",JSONPath=".spec.poolSize"
 // +kubebuilder:printcolumn:name="Pool Mode",type="string",JSONPath=".spec.poolMode"
+// +kubebuilder:validation:XValidation:rule="size(self.metadata.name) <= 63 && self.metadata.name.matches('^[a-zA-Z0-9_-]+$')",message="Pool name must be at most 63 characters of letters, digits, underscores and dashes"
 type ConnectionPool struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
@@ -69,6 +75,15 @@ func (cp ConnectionPool) AuthSecretRef() AuthSecretReference {
 	return cp.Spec.AuthSecretRef
 }
 
+func (cp *ConnectionPool) GetSyncStatus() *SyncStatus {
+	return &cp.Status.SyncStatus
+}
+
+// UpdateConsoleURL sets the link to the connection pools of the service in the Aiven Console
+func (cp *ConnectionPool) UpdateConsoleURL() {
+	cp.Status.ConsoleURL = serviceConsoleURL(cp.Spec.Project, cp.Spec.ServiceName, "pools")
+}
+
 // +kubebuilder:object:root=true
 
 // ConnectionPoolList contains a list of ConnectionPool
@@ -7,16 +7,24 @@ import (
 )
 
 // DatabaseSpec defines the desired state of Database
+// +kubebuilder:validation:XValidation:rule="has(self.lcCollate) == has(oldSelf.lcCollate) && (!has(self.lcCollate) || self.lcCollate == oldSelf.lcCollate)",message="lcCollate is immutable"
+// +kubebuilder:validation:XValidation:rule="has(self.lcCtype) == has(oldSelf.lcCtype) && (!has(self.lcCtype) || self.lcCtype == oldSelf.lcCtype)",message="lcCtype is immutable"
 type DatabaseSpec struct {
 	// +kubebuilder:validation:MaxLength=63
 	// +kubebuilder:validation:Format="^[a-zA-Z0-9_-]*$"
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
 	// Project to link the database to
 	Project string `json:"project"`
 
 	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
 	// PostgreSQL service to link the database to
 	ServiceName string `json:"serviceName"`
 
+	// Service resource of the serviceName. A service in another namespace must be shared with a ReferenceGrant.
+	// Its authSecretRef is used if the resource doesn't set one
+	ServiceRef *ServiceReference `json:"serviceRef,omitempty"`
+
 	// +kubebuilder:validation:MaxLength=128
 	// Default string sort order (LC_COLLATE) of the database. Default value: en_US.UTF-8
 	LcCollate string `json:"lcCollate,omitempty"`
@@ -25,9 +33,20 @@ type DatabaseSpec struct {
 	// Default character classification (LC_CTYPE) of the database. Default value: en_US.UTF-8
 	LcCtype string `json:"lcCtype,omitempty"`
 
+	// +kubebuilder:validation:MaxLength=32
+	// +kubebuilder:validation:Pattern="^[a-z0-9_]+$"
+	// Default character set of the database, only applicable to MySQL services. Example value: utf8mb4
+	Charset string `json:"charset,omitempty"`
+
+	// +kubebuilder:validation:MaxLength=64
+	// +kubebuilder:validation:Pattern="^[a-z0-9_]+$"
+	// Default collation of the database, only applicable to MySQL services. Example value: utf8mb4_0900_ai_ci
+	Collation string `json:"collation,omitempty"`
+
 	// It is a Kubernetes side deletion protections, which prevents the database
 	// from being deleted by Kubernetes. It is recommended to enable this for any production
-	// databases containing critical data.
+	// databases containing critical data. The operator doesn't drop a protected database
+	// even if the resource is deleted, until the protection is turned off.
 	TerminationProtection bool `json:"terminationProtection,omitempty"`
 
 	// Authentication reference to Aiven token in a secret
@@ -38,6 +57,11 @@ type DatabaseSpec struct {
 type DatabaseStatus struct {
 	// Conditions represent the latest available observations of an Database state
 	Conditions []metav1.Condition `json:"conditions"`
+
+	// Link to the databases of the service in the Aiven Console
+	ConsoleURL string `json:"consoleURL,omitempty"`
+
+	SyncStatus `json:",inline"`
 }
 
 // +kubebuilder:object:root=true
@@ -46,6 +70,8 @@ type DatabaseStatus struct {
 // Database is the Schema for the databases API
 // +kubebuilder:printcolumn:name="Service Name",type="string",JSONPath=".spec.serviceName"
 // +kubebuilder:printcolumn:name="Project",type="string",JSONPath=".spec.project"
+// +kubebuilder:printcolumn:name="Protected",type="boolean",JSONPath=".spec.terminationProtection"
+// +kubebuilder:validation:XValidation:rule="size(self.metadata.name) <= 40 && self.metadata.name.matches('^[a-zA-Z0-9_-]+$')",message="Database name must be at most 40 characters of letters, digits, underscores and dashes"
 type Database struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
@@ -58,6 +84,30 @@ func (db Database) AuthSecretRef() AuthSecretReference {
 	return db.Spec.AuthSecretRef
 }
 
+func (db *Database) GetSyncStatus() *SyncStatus {
+	return &db.Status.SyncStatus
+}
+
+// UpdateConsoleURL sets the link to the databases of the service in the Aiven Console
+func (db *Database) UpdateConsoleURL() {
+	db.Status.ConsoleURL = serviceConsoleURL(db.Spec.Project, db.Spec.ServiceName, "databases")
+}
+
+func (db Database) GetServiceRef() *ServiceReference {
+	return db.Spec.ServiceRef
+}
+
+func (db Database) GetProjectAndServiceName() (string, string) {
+	return db.Spec.Project, db.Spec.ServiceName
+}
+
+func (db Database) GetRefs() []*ResourceReferenceObject {
+	if db.Spec.ServiceRef == nil {
+		return nil
+	}
+	return []*ResourceReferenceObject{db.Spec.ServiceRef.Service(db.GetNamespace())}
+}
+
 // +kubebuilder:object:root=true
 
 // DatabaseList contains a list of Database
@@ -30,6 +30,11 @@ func (r *Database) Default() {
 
 	const defaultLC = "en_US.UTF-8"
 
+	// MySQL databases have a charset and a collation instead
+	if r.Spec.Charset != "" || r.Spec.Collation != "" {
+		return
+	}
+
 	if r.Spec.LcCtype == "" {
 		r.Spec.LcCtype = defaultLC
 	}
@@ -47,7 +52,7 @@ var _ webhook.Validator = &Database{}
 func (r *Database) ValidateCreate() error {
 	databaselog.Info("validate create", "name", r.Name)
 
-	return nil
+	return r.Spec.ServiceRef.validateKind("PostgreSQL", "MySQL")
 }
 
 // ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
@@ -70,7 +75,7 @@ func (r *Database) ValidateUpdate(old runtime.Object) error {
 		return errors.New("cannot update a Database, lc_ctype field is immutable and cannot be updated")
 	}
 
-	return nil
+	return r.Spec.ServiceRef.validateKind("PostgreSQL", "MySQL")
 }
 
 // ValidateDelete implements webhook.Validator so a webhook will be registered for the type
@@ -0,0 +1,51 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package v1alpha1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDatabaseValidateUpdate(t *testing.T) {
+	old := &Database{Spec: DatabaseSpec{
+		Project:     "foo",
+		ServiceName: "pg",
+		LcCollate:   "en_US.UTF-8",
+		LcCtype:     "en_US.UTF-8",
+	}}
+
+	cases := []struct {
+		name   string
+		update func(db *Database)
+		valid  bool
+	}{
+		{name: "termination protection", update: func(db *Database) { db.Spec.TerminationProtection = true }, valid: true},
+		{name: "project", update: func(db *Database) { db.Spec.Project = "bar" }, valid: false},
+		{name: "service name", update: func(db *Database) { db.Spec.ServiceName = "pg2" }, valid: false},
+		{name: "lc_collate", update: func(db *Database) { db.Spec.LcCollate = "C" }, valid: false},
+		{name: "lc_ctype", update: func(db *Database) { db.Spec.LcCtype = "C" }, valid: false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			db := old.DeepCopy()
+			c.update(db)
+			err := db.ValidateUpdate(old)
+			if c.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestDatabaseValidateDelete(t *testing.T) {
+	db := &Database{}
+	assert.NoError(t, db.ValidateDelete())
+
+	db.Spec.TerminationProtection = true
+	assert.EqualError(t, db.ValidateDelete(), "cannot delete Database, termination protection is on")
+}
@@ -0,0 +1,83 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	dragonflyuserconfig "github.com/aiven/aiven-operator/api/v1alpha1/userconfigs/dragonfly"
+)
+
+// NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
+
+// DragonflySpec defines the desired state of Dragonfly
+// +kubebuilder:validation:XValidation:rule="!has(self.disk_space) || !has(self.additionalDiskSpace)",message="disk_space and additionalDiskSpace are mutually exclusive"
+type DragonflySpec struct {
+	ServiceCommonSpec `json:",inline"`
+
+	// +kubebuilder:validation:Format="^[1-9][0-9]*(GiB|G)*"
+	// The disk space of the service, possible values depend on the service type, the cloud provider and the project. Reducing will result in the service re-balancing.
+	DiskSpace string `json:"disk_space,omitempty"`
+
+	// +kubebuilder:validation:Format="^[1-9][0-9]*(GiB|G)*"
+	// The disk space added to the disk space of the plan, e.g. 30GiB. Must be a multiple of the disk space step of the plan,
+	// and fit its maximum disk space. The disk space of the plan and the additional one are in status.diskSpace.
+	// Can't be set with disk_space. Reducing will result in the service re-balancing.
+	AdditionalDiskSpace string `json:"additionalDiskSpace,omitempty"`
+
+	// Authentication reference to Aiven token in a secret
+	AuthSecretRef AuthSecretReference `json:"authSecretRef,omitempty"`
+
+	// Information regarding secret creation
+	ConnInfoSecretTarget ConnInfoSecretTarget `json:"connInfoSecretTarget,omitempty"`
+
+	// Dragonfly specific user configuration options
+	UserConfig *dragonflyuserconfig.DragonflyUserConfig `json:"userConfig,omitempty"`
+}
+
+// Dragonfly is the Schema for the dragonflies API
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Project",type="string",JSONPath=".spec.project"
+// +kubebuilder:printcolumn:name="Region",type="string",JSONPath=".spec.cloudName"
+// +kubebuilder:printcolumn:name="Plan",type="string",JSONPath=".spec.plan"
+// +kubebuilder:printcolumn:name="State",type="string",JSONPath=".status.state"
+// +kubebuilder:printcolumn:name="Endpoint",type="string",JSONPath=".status.connectionInfo.endpoint"
+// +kubebuilder:validation:XValidation:rule="size(self.metadata.name) <= 63 && self.metadata.name.matches('^[a-z][-a-z0-9]*$')",message="Service name must be at most 63 characters of lowercase letters, digits and dashes, and start with a letter"
+type Dragonfly struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DragonflySpec `json:"spec,omitempty"`
+	Status ServiceStatus `json:"status,omitempty"`
+}
+
+func (in *Dragonfly) AuthSecretRef() AuthSecretReference {
+	return in.Spec.AuthSecretRef
+}
+
+func (in *Dragonfly) GetSyncStatus() *SyncStatus {
+	return &in.Status.SyncStatus
+}
+
+// UpdateConsoleURL sets the link to the service in the Aiven Console
+func (in *Dragonfly) UpdateConsoleURL() {
+	in.Status.ConsoleURL = serviceConsoleURL(in.Spec.Project, in.Name, "overview")
+}
+
+func (in *Dragonfly) GetRefs() []*ResourceReferenceObject {
+	return in.Spec.GetRefs(in.GetNamespace())
+}
+
+//+kubebuilder:object:root=true
+
+// DragonflyList contains a list of Dragonfly
+type DragonflyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Dragonfly `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Dragonfly{}, &DragonflyList{})
+}
@@ -0,0 +1,76 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package v1alpha1
+
+import (
+	"errors"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// log is for logging in this package.
+var dragonflylog = logf.Log.WithName("dragonfly-resource")
+
+func (in *Dragonfly) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(in).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/mutate-aiven-io-v1alpha1-dragonfly,mutating=true,failurePolicy=fail,sideEffects=None,groups=aiven.io,resources=dragonflies,verbs=create;update,versions=v1alpha1,name=mdragonfly.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Defaulter = &Dragonfly{}
+
+func (in *Dragonfly) Default() {
+	dragonflylog.Info("default", "name", in.Name)
+}
+
+//+kubebuilder:webhook:verbs=create;update;delete,path=/validate-aiven-io-v1alpha1-dragonfly,mutating=false,failurePolicy=fail,groups=aiven.io,resources=dragonflies,versions=v1alpha1,name=vdragonfly.kb.io,sideEffects=none,admissionReviewVersions=v1
+
+var _ webhook.Validator = &Dragonfly{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (in *Dragonfly) ValidateCreate() error {
+	dragonflylog.Info("validate create", "name", in.Name)
+
+	return in.Spec.Validate()
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (in *Dragonfly) ValidateUpdate(old runtime.Object) error {
+	dragonflylog.Info("validate update", "name", in.Name)
+
+	if in.Spec.Project != old.(*Dragonfly).Spec.Project {
+		return errors.New("cannot update a Dragonfly service, project field is immutable and cannot be updated")
+	}
+
+	if in.Spec.ConnInfoSecretTarget.Name != old.(*Dragonfly).Spec.ConnInfoSecretTarget.Name {
+		return errors.New("cannot update a Dragonfly service, connInfoSecretTarget.name field is immutable and cannot be updated")
+	}
+
+	err := ValidateDownsize(in, old.(*Dragonfly).Status.DiskSpace, old.(*Dragonfly).Spec.Plan, in.Spec.Plan, old.(*Dragonfly).Spec.DiskSpace, in.Spec.DiskSpace)
+	if err != nil {
+		return err
+	}
+
+	err = ValidateAdditionalDiskDownsize(in, old.(*Dragonfly).Spec.AdditionalDiskSpace, in.Spec.AdditionalDiskSpace)
+	if err != nil {
+		return err
+	}
+
+	return in.Spec.Validate()
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (in *Dragonfly) ValidateDelete() error {
+	dragonflylog.Info("validate delete", "name", in.Name)
+
+	if in.Spec.TerminationProtection {
+		return errors.New("cannot delete Dragonfly service, termination protection is on")
+	}
+
+	return nil
+}
@@ -0,0 +1,133 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// FlinkApplicationSpec defines the desired state of FlinkApplication
+type FlinkApplicationSpec struct {
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Format="^[a-zA-Z0-9_-]*$"
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// Project to link the application to
+	Project string `json:"project"`
+
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// Flink service to link the application to
+	ServiceName string `json:"serviceName"`
+
+	// The SQL application version. A change creates a new version of the application,
+	// the versions are deployed with FlinkApplicationDeployment
+	Version FlinkApplicationVersion `json:"version"`
+
+	// Authentication reference to Aiven token in a secret
+	AuthSecretRef AuthSecretReference `json:"authSecretRef,omitempty"`
+}
+
+// FlinkApplicationVersion is the SQL of an application version
+type FlinkApplicationVersion struct {
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=64
+	// Tables the statement reads from
+	Sources []FlinkApplicationTable `json:"sources"`
+
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=64
+	// Tables the statement writes to
+	Sinks []FlinkApplicationTable `json:"sinks"`
+
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=50000
+	// SQL statement of the job, e.g. INSERT INTO sink SELECT * FROM source
+	Statement string `json:"statement"`
+}
+
+// FlinkApplicationTable is a source or a sink table of the application
+type FlinkApplicationTable struct {
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=50000
+	// CREATE TABLE statement of the table
+	CreateTable string `json:"createTable"`
+
+	// ID of the Flink service integration the table uses, e.g. to a Kafka or a PostgreSQL service
+	IntegrationID string `json:"integrationId,omitempty"`
+}
+
+// FlinkApplicationStatus defines the observed state of FlinkApplication
+type FlinkApplicationStatus struct {
+	// Conditions represent the latest available observations of an FlinkApplication state
+	Conditions []metav1.Condition `json:"conditions"`
+
+	// ID of the application
+	ID string `json:"id,omitempty"`
+
+	// Versions of the application, the latest last
+	Versions []FlinkApplicationVersionStatus `json:"versions,omitempty"`
+
+	// Link to the applications of the service in the Aiven Console
+	ConsoleURL string `json:"consoleURL,omitempty"`
+
+	SyncStatus `json:",inline"`
+}
+
+// FlinkApplicationVersionStatus is a version of the application on Aiven side
+type FlinkApplicationVersionStatus struct {
+	// Version number
+	Version int `json:"version"`
+
+	// ID of the version
+	ID string `json:"id"`
+}
+
+// LatestVersion returns the latest version of the application, nil if there are none
+func (in *FlinkApplicationStatus) LatestVersion() *FlinkApplicationVersionStatus {
+	if len(in.Versions) == 0 {
+		return nil
+	}
+	return &in.Versions[len(in.Versions)-1]
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// FlinkApplication is the Schema for the flinkapplications API.
+// It manages a SQL application of a Flink service and its versions, the application is named after the resource
+// +kubebuilder:printcolumn:name="Service Name",type="string",JSONPath=".spec.serviceName"
+// +kubebuilder:printcolumn:name="Project",type="string",JSONPath=".spec.project"
+// +kubebuilder:printcolumn:name="Version",type="integer",JSONPath=".status.versions[-1:].version"
+type FlinkApplication struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   FlinkApplicationSpec   `json:"spec,omitempty"`
+	Status FlinkApplicationStatus `json:"status,omitempty"`
+}
+
+func (in *FlinkApplication) AuthSecretRef() AuthSecretReference {
+	return in.Spec.AuthSecretRef
+}
+
+func (in *FlinkApplication) GetSyncStatus() *SyncStatus {
+	return &in.Status.SyncStatus
+}
+
+// UpdateConsoleURL sets the link to the applications of the service in the Aiven Console
+func (in *FlinkApplication) UpdateConsoleURL() {
+	in.Status.ConsoleURL = serviceConsoleURL(in.Spec.Project, in.Spec.ServiceName, "applications")
+}
+
+// +kubebuilder:object:root=true
+
+// FlinkApplicationList contains a list of FlinkApplication
+type FlinkApplicationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []FlinkApplication `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&FlinkApplication{}, &FlinkApplicationList{})
+}
@@ -0,0 +1,143 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// FlinkApplicationDeploymentSpec defines the desired state of FlinkApplicationDeployment
+type FlinkApplicationDeploymentSpec struct {
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Format="^[a-zA-Z0-9_-]*$"
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// Project to link the deployment to
+	Project string `json:"project"`
+
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// Flink service to link the deployment to
+	ServiceName string `json:"serviceName"`
+
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// FlinkApplication resource to deploy
+	ApplicationRef ResourceReference `json:"applicationRef"`
+
+	// +kubebuilder:validation:Minimum=1
+	// Version of the application to deploy, the latest version by default
+	Version *int `json:"version,omitempty"`
+
+	// +kubebuilder:validation:Enum=Running;Stopped
+	// +kubebuilder:default=Running
+	// Running starts the job, Stopped stops it with a savepoint. The restarted job resumes from the savepoint
+	State string `json:"state,omitempty"`
+
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=128
+	// +kubebuilder:default=1
+	// Number of the parallel instances of the job
+	Parallelism int `json:"parallelism,omitempty"`
+
+	// +kubebuilder:default=true
+	// Restarts the failed job automatically
+	RestartEnabled *bool `json:"restartEnabled,omitempty"`
+
+	// +kubebuilder:validation:MaxLength=2048
+	// Savepoint the first deployment starts from, e.g. the one of another application.
+	// The redeployments start from the last savepoint of the previous deployment
+	StartingSavepoint string `json:"startingSavepoint,omitempty"`
+
+	// Authentication reference to Aiven token in a secret
+	AuthSecretRef AuthSecretReference `json:"authSecretRef,omitempty"`
+}
+
+// FlinkApplicationDeploymentStatus defines the observed state of FlinkApplicationDeployment
+type FlinkApplicationDeploymentStatus struct {
+	// Conditions represent the latest available observations of an FlinkApplicationDeployment state
+	Conditions []metav1.Condition `json:"conditions"`
+
+	// ID of the application
+	ApplicationID string `json:"applicationId,omitempty"`
+
+	// ID of the application version to deploy
+	VersionID string `json:"versionId,omitempty"`
+
+	// ID of the current deployment. A change of the version, the parallelism or the restart strategy
+	// stops the job with a savepoint, and deploys it again
+	DeploymentID string `json:"deploymentId,omitempty"`
+
+	// State of the current deployment on Aiven side, e.g. RUNNING, SAVING_AND_STOP or FAILED
+	State string `json:"state,omitempty"`
+
+	// ID of the Flink job of the current deployment
+	JobID string `json:"jobId,omitempty"`
+
+	// Last savepoint of the current deployment
+	LastSavepoint string `json:"lastSavepoint,omitempty"`
+
+	// Error message of the failed deployment
+	Error string `json:"error,omitempty"`
+
+	SyncStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// FlinkApplicationDeployment is the Schema for the flinkapplicationdeployments API.
+// It deploys a version of a FlinkApplication, and starts or stops the job
+// +kubebuilder:printcolumn:name="Service Name",type="string",JSONPath=".spec.serviceName"
+// +kubebuilder:printcolumn:name="Application",type="string",JSONPath=".spec.applicationRef.name"
+// +kubebuilder:printcolumn:name="Desired State",type="string",JSONPath=".spec.state"
+// +kubebuilder:printcolumn:name="State",type="string",JSONPath=".status.state"
+type FlinkApplicationDeployment struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   FlinkApplicationDeploymentSpec   `json:"spec,omitempty"`
+	Status FlinkApplicationDeploymentStatus `json:"status,omitempty"`
+}
+
+func (in *FlinkApplicationDeployment) AuthSecretRef() AuthSecretReference {
+	return in.Spec.AuthSecretRef
+}
+
+func (in *FlinkApplicationDeployment) GetSyncStatus() *SyncStatus {
+	return &in.Status.SyncStatus
+}
+
+// GetRefs returns the FlinkApplication the deployment waits for
+func (in *FlinkApplicationDeployment) GetRefs() []*ResourceReferenceObject {
+	return []*ResourceReferenceObject{in.Spec.ApplicationRef.FlinkApplication(in.GetNamespace())}
+}
+
+// IsStopped returns true if the job is stopped on purpose
+func (in *FlinkApplicationDeployment) IsStopped() bool {
+	return in.Spec.State == "Stopped"
+}
+
+// IsRestartEnabled returns true if the failed job is restarted, which is the default
+func (in *FlinkApplicationDeployment) IsRestartEnabled() bool {
+	return in.Spec.RestartEnabled == nil || *in.Spec.RestartEnabled
+}
+
+// GetParallelism returns the number of the parallel instances of the job, one by default
+func (in *FlinkApplicationDeployment) GetParallelism() int {
+	if in.Spec.Parallelism < 1 {
+		return 1
+	}
+	return in.Spec.Parallelism
+}
+
+// +kubebuilder:object:root=true
+
+// FlinkApplicationDeploymentList contains a list of FlinkApplicationDeployment
+type FlinkApplicationDeploymentList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []FlinkApplicationDeployment `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&FlinkApplicationDeployment{}, &FlinkApplicationDeploymentList{})
+}
@@ -0,0 +1,116 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// GCPVPCPeeringConnectionSpec defines the desired state of GCPVPCPeeringConnection
+// +kubebuilder:validation:XValidation:rule="has(self.vpcId) != has(self.projectVPCRef)",message="Set either vpcId or projectVPCRef"
+type GCPVPCPeeringConnectionSpec struct {
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Format="^[a-zA-Z0-9_-]*$"
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// The project the VPC belongs to
+	Project string `json:"project"`
+
+	// +kubebuilder:validation:MaxLength=36
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// Identifier of the Aiven project VPC
+	VPCID string `json:"vpcId,omitempty"`
+
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// ProjectVPC resource to use its ID as vpcId. The peering connection waits for the VPC to be ACTIVE
+	ProjectVPCRef *ResourceReference `json:"projectVPCRef,omitempty"`
+
+	// +kubebuilder:validation:MinLength=6
+	// +kubebuilder:validation:MaxLength=30
+	// +kubebuilder:validation:Pattern="^[a-z][-a-z0-9]*[a-z0-9]$"
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// GCP project ID of the peer VPC network
+	GCPProjectID string `json:"gcpProjectId"`
+
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern="^[a-z]([-a-z0-9]*[a-z0-9])?$"
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// Name of the peer VPC network in the GCP project
+	PeerVPC string `json:"peerVpc"`
+
+	// Authentication reference to Aiven token in a secret
+	AuthSecretRef AuthSecretReference `json:"authSecretRef,omitempty"`
+}
+
+// GCPVPCPeeringConnectionStatus defines the observed state of GCPVPCPeeringConnection
+type GCPVPCPeeringConnectionStatus struct {
+	// Conditions represent the latest available observations of an GCPVPCPeeringConnection state
+	Conditions []metav1.Condition `json:"conditions"`
+
+	// State of the peering connection, e.g. PENDING_PEER or ACTIVE
+	State string `json:"state"`
+
+	// Identifier of the Aiven project VPC of the peering connection
+	VPCID string `json:"vpcId,omitempty"`
+
+	// Link to the Aiven VPC network in GCP. Create the peering from the peer VPC network to it
+	SelfLink string `json:"selfLink,omitempty"`
+
+	// What to do to make the peering connection active, or why it failed
+	Message string `json:"message,omitempty"`
+
+	// Link to the VPCs of the project in the Aiven Console
+	ConsoleURL string `json:"consoleURL,omitempty"`
+
+	SyncStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// GCPVPCPeeringConnection is the Schema for the gcpvpcpeeringconnections API.
+// It peers an Aiven project VPC with a VPC network in GCP
+// +kubebuilder:printcolumn:name="Project",type="string",JSONPath=".spec.project"
+// +kubebuilder:printcolumn:name="GCP Project",type="string",JSONPath=".spec.gcpProjectId"
+// +kubebuilder:printcolumn:name="Peer VPC",type="string",JSONPath=".spec.peerVpc"
+// +kubebuilder:printcolumn:name="State",type="string",JSONPath=".status.state"
+// +kubebuilder:printcolumn:name="VPC ID",type="string",JSONPath=".status.vpcId",priority=1
+type GCPVPCPeeringConnection struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   GCPVPCPeeringConnectionSpec   `json:"spec,omitempty"`
+	Status GCPVPCPeeringConnectionStatus `json:"status,omitempty"`
+}
+
+func (in *GCPVPCPeeringConnection) AuthSecretRef() AuthSecretReference {
+	return in.Spec.AuthSecretRef
+}
+
+func (in *GCPVPCPeeringConnection) GetSyncStatus() *SyncStatus {
+	return &in.Status.SyncStatus
+}
+
+// UpdateConsoleURL sets the link to the VPCs of the project in the Aiven Console
+func (in *GCPVPCPeeringConnection) UpdateConsoleURL() {
+	in.Status.ConsoleURL = consoleURL("project", in.Spec.Project, "vpcs")
+}
+
+func (in *GCPVPCPeeringConnection) GetRefs() []*ResourceReferenceObject {
+	if in.Spec.ProjectVPCRef == nil {
+		return nil
+	}
+	return []*ResourceReferenceObject{in.Spec.ProjectVPCRef.ProjectVPC(in.GetNamespace())}
+}
+
+// +kubebuilder:object:root=true
+
+// GCPVPCPeeringConnectionList contains a list of GCPVPCPeeringConnection
+type GCPVPCPeeringConnectionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []GCPVPCPeeringConnection `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&GCPVPCPeeringConnection{}, &GCPVPCPeeringConnectionList{})
+}
@@ -11,6 +11,7 @@ import (
 // NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
 
 // GrafanaSpec defines the desired state of Grafana
+// +kubebuilder:validation:XValidation:rule="!has(self.disk_space) || !has(self.additionalDiskSpace)",message="disk_space and additionalDiskSpace are mutually exclusive"
 type GrafanaSpec struct {
 	ServiceCommonSpec `json:",inline"`
 
@@ -18,6 +19,12 @@ type GrafanaSpec struct {
 	// The disk space of the service, possible values depend on the service type, the cloud provider and the project. Reducing will result in the service re-balancing.
 	DiskSpace string `json:"disk_space,omitempty"`
 
+	// +kubebuilder:validation:Format="^[1-9][0-9]*(GiB|G)*"
+	// The disk space added to the disk space of the plan, e.g. 30GiB. Must be a multiple of the disk space step of the plan,
+	// and fit its maximum disk space. The disk space of the plan and the additional one are in status.diskSpace.
+	// Can't be set with disk_space. Reducing will result in the service re-balancing.
+	AdditionalDiskSpace string `json:"additionalDiskSpace,omitempty"`
+
 	// Authentication reference to Aiven token in a secret
 	AuthSecretRef AuthSecretReference `json:"authSecretRef,omitempty"`
 
@@ -35,6 +42,8 @@ type GrafanaSpec struct {
 // +kubebuilder:printcolumn:name="Region",type="string",JSONPath=".spec.cloudName"
 // +kubebuilder:printcolumn:name="Plan",type="string",JSONPath=".spec.plan"
 // +kubebuilder:printcolumn:name="State",type="string",JSONPath=".status.state"
+// +kubebuilder:printcolumn:name="Endpoint",type="string",JSONPath=".status.connectionInfo.endpoint"
+// +kubebuilder:validation:XValidation:rule="size(self.metadata.name) <= 63 && self.metadata.name.matches('^[a-z][-a-z0-9]*$')",message="Service name must be at most 63 characters of lowercase letters, digits and dashes, and start with a letter"
 type Grafana struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
@@ -47,6 +56,15 @@ func (in *Grafana) AuthSecretRef() AuthSecretReference {
 	return in.Spec.AuthSecretRef
 }
 
+func (in *Grafana) GetSyncStatus() *SyncStatus {
+	return &in.Status.SyncStatus
+}
+
+// UpdateConsoleURL sets the link to the service in the Aiven Console
+func (in *Grafana) UpdateConsoleURL() {
+	in.Status.ConsoleURL = serviceConsoleURL(in.Spec.Project, in.Name, "overview")
+}
+
 func (in *Grafana) GetRefs() []*ResourceReferenceObject {
 	return in.Spec.GetRefs(in.GetNamespace())
 }
@@ -51,6 +51,16 @@ func (in *Grafana) ValidateUpdate(old runtime.Object) error {
 		return errors.New("cannot update a Grafana service, connInfoSecretTarget.name field is immutable and cannot be updated")
 	}
 
+	err := ValidateDownsize(in, old.(*Grafana).Status.DiskSpace, old.(*Grafana).Spec.Plan, in.Spec.Plan, old.(*Grafana).Spec.DiskSpace, in.Spec.DiskSpace)
+	if err != nil {
+		return err
+	}
+
+	err = ValidateAdditionalDiskDownsize(in, old.(*Grafana).Spec.AdditionalDiskSpace, in.Spec.AdditionalDiskSpace)
+	if err != nil {
+		return err
+	}
+
 	return in.Spec.Validate()
 }
 
@@ -9,6 +9,7 @@ import (
 )
 
 // KafkaSpec defines the desired state of Kafka
+// +kubebuilder:validation:XValidation:rule="!has(self.disk_space) || !has(self.additionalDiskSpace)",message="disk_space and additionalDiskSpace are mutually exclusive"
 type KafkaSpec struct {
 	ServiceCommonSpec `json:",inline"`
 
@@ -16,6 +17,12 @@ type KafkaSpec struct {
 	// The disk space of the service, possible values depend on the service type, the cloud provider and the project. Reducing will result in the service re-balancing.
 	DiskSpace string `json:"disk_space,omitempty"`
 
+	// +kubebuilder:validation:Format="^[1-9][0-9]*(GiB|G)*"
+	// The disk space added to the disk space of the plan, e.g. 30GiB. Must be a multiple of the disk space step of the plan,
+	// and fit its maximum disk space. The disk space of the plan and the additional one are in status.diskSpace.
+	// Can't be set with disk_space. Reducing will result in the service re-balancing.
+	AdditionalDiskSpace string `json:"additionalDiskSpace,omitempty"`
+
 	// Authentication reference to Aiven token in a secret
 	AuthSecretRef AuthSecretReference `json:"authSecretRef,omitempty"`
 
@@ -27,6 +34,11 @@ type KafkaSpec struct {
 
 	// Kafka specific user configuration options
 	UserConfig *kafkauserconfig.KafkaUserConfig `json:"userConfig,omitempty"`
+
+	// Default config of the KafkaTopics that refer to the service with serviceRef,
+	// e.g. the retention, the cleanup policy or min.insync.replicas.
+	// The topics inherit the fields they don't set in their own config
+	TopicDefaults *KafkaTopicConfig `json:"topicDefaults,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -37,6 +49,9 @@ type KafkaSpec struct {
 // +kubebuilder:printcolumn:name="Region",type="string",JSONPath=".spec.cloudName"
 // +kubebuilder:printcolumn:name="Plan",type="string",JSONPath=".spec.plan"
 // +kubebuilder:printcolumn:name="State",type="string",JSONPath=".status.state"
+// +kubebuilder:printcolumn:name="Endpoint",type="string",JSONPath=".status.connectionInfo.endpoint"
+// +kubebuilder:printcolumn:name="Progress",type="integer",JSONPath=".status.migrationProgress",priority=1
+// +kubebuilder:validation:XValidation:rule="size(self.metadata.name) <= 63 && self.metadata.name.matches('^[a-z][-a-z0-9]*$')",message="Service name must be at most 63 characters of lowercase letters, digits and dashes, and start with a letter"
 type Kafka struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
@@ -49,6 +64,19 @@ func (in *Kafka) AuthSecretRef() AuthSecretReference {
 	return in.Spec.AuthSecretRef
 }
 
+func (in *Kafka) GetSyncStatus() *SyncStatus {
+	return &in.Status.SyncStatus
+}
+
+// UpdateConsoleURL sets the link to the service in the Aiven Console
+func (in *Kafka) UpdateConsoleURL() {
+	in.Status.ConsoleURL = serviceConsoleURL(in.Spec.Project, in.Name, "overview")
+}
+
+func (in *Kafka) GetConnInfoSecretTarget() ConnInfoSecretTarget {
+	return in.Spec.ConnInfoSecretTarget
+}
+
 func (in *Kafka) GetRefs() []*ResourceReferenceObject {
 	return in.Spec.GetRefs(in.GetNamespace())
 }
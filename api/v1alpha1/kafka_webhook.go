@@ -52,6 +52,16 @@ func (r *Kafka) ValidateUpdate(old runtime.Object) error {
 		return errors.New("cannot update a Kafka service, connInfoSecretTarget.name field is immutable and cannot be updated")
 	}
 
+	err := ValidateDownsize(r, old.(*Kafka).Status.DiskSpace, old.(*Kafka).Spec.Plan, r.Spec.Plan, old.(*Kafka).Spec.DiskSpace, r.Spec.DiskSpace)
+	if err != nil {
+		return err
+	}
+
+	err = ValidateAdditionalDiskDownsize(r, old.(*Kafka).Spec.AdditionalDiskSpace, r.Spec.AdditionalDiskSpace)
+	if err != nil {
+		return err
+	}
+
 	return r.Spec.Validate()
 }
 
@@ -21,9 +21,15 @@ type KafkaACLSpec struct {
 	// Kafka permission to grant (admin, read, readwrite, write)
 	Permission string `json:"permission"`
 
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=249
+	// +kubebuilder:validation:Pattern="^[a-zA-Z0-9_.*?-]+$"
 	// Topic name pattern for the ACL entry
 	Topic string `json:"topic"`
 
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=64
+	// +kubebuilder:validation:Pattern="^[a-zA-Z0-9_.*?-]+$"
 	// Username pattern for the ACL entry
 	Username string `json:"username"`
 
@@ -38,6 +44,11 @@ type KafkaACLStatus struct {
 
 	// Kafka ACL ID
 	ID string `json:"id"`
+
+	// Link to the ACLs of the service in the Aiven Console
+	ConsoleURL string `json:"consoleURL,omitempty"`
+
+	SyncStatus `json:",inline"`
 }
 
 // +kubebuilder:object:root=true
@@ -61,6 +72,15 @@ func (acl KafkaACL) AuthSecretRef() AuthSecretReference {
 	return acl.Spec.AuthSecretRef
 }
 
+func (acl *KafkaACL) GetSyncStatus() *SyncStatus {
+	return &acl.Status.SyncStatus
+}
+
+// UpdateConsoleURL sets the link to the ACLs of the service in the Aiven Console
+func (acl *KafkaACL) UpdateConsoleURL() {
+	acl.Status.ConsoleURL = serviceConsoleURL(acl.Spec.Project, acl.Spec.ServiceName, "acl")
+}
+
 // +kubebuilder:object:root=true
 
 // KafkaACLList contains a list of KafkaACL
@@ -48,6 +48,11 @@ func (r *KafkaConnect) ValidateUpdate(old runtime.Object) error {
 		return errors.New("cannot update a KafkaConnect service, project field is immutable and cannot be updated")
 	}
 
+	err := ValidateDownsize(r, old.(*KafkaConnect).Status.DiskSpace, old.(*KafkaConnect).Spec.Plan, r.Spec.Plan, "", "")
+	if err != nil {
+		return err
+	}
+
 	return r.Spec.Validate()
 }
 
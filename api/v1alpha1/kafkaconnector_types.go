@@ -43,6 +43,11 @@ type KafkaConnectorStatus struct {
 
 	// TasksStatus contains metadata about the running tasks
 	TasksStatus KafkaConnectorTasksStatus `json:"tasksStatus"`
+
+	// Link to the connector in the Aiven Console
+	ConsoleURL string `json:"consoleURL,omitempty"`
+
+	SyncStatus `json:",inline"`
 }
 
 // KafkaConnectorPluginStatus describes the observed state of a Kafka Connector Plugin
@@ -88,6 +93,15 @@ func (kfk KafkaConnector) AuthSecretRef() AuthSecretReference {
 	return kfk.Spec.AuthSecretRef
 }
 
+func (kfk *KafkaConnector) GetSyncStatus() *SyncStatus {
+	return &kfk.Status.SyncStatus
+}
+
+// UpdateConsoleURL sets the link to the connector in the Aiven Console
+func (kfk *KafkaConnector) UpdateConsoleURL() {
+	kfk.Status.ConsoleURL = serviceConsoleURL(kfk.Spec.Project, kfk.Spec.ServiceName, "connectors", kfk.Name)
+}
+
 //+kubebuilder:object:root=true
 
 // KafkaConnectorList contains a list of KafkaConnector
@@ -0,0 +1,115 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// KafkaNativeACLSpec defines the desired state of KafkaNativeACL
+type KafkaNativeACLSpec struct {
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Format="^[a-zA-Z0-9_-]*$"
+	// Project to link the Kafka ACL to
+	Project string `json:"project"`
+
+	// +kubebuilder:validation:MaxLength=63
+	// Service to link the Kafka ACL to
+	ServiceName string `json:"serviceName"`
+
+	// +kubebuilder:validation:Enum=Topic;Group;Cluster;TransactionalId;DelegationToken;User
+	// Kafka resource type
+	ResourceType string `json:"resourceType"`
+
+	// +kubebuilder:validation:MaxLength=256
+	// +kubebuilder:validation:MinLength=1
+	// Name of the resource, or its prefix when the pattern type is PREFIXED. Use kafka-cluster for the Cluster resource type
+	ResourceName string `json:"resourceName"`
+
+	// +kubebuilder:validation:Enum=LITERAL;PREFIXED
+	// +kubebuilder:default=LITERAL
+	// Resource pattern type
+	PatternType string `json:"patternType,omitempty"`
+
+	// +kubebuilder:validation:Enum=All;Alter;AlterConfigs;ClusterAction;Create;CreateTokens;Delete;Describe;DescribeConfigs;DescribeTokens;IdempotentWrite;Read;Write
+	// Kafka operation
+	Operation string `json:"operation"`
+
+	// +kubebuilder:validation:Enum=ALLOW;DENY
+	// +kubebuilder:default=ALLOW
+	// Permission type
+	PermissionType string `json:"permissionType,omitempty"`
+
+	// +kubebuilder:validation:MaxLength=256
+	// +kubebuilder:validation:Pattern="^User:.+$"
+	// Principal the ACL entry applies to, e.g. User:alice or User:* for all users
+	Principal string `json:"principal"`
+
+	// +kubebuilder:validation:MaxLength=256
+	// +kubebuilder:default="*"
+	// Host the principal connects from, * for any host
+	Host string `json:"host,omitempty"`
+
+	// Authentication reference to Aiven token in a secret
+	AuthSecretRef AuthSecretReference `json:"authSecretRef,omitempty"`
+}
+
+// KafkaNativeACLStatus defines the observed state of KafkaNativeACL
+type KafkaNativeACLStatus struct {
+	// Conditions represent the latest available observations of an KafkaNativeACL state
+	Conditions []metav1.Condition `json:"conditions"`
+
+	// Kafka native ACL ID
+	ID string `json:"id"`
+
+	// Link to the ACLs of the service in the Aiven Console
+	ConsoleURL string `json:"consoleURL,omitempty"`
+
+	SyncStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// KafkaNativeACL is the Schema for the kafkanativeacls API.
+// Unlike KafkaACL, it manages the Kafka-native ACL entries
+// +kubebuilder:printcolumn:name="Service Name",type="string",JSONPath=".spec.serviceName"
+// +kubebuilder:printcolumn:name="Project",type="string",JSONPath=".spec.project"
+// +kubebuilder:printcolumn:name="Principal",type="string",JSONPath=".spec.principal"
+// +kubebuilder:printcolumn:name="Permission",type="string",JSONPath=".spec.permissionType"
+// +kubebuilder:printcolumn:name="Operation",type="string",JSONPath=".spec.operation"
+// +kubebuilder:printcolumn:name="Resource Type",type="string",JSONPath=".spec.resourceType"
+// +kubebuilder:printcolumn:name="Resource Name",type="string",JSONPath=".spec.resourceName"
+type KafkaNativeACL struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   KafkaNativeACLSpec   `json:"spec,omitempty"`
+	Status KafkaNativeACLStatus `json:"status,omitempty"`
+}
+
+func (acl KafkaNativeACL) AuthSecretRef() AuthSecretReference {
+	return acl.Spec.AuthSecretRef
+}
+
+func (acl *KafkaNativeACL) GetSyncStatus() *SyncStatus {
+	return &acl.Status.SyncStatus
+}
+
+// UpdateConsoleURL sets the link to the ACLs of the service in the Aiven Console
+func (acl *KafkaNativeACL) UpdateConsoleURL() {
+	acl.Status.ConsoleURL = serviceConsoleURL(acl.Spec.Project, acl.Spec.ServiceName, "acl")
+}
+
+// +kubebuilder:object:root=true
+
+// KafkaNativeACLList contains a list of KafkaNativeACL
+type KafkaNativeACLList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []KafkaNativeACL `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&KafkaNativeACL{}, &KafkaNativeACLList{})
+}
@@ -0,0 +1,82 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package v1alpha1
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// kafkaClusterResourceName is the only resource name of the Cluster resource type
+const kafkaClusterResourceName = "kafka-cluster"
+
+// log is for logging in this package.
+var kafkanativeacllog = logf.Log.WithName("kafkanativeacl-resource")
+
+func (r *KafkaNativeACL) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/mutate-aiven-io-v1alpha1-kafkanativeacl,mutating=true,failurePolicy=fail,groups=aiven.io,resources=kafkanativeacls,verbs=create;update,versions=v1alpha1,name=mkafkanativeacl.kb.io,sideEffects=none,admissionReviewVersions=v1
+
+var _ webhook.Defaulter = &KafkaNativeACL{}
+
+// Default implements webhook.Defaulter so a webhook will be registered for the type
+func (r *KafkaNativeACL) Default() {
+	kafkanativeacllog.Info("default", "name", r.Name)
+}
+
+//+kubebuilder:webhook:verbs=create;update,path=/validate-aiven-io-v1alpha1-kafkanativeacl,mutating=false,failurePolicy=fail,groups=aiven.io,resources=kafkanativeacls,versions=v1alpha1,name=vkafkanativeacl.kb.io,sideEffects=none,admissionReviewVersions=v1
+
+var _ webhook.Validator = &KafkaNativeACL{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (r *KafkaNativeACL) ValidateCreate() error {
+	kafkanativeacllog.Info("validate create", "name", r.Name)
+
+	return r.validateResource()
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (r *KafkaNativeACL) ValidateUpdate(old runtime.Object) error {
+	kafkanativeacllog.Info("validate update", "name", r.Name)
+
+	if r.Spec.Project != old.(*KafkaNativeACL).Spec.Project {
+		return fmt.Errorf("project cannot be changed")
+	}
+
+	if r.Spec.ServiceName != old.(*KafkaNativeACL).Spec.ServiceName {
+		return fmt.Errorf("serviceName cannot be changed")
+	}
+
+	return r.validateResource()
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (r *KafkaNativeACL) ValidateDelete() error {
+	kafkanativeacllog.Info("validate delete", "name", r.Name)
+
+	return nil
+}
+
+// validateResource checks the resource name of the Cluster resource type, which Kafka accepts as kafka-cluster only
+func (r *KafkaNativeACL) validateResource() error {
+	if r.Spec.ResourceType != "Cluster" {
+		return nil
+	}
+
+	if r.Spec.ResourceName != kafkaClusterResourceName {
+		return fmt.Errorf("resourceName must be %s for the Cluster resource type", kafkaClusterResourceName)
+	}
+
+	if r.Spec.PatternType == "PREFIXED" {
+		return fmt.Errorf("patternType must be LITERAL for the Cluster resource type")
+	}
+	return nil
+}
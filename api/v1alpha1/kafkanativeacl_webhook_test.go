@@ -0,0 +1,41 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package v1alpha1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKafkaNativeACLValidateResource(t *testing.T) {
+	cases := []struct {
+		name         string
+		resourceType string
+		resourceName string
+		patternType  string
+		valid        bool
+	}{
+		{name: "topic", resourceType: "Topic", resourceName: "orders", patternType: "LITERAL", valid: true},
+		{name: "topic prefix", resourceType: "Topic", resourceName: "orders-", patternType: "PREFIXED", valid: true},
+		{name: "cluster", resourceType: "Cluster", resourceName: "kafka-cluster", patternType: "LITERAL", valid: true},
+		{name: "cluster with another name", resourceType: "Cluster", resourceName: "orders", patternType: "LITERAL", valid: false},
+		{name: "cluster prefix", resourceType: "Cluster", resourceName: "kafka-cluster", patternType: "PREFIXED", valid: false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			acl := &KafkaNativeACL{Spec: KafkaNativeACLSpec{
+				ResourceType: c.resourceType,
+				ResourceName: c.resourceName,
+				PatternType:  c.patternType,
+			}}
+			err := acl.validateResource()
+			if c.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
@@ -18,20 +18,51 @@ type KafkaSchemaSpec struct {
 	ServiceName string `json:"serviceName"`
 
 	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern="^[a-zA-Z0-9_.:-]+$"
 	// Kafka Schema Subject name
 	SubjectName string `json:"subjectName"`
 
-	// Kafka Schema configuration should be a valid Avro Schema JSON format
+	// Kafka Schema configuration should be a valid schema of the schemaType format
 	Schema string `json:"schema"`
 
+	// +kubebuilder:validation:Enum=AVRO;JSON;PROTOBUF
+	// Format of the schema, AVRO if not set
+	SchemaType string `json:"schemaType,omitempty"`
+
+	// Schemas of other subjects the schema refers to, e.g. the Protobuf imports or the Avro named types.
+	// The schema waits for the referenced subject versions to exist before it is registered
+	References []KafkaSchemaReference `json:"references,omitempty"`
+
 	// +kubebuilder:validation:Enum=BACKWARD;BACKWARD_TRANSITIVE;FORWARD;FORWARD_TRANSITIVE;FULL;FULL_TRANSITIVE;NONE
 	// Kafka Schemas compatibility level
 	CompatibilityLevel string `json:"compatibilityLevel,omitempty"`
 
+	// +kubebuilder:validation:Enum=soft;hard;none
+	// +kubebuilder:default=soft
+	// What happens to the subject when the resource is deleted: soft deletes it, so it can be restored,
+	// hard deletes it permanently with its schema history, and none leaves it in the schema registry
+	DeletionPolicy string `json:"deletionPolicy,omitempty"`
+
 	// Authentication reference to Aiven token in a secret
 	AuthSecretRef AuthSecretReference `json:"authSecretRef,omitempty"`
 }
 
+// KafkaSchemaReference is a schema version of another subject the schema refers to
+type KafkaSchemaReference struct {
+	// +kubebuilder:validation:MinLength=1
+	// Name the schema refers to the reference by: the import path with Protobuf,
+	// the fully qualified type name with Avro, or the $ref URL with JSON
+	Name string `json:"name"`
+
+	// +kubebuilder:validation:MinLength=1
+	// Subject of the referenced schema
+	Subject string `json:"subject"`
+
+	// +kubebuilder:validation:Minimum=1
+	// Version of the referenced schema
+	Version int `json:"version"`
+}
+
 // KafkaSchemaStatus defines the observed state of KafkaSchema
 type KafkaSchemaStatus struct {
 	// Conditions represent the latest available observations of an KafkaSchema state
@@ -39,6 +70,11 @@ type KafkaSchemaStatus struct {
 
 	// Kafka Schema configuration version
 	Version int `json:"version"`
+
+	// Link to the schemas of the service in the Aiven Console
+	ConsoleURL string `json:"consoleURL,omitempty"`
+
+	SyncStatus `json:",inline"`
 }
 
 // +kubebuilder:object:root=true
@@ -62,6 +98,15 @@ func (kfks KafkaSchema) AuthSecretRef() AuthSecretReference {
 	return kfks.Spec.AuthSecretRef
 }
 
+func (kfks *KafkaSchema) GetSyncStatus() *SyncStatus {
+	return &kfks.Status.SyncStatus
+}
+
+// UpdateConsoleURL sets the link to the schemas of the service in the Aiven Console
+func (kfks *KafkaSchema) UpdateConsoleURL() {
+	kfks.Status.ConsoleURL = serviceConsoleURL(kfks.Spec.Project, kfks.Spec.ServiceName, "schemas")
+}
+
 // +kubebuilder:object:root=true
 
 // KafkaSchemaList contains a list of KafkaSchema
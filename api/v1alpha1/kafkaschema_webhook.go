@@ -4,6 +4,7 @@ package v1alpha1
 
 import (
 	"errors"
+	"fmt"
 
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -37,7 +38,7 @@ var _ webhook.Validator = &KafkaSchema{}
 func (r *KafkaSchema) ValidateCreate() error {
 	kafkaschemalog.Info("validate create", "name", r.Name)
 
-	return nil
+	return r.validateReferences()
 }
 
 // ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
@@ -56,7 +57,7 @@ func (r *KafkaSchema) ValidateUpdate(old runtime.Object) error {
 		return errors.New("cannot update a KafkaSchema, subjectName field is immutable and cannot be updated")
 	}
 
-	return nil
+	return r.validateReferences()
 }
 
 // ValidateDelete implements webhook.Validator so a webhook will be registered for the type
@@ -65,3 +66,18 @@ func (r *KafkaSchema) ValidateDelete() error {
 
 	return nil
 }
+
+// validateReferences rejects the references the schema registry can't resolve
+func (r *KafkaSchema) validateReferences() error {
+	names := make(map[string]bool, len(r.Spec.References))
+	for _, ref := range r.Spec.References {
+		if ref.Subject == r.Spec.SubjectName {
+			return fmt.Errorf("the schema can't refer to its own subject %q", ref.Subject)
+		}
+		if names[ref.Name] {
+			return fmt.Errorf("reference name %q is not unique", ref.Name)
+		}
+		names[ref.Name] = true
+	}
+	return nil
+}
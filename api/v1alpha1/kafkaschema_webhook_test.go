@@ -0,0 +1,57 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package v1alpha1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKafkaSchemaValidateReferences(t *testing.T) {
+	cases := []struct {
+		name       string
+		references []KafkaSchemaReference
+		valid      bool
+	}{
+		{
+			name:  "no references",
+			valid: true,
+		},
+		{
+			name: "references",
+			references: []KafkaSchemaReference{
+				{Name: "common/money.proto", Subject: "money", Version: 1},
+				{Name: "common/address.proto", Subject: "address", Version: 3},
+			},
+			valid: true,
+		},
+		{
+			name: "own subject",
+			references: []KafkaSchemaReference{
+				{Name: "orders.proto", Subject: "orders", Version: 1},
+			},
+			valid: false,
+		},
+		{
+			name: "same name twice",
+			references: []KafkaSchemaReference{
+				{Name: "common/money.proto", Subject: "money", Version: 1},
+				{Name: "common/money.proto", Subject: "money", Version: 2},
+			},
+			valid: false,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			schema := &KafkaSchema{Spec: KafkaSchemaSpec{SubjectName: "orders", References: c.references}}
+			err := schema.validateReferences()
+			if c.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
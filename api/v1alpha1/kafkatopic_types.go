@@ -17,6 +17,10 @@ type KafkaTopicSpec struct {
 	// Service name.
 	ServiceName string `json:"serviceName"`
 
+	// Service resource of the serviceName. A service in another namespace must be shared with a ReferenceGrant.
+	// Its authSecretRef is used if the resource doesn't set one
+	ServiceRef *ServiceReference `json:"serviceRef,omitempty"`
+
 	// +kubebuilder:validation:Minimum=1
 	// +kubebuilder:validation:Maximum=1000000
 	// Number of partitions to create in the topic
@@ -39,6 +43,10 @@ type KafkaTopicSpec struct {
 
 	// Authentication reference to Aiven token in a secret
 	AuthSecretRef AuthSecretReference `json:"authSecretRef,omitempty"`
+
+	// Shows the lag of the consumer groups of the topic in the status and as the aiven_operator_kafka_topic_consumer_group_lag metric.
+	// The lag is refreshed every 5 minutes
+	ConsumerGroupLag bool `json:"consumerGroupLag,omitempty"`
 }
 
 type KafkaTopicTag struct {
@@ -133,6 +141,35 @@ type KafkaTopicStatus struct {
 
 	// State represents the state of the kafka topic
 	State string `json:"state"`
+
+	// Number of the partitions that are live on Aiven side.
+	// It is less than spec.partitions until the added partitions are live
+	Partitions int `json:"partitions,omitempty"`
+
+	// Number of the partitions with fewer in-sync replicas than the replication factor,
+	// e.g. while the partitions are reassigned
+	UnderReplicatedPartitions int `json:"underReplicatedPartitions,omitempty"`
+
+	// Lag of the consumer groups of the topic, the most lagging first, at most 20 of them.
+	// Set when spec.consumerGroupLag is enabled
+	ConsumerGroups []KafkaTopicConsumerGroup `json:"consumerGroups,omitempty"`
+
+	// Link to the topic in the Aiven Console
+	ConsoleURL string `json:"consoleURL,omitempty"`
+
+	// Config fields inherited from the topicDefaults of the Kafka resource of serviceRef
+	InheritedConfig *KafkaTopicConfig `json:"inheritedConfig,omitempty"`
+
+	SyncStatus `json:",inline"`
+}
+
+// KafkaTopicConsumerGroup is the lag of a consumer group
+type KafkaTopicConsumerGroup struct {
+	// Name of the consumer group
+	Name string `json:"name"`
+
+	// Number of the messages the group has not consumed yet, summed over the partitions
+	Lag int64 `json:"lag"`
 }
 
 // +kubebuilder:object:root=true
@@ -143,6 +180,7 @@ type KafkaTopicStatus struct {
 // +kubebuilder:printcolumn:name="Project",type="string",JSONPath=".spec.project"
 // +kubebuilder:printcolumn:name="Partitions",type="string",JSONPath=".spec.partitions"
 // +kubebuilder:printcolumn:name="Replication",type="string",JSONPath=".spec.replication"
+// +kubebuilder:validation:XValidation:rule="size(self.metadata.name) <= 249 && self.metadata.name.matches('^[a-zA-Z0-9._-]+$')",message="Topic name must be at most 249 characters of letters, digits, dots, underscores and dashes"
 type KafkaTopic struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
@@ -155,6 +193,30 @@ func (kfkt KafkaTopic) AuthSecretRef() AuthSecretReference {
 	return kfkt.Spec.AuthSecretRef
 }
 
+func (kfkt *KafkaTopic) GetSyncStatus() *SyncStatus {
+	return &kfkt.Status.SyncStatus
+}
+
+// UpdateConsoleURL sets the link to the topic in the Aiven Console
+func (kfkt *KafkaTopic) UpdateConsoleURL() {
+	kfkt.Status.ConsoleURL = serviceConsoleURL(kfkt.Spec.Project, kfkt.Spec.ServiceName, "topics", kfkt.Name)
+}
+
+func (kfkt KafkaTopic) GetServiceRef() *ServiceReference {
+	return kfkt.Spec.ServiceRef
+}
+
+func (kfkt KafkaTopic) GetProjectAndServiceName() (string, string) {
+	return kfkt.Spec.Project, kfkt.Spec.ServiceName
+}
+
+func (kfkt KafkaTopic) GetRefs() []*ResourceReferenceObject {
+	if kfkt.Spec.ServiceRef == nil {
+		return nil
+	}
+	return []*ResourceReferenceObject{kfkt.Spec.ServiceRef.Service(kfkt.GetNamespace())}
+}
+
 // +kubebuilder:object:root=true
 
 // KafkaTopicList contains a list of KafkaTopic
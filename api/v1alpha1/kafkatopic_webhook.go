@@ -37,7 +37,7 @@ var _ webhook.Validator = &KafkaTopic{}
 func (r *KafkaTopic) ValidateCreate() error {
 	kafkatopiclog.Info("validate create", "name", r.Name)
 
-	return nil
+	return r.Spec.ServiceRef.validateKind("Kafka")
 }
 
 // ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
@@ -52,7 +52,7 @@ func (r *KafkaTopic) ValidateUpdate(old runtime.Object) error {
 		return errors.New("cannot update a KafkaTopic, serviceName field is immutable and cannot be updated")
 	}
 
-	return nil
+	return r.Spec.ServiceRef.validateKind("Kafka")
 }
 
 // ValidateDelete implements webhook.Validator so a webhook will be registered for the type
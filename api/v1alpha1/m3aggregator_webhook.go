@@ -0,0 +1,76 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package v1alpha1
+
+import (
+	"errors"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// log is for logging in this package.
+var m3aggregatorlog = logf.Log.WithName("m3aggregator-resource")
+
+func (in *M3Aggregator) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(in).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/mutate-aiven-io-v1alpha1-m3aggregator,mutating=true,failurePolicy=fail,sideEffects=None,groups=aiven.io,resources=m3aggregators,verbs=create;update,versions=v1alpha1,name=mm3aggregator.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Defaulter = &M3Aggregator{}
+
+func (in *M3Aggregator) Default() {
+	m3aggregatorlog.Info("default", "name", in.Name)
+}
+
+//+kubebuilder:webhook:verbs=create;update;delete,path=/validate-aiven-io-v1alpha1-m3aggregator,mutating=false,failurePolicy=fail,groups=aiven.io,resources=m3aggregators,versions=v1alpha1,name=vm3aggregator.kb.io,sideEffects=none,admissionReviewVersions=v1
+
+var _ webhook.Validator = &M3Aggregator{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (in *M3Aggregator) ValidateCreate() error {
+	m3aggregatorlog.Info("validate create", "name", in.Name)
+
+	return in.Spec.Validate()
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (in *M3Aggregator) ValidateUpdate(old runtime.Object) error {
+	m3aggregatorlog.Info("validate update", "name", in.Name)
+
+	if in.Spec.Project != old.(*M3Aggregator).Spec.Project {
+		return errors.New("cannot update a M3Aggregator service, project field is immutable and cannot be updated")
+	}
+
+	if in.Spec.ConnInfoSecretTarget.Name != old.(*M3Aggregator).Spec.ConnInfoSecretTarget.Name {
+		return errors.New("cannot update a M3Aggregator service, connInfoSecretTarget.name field is immutable and cannot be updated")
+	}
+
+	err := ValidateDownsize(in, old.(*M3Aggregator).Status.DiskSpace, old.(*M3Aggregator).Spec.Plan, in.Spec.Plan, old.(*M3Aggregator).Spec.DiskSpace, in.Spec.DiskSpace)
+	if err != nil {
+		return err
+	}
+
+	err = ValidateAdditionalDiskDownsize(in, old.(*M3Aggregator).Spec.AdditionalDiskSpace, in.Spec.AdditionalDiskSpace)
+	if err != nil {
+		return err
+	}
+
+	return in.Spec.Validate()
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (in *M3Aggregator) ValidateDelete() error {
+	m3aggregatorlog.Info("validate delete", "name", in.Name)
+
+	if in.Spec.TerminationProtection {
+		return errors.New("cannot delete M3Aggregator service, termination protection is on")
+	}
+
+	return nil
+}
@@ -0,0 +1,76 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package v1alpha1
+
+import (
+	"errors"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// log is for logging in this package.
+var m3dblog = logf.Log.WithName("m3db-resource")
+
+func (in *M3DB) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(in).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/mutate-aiven-io-v1alpha1-m3db,mutating=true,failurePolicy=fail,sideEffects=None,groups=aiven.io,resources=m3dbs,verbs=create;update,versions=v1alpha1,name=mm3db.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Defaulter = &M3DB{}
+
+func (in *M3DB) Default() {
+	m3dblog.Info("default", "name", in.Name)
+}
+
+//+kubebuilder:webhook:verbs=create;update;delete,path=/validate-aiven-io-v1alpha1-m3db,mutating=false,failurePolicy=fail,groups=aiven.io,resources=m3dbs,versions=v1alpha1,name=vm3db.kb.io,sideEffects=none,admissionReviewVersions=v1
+
+var _ webhook.Validator = &M3DB{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (in *M3DB) ValidateCreate() error {
+	m3dblog.Info("validate create", "name", in.Name)
+
+	return in.Spec.Validate()
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (in *M3DB) ValidateUpdate(old runtime.Object) error {
+	m3dblog.Info("validate update", "name", in.Name)
+
+	if in.Spec.Project != old.(*M3DB).Spec.Project {
+		return errors.New("cannot update a M3DB service, project field is immutable and cannot be updated")
+	}
+
+	if in.Spec.ConnInfoSecretTarget.Name != old.(*M3DB).Spec.ConnInfoSecretTarget.Name {
+		return errors.New("cannot update a M3DB service, connInfoSecretTarget.name field is immutable and cannot be updated")
+	}
+
+	err := ValidateDownsize(in, old.(*M3DB).Status.DiskSpace, old.(*M3DB).Spec.Plan, in.Spec.Plan, old.(*M3DB).Spec.DiskSpace, in.Spec.DiskSpace)
+	if err != nil {
+		return err
+	}
+
+	err = ValidateAdditionalDiskDownsize(in, old.(*M3DB).Spec.AdditionalDiskSpace, in.Spec.AdditionalDiskSpace)
+	if err != nil {
+		return err
+	}
+
+	return in.Spec.Validate()
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (in *M3DB) ValidateDelete() error {
+	m3dblog.Info("validate delete", "name", in.Name)
+
+	if in.Spec.TerminationProtection {
+		return errors.New("cannot delete M3DB service, termination protection is on")
+	}
+
+	return nil
+}
@@ -52,6 +52,16 @@ func (in *MySQL) ValidateUpdate(old runtime.Object) error {
 		return errors.New("cannot update a MySQL service, connInfoSecretTarget.name field is immutable and cannot be updated")
 	}
 
+	err := ValidateDownsize(in, old.(*MySQL).Status.DiskSpace, old.(*MySQL).Spec.Plan, in.Spec.Plan, old.(*MySQL).Spec.DiskSpace, in.Spec.DiskSpace)
+	if err != nil {
+		return err
+	}
+
+	err = ValidateAdditionalDiskDownsize(in, old.(*MySQL).Spec.AdditionalDiskSpace, in.Spec.AdditionalDiskSpace)
+	if err != nil {
+		return err
+	}
+
 	return in.Spec.Validate()
 }
 
@@ -0,0 +1,80 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package v1alpha1
+
+import "fmt"
+
+// The Aiven limits of the names. The kubebuilder markers can't refer to constants,
+// so TestNameRules checks that the markers and the CRDs validate the names with these
+const (
+	serviceNameMaxLength = 63
+	serviceNamePattern   = `^[a-z][-a-z0-9]*$`
+
+	projectNameMaxLength = 63
+	projectNamePattern   = `^[a-zA-Z0-9_-]+$`
+
+	connectionPoolNameMaxLength = 63
+	connectionPoolNamePattern   = `^[a-zA-Z0-9_-]+$`
+
+	databaseNameMaxLength = 40
+	databaseNamePattern   = `^[a-zA-Z0-9_-]+$`
+
+	kafkaTopicNameMaxLength = 249
+	kafkaTopicNamePattern   = `^[a-zA-Z0-9._-]+$`
+
+	userNameMaxLength = 64
+	userNamePattern   = `^[a-zA-Z0-9_][a-zA-Z0-9_.-]*$`
+
+	// The Kafka ACLs match the topics and the users with wildcards
+	kafkaACLPatternPattern = `^[a-zA-Z0-9_.*?-]+$`
+
+	kafkaSchemaSubjectNameMaxLength = 63
+	kafkaSchemaSubjectNamePattern   = `^[a-zA-Z0-9_.:-]+$`
+)
+
+// nameRule is the rule of the resource name in the CRD
+type nameRule struct {
+	maxLength int
+	pattern   string
+	message   string
+}
+
+// cel returns the CEL rule of the metadata.name
+func (r nameRule) cel() string {
+	return fmt.Sprintf("size(self.metadata.name) <= %d && self.metadata.name.matches('%s')", r.maxLength, r.pattern)
+}
+
+var (
+	serviceNameRule = nameRule{serviceNameMaxLength, serviceNamePattern,
+		"Service name must be at most 63 characters of lowercase letters, digits and dashes, and start with a letter"}
+	userNameRule = nameRule{userNameMaxLength, userNamePattern,
+		"User name must be at most 64 characters of letters, digits, dots, underscores and dashes, and not start with a dot or a dash"}
+)
+
+// resourceNameRules are the rules of the resources named after Aiven entities, by kind
+var resourceNameRules = map[string]nameRule{
+	"Cassandra":    serviceNameRule,
+	"Clickhouse":   serviceNameRule,
+	"Dragonfly":    serviceNameRule,
+	"Grafana":      serviceNameRule,
+	"Kafka":        serviceNameRule,
+	"KafkaConnect": serviceNameRule,
+	"M3Aggregator": serviceNameRule,
+	"M3DB":         serviceNameRule,
+	"MySQL":        serviceNameRule,
+	"OpenSearch":   serviceNameRule,
+	"PostgreSQL":   serviceNameRule,
+	"Redis":        serviceNameRule,
+	"Thanos":       serviceNameRule,
+	"Valkey":       serviceNameRule,
+	"Project": {projectNameMaxLength, projectNamePattern,
+		"Project name must be at most 63 characters of letters, digits, underscores and dashes"},
+	"ConnectionPool": {connectionPoolNameMaxLength, connectionPoolNamePattern,
+		"Pool name must be at most 63 characters of letters, digits, underscores and dashes"},
+	"Database": {databaseNameMaxLength, databaseNamePattern,
+		"Database name must be at most 40 characters of letters, digits, underscores and dashes"},
+	"KafkaTopic": {kafkaTopicNameMaxLength, kafkaTopicNamePattern,
+		"Topic name must be at most 249 characters of letters, digits, dots, underscores and dashes"},
+	"ServiceUser":    userNameRule,
+	"ClickhouseUser": userNameRule,
+}
@@ -0,0 +1,205 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package v1alpha1
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+)
+
+// fieldNameRules are the spec fields named after Aiven entities, by kind
+var fieldNameRules = map[string]map[string]nameRule{
+	"KafkaACL": {
+		"topic":    {maxLength: kafkaTopicNameMaxLength, pattern: kafkaACLPatternPattern},
+		"username": {maxLength: userNameMaxLength, pattern: kafkaACLPatternPattern},
+	},
+	"KafkaSchema": {
+		"subjectName": {maxLength: kafkaSchemaSubjectNameMaxLength, pattern: kafkaSchemaSubjectNamePattern},
+	},
+}
+
+// readCRDs returns the CRDs of config/crd/bases by kind
+func readCRDs(t *testing.T) map[string]*apiextensionsv1.CustomResourceDefinition {
+	files, err := filepath.Glob(filepath.Join("..", "..", "config", "crd", "bases", "*.yaml"))
+	require.NoError(t, err)
+
+	crds := make(map[string]*apiextensionsv1.CustomResourceDefinition)
+	for _, f := range files {
+		b, err := os.ReadFile(f)
+		require.NoError(t, err)
+		crd := new(apiextensionsv1.CustomResourceDefinition)
+		require.NoError(t, yaml.Unmarshal(b, crd), f)
+		crds[crd.Spec.Names.Kind] = crd
+	}
+	return crds
+}
+
+// readTypes returns the source of the api types
+func readTypes(t *testing.T) string {
+	files, err := filepath.Glob("*_types.go")
+	require.NoError(t, err)
+
+	var src strings.Builder
+	for _, f := range files {
+		b, err := os.ReadFile(f)
+		require.NoError(t, err)
+		src.Write(b)
+	}
+	return src.String()
+}
+
+func TestNameRules(t *testing.T) {
+	crds := readCRDs(t)
+	types := readTypes(t)
+
+	for kind, rule := range resourceNameRules {
+		t.Run(kind, func(t *testing.T) {
+			marker := `// +kubebuilder:validation:XValidation:rule="` + rule.cel() + `",message="` + rule.message + `"`
+			assert.Contains(t, types, marker)
+
+			crd, ok := crds[kind]
+			require.True(t, ok)
+			schema := crd.Spec.Versions[0].Schema.OpenAPIV3Schema
+			assert.Contains(t, schema.XValidations, apiextensionsv1.ValidationRule{Rule: rule.cel(), Message: rule.message})
+		})
+	}
+
+	for kind, fields := range fieldNameRules {
+		for field, rule := range fields {
+			t.Run(kind+"."+field, func(t *testing.T) {
+				crd, ok := crds[kind]
+				require.True(t, ok)
+				prop := crd.Spec.Versions[0].Schema.OpenAPIV3Schema.Properties["spec"].Properties[field]
+				require.NotNil(t, prop.MaxLength)
+				assert.Equal(t, int64(rule.maxLength), *prop.MaxLength)
+				assert.Equal(t, rule.pattern, prop.Pattern)
+			})
+		}
+	}
+}
+
+// CEL matches() and the Go regexp are both RE2
+func (r nameRule) allows(name string) bool {
+	return len(name) <= r.maxLength && regexp.MustCompile(r.pattern).MatchString(name)
+}
+
+func TestNameRuleAllows(t *testing.T) {
+	cases := []struct {
+		kind  string
+		name  string
+		valid bool
+	}{
+		{kind: "PostgreSQL", name: "my-pg", valid: true},
+		{kind: "PostgreSQL", name: "My-pg", valid: false},
+		{kind: "PostgreSQL", name: "1-pg", valid: false},
+		{kind: "PostgreSQL", name: "my.pg", valid: false},
+		{kind: "PostgreSQL", name: strings.Repeat("a", 64), valid: false},
+		{kind: "Project", name: "my_Project-1", valid: true},
+		{kind: "Project", name: "my.project", valid: false},
+		{kind: "ConnectionPool", name: "my.pool", valid: false},
+		{kind: "Database", name: "my_db", valid: true},
+		{kind: "Database", name: strings.Repeat("a", 41), valid: false},
+		{kind: "KafkaTopic", name: "orders.v1_eu-north", valid: true},
+		{kind: "KafkaTopic", name: "orders:v1", valid: false},
+		{kind: "KafkaTopic", name: strings.Repeat("a", 250), valid: false},
+		{kind: "ServiceUser", name: "john.doe", valid: true},
+		{kind: "ServiceUser", name: ".john", valid: false},
+		{kind: "ServiceUser", name: "john@doe", valid: false},
+		{kind: "ClickhouseUser", name: "-john", valid: false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.kind+"/"+c.name, func(t *testing.T) {
+			assert.Equal(t, c.valid, resourceNameRules[c.kind].allows(c.name))
+		})
+	}
+}
+
+var _ = Describe("Name validation", func() {
+	meta := func(name string) metav1.ObjectMeta {
+		return metav1.ObjectMeta{Name: name, Namespace: "default"}
+	}
+
+	// A name valid in Kubernetes, which the rule of the kind rejects
+	badNames := map[string]client.Object{
+		"Cassandra":      &Cassandra{ObjectMeta: meta("my.cassandra")},
+		"Clickhouse":     &Clickhouse{ObjectMeta: meta("my.clickhouse")},
+		"Dragonfly":      &Dragonfly{ObjectMeta: meta("my.dragonfly")},
+		"Grafana":        &Grafana{ObjectMeta: meta("my.grafana")},
+		"Kafka":          &Kafka{ObjectMeta: meta("my.kafka")},
+		"KafkaConnect":   &KafkaConnect{ObjectMeta: meta("my.kafka-connect")},
+		"M3Aggregator":   &M3Aggregator{ObjectMeta: meta("my.m3aggregator")},
+		"M3DB":           &M3DB{ObjectMeta: meta("my.m3db")},
+		"MySQL":          &MySQL{ObjectMeta: meta("my.mysql")},
+		"OpenSearch":     &OpenSearch{ObjectMeta: meta("my.opensearch")},
+		"PostgreSQL":     &PostgreSQL{ObjectMeta: meta("my.pg")},
+		"Redis":          &Redis{ObjectMeta: meta("my.redis")},
+		"Thanos":         &Thanos{ObjectMeta: meta("my.thanos")},
+		"Valkey":         &Valkey{ObjectMeta: meta("my.valkey")},
+		"Project":        &Project{ObjectMeta: meta("my.project")},
+		"ConnectionPool": &ConnectionPool{ObjectMeta: meta("my.pool")},
+		"Database":       &Database{ObjectMeta: meta(strings.Repeat("a", 41))},
+		"KafkaTopic":     &KafkaTopic{ObjectMeta: meta(strings.Repeat("a", 250))},
+		"ServiceUser":    &ServiceUser{ObjectMeta: meta(".john")},
+		"ClickhouseUser": &ClickhouseUser{ObjectMeta: meta("-john")},
+	}
+
+	It("has a case for every rule", func() {
+		for kind := range resourceNameRules {
+			Expect(badNames).To(HaveKey(kind))
+		}
+	})
+
+	for kind, obj := range badNames {
+		kind, obj := kind, obj
+		It("rejects a bad "+kind+" name", func() {
+			err := k8sClient.Create(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(resourceNameRules[kind].message))
+		})
+	}
+
+	It("rejects a bad KafkaACL topic and username", func() {
+		acl := &KafkaACL{
+			ObjectMeta: meta("acl"),
+			Spec: KafkaACLSpec{
+				Project:     "my-project",
+				ServiceName: "my-kafka",
+				Permission:  "read",
+				Topic:       "orders/v1",
+				Username:    "john@doe",
+			},
+		}
+		err := k8sClient.Create(ctx, acl)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("spec.topic"))
+		Expect(err.Error()).To(ContainSubstring("spec.username"))
+	})
+
+	It("rejects a bad KafkaSchema subject name", func() {
+		schema := &KafkaSchema{
+			ObjectMeta: meta("schema"),
+			Spec: KafkaSchemaSpec{
+				Project:     "my-project",
+				ServiceName: "my-kafka",
+				SubjectName: "orders/v1",
+				Schema:      `{"type": "string"}`,
+			},
+		}
+		err := k8sClient.Create(ctx, schema)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("spec.subjectName"))
+	})
+})
@@ -52,6 +52,16 @@ func (r *OpenSearch) ValidateUpdate(old runtime.Object) error {
 		return errors.New("cannot update a OpenSearch service, connInfoSecretTarget.name field is immutable and cannot be updated")
 	}
 
+	err := ValidateDownsize(r, old.(*OpenSearch).Status.DiskSpace, old.(*OpenSearch).Spec.Plan, r.Spec.Plan, old.(*OpenSearch).Spec.DiskSpace, r.Spec.DiskSpace)
+	if err != nil {
+		return err
+	}
+
+	err = ValidateAdditionalDiskDownsize(r, old.(*OpenSearch).Spec.AdditionalDiskSpace, r.Spec.AdditionalDiskSpace)
+	if err != nil {
+		return err
+	}
+
 	return r.Spec.Validate()
 }
 
@@ -0,0 +1,97 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// OpenSearchACLSpec defines the desired state of OpenSearchACL
+type OpenSearchACLSpec struct {
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Format="^[a-zA-Z0-9_-]*$"
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// Project to link the OpenSearch ACLs to
+	Project string `json:"project"`
+
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// Service to link the OpenSearch ACLs to. The resource manages the ACLs of the service, except the ones of the ServiceUsers with openSearchAclRules
+	ServiceName string `json:"serviceName"`
+
+	// Enables the extended ACLs, which also apply the rules to the _mget, _msearch and _bulk APIs
+	ExtendedACL bool `json:"extendedAcl,omitempty"`
+
+	// +kubebuilder:validation:MaxItems=256
+	// Index ACL rules of the users. The users that are not listed lose their access to the indexes, the users of the ServiceUsers with openSearchAclRules can't be listed
+	ACLs []OpenSearchUserACL `json:"acls,omitempty"`
+
+	// Authentication reference to Aiven token in a secret
+	AuthSecretRef AuthSecretReference `json:"authSecretRef,omitempty"`
+}
+
+// OpenSearchUserACL is the index ACL rules of a user
+type OpenSearchUserACL struct {
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=64
+	// Username of the service user, * for all the users
+	Username string `json:"username"`
+
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=256
+	// Rules of the user
+	Rules []OpenSearchACLRule `json:"rules"`
+}
+
+// OpenSearchACLStatus defines the observed state of OpenSearchACL
+type OpenSearchACLStatus struct {
+	// Conditions represent the latest available observations of an OpenSearchACL state
+	Conditions []metav1.Condition `json:"conditions"`
+
+	// Link to the ACLs of the service in the Aiven Console
+	ConsoleURL string `json:"consoleURL,omitempty"`
+
+	SyncStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// OpenSearchACL is the Schema for the opensearchacls API.
+// It manages the index ACL config of an OpenSearch service
+// +kubebuilder:printcolumn:name="Service Name",type="string",JSONPath=".spec.serviceName"
+// +kubebuilder:printcolumn:name="Project",type="string",JSONPath=".spec.project"
+// +kubebuilder:printcolumn:name="Extended ACL",type="boolean",JSONPath=".spec.extendedAcl"
+type OpenSearchACL struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OpenSearchACLSpec   `json:"spec,omitempty"`
+	Status OpenSearchACLStatus `json:"status,omitempty"`
+}
+
+func (in *OpenSearchACL) AuthSecretRef() AuthSecretReference {
+	return in.Spec.AuthSecretRef
+}
+
+func (in *OpenSearchACL) GetSyncStatus() *SyncStatus {
+	return &in.Status.SyncStatus
+}
+
+// UpdateConsoleURL sets the link to the ACLs of the service in the Aiven Console
+func (in *OpenSearchACL) UpdateConsoleURL() {
+	in.Status.ConsoleURL = serviceConsoleURL(in.Spec.Project, in.Spec.ServiceName, "acl")
+}
+
+// +kubebuilder:object:root=true
+
+// OpenSearchACLList contains a list of OpenSearchACL
+type OpenSearchACLList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OpenSearchACL `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&OpenSearchACL{}, &OpenSearchACLList{})
+}
@@ -0,0 +1,126 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// OpenSearchSnapshotRepositorySpec defines the desired state of OpenSearchSnapshotRepository
+type OpenSearchSnapshotRepositorySpec struct {
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Format="^[a-zA-Z0-9_-]*$"
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// Target project.
+	Project string `json:"project"`
+
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// OpenSearch service to register the repository on
+	ServiceName string `json:"serviceName"`
+
+	// +kubebuilder:validation:Enum=s3;gcs
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// Type of the repository, the settings of the same name are required
+	Type string `json:"type"`
+
+	// Amazon S3 or S3 compatible storage settings
+	S3 *OpenSearchS3RepositorySettings `json:"s3,omitempty"`
+
+	// Google Cloud Storage settings
+	GCS *OpenSearchGCSRepositorySettings `json:"gcs,omitempty"`
+
+	// Registers the repository as read-only, so the service only restores from it
+	ReadOnly bool `json:"readOnly,omitempty"`
+
+	// Authentication reference to Aiven token in a secret
+	AuthSecretRef AuthSecretReference `json:"authSecretRef,omitempty"`
+}
+
+// OpenSearchS3RepositorySettings is an S3 bucket with the credentials in secrets
+type OpenSearchS3RepositorySettings struct {
+	// +kubebuilder:validation:MinLength=1
+	// Bucket name
+	Bucket string `json:"bucket"`
+
+	// Bucket region, e.g. eu-west-1
+	Region string `json:"region,omitempty"`
+
+	// Endpoint of S3 compatible storage, the AWS one is used by default
+	Endpoint string `json:"endpoint,omitempty"`
+
+	// Path in the bucket to keep the snapshots in
+	BasePath string `json:"basePath,omitempty"`
+
+	// Access key ID in a Secret in the namespace of the repository
+	AccessKeyRef SecretKeyReference `json:"accessKeyRef"`
+
+	// Secret access key in a Secret in the namespace of the repository
+	SecretKeyRef SecretKeyReference `json:"secretKeyRef"`
+}
+
+// OpenSearchGCSRepositorySettings is a Google Cloud Storage bucket with the credentials in a secret
+type OpenSearchGCSRepositorySettings struct {
+	// +kubebuilder:validation:MinLength=1
+	// Bucket name
+	Bucket string `json:"bucket"`
+
+	// Path in the bucket to keep the snapshots in
+	BasePath string `json:"basePath,omitempty"`
+
+	// Service account JSON key in a Secret in the namespace of the repository
+	CredentialsRef SecretKeyReference `json:"credentialsRef"`
+}
+
+// OpenSearchSnapshotRepositoryStatus defines the observed state of OpenSearchSnapshotRepository
+type OpenSearchSnapshotRepositoryStatus struct {
+	// Conditions represent the latest available observations of an OpenSearchSnapshotRepository state
+	Conditions []metav1.Condition `json:"conditions"`
+
+	// Link to the service in the Aiven Console
+	ConsoleURL string `json:"consoleURL,omitempty"`
+
+	SyncStatus `json:",inline"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+
+// OpenSearchSnapshotRepository is the Schema for the opensearchsnapshotrepositories API.
+// The resource name is the repository name on the service
+// +kubebuilder:printcolumn:name="Service Name",type="string",JSONPath=".spec.serviceName"
+// +kubebuilder:printcolumn:name="Project",type="string",JSONPath=".spec.project"
+// +kubebuilder:printcolumn:name="Type",type="string",JSONPath=".spec.type"
+type OpenSearchSnapshotRepository struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OpenSearchSnapshotRepositorySpec   `json:"spec,omitempty"`
+	Status OpenSearchSnapshotRepositoryStatus `json:"status,omitempty"`
+}
+
+func (in *OpenSearchSnapshotRepository) AuthSecretRef() AuthSecretReference {
+	return in.Spec.AuthSecretRef
+}
+
+func (in *OpenSearchSnapshotRepository) GetSyncStatus() *SyncStatus {
+	return &in.Status.SyncStatus
+}
+
+// UpdateConsoleURL sets the link to the service in the Aiven Console
+func (in *OpenSearchSnapshotRepository) UpdateConsoleURL() {
+	in.Status.ConsoleURL = serviceConsoleURL(in.Spec.Project, in.Spec.ServiceName, "overview")
+}
+
+//+kubebuilder:object:root=true
+
+// OpenSearchSnapshotRepositoryList contains a list of OpenSearchSnapshotRepository
+type OpenSearchSnapshotRepositoryList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OpenSearchSnapshotRepository `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&OpenSearchSnapshotRepository{}, &OpenSearchSnapshotRepositoryList{})
+}
@@ -0,0 +1,80 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package v1alpha1
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// log is for logging in this package.
+var opensearchsnapshotrepositorylog = logf.Log.WithName("opensearchsnapshotrepository-resource")
+
+func (in *OpenSearchSnapshotRepository) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(in).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/mutate-aiven-io-v1alpha1-opensearchsnapshotrepository,mutating=true,failurePolicy=fail,groups=aiven.io,resources=opensearchsnapshotrepositories,verbs=create;update,versions=v1alpha1,name=mopensearchsnapshotrepository.kb.io,sideEffects=none,admissionReviewVersions=v1
+
+var _ webhook.Defaulter = &OpenSearchSnapshotRepository{}
+
+// Default implements webhook.Defaulter so a webhook will be registered for the type
+func (in *OpenSearchSnapshotRepository) Default() {
+	opensearchsnapshotrepositorylog.Info("default", "name", in.Name)
+}
+
+//+kubebuilder:webhook:verbs=create;update,path=/validate-aiven-io-v1alpha1-opensearchsnapshotrepository,mutating=false,failurePolicy=fail,groups=aiven.io,resources=opensearchsnapshotrepositories,versions=v1alpha1,name=vopensearchsnapshotrepository.kb.io,sideEffects=none,admissionReviewVersions=v1
+
+var _ webhook.Validator = &OpenSearchSnapshotRepository{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (in *OpenSearchSnapshotRepository) ValidateCreate() error {
+	opensearchsnapshotrepositorylog.Info("validate create", "name", in.Name)
+
+	return in.Spec.Validate()
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (in *OpenSearchSnapshotRepository) ValidateUpdate(old runtime.Object) error {
+	opensearchsnapshotrepositorylog.Info("validate update", "name", in.Name)
+
+	return in.Spec.Validate()
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (in *OpenSearchSnapshotRepository) ValidateDelete() error {
+	opensearchsnapshotrepositorylog.Info("validate delete", "name", in.Name)
+
+	return nil
+}
+
+// Validate checks that only the settings of the repository type are set, and the secrets are referenced
+func (in *OpenSearchSnapshotRepositorySpec) Validate() error {
+	refs := make(map[string]SecretKeyReference)
+	switch in.Type {
+	case "s3":
+		if in.S3 == nil || in.GCS != nil {
+			return fmt.Errorf("repository of type %q requires s3 settings only", in.Type)
+		}
+		refs["s3.accessKeyRef"] = in.S3.AccessKeyRef
+		refs["s3.secretKeyRef"] = in.S3.SecretKeyRef
+	case "gcs":
+		if in.GCS == nil || in.S3 != nil {
+			return fmt.Errorf("repository of type %q requires gcs settings only", in.Type)
+		}
+		refs["gcs.credentialsRef"] = in.GCS.CredentialsRef
+	}
+
+	for field, ref := range refs {
+		if ref.Name == "" || ref.Key == "" {
+			return fmt.Errorf("%s requires name and key", field)
+		}
+	}
+	return nil
+}
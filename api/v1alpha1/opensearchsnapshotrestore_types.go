@@ -0,0 +1,125 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// OpenSearchSnapshotRestoreSpec defines the desired state of OpenSearchSnapshotRestore.
+// A restore runs once, create another resource to run it again
+type OpenSearchSnapshotRestoreSpec struct {
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Format="^[a-zA-Z0-9_-]*$"
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// Target project.
+	Project string `json:"project"`
+
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// OpenSearch service to restore the snapshot to
+	ServiceName string `json:"serviceName"`
+
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// Repository registered on the service, e.g. with an OpenSearchSnapshotRepository
+	RepositoryName string `json:"repositoryName"`
+
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// Snapshot to restore
+	SnapshotName string `json:"snapshotName"`
+
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// Indices or patterns to restore, all indices of the snapshot by default.
+	// The restored indices must not exist on the service, unless renamed
+	Indices []string `json:"indices,omitempty"`
+
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// Regular expression of the index names to rename, e.g. "(.+)"
+	RenamePattern string `json:"renamePattern,omitempty"`
+
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// Replacement of the renamed index names, e.g. "restored-$1"
+	RenameReplacement string `json:"renameReplacement,omitempty"`
+
+	// Authentication reference to Aiven token in a secret
+	AuthSecretRef AuthSecretReference `json:"authSecretRef,omitempty"`
+}
+
+// OpenSearchSnapshotRestoreStep is a step of the restore
+type OpenSearchSnapshotRestoreStep struct {
+	// +kubebuilder:validation:Enum=CheckSnapshot;StartRestore;RecoverIndices
+	// Step name
+	Name string `json:"name"`
+
+	// +kubebuilder:validation:Enum=InProgress;Done;Failed
+	// Step state
+	State string `json:"state"`
+
+	// Details of the state, e.g. the recovery progress
+	Message string `json:"message,omitempty"`
+
+	// Last time the state changed
+	LastTransitionTime metav1.Time `json:"lastTransitionTime"`
+}
+
+// OpenSearchSnapshotRestoreStatus defines the observed state of OpenSearchSnapshotRestore
+type OpenSearchSnapshotRestoreStatus struct {
+	// Conditions represent the latest available observations of an OpenSearchSnapshotRestore state
+	Conditions []metav1.Condition `json:"conditions"`
+
+	// Steps of the restore in the order they run
+	Steps []OpenSearchSnapshotRestoreStep `json:"steps,omitempty"`
+
+	// Indices the snapshot is restored to
+	Indices []string `json:"indices,omitempty"`
+
+	// Link to the service in the Aiven Console
+	ConsoleURL string `json:"consoleURL,omitempty"`
+
+	SyncStatus `json:",inline"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+
+// OpenSearchSnapshotRestore is the Schema for the opensearchsnapshotrestores API
+// +kubebuilder:printcolumn:name="Service Name",type="string",JSONPath=".spec.serviceName"
+// +kubebuilder:printcolumn:name="Repository",type="string",JSONPath=".spec.repositoryName"
+// +kubebuilder:printcolumn:name="Snapshot",type="string",JSONPath=".spec.snapshotName"
+// +kubebuilder:printcolumn:name="Step",type="string",JSONPath=".status.steps[-1:].name"
+// +kubebuilder:printcolumn:name="State",type="string",JSONPath=".status.steps[-1:].state"
+type OpenSearchSnapshotRestore struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OpenSearchSnapshotRestoreSpec   `json:"spec,omitempty"`
+	Status OpenSearchSnapshotRestoreStatus `json:"status,omitempty"`
+}
+
+func (in *OpenSearchSnapshotRestore) AuthSecretRef() AuthSecretReference {
+	return in.Spec.AuthSecretRef
+}
+
+func (in *OpenSearchSnapshotRestore) GetSyncStatus() *SyncStatus {
+	return &in.Status.SyncStatus
+}
+
+// UpdateConsoleURL sets the link to the service in the Aiven Console
+func (in *OpenSearchSnapshotRestore) UpdateConsoleURL() {
+	in.Status.ConsoleURL = serviceConsoleURL(in.Spec.Project, in.Spec.ServiceName, "overview")
+}
+
+//+kubebuilder:object:root=true
+
+// OpenSearchSnapshotRestoreList contains a list of OpenSearchSnapshotRestore
+type OpenSearchSnapshotRestoreList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OpenSearchSnapshotRestore `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&OpenSearchSnapshotRestore{}, &OpenSearchSnapshotRestoreList{})
+}
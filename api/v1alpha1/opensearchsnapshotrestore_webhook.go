@@ -0,0 +1,58 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package v1alpha1
+
+import (
+	"errors"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// log is for logging in this package.
+var opensearchsnapshotrestorelog = logf.Log.WithName("opensearchsnapshotrestore-resource")
+
+func (in *OpenSearchSnapshotRestore) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(in).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/mutate-aiven-io-v1alpha1-opensearchsnapshotrestore,mutating=true,failurePolicy=fail,groups=aiven.io,resources=opensearchsnapshotrestores,verbs=create;update,versions=v1alpha1,name=mopensearchsnapshotrestore.kb.io,sideEffects=none,admissionReviewVersions=v1
+
+var _ webhook.Defaulter = &OpenSearchSnapshotRestore{}
+
+// Default implements webhook.Defaulter so a webhook will be registered for the type
+func (in *OpenSearchSnapshotRestore) Default() {
+	opensearchsnapshotrestorelog.Info("default", "name", in.Name)
+}
+
+//+kubebuilder:webhook:verbs=create;update,path=/validate-aiven-io-v1alpha1-opensearchsnapshotrestore,mutating=false,failurePolicy=fail,groups=aiven.io,resources=opensearchsnapshotrestores,versions=v1alpha1,name=vopensearchsnapshotrestore.kb.io,sideEffects=none,admissionReviewVersions=v1
+
+var _ webhook.Validator = &OpenSearchSnapshotRestore{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (in *OpenSearchSnapshotRestore) ValidateCreate() error {
+	opensearchsnapshotrestorelog.Info("validate create", "name", in.Name)
+
+	if (in.Spec.RenamePattern == "") != (in.Spec.RenameReplacement == "") {
+		return errors.New("renamePattern and renameReplacement must be set together")
+	}
+	return nil
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (in *OpenSearchSnapshotRestore) ValidateUpdate(old runtime.Object) error {
+	opensearchsnapshotrestorelog.Info("validate update", "name", in.Name)
+
+	return nil
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (in *OpenSearchSnapshotRestore) ValidateDelete() error {
+	opensearchsnapshotrestorelog.Info("validate delete", "name", in.Name)
+
+	return nil
+}
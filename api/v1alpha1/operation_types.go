@@ -0,0 +1,73 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// OperationSpec defines the desired state of Operation
+// +kubebuilder:validation:XValidation:rule="self.action == 'RotateCredentials' ? has(self.__namespace__) : has(self.project)",message="RotateCredentials requires namespace, the other actions require project"
+type OperationSpec struct {
+	// +kubebuilder:validation:Enum=ResyncProject;PauseProject;ResumeProject;RotateCredentials
+	// Action to run once: ResyncProject reconciles all the resources of the project right away,
+	// PauseProject stops the reconciliation of the resources of the project, ResumeProject restarts it,
+	// RotateCredentials resets the passwords of the ServiceUsers of the namespace
+	Action string `json:"action"`
+
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Format="^[a-zA-Z0-9_-]*$"
+	// Project of the resources, in all the namespaces
+	Project string `json:"project,omitempty"`
+
+	// +kubebuilder:validation:MaxLength=63
+	// Namespace of the ServiceUsers
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// OperationStatus defines the observed state of Operation
+type OperationStatus struct {
+	// Conditions represent the latest available observations of an Operation state
+	Conditions []metav1.Condition `json:"conditions"`
+
+	// Operation state, Succeeded or Failed
+	State string `json:"state,omitempty"`
+
+	// Resources the action was applied to, as Kind namespace/name
+	Resources []string `json:"resources,omitempty"`
+
+	// Time the action completed
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster
+
+// Operation is the Schema for the operations API.
+// It runs an operational action on many resources at once, e.g. during an incident
+// +kubebuilder:validation:XValidation:rule="self.spec == oldSelf.spec",message="Operation is immutable, create another one"
+// +kubebuilder:printcolumn:name="Action",type="string",JSONPath=".spec.action"
+// +kubebuilder:printcolumn:name="Project",type="string",JSONPath=".spec.project"
+// +kubebuilder:printcolumn:name="Namespace",type="string",JSONPath=".spec.namespace"
+// +kubebuilder:printcolumn:name="State",type="string",JSONPath=".status.state"
+type Operation struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OperationSpec   `json:"spec,omitempty"`
+	Status OperationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// OperationList contains a list of Operation
+type OperationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Operation `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Operation{}, &OperationList{})
+}
@@ -0,0 +1,71 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// OperatorConfigName is the name of the OperatorConfig the operator uses
+const OperatorConfigName = "default"
+
+// OperatorConfigSpec defines the desired state of OperatorConfig
+type OperatorConfigSpec struct {
+	// Interval the ready resources are reconciled at, to pick up the changes made on Aiven side.
+	// Not set keeps the resync of the controller cache, every 10 hours
+	ResyncInterval *metav1.Duration `json:"resyncInterval,omitempty"`
+
+	// +kubebuilder:validation:Minimum=0
+	// Reconciliations per minute all controllers share, overrides the --aiven-api-rate-limit flag.
+	// Zero disables the limit
+	AivenAPIRateLimit *int `json:"aivenAPIRateLimit,omitempty"`
+
+	// +kubebuilder:validation:MaxProperties=25
+	// Tags added to all services. The tags of the service take precedence
+	DefaultTags map[string]string `json:"defaultTags,omitempty"`
+
+	// +kubebuilder:validation:XValidation:rule="self.all(k, k in ['DiskPressure', 'ServiceDiff', 'ServiceNotifications'])",message="Supported feature gates are DiskPressure, ServiceDiff and ServiceNotifications"
+	// Enables or disables the features: DiskPressure checks the disk usage of the services,
+	// ServiceDiff shows the changes made outside the operator in the service status,
+	// ServiceNotifications shows the notifications of the services in their status. All are enabled by default
+	FeatureGates map[string]bool `json:"featureGates,omitempty"`
+}
+
+// OperatorConfigStatus defines the observed state of OperatorConfig
+type OperatorConfigStatus struct {
+	// Conditions represent the latest available observations of an OperatorConfig state
+	Conditions []metav1.Condition `json:"conditions"`
+
+	// Generation of the spec the operator applied
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster
+
+// OperatorConfig is the Schema for the operatorconfigs API.
+// It tunes the running operator without a restart, only the one named default is used
+// +kubebuilder:validation:XValidation:rule="self.metadata.name == 'default'",message="OperatorConfig must be named default"
+// +kubebuilder:printcolumn:name="Resync Interval",type="string",JSONPath=".spec.resyncInterval"
+// +kubebuilder:printcolumn:name="API Rate Limit",type="integer",JSONPath=".spec.aivenAPIRateLimit"
+type OperatorConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OperatorConfigSpec   `json:"spec,omitempty"`
+	Status OperatorConfigStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// OperatorConfigList contains a list of OperatorConfig
+type OperatorConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OperatorConfig `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&OperatorConfig{}, &OperatorConfigList{})
+}
@@ -0,0 +1,93 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// OrganizationPermissionSpec defines the desired state of OrganizationPermission
+type OrganizationPermissionSpec struct {
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// Identifier of the organization the project belongs to
+	OrganizationID string `json:"organizationId"`
+
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Format="^[a-zA-Z0-9_-]*$"
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// Project to grant the roles on. The resource manages all the permissions of the project
+	Project string `json:"project"`
+
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=256
+	// Roles of the users and the groups of the organization on the project. The principals that are not listed lose their roles
+	Permissions []OrganizationPermissionEntry `json:"permissions"`
+
+	// Authentication reference to Aiven token in a secret.
+	// The token must be allowed to manage the permissions of the organization
+	AuthSecretRef AuthSecretReference `json:"authSecretRef,omitempty"`
+}
+
+// OrganizationPermissionEntry is the roles of a user or a group
+type OrganizationPermissionEntry struct {
+	// +kubebuilder:validation:Enum=user;user_group
+	// Type of the principal
+	PrincipalType string `json:"principalType"`
+
+	// +kubebuilder:validation:MinLength=1
+	// ID of the user, e.g. u123a456b7890c, or of the group, e.g. ug123a456b7890c
+	PrincipalID string `json:"principalId"`
+
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:XValidation:rule="self.all(p, p in ['admin', 'developer', 'operator', 'read_only'])",message="Permissions must be admin, developer, operator or read_only"
+	// Roles of the principal on the project: admin, developer, operator or read_only
+	Permissions []string `json:"permissions"`
+}
+
+// OrganizationPermissionStatus defines the observed state of OrganizationPermission
+type OrganizationPermissionStatus struct {
+	// Conditions represent the latest available observations of an OrganizationPermission state
+	Conditions []metav1.Condition `json:"conditions"`
+
+	// Differences between the spec and the permissions on Aiven side, e.g. roles granted in the Aiven Console
+	Drift []string `json:"drift,omitempty"`
+
+	SyncStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// OrganizationPermission is the Schema for the organizationpermissions API.
+// It grants the users and the groups of the organization their roles on a project
+// +kubebuilder:printcolumn:name="Organization",type="string",JSONPath=".spec.organizationId"
+// +kubebuilder:printcolumn:name="Project",type="string",JSONPath=".spec.project"
+type OrganizationPermission struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OrganizationPermissionSpec   `json:"spec,omitempty"`
+	Status OrganizationPermissionStatus `json:"status,omitempty"`
+}
+
+func (in *OrganizationPermission) AuthSecretRef() AuthSecretReference {
+	return in.Spec.AuthSecretRef
+}
+
+func (in *OrganizationPermission) GetSyncStatus() *SyncStatus {
+	return &in.Status.SyncStatus
+}
+
+// +kubebuilder:object:root=true
+
+// OrganizationPermissionList contains a list of OrganizationPermission
+type OrganizationPermissionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OrganizationPermission `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&OrganizationPermission{}, &OrganizationPermissionList{})
+}
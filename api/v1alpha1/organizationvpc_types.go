@@ -0,0 +1,121 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// OrganizationVPCSpec defines the desired state of OrganizationVPC
+type OrganizationVPCSpec struct {
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// Identifier of the organization the VPC belongs to
+	OrganizationID string `json:"organizationId"`
+
+	// +kubebuilder:validation:MaxLength=256
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// Cloud the VPC is in
+	CloudName string `json:"cloudName"`
+
+	// +kubebuilder:validation:MaxLength=36
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// Network address range used by the VPC like 192.168.0.0/24
+	NetworkCidr string `json:"networkCidr"`
+
+	// Peering connections of the VPC. The ones that are not listed are deleted
+	PeeringConnections []OrganizationVPCPeeringConnectionSpec `json:"peeringConnections,omitempty"`
+
+	// Authentication reference to Aiven token in a secret.
+	// The token must be allowed to manage the VPCs of the organization
+	AuthSecretRef AuthSecretReference `json:"authSecretRef,omitempty"`
+}
+
+// OrganizationVPCPeeringConnectionSpec is a peering connection to a VPC in a cloud account
+type OrganizationVPCPeeringConnectionSpec struct {
+	// +kubebuilder:validation:MinLength=1
+	// Cloud account of the peer VPC, e.g. AWS account ID, GCP project ID or Azure subscription ID
+	PeerCloudAccount string `json:"peerCloudAccount"`
+
+	// +kubebuilder:validation:MinLength=1
+	// ID of the peer VPC, e.g. AWS VPC ID, GCP network name or Azure virtual network name
+	PeerVPC string `json:"peerVpc"`
+
+	// Region of the peer VPC, if it is not in the region of the organization VPC
+	PeerRegion string `json:"peerRegion,omitempty"`
+
+	// Azure resource group of the peer virtual network
+	PeerResourceGroup string `json:"peerResourceGroup,omitempty"`
+
+	// Azure app ID of the peering
+	PeerAzureAppID string `json:"peerAzureAppId,omitempty"`
+
+	// Azure tenant ID of the peering
+	PeerAzureTenantID string `json:"peerAzureTenantId,omitempty"`
+
+	// Network address ranges of the peer VPC to route to it, all of them by default
+	UserPeerNetworkCIDRs []string `json:"userPeerNetworkCidrs,omitempty"`
+}
+
+// OrganizationVPCStatus defines the observed state of OrganizationVPC
+type OrganizationVPCStatus struct {
+	// Conditions represent the latest available observations of an OrganizationVPC state
+	Conditions []metav1.Condition `json:"conditions"`
+
+	// State of VPC
+	State string `json:"state"`
+
+	// Organization VPC id, services use it as their projectVpcId
+	ID string `json:"id"`
+
+	// Link to the VPCs of the organization in the Aiven Console
+	ConsoleURL string `json:"consoleURL,omitempty"`
+
+	// Peering connections of the VPC, and what to do for the ones that are not active
+	PeeringConnections []ProjectVPCPeeringConnection `json:"peeringConnections,omitempty"`
+
+	SyncStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// OrganizationVPC is the Schema for the organizationvpcs API.
+// It is a VPC shared by the projects of the organization
+// +kubebuilder:printcolumn:name="Organization",type="string",JSONPath=".spec.organizationId"
+// +kubebuilder:printcolumn:name="Cloud",type="string",JSONPath=".spec.cloudName"
+// +kubebuilder:printcolumn:name="Network CIDR",type="string",JSONPath=".spec.networkCidr"
+// +kubebuilder:printcolumn:name="State",type="string",JSONPath=".status.state"
+type OrganizationVPC struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OrganizationVPCSpec   `json:"spec,omitempty"`
+	Status OrganizationVPCStatus `json:"status,omitempty"`
+}
+
+func (in *OrganizationVPC) AuthSecretRef() AuthSecretReference {
+	return in.Spec.AuthSecretRef
+}
+
+func (in *OrganizationVPC) GetSyncStatus() *SyncStatus {
+	return &in.Status.SyncStatus
+}
+
+// UpdateConsoleURL sets the link to the VPCs of the organization in the Aiven Console
+func (in *OrganizationVPC) UpdateConsoleURL() {
+	in.Status.ConsoleURL = consoleURL("account", in.Spec.OrganizationID, "admin", "vpcs")
+}
+
+// +kubebuilder:object:root=true
+
+// OrganizationVPCList contains a list of OrganizationVPC
+type OrganizationVPCList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OrganizationVPC `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&OrganizationVPC{}, &OrganizationVPCList{})
+}
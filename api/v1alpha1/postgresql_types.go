@@ -9,6 +9,7 @@ import (
 )
 
 // PostgreSQLSpec defines the desired state of postgres instance
+// +kubebuilder:validation:XValidation:rule="!has(self.disk_space) || !has(self.additionalDiskSpace)",message="disk_space and additionalDiskSpace are mutually exclusive"
 type PostgreSQLSpec struct {
 	ServiceCommonSpec `json:",inline"`
 
@@ -16,6 +17,12 @@ type PostgreSQLSpec struct {
 	// The disk space of the service, possible values depend on the service type, the cloud provider and the project. Reducing will result in the service re-balancing.
 	DiskSpace string `json:"disk_space,omitempty"`
 
+	// +kubebuilder:validation:Format="^[1-9][0-9]*(GiB|G)*"
+	// The disk space added to the disk space of the plan, e.g. 30GiB. Must be a multiple of the disk space step of the plan,
+	// and fit its maximum disk space. The disk space of the plan and the additional one are in status.diskSpace.
+	// Can't be set with disk_space. Reducing will result in the service re-balancing.
+	AdditionalDiskSpace string `json:"additionalDiskSpace,omitempty"`
+
 	// Authentication reference to Aiven token in a secret
 	AuthSecretRef AuthSecretReference `json:"authSecretRef,omitempty"`
 
@@ -26,6 +33,33 @@ type PostgreSQLSpec struct {
 	UserConfig *pguserconfig.PgUserConfig `json:"userConfig,omitempty"`
 }
 
+// PostgreSQLStatus defines the observed state of PostgreSQL
+type PostgreSQLStatus struct {
+	ServiceStatus `json:",inline"`
+
+	// Limits of the service plan to size the connection pools and the applications with
+	Limits *PostgreSQLLimits `json:"limits,omitempty"`
+}
+
+// PostgreSQLLimits are the limits of the service that depend on its plan
+type PostgreSQLLimits struct {
+	// The plan the limits are of
+	Plan string `json:"plan"`
+
+	// The cloud the limits are of, the node memory differs between the clouds
+	CloudName string `json:"cloudName"`
+
+	// Maximum number of connections to the service. A few of them are reserved for the Aiven maintenance
+	MaxConnections int `json:"maxConnections,omitempty"`
+
+	// Memory of a node in megabytes
+	NodeMemoryMB int `json:"nodeMemoryMB,omitempty"`
+
+	// The work_mem of the service in megabytes: userConfig.pg.work_mem,
+	// or the Aiven default of 1MB + 0.075% of the node memory, up to 32MB
+	WorkMemMB int `json:"workMemMB,omitempty"`
+}
+
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 
@@ -35,18 +69,30 @@ type PostgreSQLSpec struct {
 // +kubebuilder:printcolumn:name="Region",type="string",JSONPath=".spec.cloudName"
 // +kubebuilder:printcolumn:name="Plan",type="string",JSONPath=".spec.plan"
 // +kubebuilder:printcolumn:name="State",type="string",JSONPath=".status.state"
+// +kubebuilder:printcolumn:name="Endpoint",type="string",JSONPath=".status.connectionInfo.endpoint"
+// +kubebuilder:printcolumn:name="Max Connections",type="integer",JSONPath=".status.limits.maxConnections",priority=1
+// +kubebuilder:validation:XValidation:rule="size(self.metadata.name) <= 63 && self.metadata.name.matches('^[a-z][-a-z0-9]*$')",message="Service name must be at most 63 characters of lowercase letters, digits and dashes, and start with a letter"
 type PostgreSQL struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
 
-	Spec   PostgreSQLSpec `json:"spec,omitempty"`
-	Status ServiceStatus  `json:"status,omitempty"`
+	Spec   PostgreSQLSpec   `json:"spec,omitempty"`
+	Status PostgreSQLStatus `json:"status,omitempty"`
 }
 
 func (in *PostgreSQL) AuthSecretRef() AuthSecretReference {
 	return in.Spec.AuthSecretRef
 }
 
+func (in *PostgreSQL) GetSyncStatus() *SyncStatus {
+	return &in.Status.SyncStatus
+}
+
+// UpdateConsoleURL sets the link to the service in the Aiven Console
+func (in *PostgreSQL) UpdateConsoleURL() {
+	in.Status.ConsoleURL = serviceConsoleURL(in.Spec.Project, in.Name, "overview")
+}
+
 func (in *PostgreSQL) GetRefs() []*ResourceReferenceObject {
 	return in.Spec.GetRefs(in.GetNamespace())
 }
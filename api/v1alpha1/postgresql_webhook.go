@@ -52,6 +52,16 @@ func (r *PostgreSQL) ValidateUpdate(old runtime.Object) error {
 		return errors.New("cannot update a PostgreSQL service, connInfoSecretTarget.name field is immutable and cannot be updated")
 	}
 
+	err := ValidateDownsize(r, old.(*PostgreSQL).Status.DiskSpace, old.(*PostgreSQL).Spec.Plan, r.Spec.Plan, old.(*PostgreSQL).Spec.DiskSpace, r.Spec.DiskSpace)
+	if err != nil {
+		return err
+	}
+
+	err = ValidateAdditionalDiskDownsize(r, old.(*PostgreSQL).Spec.AdditionalDiskSpace, r.Spec.AdditionalDiskSpace)
+	if err != nil {
+		return err
+	}
+
 	return r.Spec.Validate()
 }
 
@@ -57,9 +57,15 @@ type ProjectSpec struct {
 	// Information regarding secret creation
 	ConnInfoSecretTarget ConnInfoSecretTarget `json:"connInfoSecretTarget,omitempty"`
 
+	// Also stores the project CA certificate into a ConfigMap under the `ca.crt` key
+	CACertConfigMapTarget *ConfigMapTarget `json:"caCertConfigMapTarget,omitempty"`
+
 	// Tags are key-value pairs that allow you to categorize projects
 	Tags map[string]string `json:"tags,omitempty"`
 
+	// Aggregates the state of the project resources into the status
+	AggregateStatus bool `json:"aggregateStatus,omitempty"`
+
 	// Authentication reference to Aiven token in a secret
 	AuthSecretRef AuthSecretReference `json:"authSecretRef,omitempty"`
 }
@@ -84,12 +90,43 @@ type ProjectStatus struct {
 
 	// Payment method name
 	PaymentMethod string `json:"paymentMethod,omitempty"`
+
+	// Aggregated state of the project resources, see spec.aggregateStatus
+	Aggregate *ProjectAggregateStatus `json:"aggregate,omitempty"`
+
+	// Link to the project in the Aiven Console
+	ConsoleURL string `json:"consoleURL,omitempty"`
+
+	SyncStatus `json:",inline"`
+}
+
+// ProjectAggregateStatus summarizes the state of the project resources
+type ProjectAggregateStatus struct {
+	// Number of the resources in the namespace linked to the project that are running
+	Ready int `json:"ready"`
+
+	// Number of the resources in the namespace linked to the project that are not running yet
+	NotReady int `json:"notReady"`
+
+	// Number of the resources in the namespace linked to the project that report a failure in their Running condition
+	Error int `json:"error"`
+
+	// Services with pending maintenance updates
+	PendingMaintenance []string `json:"pendingMaintenance,omitempty"`
+
+	// Estimated hourly cost of the powered on services in USD, by their plan and additional disk space prices
+	EstimatedHourlyCostUSD string `json:"estimatedHourlyCostUsd,omitempty"`
 }
 
 // +kubebuilder:object:root=true
 
 // Project is the Schema for the projects API
 // +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Ready",type="integer",JSONPath=".status.aggregate.ready"
+// +kubebuilder:printcolumn:name="Not Ready",type="integer",JSONPath=".status.aggregate.notReady"
+// +kubebuilder:printcolumn:name="Error",type="integer",JSONPath=".status.aggregate.error"
+// +kubebuilder:printcolumn:name="Hourly Cost USD",type="string",JSONPath=".status.aggregate.estimatedHourlyCostUsd"
+// +kubebuilder:validation:XValidation:rule="size(self.metadata.name) <= 63 && self.metadata.name.matches('^[a-zA-Z0-9_-]+$')",message="Project name must be at most 63 characters of letters, digits, underscores and dashes"
 type Project struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
@@ -102,6 +139,15 @@ func (proj Project) AuthSecretRef() AuthSecretReference {
 	return proj.Spec.AuthSecretRef
 }
 
+func (proj *Project) GetSyncStatus() *SyncStatus {
+	return &proj.Status.SyncStatus
+}
+
+// UpdateConsoleURL sets the link to the project in the Aiven Console
+func (proj *Project) UpdateConsoleURL() {
+	proj.Status.ConsoleURL = consoleURL("project", proj.Name, "services")
+}
+
 // +kubebuilder:object:root=true
 
 // ProjectList contains a list of Project
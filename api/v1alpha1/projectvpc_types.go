@@ -20,6 +20,7 @@ type ProjectVPCSpec struct {
 	CloudName string `json:"cloudName"`
 
 	// +kubebuilder:validation:MaxLength=36
+	// +kubebuilder:validation:Pattern="^([0-9]{1,3}[.]){3}[0-9]{1,3}/[0-9]{1,2}$"
 	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
 	// Network address range used by the VPC like 192.168.0.0/24
 	NetworkCidr string `json:"networkCidr"`
@@ -38,6 +39,35 @@ type ProjectVPCStatus struct {
 
 	// Project VPC id
 	ID string `json:"id"`
+
+	// Link to the VPCs of the project in the Aiven Console
+	ConsoleURL string `json:"consoleURL,omitempty"`
+
+	// Peering connections of the VPC, and what to do for the ones that are not active
+	PeeringConnections []ProjectVPCPeeringConnection `json:"peeringConnections,omitempty"`
+
+	SyncStatus `json:",inline"`
+}
+
+// ProjectVPCPeeringConnection is a peering connection of the VPC
+type ProjectVPCPeeringConnection struct {
+	// Cloud account of the peer VPC, e.g. AWS account ID or GCP project ID
+	PeerCloudAccount string `json:"peerCloudAccount"`
+
+	// ID of the peer VPC
+	PeerVPC string `json:"peerVpc"`
+
+	// Region of the peer VPC, if it is not in the region of the project VPC
+	PeerRegion string `json:"peerRegion,omitempty"`
+
+	// State of the peering connection, e.g. PENDING_PEER or ACTIVE
+	State string `json:"state"`
+
+	// Network address ranges of the peer VPC that are routed to it
+	UserPeerNetworkCIDRs []string `json:"userPeerNetworkCidrs,omitempty"`
+
+	// What to do to make the peering connection active, or why it failed
+	Message string `json:"message,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -48,6 +78,7 @@ type ProjectVPCStatus struct {
 // +kubebuilder:printcolumn:name="Cloud",type="string",JSONPath=".spec.cloudName"
 // +kubebuilder:printcolumn:name="Network CIDR",type="string",JSONPath=".spec.networkCidr"
 // +kubebuilder:printcolumn:name="State",type="string",JSONPath=".status.state"
+// +kubebuilder:printcolumn:name="VPC ID",type="string",JSONPath=".status.id",priority=1
 type ProjectVPC struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
@@ -60,6 +91,15 @@ func (pvpc ProjectVPC) AuthSecretRef() AuthSecretReference {
 	return pvpc.Spec.AuthSecretRef
 }
 
+func (pvpc *ProjectVPC) GetSyncStatus() *SyncStatus {
+	return &pvpc.Status.SyncStatus
+}
+
+// UpdateConsoleURL sets the link to the VPCs of the project in the Aiven Console
+func (pvpc *ProjectVPC) UpdateConsoleURL() {
+	pvpc.Status.ConsoleURL = consoleURL("project", pvpc.Spec.Project, "vpcs")
+}
+
 // +kubebuilder:object:root=true
 
 // ProjectVPCList contains a list of ProjectVPC
@@ -52,6 +52,16 @@ func (r *Redis) ValidateUpdate(old runtime.Object) error {
 		return errors.New("cannot update a Redis service, connInfoSecretTarget.name field is immutable and cannot be updated")
 	}
 
+	err := ValidateDownsize(r, old.(*Redis).Status.DiskSpace, old.(*Redis).Spec.Plan, r.Spec.Plan, old.(*Redis).Spec.DiskSpace, r.Spec.DiskSpace)
+	if err != nil {
+		return err
+	}
+
+	err = ValidateAdditionalDiskDownsize(r, old.(*Redis).Spec.AdditionalDiskSpace, r.Spec.AdditionalDiskSpace)
+	if err != nil {
+		return err
+	}
+
 	return r.Spec.Validate()
 }
 
@@ -0,0 +1,86 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ReferenceGrantSpec defines the desired state of ReferenceGrant
+type ReferenceGrantSpec struct {
+	// +kubebuilder:validation:MinItems=1
+	// Resources of the other namespaces that are allowed to refer to the services
+	From []ReferenceGrantFrom `json:"from"`
+
+	// +kubebuilder:validation:MinItems=1
+	// Services of the namespace of the grant that can be referred to
+	To []ReferenceGrantTo `json:"to"`
+}
+
+// ReferenceGrantFrom allows the resources of a kind in a namespace to refer to the services
+type ReferenceGrantFrom struct {
+	// +kubebuilder:validation:Enum=Database;KafkaTopic;ServiceUser
+	// Kind of the referring resources
+	Kind string `json:"kind"`
+
+	// +kubebuilder:validation:MinLength=1
+	// Namespace of the referring resources
+	Namespace string `json:"namespace"`
+}
+
+// ReferenceGrantTo is a service that can be referred to
+type ReferenceGrantTo struct {
+	// +kubebuilder:validation:Enum=Cassandra;Clickhouse;Dragonfly;Grafana;Kafka;KafkaConnect;M3Aggregator;M3DB;MySQL;OpenSearch;PostgreSQL;Redis;Thanos;Valkey
+	// Kind of the service
+	Kind string `json:"kind"`
+
+	// +kubebuilder:validation:MinLength=1
+	// Name of the service resource, all the services of the kind if not set
+	Name string `json:"name,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ReferenceGrant is the Schema for the referencegrants API.
+// It allows resources of other namespaces to refer to the services of its namespace,
+// which shares the Aiven token of the services with them
+type ReferenceGrant struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ReferenceGrantSpec `json:"spec,omitempty"`
+}
+
+// Allows returns true if the grant allows the resource of the kind in the namespace to refer to the service
+func (in *ReferenceGrant) Allows(fromKind, fromNamespace, toKind, toName string) bool {
+	from := false
+	for _, f := range in.Spec.From {
+		if f.Kind == fromKind && f.Namespace == fromNamespace {
+			from = true
+			break
+		}
+	}
+	if !from {
+		return false
+	}
+
+	for _, t := range in.Spec.To {
+		if t.Kind == toKind && (t.Name == "" || t.Name == toName) {
+			return true
+		}
+	}
+	return false
+}
+
+// +kubebuilder:object:root=true
+
+// ReferenceGrantList contains a list of ReferenceGrant
+type ReferenceGrantList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ReferenceGrant `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ReferenceGrant{}, &ReferenceGrantList{})
+}
@@ -0,0 +1,52 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package v1alpha1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReferenceGrantAllows(t *testing.T) {
+	grant := &ReferenceGrant{
+		Spec: ReferenceGrantSpec{
+			From: []ReferenceGrantFrom{
+				{Kind: "KafkaTopic", Namespace: "app"},
+				{Kind: "ServiceUser", Namespace: "app"},
+			},
+			To: []ReferenceGrantTo{
+				{Kind: "Kafka", Name: "kafka"},
+				{Kind: "PostgreSQL"},
+			},
+		},
+	}
+
+	cases := []struct {
+		name                                    string
+		fromKind, fromNamespace, toKind, toName string
+		allows                                  bool
+	}{
+		{name: "named service", fromKind: "KafkaTopic", fromNamespace: "app", toKind: "Kafka", toName: "kafka", allows: true},
+		{name: "any service of the kind", fromKind: "ServiceUser", fromNamespace: "app", toKind: "PostgreSQL", toName: "pg", allows: true},
+		{name: "other service", fromKind: "KafkaTopic", fromNamespace: "app", toKind: "Kafka", toName: "other", allows: false},
+		{name: "other kind", fromKind: "Database", fromNamespace: "app", toKind: "PostgreSQL", toName: "pg", allows: false},
+		{name: "other namespace", fromKind: "KafkaTopic", fromNamespace: "other", toKind: "Kafka", toName: "kafka", allows: false},
+		{name: "other service kind", fromKind: "ServiceUser", fromNamespace: "app", toKind: "MySQL", toName: "kafka", allows: false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assert.Equal(t, c.allows, grant.Allows(c.fromKind, c.fromNamespace, c.toKind, c.toName))
+		})
+	}
+}
+
+func TestServiceReferenceValidateKind(t *testing.T) {
+	var ref *ServiceReference
+	assert.NoError(t, ref.validateKind("Kafka"))
+
+	ref = &ServiceReference{Kind: "PostgreSQL", Name: "pg"}
+	assert.NoError(t, ref.validateKind("PostgreSQL", "MySQL"))
+	assert.ErrorContains(t, ref.validateKind("Kafka"), `got "PostgreSQL"`)
+}
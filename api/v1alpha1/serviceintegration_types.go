@@ -13,7 +13,7 @@ type ServiceIntegrationSpec struct {
 	// Project the integration belongs to
 	Project string `json:"project"`
 
-	// +kubebuilder:validation:Enum=datadog;kafka_logs;kafka_connect;metrics;dashboard;rsyslog;read_replica;schema_registry_proxy;signalfx;jolokia;internal_connectivity;external_google_cloud_logging;datasource
+	// +kubebuilder:validation:Enum=datadog;kafka_logs;kafka_connect;metrics;dashboard;rsyslog;read_replica;schema_registry_proxy;signalfx;jolokia;internal_connectivity;external_google_cloud_logging;datasource;m3aggregator;logs
 	// Type of the service integration
 	IntegrationType string `json:"integrationType"`
 
@@ -41,6 +41,9 @@ type ServiceIntegrationSpec struct {
 	// Metrics configuration values
 	MetricsUserConfig ServiceIntegrationMetricsUserConfig `json:"metrics,omitempty"`
 
+	// Logs configuration values
+	LogsUserConfig ServiceIntegrationLogsUserConfig `json:"logs,omitempty"`
+
 	// Authentication reference to Aiven token in a secret
 	AuthSecretRef AuthSecretReference `json:"authSecretRef,omitempty"`
 }
@@ -52,6 +55,11 @@ type ServiceIntegrationStatus struct {
 
 	// Service integration ID
 	ID string `json:"id"`
+
+	// Link to the integrations of the source service in the Aiven Console
+	ConsoleURL string `json:"consoleURL,omitempty"`
+
+	SyncStatus `json:",inline"`
 }
 
 type ServiceIntegrationMetricsUserConfig struct {
@@ -60,9 +68,10 @@ type ServiceIntegrationMetricsUserConfig struct {
 	// Name of the database where to store metric datapoints. Only affects PostgreSQL destinations
 	Database string `json:"database,omitempty"`
 
-	// +kubebuilder:validation:Max=10000
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=10000
 	// Number of days to keep old metrics. Only affects PostgreSQL destinations. Set to 0 for no automatic cleanup. Defaults to 30 days.
-	RetentionDays int `json:"retention_days,omitempty"`
+	RetentionDays *int `json:"retention_days,omitempty"`
 
 	// +kubebuilder:validation:Format="^[_A-Za-z0-9][-._A-Za-z0-9]{0,39}$"
 	// +kubebuilder:validation:MaxLength=40
@@ -75,6 +84,19 @@ type ServiceIntegrationMetricsUserConfig struct {
 	Username string `json:"username,omitempty"`
 }
 
+type ServiceIntegrationLogsUserConfig struct {
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=10000
+	// Number of days to keep the daily indexes of the logs. Defaults to 3 days.
+	ElasticsearchIndexDaysMax *int `json:"elasticsearch_index_days_max,omitempty"`
+
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=1024
+	// +kubebuilder:validation:Pattern=`^[a-z0-9][a-z0-9-_.]+$`
+	// Prefix of the log indexes. Defaults to 'logs'.
+	ElasticsearchIndexPrefix string `json:"elasticsearch_index_prefix,omitempty"`
+}
+
 type ServiceIntegrationKafkaLogsUserConfig struct {
 	// +kubebuilder:validation:MaxLength=63
 	// +kubebuilder:validation:MinLength=1
@@ -143,6 +165,15 @@ func (svcint ServiceIntegration) AuthSecretRef() AuthSecretReference {
 	return svcint.Spec.AuthSecretRef
 }
 
+func (svcint *ServiceIntegration) GetSyncStatus() *SyncStatus {
+	return &svcint.Status.SyncStatus
+}
+
+// UpdateConsoleURL sets the link to the integrations of the source service in the Aiven Console
+func (svcint *ServiceIntegration) UpdateConsoleURL() {
+	svcint.Status.ConsoleURL = serviceConsoleURL(svcint.Spec.Project, svcint.Spec.SourceServiceName, "integrations")
+}
+
 // +kubebuilder:object:root=true
 
 // ServiceIntegrationList contains a list of ServiceIntegration
@@ -4,6 +4,7 @@ package v1alpha1
 
 import (
 	"errors"
+	"fmt"
 
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -58,7 +59,7 @@ func (r *ServiceIntegration) ValidateCreate() error {
 		return errors.New("destinationEndpointID cannot be empty when sourceEndpointID is set")
 	}
 
-	return nil
+	return r.validateRetention()
 }
 
 // ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
@@ -89,6 +90,20 @@ func (r *ServiceIntegration) ValidateUpdate(old runtime.Object) error {
 		return errors.New("cannot update service integration, destinationServiceName field is idempotent")
 	}
 
+	return r.validateRetention()
+}
+
+// validateRetention rejects the retention settings of another integration type,
+// which are not sent to Aiven, so the retention would silently stay the default one
+func (r *ServiceIntegration) validateRetention() error {
+	if r.Spec.IntegrationType != "logs" && r.Spec.LogsUserConfig != (ServiceIntegrationLogsUserConfig{}) {
+		return fmt.Errorf("logs field can be used with logs integration type only, got %q", r.Spec.IntegrationType)
+	}
+
+	if r.Spec.IntegrationType != "metrics" && r.Spec.MetricsUserConfig != (ServiceIntegrationMetricsUserConfig{}) {
+		return fmt.Errorf("metrics field can be used with metrics integration type only, got %q", r.Spec.IntegrationType)
+	}
+
 	return nil
 }
 
@@ -0,0 +1,55 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package v1alpha1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServiceIntegrationValidateRetention(t *testing.T) {
+	days := 7
+	cases := []struct {
+		name  string
+		spec  ServiceIntegrationSpec
+		valid bool
+	}{
+		{
+			name:  "no retention",
+			spec:  ServiceIntegrationSpec{IntegrationType: "logs"},
+			valid: true,
+		},
+		{
+			name:  "logs retention",
+			spec:  ServiceIntegrationSpec{IntegrationType: "logs", LogsUserConfig: ServiceIntegrationLogsUserConfig{ElasticsearchIndexDaysMax: &days}},
+			valid: true,
+		},
+		{
+			name:  "metrics retention",
+			spec:  ServiceIntegrationSpec{IntegrationType: "metrics", MetricsUserConfig: ServiceIntegrationMetricsUserConfig{RetentionDays: &days}},
+			valid: true,
+		},
+		{
+			name:  "logs retention on metrics integration",
+			spec:  ServiceIntegrationSpec{IntegrationType: "metrics", LogsUserConfig: ServiceIntegrationLogsUserConfig{ElasticsearchIndexDaysMax: &days}},
+			valid: false,
+		},
+		{
+			name:  "metrics retention on logs integration",
+			spec:  ServiceIntegrationSpec{IntegrationType: "logs", MetricsUserConfig: ServiceIntegrationMetricsUserConfig{RetentionDays: &days}},
+			valid: false,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := (&ServiceIntegration{Spec: c.spec}).validateRetention()
+			if c.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
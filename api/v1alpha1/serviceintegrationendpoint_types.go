@@ -0,0 +1,111 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// ServiceIntegrationEndpointSpec defines the desired state of ServiceIntegrationEndpoint
+type ServiceIntegrationEndpointSpec struct {
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Format="^[a-zA-Z0-9_-]*$"
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// Target project.
+	Project string `json:"project"`
+
+	// +kubebuilder:validation:MaxLength=36
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// Name of the service integration endpoint
+	EndpointName string `json:"endpointName"`
+
+	// +kubebuilder:validation:Enum=datadog;external_aws_cloudwatch_logs;external_aws_cloudwatch_metrics;external_elasticsearch_logs;external_google_cloud_logging;external_kafka;external_opensearch_logs;external_schema_registry;jolokia;prometheus;rsyslog
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// Type of the service integration endpoint
+	EndpointType string `json:"endpointType"`
+
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// Endpoint type specific configuration, see the Aiven API documentation.
+	// Keep the credentials in userConfigSecrets
+	UserConfig *runtime.RawExtension `json:"userConfig,omitempty"`
+
+	// User configuration fields read from secrets, like the Datadog API key or the external Kafka SASL password.
+	// The values are resolved on every update, so the credentials are not stored in the resource
+	UserConfigSecrets []EndpointUserConfigSecret `json:"userConfigSecrets,omitempty"`
+
+	// Authentication reference to Aiven token in a secret
+	AuthSecretRef AuthSecretReference `json:"authSecretRef,omitempty"`
+}
+
+// EndpointUserConfigSecret is a user configuration field with the value in a secret
+type EndpointUserConfigSecret struct {
+	// +kubebuilder:validation:MinLength=1
+	// Name of the user configuration field, e.g. datadog_api_key
+	Name string `json:"name"`
+
+	// Source of the field value
+	ValueFrom EndpointUserConfigSecretSource `json:"valueFrom"`
+}
+
+// EndpointUserConfigSecretSource selects the value of a user configuration field
+type EndpointUserConfigSecretSource struct {
+	// Key of a Secret in the namespace of the endpoint
+	SecretKeyRef SecretKeyReference `json:"secretKeyRef"`
+}
+
+// ServiceIntegrationEndpointStatus defines the observed state of ServiceIntegrationEndpoint
+type ServiceIntegrationEndpointStatus struct {
+	// Conditions represent the latest available observations of an ServiceIntegrationEndpoint state
+	Conditions []metav1.Condition `json:"conditions"`
+
+	// Service integration endpoint ID, use it in the ServiceIntegration source or destination endpoint
+	ID string `json:"id"`
+
+	// Link to the integration endpoints of the project in the Aiven Console
+	ConsoleURL string `json:"consoleURL,omitempty"`
+
+	SyncStatus `json:",inline"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+
+// ServiceIntegrationEndpoint is the Schema for the serviceintegrationendpoints API
+// +kubebuilder:printcolumn:name="Project",type="string",JSONPath=".spec.project"
+// +kubebuilder:printcolumn:name="Endpoint Name",type="string",JSONPath=".spec.endpointName"
+// +kubebuilder:printcolumn:name="Endpoint Type",type="string",JSONPath=".spec.endpointType"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".status.id"
+type ServiceIntegrationEndpoint struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ServiceIntegrationEndpointSpec   `json:"spec,omitempty"`
+	Status ServiceIntegrationEndpointStatus `json:"status,omitempty"`
+}
+
+func (in *ServiceIntegrationEndpoint) AuthSecretRef() AuthSecretReference {
+	return in.Spec.AuthSecretRef
+}
+
+func (in *ServiceIntegrationEndpoint) GetSyncStatus() *SyncStatus {
+	return &in.Status.SyncStatus
+}
+
+// UpdateConsoleURL sets the link to the integration endpoints of the project in the Aiven Console
+func (in *ServiceIntegrationEndpoint) UpdateConsoleURL() {
+	in.Status.ConsoleURL = consoleURL("project", in.Spec.Project, "integration-endpoints")
+}
+
+//+kubebuilder:object:root=true
+
+// ServiceIntegrationEndpointList contains a list of ServiceIntegrationEndpoint
+type ServiceIntegrationEndpointList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ServiceIntegrationEndpoint `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ServiceIntegrationEndpoint{}, &ServiceIntegrationEndpointList{})
+}
@@ -0,0 +1,95 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package v1alpha1
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// log is for logging in this package.
+var serviceintegrationendpointlog = logf.Log.WithName("serviceintegrationendpoint-resource")
+
+func (in *ServiceIntegrationEndpoint) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(in).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/mutate-aiven-io-v1alpha1-serviceintegrationendpoint,mutating=true,failurePolicy=fail,groups=aiven.io,resources=serviceintegrationendpoints,verbs=create;update,versions=v1alpha1,name=mserviceintegrationendpoint.kb.io,sideEffects=none,admissionReviewVersions=v1
+
+var _ webhook.Defaulter = &ServiceIntegrationEndpoint{}
+
+// Default implements webhook.Defaulter so a webhook will be registered for the type
+func (in *ServiceIntegrationEndpoint) Default() {
+	serviceintegrationendpointlog.Info("default", "name", in.Name)
+}
+
+//+kubebuilder:webhook:verbs=create;update,path=/validate-aiven-io-v1alpha1-serviceintegrationendpoint,mutating=false,failurePolicy=fail,groups=aiven.io,resources=serviceintegrationendpoints,versions=v1alpha1,name=vserviceintegrationendpoint.kb.io,sideEffects=none,admissionReviewVersions=v1
+
+var _ webhook.Validator = &ServiceIntegrationEndpoint{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (in *ServiceIntegrationEndpoint) ValidateCreate() error {
+	serviceintegrationendpointlog.Info("validate create", "name", in.Name)
+
+	return in.Spec.Validate()
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (in *ServiceIntegrationEndpoint) ValidateUpdate(old runtime.Object) error {
+	serviceintegrationendpointlog.Info("validate update", "name", in.Name)
+
+	return in.Spec.Validate()
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (in *ServiceIntegrationEndpoint) ValidateDelete() error {
+	serviceintegrationendpointlog.Info("validate delete", "name", in.Name)
+
+	return nil
+}
+
+// Validate checks that the user config is an object and every field is set either in it or from a secret
+func (in *ServiceIntegrationEndpointSpec) Validate() error {
+	userConfig, err := in.GetUserConfig()
+	if err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool, len(in.UserConfigSecrets))
+	for _, s := range in.UserConfigSecrets {
+		if seen[s.Name] {
+			return fmt.Errorf("userConfigSecrets field %q is declared more than once", s.Name)
+		}
+		seen[s.Name] = true
+
+		if _, ok := userConfig[s.Name]; ok {
+			return fmt.Errorf("field %q is set in both userConfig and userConfigSecrets", s.Name)
+		}
+
+		ref := s.ValueFrom.SecretKeyRef
+		if ref.Name == "" || ref.Key == "" {
+			return fmt.Errorf("userConfigSecrets field %q requires secretKeyRef name and key", s.Name)
+		}
+	}
+	return nil
+}
+
+// GetUserConfig returns the user config fields, without the ones from the secrets
+func (in *ServiceIntegrationEndpointSpec) GetUserConfig() (map[string]interface{}, error) {
+	userConfig := make(map[string]interface{})
+	if in.UserConfig == nil || len(in.UserConfig.Raw) == 0 {
+		return userConfig, nil
+	}
+
+	if err := json.Unmarshal(in.UserConfig.Raw, &userConfig); err != nil {
+		return nil, fmt.Errorf("userConfig must be an object: %w", err)
+	}
+	return userConfig, nil
+}
@@ -17,15 +17,88 @@ type ServiceUserSpec struct {
 	// Service to link the user to
 	ServiceName string `json:"serviceName"`
 
+	// Service resource of the serviceName. A service in another namespace must be shared with a ReferenceGrant.
+	// Its authSecretRef is used if the resource doesn't set one
+	ServiceRef *ServiceReference `json:"serviceRef,omitempty"`
+
 	// +kubebuilder:validation:Enum=caching_sha2_password;mysql_native_password
 	// Authentication details
 	Authentication string `json:"authentication,omitempty"`
 
+	// +kubebuilder:validation:Enum=password;mtls
+	// Authentication type of the clients, the secret has the credentials of the type only.
+	// mtls authenticates with the access certificate and key of the user, only applicable to Kafka services
+	// with the certificate authentication enabled. Empty value writes both the password and the certificate
+	AuthenticationType string `json:"authenticationType,omitempty"`
+
 	// Information regarding secret creation
 	ConnInfoSecretTarget ConnInfoSecretTarget `json:"connInfoSecretTarget,omitempty"`
 
 	// Authentication reference to Aiven token in a secret
 	AuthSecretRef AuthSecretReference `json:"authSecretRef,omitempty"`
+
+	// OpenSearch index ACL rules of the user, only applicable to OpenSearch services.
+	// Enables ACLs on the service when set.
+	OpenSearchACLRules []OpenSearchACLRule `json:"openSearchAclRules,omitempty"`
+
+	// Kafka topic access of the user, only applicable to Kafka services.
+	// Each entry is created as a Kafka ACL of the user, and removed with the entry or the user
+	KafkaTopicAccess []KafkaTopicAccess `json:"kafkaTopicAccess,omitempty"`
+
+	// MySQL privileges of the user, only applicable to MySQL services.
+	// The privileges removed from the list are revoked
+	MySQLGrants []MySQLGrant `json:"mysqlGrants,omitempty"`
+
+	// +kubebuilder:validation:XValidation:rule="duration(self) >= duration('5m')",message="TTL must be at least 5m"
+	// Time to live of the user since the creation of the resource, e.g. 24h for a preview environment or a CI job.
+	// The expired user is deleted with its credentials, which are revoked first
+	TTL *metav1.Duration `json:"ttl,omitempty"`
+}
+
+// MySQLGrant grants privileges on a database or a table
+type MySQLGrant struct {
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=64
+	// Database the privileges are granted on, * for all databases
+	Database string `json:"database"`
+
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=64
+	// +kubebuilder:default="*"
+	// Table the privileges are granted on, * for all tables of the database
+	Table string `json:"table,omitempty"`
+
+	// +kubebuilder:validation:MinItems=1
+	// Privileges granted, like SELECT, INSERT or ALL PRIVILEGES
+	Privileges []MySQLPrivilege `json:"privileges"`
+}
+
+// MySQLPrivilege is a privilege that can be granted on databases and tables
+// +kubebuilder:validation:Enum="ALL PRIVILEGES";ALTER;"ALTER ROUTINE";CREATE;"CREATE ROUTINE";"CREATE TEMPORARY TABLES";"CREATE VIEW";DELETE;DROP;EVENT;EXECUTE;INDEX;INSERT;"LOCK TABLES";REFERENCES;SELECT;"SHOW VIEW";TRIGGER;UPDATE
+type MySQLPrivilege string
+
+// KafkaTopicAccess grants a permission on topics matching the pattern
+type KafkaTopicAccess struct {
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=249
+	// Topic name or pattern, supports wildcards
+	Topic string `json:"topic"`
+
+	// +kubebuilder:validation:Enum=admin;read;readwrite;write
+	// Permission granted on the matching topics
+	Permission string `json:"permission"`
+}
+
+// OpenSearchACLRule grants a permission on indexes matching the pattern
+type OpenSearchACLRule struct {
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=249
+	// Index name or pattern, supports wildcards
+	Index string `json:"index"`
+
+	// +kubebuilder:validation:Enum=deny;admin;read;readwrite;write
+	// Permission granted on the matching indexes
+	Permission string `json:"permission"`
 }
 
 // ServiceUserStatus defines the observed state of ServiceUser
@@ -35,6 +108,35 @@ type ServiceUserStatus struct {
 
 	// Type of the user account
 	Type string `json:"type,omitempty"`
+
+	// OpenSearch index ACL rules of the user applied to the service
+	OpenSearchACLRules []OpenSearchACLRule `json:"openSearchAclRules,omitempty"`
+
+	// Kafka ACLs created for the kafkaTopicAccess entries
+	KafkaACLs []ServiceUserKafkaACL `json:"kafkaAcls,omitempty"`
+
+	// MySQL privileges granted for the mysqlGrants entries
+	MySQLGrants []MySQLGrant `json:"mysqlGrants,omitempty"`
+
+	// Link to the users of the service in the Aiven Console
+	ConsoleURL string `json:"consoleURL,omitempty"`
+
+	// Time the user expires at and is deleted, when it has a TTL
+	ExpiresAt *metav1.Time `json:"expiresAt,omitempty"`
+
+	SyncStatus `json:",inline"`
+}
+
+// ServiceUserKafkaACL is a Kafka ACL created for a kafkaTopicAccess entry
+type ServiceUserKafkaACL struct {
+	// Kafka ACL ID
+	ID string `json:"id"`
+
+	// Topic name or pattern
+	Topic string `json:"topic"`
+
+	// Permission granted on the matching topics
+	Permission string `json:"permission"`
 }
 
 // +kubebuilder:object:root=true
@@ -44,6 +146,8 @@ type ServiceUserStatus struct {
 // +kubebuilder:printcolumn:name="Service Name",type="string",JSONPath=".spec.serviceName"
 // +kubebuilder:printcolumn:name="Project",type="string",JSONPath=".spec.project"
 // +kubebuilder:printcolumn:name="Connection Information Secret",type="string",JSONPath=".spec.connInfoSecretTarget.name"
+// +kubebuilder:printcolumn:name="Expires At",type="date",JSONPath=".status.expiresAt"
+// +kubebuilder:validation:XValidation:rule="size(self.metadata.name) <= 64 && self.metadata.name.matches('^[a-zA-Z0-9_][a-zA-Z0-9_.-]*$')",message="User name must be at most 64 characters of letters, digits, dots, underscores and dashes, and not start with a dot or a dash"
 type ServiceUser struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
@@ -56,6 +160,38 @@ func (svcusr ServiceUser) AuthSecretRef() AuthSecretReference {
 	return svcusr.Spec.AuthSecretRef
 }
 
+func (svcusr *ServiceUser) GetSyncStatus() *SyncStatus {
+	return &svcusr.Status.SyncStatus
+}
+
+func (svcusr ServiceUser) GetTTL() *metav1.Duration {
+	return svcusr.Spec.TTL
+}
+
+// UpdateConsoleURL sets the link to the users of the service in the Aiven Console
+func (svcusr *ServiceUser) UpdateConsoleURL() {
+	svcusr.Status.ConsoleURL = serviceConsoleURL(svcusr.Spec.Project, svcusr.Spec.ServiceName, "users")
+}
+
+func (svcusr ServiceUser) GetServiceRef() *ServiceReference {
+	return svcusr.Spec.ServiceRef
+}
+
+func (svcusr ServiceUser) GetProjectAndServiceName() (string, string) {
+	return svcusr.Spec.Project, svcusr.Spec.ServiceName
+}
+
+func (svcusr ServiceUser) GetRefs() []*ResourceReferenceObject {
+	if svcusr.Spec.ServiceRef == nil {
+		return nil
+	}
+	return []*ResourceReferenceObject{svcusr.Spec.ServiceRef.Service(svcusr.GetNamespace())}
+}
+
+func (svcusr ServiceUser) GetConnInfoSecretTarget() ConnInfoSecretTarget {
+	return svcusr.Spec.ConnInfoSecretTarget
+}
+
 // +kubebuilder:object:root=true
 
 // ServiceUserList contains a list of ServiceUser
@@ -4,6 +4,8 @@ package v1alpha1
 
 import (
 	"errors"
+	"fmt"
+	"strings"
 
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -38,7 +40,7 @@ var _ webhook.Validator = &ServiceUser{}
 func (r *ServiceUser) ValidateCreate() error {
 	serviceuserlog.Info("validate create", "name", r.Name)
 
-	return nil
+	return r.Spec.Validate()
 }
 
 // ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
@@ -57,7 +59,7 @@ func (r *ServiceUser) ValidateUpdate(old runtime.Object) error {
 		return errors.New("cannot update a ServiceUser, connInfoSecretTarget.name field is immutable and cannot be updated")
 	}
 
-	return nil
+	return r.Spec.Validate()
 }
 
 // ValidateDelete implements webhook.Validator so a webhook will be registered for the type
@@ -66,3 +68,42 @@ func (r *ServiceUser) ValidateDelete() error {
 
 	return nil
 }
+
+// Validate checks the fields that apply to different service types are not mixed
+func (in *ServiceUserSpec) Validate() error {
+	fields := make([]string, 0)
+	if len(in.OpenSearchACLRules) > 0 {
+		fields = append(fields, "openSearchAclRules")
+	}
+	if len(in.KafkaTopicAccess) > 0 {
+		fields = append(fields, "kafkaTopicAccess")
+	}
+	if len(in.MySQLGrants) > 0 {
+		fields = append(fields, "mysqlGrants")
+	}
+	if len(fields) > 1 {
+		return fmt.Errorf("%s can't be used together, they apply to different service types", strings.Join(fields, " and "))
+	}
+
+	seen := make(map[KafkaTopicAccess]bool, len(in.KafkaTopicAccess))
+	for _, a := range in.KafkaTopicAccess {
+		if seen[a] {
+			return fmt.Errorf("kafkaTopicAccess has duplicate %s permission on topic %q", a.Permission, a.Topic)
+		}
+		seen[a] = true
+	}
+
+	granted := make(map[string]bool, len(in.MySQLGrants))
+	for _, g := range in.MySQLGrants {
+		if g.Database == "*" && g.Table != "*" && g.Table != "" {
+			return fmt.Errorf("mysqlGrants can't grant on table %q of all databases", g.Table)
+		}
+
+		on := g.Database + "." + g.Table
+		if granted[on] {
+			return fmt.Errorf("mysqlGrants has duplicate entries for %s, merge the privileges", on)
+		}
+		granted[on] = true
+	}
+	return nil
+}
@@ -0,0 +1,97 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// StackSpec defines the desired state of Stack
+type StackSpec struct {
+	// +kubebuilder:validation:MinItems=1
+	// Resources of the stack. They are created in the dependency order
+	// (projects, VPCs, services, service children, integrations) and deleted in the reverse one
+	Resources []StackResource `json:"resources"`
+
+	// Authentication reference to Aiven token in a secret.
+	// It is used for the resources that don't set their own
+	AuthSecretRef AuthSecretReference `json:"authSecretRef,omitempty"`
+}
+
+// StackResource is a resource created and owned by the stack
+type StackResource struct {
+	// +kubebuilder:validation:Enum=AWSPrivateLink;AzurePrivateLink;AzureVNetPeeringConnection;Cassandra;Clickhouse;ClickhouseGrant;ClickhouseRole;ClickhouseUser;ConnectionPool;Database;Dragonfly;FlinkApplication;FlinkApplicationDeployment;GCPVPCPeeringConnection;Grafana;Kafka;KafkaACL;KafkaConnect;KafkaConnector;KafkaNativeACL;KafkaSchema;KafkaTopic;M3Aggregator;M3DB;MySQL;OpenSearch;OpenSearchACL;OpenSearchSnapshotRepository;OpenSearchSnapshotRestore;OrganizationPermission;OrganizationVPC;PostgreSQL;Project;ProjectVPC;Redis;ServiceIntegration;ServiceIntegrationEndpoint;ServiceUser;Thanos;Valkey
+	// Kind of the resource
+	Kind string `json:"kind"`
+
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	// Name of the resource
+	Name string `json:"name"`
+
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// Spec of the resource, as in the resource kind itself
+	Spec runtime.RawExtension `json:"spec"`
+}
+
+// StackResourceStatus is the observed state of a resource of the stack
+type StackResourceStatus struct {
+	// Kind of the resource
+	Kind string `json:"kind"`
+
+	// Name of the resource
+	Name string `json:"name"`
+
+	// Ready is true when the resource is processed and running
+	Ready bool `json:"ready"`
+}
+
+// StackStatus defines the observed state of Stack
+type StackStatus struct {
+	// Conditions represent the latest available observations of a Stack state
+	Conditions []metav1.Condition `json:"conditions"`
+
+	// Stack state, one of CREATING, RUNNING or DELETING
+	State string `json:"state,omitempty"`
+
+	// Resources created by the stack
+	Resources []StackResourceStatus `json:"resources,omitempty"`
+
+	SyncStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// Stack is the Schema for the stacks API.
+// It creates a set of resources in the dependency order and deletes them in the reverse one
+// +kubebuilder:printcolumn:name="State",type="string",JSONPath=".status.state"
+type Stack struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   StackSpec   `json:"spec,omitempty"`
+	Status StackStatus `json:"status,omitempty"`
+}
+
+func (in *Stack) AuthSecretRef() AuthSecretReference {
+	return in.Spec.AuthSecretRef
+}
+
+func (in *Stack) GetSyncStatus() *SyncStatus {
+	return &in.Status.SyncStatus
+}
+
+// +kubebuilder:object:root=true
+
+// StackList contains a list of Stack
+type StackList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Stack `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Stack{}, &StackList{})
+}
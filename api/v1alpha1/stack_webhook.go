@@ -0,0 +1,68 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package v1alpha1
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// log is for logging in this package.
+var stacklog = logf.Log.WithName("stack-resource")
+
+func (r *Stack) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/mutate-aiven-io-v1alpha1-stack,mutating=true,failurePolicy=fail,groups=aiven.io,resources=stacks,verbs=create;update,versions=v1alpha1,name=mstack.kb.io,sideEffects=none,admissionReviewVersions=v1
+
+var _ webhook.Defaulter = &Stack{}
+
+// Default implements webhook.Defaulter so a webhook will be registered for the type
+func (r *Stack) Default() {
+	stacklog.Info("default", "name", r.Name)
+}
+
+//+kubebuilder:webhook:verbs=create;update,path=/validate-aiven-io-v1alpha1-stack,mutating=false,failurePolicy=fail,groups=aiven.io,resources=stacks,versions=v1alpha1,name=vstack.kb.io,sideEffects=none,admissionReviewVersions=v1
+
+var _ webhook.Validator = &Stack{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (r *Stack) ValidateCreate() error {
+	stacklog.Info("validate create", "name", r.Name)
+
+	return r.Spec.Validate()
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (r *Stack) ValidateUpdate(old runtime.Object) error {
+	stacklog.Info("validate update", "name", r.Name)
+
+	return r.Spec.Validate()
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (r *Stack) ValidateDelete() error {
+	stacklog.Info("validate delete", "name", r.Name)
+
+	return nil
+}
+
+// Validate checks that every resource of the stack is unique
+func (in *StackSpec) Validate() error {
+	seen := make(map[string]bool, len(in.Resources))
+	for _, res := range in.Resources {
+		key := res.Kind + "/" + res.Name
+		if seen[key] {
+			return fmt.Errorf("stack resource %s is declared more than once", key)
+		}
+		seen[key] = true
+	}
+	return nil
+}
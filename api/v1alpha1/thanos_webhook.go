@@ -0,0 +1,76 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package v1alpha1
+
+import (
+	"errors"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// log is for logging in this package.
+var thanoslog = logf.Log.WithName("thanos-resource")
+
+func (in *Thanos) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(in).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/mutate-aiven-io-v1alpha1-thanos,mutating=true,failurePolicy=fail,sideEffects=None,groups=aiven.io,resources=thanos,verbs=create;update,versions=v1alpha1,name=mthanos.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Defaulter = &Thanos{}
+
+func (in *Thanos) Default() {
+	thanoslog.Info("default", "name", in.Name)
+}
+
+//+kubebuilder:webhook:verbs=create;update;delete,path=/validate-aiven-io-v1alpha1-thanos,mutating=false,failurePolicy=fail,groups=aiven.io,resources=thanos,versions=v1alpha1,name=vthanos.kb.io,sideEffects=none,admissionReviewVersions=v1
+
+var _ webhook.Validator = &Thanos{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (in *Thanos) ValidateCreate() error {
+	thanoslog.Info("validate create", "name", in.Name)
+
+	return in.Spec.Validate()
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (in *Thanos) ValidateUpdate(old runtime.Object) error {
+	thanoslog.Info("validate update", "name", in.Name)
+
+	if in.Spec.Project != old.(*Thanos).Spec.Project {
+		return errors.New("cannot update a Thanos service, project field is immutable and cannot be updated")
+	}
+
+	if in.Spec.ConnInfoSecretTarget.Name != old.(*Thanos).Spec.ConnInfoSecretTarget.Name {
+		return errors.New("cannot update a Thanos service, connInfoSecretTarget.name field is immutable and cannot be updated")
+	}
+
+	err := ValidateDownsize(in, old.(*Thanos).Status.DiskSpace, old.(*Thanos).Spec.Plan, in.Spec.Plan, old.(*Thanos).Spec.DiskSpace, in.Spec.DiskSpace)
+	if err != nil {
+		return err
+	}
+
+	err = ValidateAdditionalDiskDownsize(in, old.(*Thanos).Spec.AdditionalDiskSpace, in.Spec.AdditionalDiskSpace)
+	if err != nil {
+		return err
+	}
+
+	return in.Spec.Validate()
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (in *Thanos) ValidateDelete() error {
+	thanoslog.Info("validate delete", "name", in.Name)
+
+	if in.Spec.TerminationProtection {
+		return errors.New("cannot delete Thanos service, termination protection is on")
+	}
+
+	return nil
+}
@@ -0,0 +1,154 @@
+// Code generated by user config generator. DO NOT EDIT.
+// +kubebuilder:object:generate=true
+
+package dragonflyuserconfig
+
+import "encoding/json"
+
+func (ip *IpFilter) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" || string(data) == `""` {
+		return nil
+	}
+
+	var s string
+	err := json.Unmarshal(data, &s)
+	if err == nil {
+		ip.Network = s
+		return nil
+	}
+
+	type this struct {
+		Network     string  `json:"network"`
+		Description *string `json:"description,omitempty" `
+	}
+
+	var t *this
+	err = json.Unmarshal(data, &t)
+	if err != nil {
+		return err
+	}
+	ip.Network = t.Network
+	ip.Description = t.Description
+	return nil
+}
+
+// CIDR address block, either as a string, or in a dict with an optional description field
+type IpFilter struct {
+	// +kubebuilder:validation:MaxLength=1024
+	// Description for IP filter list entry
+	Description *string `groups:"create,update" json:"description,omitempty"`
+
+	// +kubebuilder:validation:MaxLength=43
+	// CIDR address block
+	Network string `groups:"create,update" json:"network"`
+}
+
+// Migrate data from existing server
+type Migration struct {
+	// +kubebuilder:validation:MaxLength=63
+	// Database name for bootstrapping the initial connection
+	Dbname *string `groups:"create,update" json:"dbname,omitempty"`
+
+	// +kubebuilder:validation:MaxLength=255
+	// Hostname or IP address of the server where to migrate data from
+	Host string `groups:"create,update" json:"host"`
+
+	// +kubebuilder:validation:MaxLength=2048
+	// Comma-separated list of databases, which should be ignored during migration (supported by MySQL only at the moment)
+	IgnoreDbs *string `groups:"create,update" json:"ignore_dbs,omitempty"`
+
+	// +kubebuilder:validation:Enum=dump;replication
+	// The migration method to be used (currently supported only by Redis and MySQL service types)
+	Method *string `groups:"create,update" json:"method,omitempty"`
+
+	// +kubebuilder:validation:MaxLength=256
+	// Password for authentication with the server where to migrate data from
+	Password *string `groups:"create,update" json:"password,omitempty"`
+
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// Port number of the server where to migrate data from
+	Port int `groups:"create,update" json:"port"`
+
+	// The server where to migrate data from is secured with SSL
+	Ssl *bool `groups:"create,update" json:"ssl,omitempty"`
+
+	// +kubebuilder:validation:MaxLength=256
+	// User name for authentication with the server where to migrate data from
+	Username *string `groups:"create,update" json:"username,omitempty"`
+}
+
+// Allow access to selected service ports from private networks
+type PrivateAccess struct {
+	// Allow clients to connect to dragonfly with a DNS name that always resolves to the service's private IP addresses. Only available in certain network locations
+	Dragonfly *bool `groups:"create,update" json:"dragonfly,omitempty"`
+
+	// Allow clients to connect to prometheus with a DNS name that always resolves to the service's private IP addresses. Only available in certain network locations
+	Prometheus *bool `groups:"create,update" json:"prometheus,omitempty"`
+}
+
+// Allow access to selected service components through Privatelink
+type PrivatelinkAccess struct {
+	// Enable dragonfly
+	Dragonfly *bool `groups:"create,update" json:"dragonfly,omitempty"`
+
+	// Enable prometheus
+	Prometheus *bool `groups:"create,update" json:"prometheus,omitempty"`
+}
+
+// Allow access to selected service ports from the public Internet
+type PublicAccess struct {
+	// Allow clients to connect to dragonfly from the public internet for service nodes that are in a project VPC or another type of private network
+	Dragonfly *bool `groups:"create,update" json:"dragonfly,omitempty"`
+
+	// Allow clients to connect to prometheus from the public internet for service nodes that are in a project VPC or another type of private network
+	Prometheus *bool `groups:"create,update" json:"prometheus,omitempty"`
+}
+type DragonflyUserConfig struct {
+	// Evict entries when getting close to maxmemory limit
+	CacheMode *bool `groups:"create,update" json:"cache_mode,omitempty"`
+
+	// +kubebuilder:validation:Enum=off;rdb;dfs
+	// When persistence is 'rdb' or 'dfs', Dragonfly does RDB or DFS dumps every 10 minutes. Dumps are done according to the backup schedule for backup purposes. When persistence is 'off', no RDB/DFS dumps or backups are done, so data can be lost at any moment if the service is restarted for any reason, or if the service is powered off. Also, the service can't be forked.
+	DragonflyPersistence *string `groups:"create,update" json:"dragonfly_persistence,omitempty"`
+
+	// Require SSL to access Dragonfly
+	DragonflySsl *bool `groups:"create,update" json:"dragonfly_ssl,omitempty"`
+
+	// +kubebuilder:validation:MaxItems=1024
+	// Allow incoming connections from CIDR address block, e.g. '10.20.0.0/16'
+	IpFilter []*IpFilter `groups:"create,update" json:"ip_filter,omitempty"`
+
+	// Migrate data from existing server
+	Migration *Migration `groups:"create,update" json:"migration,omitempty"`
+
+	// Allow access to selected service ports from private networks
+	PrivateAccess *PrivateAccess `groups:"create,update" json:"private_access,omitempty"`
+
+	// Allow access to selected service components through Privatelink
+	PrivatelinkAccess *PrivatelinkAccess `groups:"create,update" json:"privatelink_access,omitempty"`
+
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// Name of another project to fork a service from. This has effect only when a new service is being created.
+	ProjectToForkFrom *string `groups:"create" json:"project_to_fork_from,omitempty"`
+
+	// Allow access to selected service ports from the public Internet
+	PublicAccess *PublicAccess `groups:"create,update" json:"public_access,omitempty"`
+
+	// +kubebuilder:validation:MaxLength=128
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z0-9-_:.]+$`
+	// Name of the basebackup to restore in forked service
+	RecoveryBasebackupName *string `groups:"create,update" json:"recovery_basebackup_name,omitempty"`
+
+	// Store logs for the service so that they are available in the HTTP API and console.
+	ServiceLog *bool `groups:"create,update" json:"service_log,omitempty"`
+
+	// +kubebuilder:validation:MaxLength=64
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// Name of another service to fork from. This has effect only when a new service is being created.
+	ServiceToForkFrom *string `groups:"create" json:"service_to_fork_from,omitempty"`
+
+	// Use static public IP addresses
+	StaticIps *bool `groups:"create,update" json:"static_ips,omitempty"`
+}
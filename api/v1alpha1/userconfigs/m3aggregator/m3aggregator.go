@@ -0,0 +1,64 @@
+// Code generated by user config generator. DO NOT EDIT.
+// +kubebuilder:object:generate=true
+
+package m3aggregatoruserconfig
+
+import "encoding/json"
+
+func (ip *IpFilter) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" || string(data) == `""` {
+		return nil
+	}
+
+	var s string
+	err := json.Unmarshal(data, &s)
+	if err == nil {
+		ip.Network = s
+		return nil
+	}
+
+	type this struct {
+		Network     string  `json:"network"`
+		Description *string `json:"description,omitempty" `
+	}
+
+	var t *this
+	err = json.Unmarshal(data, &t)
+	if err != nil {
+		return err
+	}
+	ip.Network = t.Network
+	ip.Description = t.Description
+	return nil
+}
+
+// CIDR address block, either as a string, or in a dict with an optional description field
+type IpFilter struct {
+	// +kubebuilder:validation:MaxLength=1024
+	// Description for IP filter list entry
+	Description *string `groups:"create,update" json:"description,omitempty"`
+
+	// +kubebuilder:validation:MaxLength=43
+	// CIDR address block
+	Network string `groups:"create,update" json:"network"`
+}
+type M3aggregatorUserConfig struct {
+	// +kubebuilder:validation:MaxLength=255
+	// Serve the web frontend using a custom CNAME pointing to the Aiven DNS name
+	CustomDomain *string `groups:"create,update" json:"custom_domain,omitempty"`
+
+	// +kubebuilder:validation:MaxItems=1024
+	// Allow incoming connections from CIDR address block, e.g. '10.20.0.0/16'
+	IpFilter []*IpFilter `groups:"create,update" json:"ip_filter,omitempty"`
+
+	// +kubebuilder:validation:Enum="1.1";"1.2";"1.5"
+	// M3 major version (deprecated, use m3aggregator_version)
+	M3Version *string `groups:"create,update" json:"m3_version,omitempty"`
+
+	// +kubebuilder:validation:Enum="1.1";"1.2";"1.5"
+	// M3 major version (the minimum compatible version)
+	M3aggregatorVersion *string `groups:"create,update" json:"m3aggregator_version,omitempty"`
+
+	// Use static public IP addresses
+	StaticIps *bool `groups:"create,update" json:"static_ips,omitempty"`
+}
@@ -0,0 +1,242 @@
+// Code generated by user config generator. DO NOT EDIT.
+// +kubebuilder:object:generate=true
+
+package m3dbuserconfig
+
+import "encoding/json"
+
+func (ip *IpFilter) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" || string(data) == `""` {
+		return nil
+	}
+
+	var s string
+	err := json.Unmarshal(data, &s)
+	if err == nil {
+		ip.Network = s
+		return nil
+	}
+
+	type this struct {
+		Network     string  `json:"network"`
+		Description *string `json:"description,omitempty" `
+	}
+
+	var t *this
+	err = json.Unmarshal(data, &t)
+	if err != nil {
+		return err
+	}
+	ip.Network = t.Network
+	ip.Description = t.Description
+	return nil
+}
+
+// CIDR address block, either as a string, or in a dict with an optional description field
+type IpFilter struct {
+	// +kubebuilder:validation:MaxLength=1024
+	// Description for IP filter list entry
+	Description *string `groups:"create,update" json:"description,omitempty"`
+
+	// +kubebuilder:validation:MaxLength=43
+	// CIDR address block
+	Network string `groups:"create,update" json:"network"`
+}
+
+// M3 limits
+type Limits struct {
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=2147483647
+	// The maximum number of data points fetched in a single read request
+	MaxRecentlyQueriedSeriesBlocks *int `groups:"create,update" json:"max_recently_queried_series_blocks,omitempty"`
+
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=2147483647
+	// The maximum number of disk bytes that can be read in a given lookback period.
+	MaxRecentlyQueriedSeriesDiskBytesRead *int `groups:"create,update" json:"max_recently_queried_series_disk_bytes_read,omitempty"`
+
+	// +kubebuilder:validation:MaxLength=60
+	// The lookback period for 'max_recently_queried_series_blocks' and 'max_recently_queried_series_disk_bytes_read'.
+	MaxRecentlyQueriedSeriesLookback *string `groups:"create,update" json:"max_recently_queried_series_lookback,omitempty"`
+
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=2147483647
+	// The maximum number of docs fetched in single query.
+	QueryDocs *int `groups:"create,update" json:"query_docs,omitempty"`
+
+	// When query limits are exceeded, whether to return error or return partial results.
+	QueryRequireExhaustive *bool `groups:"create,update" json:"query_require_exhaustive,omitempty"`
+
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=2147483647
+	// The maximum number of series fetched in single query.
+	QuerySeries *int `groups:"create,update" json:"query_series,omitempty"`
+}
+
+// M3 Tag Options
+type TagOptions struct {
+	// Allows for duplicate tags to appear on series (not allowed by default).
+	AllowTagNameDuplicates *bool `groups:"create,update" json:"allow_tag_name_duplicates,omitempty"`
+
+	// Allows for empty tags to appear on series (not allowed by default).
+	AllowTagValueEmpty *bool `groups:"create,update" json:"allow_tag_value_empty,omitempty"`
+}
+
+// M3 specific configuration options
+type M3 struct {
+	// M3 Tag Options
+	TagOptions *TagOptions `groups:"create,update" json:"tag_options,omitempty"`
+}
+
+// Retention options
+type RetentionOptions struct {
+	// Controls how long we wait before expiring stale data
+	BlockdataexpiryDuration *string `groups:"create,update" json:"blockdataexpiry_duration,omitempty"`
+
+	// Controls how long to keep a block in memory before flushing to a fileset on disk
+	BlocksizeDuration *string `groups:"create,update" json:"blocksize_duration,omitempty"`
+
+	// Controls how far into the future writes to the namespace will be accepted
+	BufferfutureDuration *string `groups:"create,update" json:"bufferfuture_duration,omitempty"`
+
+	// Controls how far into the past writes to the namespace will be accepted
+	BufferpastDuration *string `groups:"create,update" json:"bufferpast_duration,omitempty"`
+
+	// Controls the duration of time that M3DB will retain data for the namespace
+	RetentionPeriodDuration *string `groups:"create,update" json:"retention_period_duration,omitempty"`
+}
+
+// Namespace options
+type Options struct {
+	// Retention options
+	RetentionOptions RetentionOptions `groups:"create,update" json:"retention_options"`
+
+	// Controls whether M3DB will create snapshot files for this namespace
+	SnapshotEnabled *bool `groups:"create,update" json:"snapshot_enabled,omitempty"`
+
+	// Controls whether M3DB will include writes to this namespace in the commitlog
+	WritesToCommitlog *bool `groups:"create,update" json:"writes_to_commitlog,omitempty"`
+}
+
+// List of M3 namespaces
+type Namespaces struct {
+	// +kubebuilder:validation:MaxLength=64
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z0-9_-]+$`
+	// The name of the namespace
+	Name string `groups:"create,update" json:"name"`
+
+	// Namespace options
+	Options *Options `groups:"create,update" json:"options,omitempty"`
+
+	// The resolution for an aggregated namespace
+	Resolution *string `groups:"create,update" json:"resolution,omitempty"`
+
+	// +kubebuilder:validation:Enum=aggregated;unaggregated
+	// The type of aggregation (aggregated/unaggregated)
+	Type string `groups:"create,update" json:"type"`
+}
+
+// Allow access to selected service ports from private networks
+type PrivateAccess struct {
+	// Allow clients to connect to m3coordinator with a DNS name that always resolves to the service's private IP addresses. Only available in certain network locations
+	M3coordinator *bool `groups:"create,update" json:"m3coordinator,omitempty"`
+}
+
+// Allow access to selected service ports from the public Internet
+type PublicAccess struct {
+	// Allow clients to connect to m3coordinator from the public internet for service nodes that are in a project VPC or another type of private network
+	M3coordinator *bool `groups:"create,update" json:"m3coordinator,omitempty"`
+}
+
+// List of tags to be appended to matching metrics
+type Tags struct {
+	// The name of the tag
+	Name string `groups:"create,update" json:"name"`
+
+	// The value of the tag
+	Value string `groups:"create,update" json:"value"`
+}
+
+// List of M3 mapping rules
+type Mapping struct {
+	// +kubebuilder:validation:MaxItems=10
+	// List of aggregations to be applied
+	Aggregations []string `groups:"create,update" json:"aggregations,omitempty"`
+
+	// Only store the derived metric (as specified in the roll-up rules), if any
+	Drop *bool `groups:"create,update" json:"drop,omitempty"`
+
+	// +kubebuilder:validation:MaxLength=1024
+	// Matching metric names with wildcards (using __name__:wildcard) or matching tags and their (optionally wildcarded) values. For value, ! can be used at start of value for negation, and multiple filters can be supplied using space as separator.
+	Filter string `groups:"create,update" json:"filter"`
+
+	// +kubebuilder:validation:MaxLength=1024
+	// The (optional) name of the rule
+	Name *string `groups:"create,update" json:"name,omitempty"`
+
+	// +kubebuilder:validation:MaxItems=10
+	// This rule will be used to store the metrics in the given namespace(s). If a namespace is target of rules, the global default aggregation will be automatically disabled. Note that specifying filters that match no namespaces whatsoever will be returned as an error. Filter the namespace by glob (=wildcards).
+	Namespaces []string `groups:"create,update" json:"namespaces,omitempty"`
+
+	// +kubebuilder:validation:MaxItems=10
+	// List of tags to be appended to matching metrics
+	Tags []*Tags `groups:"create,update" json:"tags,omitempty"`
+}
+
+// M3 rules
+type Rules struct {
+	// List of M3 mapping rules
+	Mapping []*Mapping `groups:"create,update" json:"mapping,omitempty"`
+}
+type M3dbUserConfig struct {
+	// +kubebuilder:validation:MaxLength=255
+	// Serve the web frontend using a custom CNAME pointing to the Aiven DNS name
+	CustomDomain *string `groups:"create,update" json:"custom_domain,omitempty"`
+
+	// +kubebuilder:validation:MaxItems=1024
+	// Allow incoming connections from CIDR address block, e.g. '10.20.0.0/16'
+	IpFilter []*IpFilter `groups:"create,update" json:"ip_filter,omitempty"`
+
+	// M3 limits
+	Limits *Limits `groups:"create,update" json:"limits,omitempty"`
+
+	// M3 specific configuration options
+	M3 *M3 `groups:"create,update" json:"m3,omitempty"`
+
+	// +kubebuilder:validation:Enum="1.1";"1.2";"1.5"
+	// M3 major version (deprecated, use m3db_version)
+	M3Version *string `groups:"create,update" json:"m3_version,omitempty"`
+
+	// Enables access to Graphite Carbon plaintext metrics ingestion. It can be enabled only for services inside VPCs. The metrics are written to aggregated namespaces only.
+	M3coordinatorEnableGraphiteCarbonIngest *bool `groups:"create,update" json:"m3coordinator_enable_graphite_carbon_ingest,omitempty"`
+
+	// +kubebuilder:validation:Enum="1.1";"1.2";"1.5"
+	// M3 major version (the minimum compatible version)
+	M3dbVersion *string `groups:"create,update" json:"m3db_version,omitempty"`
+
+	// +kubebuilder:validation:MaxItems=2147483647
+	// List of M3 namespaces
+	Namespaces []*Namespaces `groups:"create,update" json:"namespaces,omitempty"`
+
+	// Allow access to selected service ports from private networks
+	PrivateAccess *PrivateAccess `groups:"create,update" json:"private_access,omitempty"`
+
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// Name of another project to fork a service from. This has effect only when a new service is being created.
+	ProjectToForkFrom *string `groups:"create" json:"project_to_fork_from,omitempty"`
+
+	// Allow access to selected service ports from the public Internet
+	PublicAccess *PublicAccess `groups:"create,update" json:"public_access,omitempty"`
+
+	// M3 rules
+	Rules *Rules `groups:"create,update" json:"rules,omitempty"`
+
+	// +kubebuilder:validation:MaxLength=64
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// Name of another service to fork from. This has effect only when a new service is being created.
+	ServiceToForkFrom *string `groups:"create" json:"service_to_fork_from,omitempty"`
+
+	// Use static public IP addresses
+	StaticIps *bool `groups:"create,update" json:"static_ips,omitempty"`
+}
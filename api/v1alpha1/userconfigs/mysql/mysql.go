@@ -112,7 +112,7 @@ type Mysql struct {
 	// +kubebuilder:validation:Minimum=0
 	// +kubebuilder:validation:Maximum=16
 	// Minimum length of words that are stored in an InnoDB FULLTEXT index. Changing this parameter will lead to a restart of the MySQL service.
-	InnodbFtMinTokenSize *int `groups:"create,update" json:"innodb_ft_min_token_size,omitempty"`
+	InnodbFtMinTokenSize *int `groups:"create,update,restart" json:"innodb_ft_min_token_size,omitempty"`
 
 	// +kubebuilder:validation:MaxLength=1024
 	// +kubebuilder:validation:Pattern=`^.+/.+$`
@@ -140,10 +140,10 @@ type Mysql struct {
 	// +kubebuilder:validation:Minimum=1
 	// +kubebuilder:validation:Maximum=64
 	// The number of I/O threads for read operations in InnoDB. Default is 4. Changing this parameter will lead to a restart of the MySQL service.
-	InnodbReadIoThreads *int `groups:"create,update" json:"innodb_read_io_threads,omitempty"`
+	InnodbReadIoThreads *int `groups:"create,update,restart" json:"innodb_read_io_threads,omitempty"`
 
 	// When enabled a transaction timeout causes InnoDB to abort and roll back the entire transaction. Changing this parameter will lead to a restart of the MySQL service.
-	InnodbRollbackOnTimeout *bool `groups:"create,update" json:"innodb_rollback_on_timeout,omitempty"`
+	InnodbRollbackOnTimeout *bool `groups:"create,update,restart" json:"innodb_rollback_on_timeout,omitempty"`
 
 	// +kubebuilder:validation:Minimum=0
 	// +kubebuilder:validation:Maximum=1000
@@ -153,7 +153,7 @@ type Mysql struct {
 	// +kubebuilder:validation:Minimum=1
 	// +kubebuilder:validation:Maximum=64
 	// The number of I/O threads for write operations in InnoDB. Default is 4. Changing this parameter will lead to a restart of the MySQL service.
-	InnodbWriteIoThreads *int `groups:"create,update" json:"innodb_write_io_threads,omitempty"`
+	InnodbWriteIoThreads *int `groups:"create,update,restart" json:"innodb_write_io_threads,omitempty"`
 
 	// +kubebuilder:validation:Minimum=30
 	// +kubebuilder:validation:Maximum=604800
@@ -180,7 +180,7 @@ type Mysql struct {
 	// +kubebuilder:validation:Minimum=1024
 	// +kubebuilder:validation:Maximum=1048576
 	// Start sizes of connection buffer and result buffer. Default is 16384 (16K). Changing this parameter will lead to a restart of the MySQL service.
-	NetBufferLength *int `groups:"create,update" json:"net_buffer_length,omitempty"`
+	NetBufferLength *int `groups:"create,update,restart" json:"net_buffer_length,omitempty"`
 
 	// +kubebuilder:validation:Minimum=1
 	// +kubebuilder:validation:Maximum=3600
@@ -295,7 +295,7 @@ type MysqlUserConfig struct {
 	Migration *Migration `groups:"create,update" json:"migration,omitempty"`
 
 	// mysql.conf configuration values
-	Mysql *Mysql `groups:"create,update" json:"mysql,omitempty"`
+	Mysql *Mysql `groups:"create,update,restart" json:"mysql,omitempty"`
 
 	// +kubebuilder:validation:Enum=8
 	// MySQL major version
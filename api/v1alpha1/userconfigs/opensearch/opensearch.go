@@ -160,7 +160,7 @@ type Opensearch struct {
 
 	// +kubebuilder:validation:MaxItems=32
 	// Whitelisted addresses for reindexing. Changing this value will cause all OpenSearch instances to restart.
-	ReindexRemoteWhitelist []string `groups:"create,update" json:"reindex_remote_whitelist,omitempty"`
+	ReindexRemoteWhitelist []string `groups:"create,update,restart" json:"reindex_remote_whitelist,omitempty"`
 
 	// +kubebuilder:validation:MaxLength=1024
 	// Script compilation circuit breaker limits the number of inline script compilations within a period of time. Default is use-context
@@ -309,7 +309,7 @@ type OpensearchUserConfig struct {
 	MaxIndexCount *int `groups:"create,update" json:"max_index_count,omitempty"`
 
 	// OpenSearch settings
-	Opensearch *Opensearch `groups:"create,update" json:"opensearch,omitempty"`
+	Opensearch *Opensearch `groups:"create,update,restart" json:"opensearch,omitempty"`
 
 	// OpenSearch Dashboards settings
 	OpensearchDashboards *OpensearchDashboards `groups:"create,update" json:"opensearch_dashboards,omitempty"`
@@ -166,7 +166,7 @@ type RedisUserConfig struct {
 	// +kubebuilder:validation:Minimum=1
 	// +kubebuilder:validation:Maximum=128
 	// Set number of redis databases. Changing this will cause a restart of redis service.
-	RedisNumberOfDatabases *int `groups:"create,update" json:"redis_number_of_databases,omitempty"`
+	RedisNumberOfDatabases *int `groups:"create,update,restart" json:"redis_number_of_databases,omitempty"`
 
 	// +kubebuilder:validation:Enum=off;rdb
 	// When persistence is 'rdb', Redis does RDB dumps each 10 minutes if any key is changed. Also RDB dumps are done according to backup schedule for backup purposes. When persistence is 'off', no RDB dumps and backups are done, so data can be lost at any moment if service is restarted for any reason, or if service is powered off. Also service can't be forked.
@@ -0,0 +1,121 @@
+// Code generated by user config generator. DO NOT EDIT.
+// +kubebuilder:object:generate=true
+
+package thanosuserconfig
+
+import "encoding/json"
+
+func (ip *IpFilter) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" || string(data) == `""` {
+		return nil
+	}
+
+	var s string
+	err := json.Unmarshal(data, &s)
+	if err == nil {
+		ip.Network = s
+		return nil
+	}
+
+	type this struct {
+		Network     string  `json:"network"`
+		Description *string `json:"description,omitempty" `
+	}
+
+	var t *this
+	err = json.Unmarshal(data, &t)
+	if err != nil {
+		return err
+	}
+	ip.Network = t.Network
+	ip.Description = t.Description
+	return nil
+}
+
+// ThanosCompactor
+type Compactor struct {
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=10000
+	// Retention time for data in days for each resolution (5m, 1h, raw)
+	RetentionDays *int `groups:"create,update" json:"retention_days,omitempty"`
+}
+
+// CIDR address block, either as a string, or in a dict with an optional description field
+type IpFilter struct {
+	// +kubebuilder:validation:MaxLength=1024
+	// Description for IP filter list entry
+	Description *string `groups:"create,update" json:"description,omitempty"`
+
+	// +kubebuilder:validation:MaxLength=43
+	// CIDR address block
+	Network string `groups:"create,update" json:"network"`
+}
+
+// Allow access to selected service ports from the public Internet
+type PublicAccess struct {
+	// Allow clients to connect to compactor from the public internet for service nodes that are in a project VPC or another type of private network
+	Compactor *bool `groups:"create,update" json:"compactor,omitempty"`
+
+	// Allow clients to connect to query from the public internet for service nodes that are in a project VPC or another type of private network
+	Query *bool `groups:"create,update" json:"query,omitempty"`
+
+	// Allow clients to connect to query_frontend from the public internet for service nodes that are in a project VPC or another type of private network
+	QueryFrontend *bool `groups:"create,update" json:"query_frontend,omitempty"`
+
+	// Allow clients to connect to receiver_ingesting from the public internet for service nodes that are in a project VPC or another type of private network
+	ReceiverIngesting *bool `groups:"create,update" json:"receiver_ingesting,omitempty"`
+
+	// Allow clients to connect to receiver_routing from the public internet for service nodes that are in a project VPC or another type of private network
+	ReceiverRouting *bool `groups:"create,update" json:"receiver_routing,omitempty"`
+
+	// Allow clients to connect to store from the public internet for service nodes that are in a project VPC or another type of private network
+	Store *bool `groups:"create,update" json:"store,omitempty"`
+}
+
+// ThanosQuery
+type Query struct {
+	// Set the default evaluation interval for subqueries. Default: 1m
+	QueryDefaultEvaluationInterval *string `groups:"create,update" json:"query.default-evaluation-interval,omitempty"`
+
+	// The maximum lookback duration for retrieving metrics during expression evaluations in PromQL. PromQL always evaluates the query for a certain timestamp, and it looks back for the given amount of time to get the latest sample. If it exceeds the maximum lookback delta, it assumes the series is stale and returns none (a gap). The lookback delta should be set to at least 2 times the slowest scrape interval. If unset, it will use the promql default of 5m.
+	QueryLookbackDelta *string `groups:"create,update" json:"query.lookback-delta,omitempty"`
+
+	// The default metadata time range duration for retrieving labels through Labels and Series API when the range parameters are not specified. The zero value means the range covers the time since the beginning. Default: 0s
+	QueryMetadataDefaultTimeRange *string `groups:"create,update" json:"query.metadata.default-time-range,omitempty"`
+
+	// Maximum time to process a query by the query node. Default: 2m
+	QueryTimeout *string `groups:"create,update" json:"query.timeout,omitempty"`
+}
+
+// ThanosQueryFrontend
+type QueryFrontend struct {
+	// Whether to align the query range boundaries with the step. If enabled, the query range boundaries will be aligned to the step, providing more accurate results for queries with high-resolution data. Default: true
+	QueryRangeAlignRangeWithStep *bool `groups:"create,update" json:"query-range.align-range-with-step,omitempty"`
+}
+type ThanosUserConfig struct {
+	// ThanosCompactor
+	Compactor *Compactor `groups:"create,update" json:"compactor,omitempty"`
+
+	// +kubebuilder:validation:MaxItems=1024
+	// Allow incoming connections from CIDR address block, e.g. '10.20.0.0/16'
+	IpFilter []*IpFilter `groups:"create,update" json:"ip_filter,omitempty"`
+
+	// +kubebuilder:validation:Minimum=0
+	// After exceeding the limit a service alert is going to be raised (0 means not set).
+	ObjectStorageUsageAlertThresholdGb *int `groups:"create,update" json:"object_storage_usage_alert_threshold_gb,omitempty"`
+
+	// Allow access to selected service ports from the public Internet
+	PublicAccess *PublicAccess `groups:"create,update" json:"public_access,omitempty"`
+
+	// ThanosQuery
+	Query *Query `groups:"create,update" json:"query,omitempty"`
+
+	// ThanosQueryFrontend
+	QueryFrontend *QueryFrontend `groups:"create,update" json:"query_frontend,omitempty"`
+
+	// Store logs for the service so that they are available in the HTTP API and console.
+	ServiceLog *bool `groups:"create,update" json:"service_log,omitempty"`
+
+	// Use static public IP addresses
+	StaticIps *bool `groups:"create,update" json:"static_ips,omitempty"`
+}
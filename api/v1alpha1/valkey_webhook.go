@@ -0,0 +1,76 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package v1alpha1
+
+import (
+	"errors"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// log is for logging in this package.
+var valkeylog = logf.Log.WithName("valkey-resource")
+
+func (in *Valkey) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(in).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/mutate-aiven-io-v1alpha1-valkey,mutating=true,failurePolicy=fail,sideEffects=None,groups=aiven.io,resources=valkeys,verbs=create;update,versions=v1alpha1,name=mvalkey.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Defaulter = &Valkey{}
+
+func (in *Valkey) Default() {
+	valkeylog.Info("default", "name", in.Name)
+}
+
+//+kubebuilder:webhook:verbs=create;update;delete,path=/validate-aiven-io-v1alpha1-valkey,mutating=false,failurePolicy=fail,groups=aiven.io,resources=valkeys,versions=v1alpha1,name=vvalkey.kb.io,sideEffects=none,admissionReviewVersions=v1
+
+var _ webhook.Validator = &Valkey{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (in *Valkey) ValidateCreate() error {
+	valkeylog.Info("validate create", "name", in.Name)
+
+	return in.Spec.Validate()
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (in *Valkey) ValidateUpdate(old runtime.Object) error {
+	valkeylog.Info("validate update", "name", in.Name)
+
+	if in.Spec.Project != old.(*Valkey).Spec.Project {
+		return errors.New("cannot update a Valkey service, project field is immutable and cannot be updated")
+	}
+
+	if in.Spec.ConnInfoSecretTarget.Name != old.(*Valkey).Spec.ConnInfoSecretTarget.Name {
+		return errors.New("cannot update a Valkey service, connInfoSecretTarget.name field is immutable and cannot be updated")
+	}
+
+	err := ValidateDownsize(in, old.(*Valkey).Status.DiskSpace, old.(*Valkey).Spec.Plan, in.Spec.Plan, old.(*Valkey).Spec.DiskSpace, in.Spec.DiskSpace)
+	if err != nil {
+		return err
+	}
+
+	err = ValidateAdditionalDiskDownsize(in, old.(*Valkey).Spec.AdditionalDiskSpace, in.Spec.AdditionalDiskSpace)
+	if err != nil {
+		return err
+	}
+
+	return in.Spec.Validate()
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (in *Valkey) ValidateDelete() error {
+	valkeylog.Info("validate delete", "name", in.Name)
+
+	if in.Spec.TerminationProtection {
+		return errors.New("cannot delete Valkey service, termination protection is on")
+	}
+
+	return nil
+}
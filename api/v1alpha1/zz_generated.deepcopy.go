@@ -10,34 +10,24 @@ package v1alpha1
 import (
 	cassandra "github.com/aiven/aiven-operator/api/v1alpha1/userconfigs/cassandra"
 	clickhouse "github.com/aiven/aiven-operator/api/v1alpha1/userconfigs/clickhouse"
+	dragonfly "github.com/aiven/aiven-operator/api/v1alpha1/userconfigs/dragonfly"
 	grafana "github.com/aiven/aiven-operator/api/v1alpha1/userconfigs/grafana"
 	kafka "github.com/aiven/aiven-operator/api/v1alpha1/userconfigs/kafka"
 	kafka_connect "github.com/aiven/aiven-operator/api/v1alpha1/userconfigs/kafka_connect"
+	m3aggregator "github.com/aiven/aiven-operator/api/v1alpha1/userconfigs/m3aggregator"
+	m3db "github.com/aiven/aiven-operator/api/v1alpha1/userconfigs/m3db"
 	mysql "github.com/aiven/aiven-operator/api/v1alpha1/userconfigs/mysql"
 	opensearch "github.com/aiven/aiven-operator/api/v1alpha1/userconfigs/opensearch"
 	pg "github.com/aiven/aiven-operator/api/v1alpha1/userconfigs/pg"
 	redis "github.com/aiven/aiven-operator/api/v1alpha1/userconfigs/redis"
+	thanos "github.com/aiven/aiven-operator/api/v1alpha1/userconfigs/thanos"
+	valkey "github.com/aiven/aiven-operator/api/v1alpha1/userconfigs/valkey"
 	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AuthSecretReference) DeepCopyInto(out *AuthSecretReference) {
-	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthSecretReference.
-func (in *AuthSecretReference) DeepCopy() *AuthSecretReference {
-	if in == nil {
-		return nil
-	}
-	out := new(AuthSecretReference)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Cassandra) DeepCopyInto(out *Cassandra) {
+func (in *AWSPrivateLink) DeepCopyInto(out *AWSPrivateLink) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -45,18 +35,18 @@ func (in *Cassandra) DeepCopyInto(out *Cassandra) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Cassandra.
-func (in *Cassandra) DeepCopy() *Cassandra {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSPrivateLink.
+func (in *AWSPrivateLink) DeepCopy() *AWSPrivateLink {
 	if in == nil {
 		return nil
 	}
-	out := new(Cassandra)
+	out := new(AWSPrivateLink)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Cassandra) DeepCopyObject() runtime.Object {
+func (in *AWSPrivateLink) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -64,31 +54,31 @@ func (in *Cassandra) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CassandraList) DeepCopyInto(out *CassandraList) {
+func (in *AWSPrivateLinkList) DeepCopyInto(out *AWSPrivateLinkList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Cassandra, len(*in))
+		*out = make([]AWSPrivateLink, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CassandraList.
-func (in *CassandraList) DeepCopy() *CassandraList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSPrivateLinkList.
+func (in *AWSPrivateLinkList) DeepCopy() *AWSPrivateLinkList {
 	if in == nil {
 		return nil
 	}
-	out := new(CassandraList)
+	out := new(AWSPrivateLinkList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *CassandraList) DeepCopyObject() runtime.Object {
+func (in *AWSPrivateLinkList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -96,131 +86,75 @@ func (in *CassandraList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CassandraSpec) DeepCopyInto(out *CassandraSpec) {
+func (in *AWSPrivateLinkSpec) DeepCopyInto(out *AWSPrivateLinkSpec) {
 	*out = *in
-	in.ServiceCommonSpec.DeepCopyInto(&out.ServiceCommonSpec)
-	out.AuthSecretRef = in.AuthSecretRef
-	out.ConnInfoSecretTarget = in.ConnInfoSecretTarget
-	if in.UserConfig != nil {
-		in, out := &in.UserConfig, &out.UserConfig
-		*out = new(cassandra.CassandraUserConfig)
-		(*in).DeepCopyInto(*out)
+	if in.ServiceRef != nil {
+		in, out := &in.ServiceRef, &out.ServiceRef
+		*out = new(ServiceReference)
+		**out = **in
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CassandraSpec.
-func (in *CassandraSpec) DeepCopy() *CassandraSpec {
-	if in == nil {
-		return nil
+	if in.Principals != nil {
+		in, out := &in.Principals, &out.Principals
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
-	out := new(CassandraSpec)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Clickhouse) DeepCopyInto(out *Clickhouse) {
-	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	out.AuthSecretRef = in.AuthSecretRef
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Clickhouse.
-func (in *Clickhouse) DeepCopy() *Clickhouse {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSPrivateLinkSpec.
+func (in *AWSPrivateLinkSpec) DeepCopy() *AWSPrivateLinkSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(Clickhouse)
+	out := new(AWSPrivateLinkSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Clickhouse) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClickhouseList) DeepCopyInto(out *ClickhouseList) {
+func (in *AWSPrivateLinkStatus) DeepCopyInto(out *AWSPrivateLinkStatus) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]Clickhouse, len(*in))
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClickhouseList.
-func (in *ClickhouseList) DeepCopy() *ClickhouseList {
-	if in == nil {
-		return nil
-	}
-	out := new(ClickhouseList)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ClickhouseList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClickhouseSpec) DeepCopyInto(out *ClickhouseSpec) {
-	*out = *in
-	in.ServiceCommonSpec.DeepCopyInto(&out.ServiceCommonSpec)
-	out.AuthSecretRef = in.AuthSecretRef
-	out.ConnInfoSecretTarget = in.ConnInfoSecretTarget
-	if in.UserConfig != nil {
-		in, out := &in.UserConfig, &out.UserConfig
-		*out = new(clickhouse.ClickhouseUserConfig)
-		(*in).DeepCopyInto(*out)
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClickhouseSpec.
-func (in *ClickhouseSpec) DeepCopy() *ClickhouseSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSPrivateLinkStatus.
+func (in *AWSPrivateLinkStatus) DeepCopy() *AWSPrivateLinkStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ClickhouseSpec)
+	out := new(AWSPrivateLinkStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClickhouseUser) DeepCopyInto(out *ClickhouseUser) {
+func (in *ApplicationUserToken) DeepCopyInto(out *ApplicationUserToken) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClickhouseUser.
-func (in *ClickhouseUser) DeepCopy() *ClickhouseUser {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationUserToken.
+func (in *ApplicationUserToken) DeepCopy() *ApplicationUserToken {
 	if in == nil {
 		return nil
 	}
-	out := new(ClickhouseUser)
+	out := new(ApplicationUserToken)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ClickhouseUser) DeepCopyObject() runtime.Object {
+func (in *ApplicationUserToken) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -228,31 +162,31 @@ func (in *ClickhouseUser) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClickhouseUserList) DeepCopyInto(out *ClickhouseUserList) {
+func (in *ApplicationUserTokenList) DeepCopyInto(out *ApplicationUserTokenList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]ClickhouseUser, len(*in))
+		*out = make([]ApplicationUserToken, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClickhouseUserList.
-func (in *ClickhouseUserList) DeepCopy() *ClickhouseUserList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationUserTokenList.
+func (in *ApplicationUserTokenList) DeepCopy() *ApplicationUserTokenList {
 	if in == nil {
 		return nil
 	}
-	out := new(ClickhouseUserList)
+	out := new(ApplicationUserTokenList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ClickhouseUserList) DeepCopyObject() runtime.Object {
+func (in *ApplicationUserTokenList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -260,24 +194,25 @@ func (in *ClickhouseUserList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClickhouseUserSpec) DeepCopyInto(out *ClickhouseUserSpec) {
+func (in *ApplicationUserTokenSpec) DeepCopyInto(out *ApplicationUserTokenSpec) {
 	*out = *in
-	out.ConnInfoSecretTarget = in.ConnInfoSecretTarget
+	out.TokenSecretRef = in.TokenSecretRef
+	in.RotationPeriod.DeepCopyInto(&out.RotationPeriod)
 	out.AuthSecretRef = in.AuthSecretRef
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClickhouseUserSpec.
-func (in *ClickhouseUserSpec) DeepCopy() *ClickhouseUserSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationUserTokenSpec.
+func (in *ApplicationUserTokenSpec) DeepCopy() *ApplicationUserTokenSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ClickhouseUserSpec)
+	out := new(ApplicationUserTokenSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClickhouseUserStatus) DeepCopyInto(out *ClickhouseUserStatus) {
+func (in *ApplicationUserTokenStatus) DeepCopyInto(out *ApplicationUserTokenStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
@@ -286,54 +221,75 @@ func (in *ClickhouseUserStatus) DeepCopyInto(out *ClickhouseUserStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.LastRotationTime != nil {
+		in, out := &in.LastRotationTime, &out.LastRotationTime
+		*out = (*in).DeepCopy()
+	}
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClickhouseUserStatus.
-func (in *ClickhouseUserStatus) DeepCopy() *ClickhouseUserStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationUserTokenStatus.
+func (in *ApplicationUserTokenStatus) DeepCopy() *ApplicationUserTokenStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ClickhouseUserStatus)
+	out := new(ApplicationUserTokenStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConnInfoSecretTarget) DeepCopyInto(out *ConnInfoSecretTarget) {
+func (in *AppliedRequest) DeepCopyInto(out *AppliedRequest) {
 	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnInfoSecretTarget.
-func (in *ConnInfoSecretTarget) DeepCopy() *ConnInfoSecretTarget {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppliedRequest.
+func (in *AppliedRequest) DeepCopy() *AppliedRequest {
 	if in == nil {
 		return nil
 	}
-	out := new(ConnInfoSecretTarget)
+	out := new(AppliedRequest)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConnectionPool) DeepCopyInto(out *ConnectionPool) {
+func (in *AuthSecretReference) DeepCopyInto(out *AuthSecretReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthSecretReference.
+func (in *AuthSecretReference) DeepCopy() *AuthSecretReference {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthSecretReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzurePrivateLink) DeepCopyInto(out *AzurePrivateLink) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionPool.
-func (in *ConnectionPool) DeepCopy() *ConnectionPool {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzurePrivateLink.
+func (in *AzurePrivateLink) DeepCopy() *AzurePrivateLink {
 	if in == nil {
 		return nil
 	}
-	out := new(ConnectionPool)
+	out := new(AzurePrivateLink)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ConnectionPool) DeepCopyObject() runtime.Object {
+func (in *AzurePrivateLink) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -341,31 +297,46 @@ func (in *ConnectionPool) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConnectionPoolList) DeepCopyInto(out *ConnectionPoolList) {
+func (in *AzurePrivateLinkConnection) DeepCopyInto(out *AzurePrivateLinkConnection) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzurePrivateLinkConnection.
+func (in *AzurePrivateLinkConnection) DeepCopy() *AzurePrivateLinkConnection {
+	if in == nil {
+		return nil
+	}
+	out := new(AzurePrivateLinkConnection)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzurePrivateLinkList) DeepCopyInto(out *AzurePrivateLinkList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]ConnectionPool, len(*in))
+		*out = make([]AzurePrivateLink, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionPoolList.
-func (in *ConnectionPoolList) DeepCopy() *ConnectionPoolList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzurePrivateLinkList.
+func (in *AzurePrivateLinkList) DeepCopy() *AzurePrivateLinkList {
 	if in == nil {
 		return nil
 	}
-	out := new(ConnectionPoolList)
+	out := new(AzurePrivateLinkList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ConnectionPoolList) DeepCopyObject() runtime.Object {
+func (in *AzurePrivateLinkList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -373,24 +344,33 @@ func (in *ConnectionPoolList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConnectionPoolSpec) DeepCopyInto(out *ConnectionPoolSpec) {
+func (in *AzurePrivateLinkSpec) DeepCopyInto(out *AzurePrivateLinkSpec) {
 	*out = *in
-	out.ConnInfoSecretTarget = in.ConnInfoSecretTarget
+	if in.ServiceRef != nil {
+		in, out := &in.ServiceRef, &out.ServiceRef
+		*out = new(ServiceReference)
+		**out = **in
+	}
+	if in.SubscriptionIDs != nil {
+		in, out := &in.SubscriptionIDs, &out.SubscriptionIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	out.AuthSecretRef = in.AuthSecretRef
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionPoolSpec.
-func (in *ConnectionPoolSpec) DeepCopy() *ConnectionPoolSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzurePrivateLinkSpec.
+func (in *AzurePrivateLinkSpec) DeepCopy() *AzurePrivateLinkSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ConnectionPoolSpec)
+	out := new(AzurePrivateLinkSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConnectionPoolStatus) DeepCopyInto(out *ConnectionPoolStatus) {
+func (in *AzurePrivateLinkStatus) DeepCopyInto(out *AzurePrivateLinkStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
@@ -399,39 +379,45 @@ func (in *ConnectionPoolStatus) DeepCopyInto(out *ConnectionPoolStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Connections != nil {
+		in, out := &in.Connections, &out.Connections
+		*out = make([]AzurePrivateLinkConnection, len(*in))
+		copy(*out, *in)
+	}
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionPoolStatus.
-func (in *ConnectionPoolStatus) DeepCopy() *ConnectionPoolStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzurePrivateLinkStatus.
+func (in *AzurePrivateLinkStatus) DeepCopy() *AzurePrivateLinkStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ConnectionPoolStatus)
+	out := new(AzurePrivateLinkStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Database) DeepCopyInto(out *Database) {
+func (in *AzureVNetPeeringConnection) DeepCopyInto(out *AzureVNetPeeringConnection) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Database.
-func (in *Database) DeepCopy() *Database {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureVNetPeeringConnection.
+func (in *AzureVNetPeeringConnection) DeepCopy() *AzureVNetPeeringConnection {
 	if in == nil {
 		return nil
 	}
-	out := new(Database)
+	out := new(AzureVNetPeeringConnection)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Database) DeepCopyObject() runtime.Object {
+func (in *AzureVNetPeeringConnection) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -439,31 +425,31 @@ func (in *Database) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DatabaseList) DeepCopyInto(out *DatabaseList) {
+func (in *AzureVNetPeeringConnectionList) DeepCopyInto(out *AzureVNetPeeringConnectionList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Database, len(*in))
+		*out = make([]AzureVNetPeeringConnection, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseList.
-func (in *DatabaseList) DeepCopy() *DatabaseList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureVNetPeeringConnectionList.
+func (in *AzureVNetPeeringConnectionList) DeepCopy() *AzureVNetPeeringConnectionList {
 	if in == nil {
 		return nil
 	}
-	out := new(DatabaseList)
+	out := new(AzureVNetPeeringConnectionList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *DatabaseList) DeepCopyObject() runtime.Object {
+func (in *AzureVNetPeeringConnectionList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -471,23 +457,28 @@ func (in *DatabaseList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DatabaseSpec) DeepCopyInto(out *DatabaseSpec) {
+func (in *AzureVNetPeeringConnectionSpec) DeepCopyInto(out *AzureVNetPeeringConnectionSpec) {
 	*out = *in
+	if in.ProjectVPCRef != nil {
+		in, out := &in.ProjectVPCRef, &out.ProjectVPCRef
+		*out = new(ResourceReference)
+		**out = **in
+	}
 	out.AuthSecretRef = in.AuthSecretRef
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseSpec.
-func (in *DatabaseSpec) DeepCopy() *DatabaseSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureVNetPeeringConnectionSpec.
+func (in *AzureVNetPeeringConnectionSpec) DeepCopy() *AzureVNetPeeringConnectionSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(DatabaseSpec)
+	out := new(AzureVNetPeeringConnectionSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DatabaseStatus) DeepCopyInto(out *DatabaseStatus) {
+func (in *AzureVNetPeeringConnectionStatus) DeepCopyInto(out *AzureVNetPeeringConnectionStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
@@ -496,20 +487,21 @@ func (in *DatabaseStatus) DeepCopyInto(out *DatabaseStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseStatus.
-func (in *DatabaseStatus) DeepCopy() *DatabaseStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureVNetPeeringConnectionStatus.
+func (in *AzureVNetPeeringConnectionStatus) DeepCopy() *AzureVNetPeeringConnectionStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(DatabaseStatus)
+	out := new(AzureVNetPeeringConnectionStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Grafana) DeepCopyInto(out *Grafana) {
+func (in *Cassandra) DeepCopyInto(out *Cassandra) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -517,18 +509,18 @@ func (in *Grafana) DeepCopyInto(out *Grafana) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Grafana.
-func (in *Grafana) DeepCopy() *Grafana {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Cassandra.
+func (in *Cassandra) DeepCopy() *Cassandra {
 	if in == nil {
 		return nil
 	}
-	out := new(Grafana)
+	out := new(Cassandra)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Grafana) DeepCopyObject() runtime.Object {
+func (in *Cassandra) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -536,31 +528,31 @@ func (in *Grafana) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GrafanaList) DeepCopyInto(out *GrafanaList) {
+func (in *CassandraList) DeepCopyInto(out *CassandraList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Grafana, len(*in))
+		*out = make([]Cassandra, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GrafanaList.
-func (in *GrafanaList) DeepCopy() *GrafanaList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CassandraList.
+func (in *CassandraList) DeepCopy() *CassandraList {
 	if in == nil {
 		return nil
 	}
-	out := new(GrafanaList)
+	out := new(CassandraList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *GrafanaList) DeepCopyObject() runtime.Object {
+func (in *CassandraList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -568,30 +560,30 @@ func (in *GrafanaList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GrafanaSpec) DeepCopyInto(out *GrafanaSpec) {
+func (in *CassandraSpec) DeepCopyInto(out *CassandraSpec) {
 	*out = *in
 	in.ServiceCommonSpec.DeepCopyInto(&out.ServiceCommonSpec)
 	out.AuthSecretRef = in.AuthSecretRef
 	out.ConnInfoSecretTarget = in.ConnInfoSecretTarget
 	if in.UserConfig != nil {
 		in, out := &in.UserConfig, &out.UserConfig
-		*out = new(grafana.GrafanaUserConfig)
+		*out = new(cassandra.CassandraUserConfig)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GrafanaSpec.
-func (in *GrafanaSpec) DeepCopy() *GrafanaSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CassandraSpec.
+func (in *CassandraSpec) DeepCopy() *CassandraSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(GrafanaSpec)
+	out := new(CassandraSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Kafka) DeepCopyInto(out *Kafka) {
+func (in *Clickhouse) DeepCopyInto(out *Clickhouse) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -599,18 +591,18 @@ func (in *Kafka) DeepCopyInto(out *Kafka) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Kafka.
-func (in *Kafka) DeepCopy() *Kafka {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Clickhouse.
+func (in *Clickhouse) DeepCopy() *Clickhouse {
 	if in == nil {
 		return nil
 	}
-	out := new(Kafka)
+	out := new(Clickhouse)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Kafka) DeepCopyObject() runtime.Object {
+func (in *Clickhouse) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -618,26 +610,26 @@ func (in *Kafka) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KafkaACL) DeepCopyInto(out *KafkaACL) {
+func (in *ClickhouseGrant) DeepCopyInto(out *ClickhouseGrant) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaACL.
-func (in *KafkaACL) DeepCopy() *KafkaACL {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClickhouseGrant.
+func (in *ClickhouseGrant) DeepCopy() *ClickhouseGrant {
 	if in == nil {
 		return nil
 	}
-	out := new(KafkaACL)
+	out := new(ClickhouseGrant)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *KafkaACL) DeepCopyObject() runtime.Object {
+func (in *ClickhouseGrant) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -645,31 +637,31 @@ func (in *KafkaACL) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KafkaACLList) DeepCopyInto(out *KafkaACLList) {
+func (in *ClickhouseGrantList) DeepCopyInto(out *ClickhouseGrantList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]KafkaACL, len(*in))
+		*out = make([]ClickhouseGrant, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaACLList.
-func (in *KafkaACLList) DeepCopy() *KafkaACLList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClickhouseGrantList.
+func (in *ClickhouseGrantList) DeepCopy() *ClickhouseGrantList {
 	if in == nil {
 		return nil
 	}
-	out := new(KafkaACLList)
+	out := new(ClickhouseGrantList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *KafkaACLList) DeepCopyObject() runtime.Object {
+func (in *ClickhouseGrantList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -677,23 +669,41 @@ func (in *KafkaACLList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KafkaACLSpec) DeepCopyInto(out *KafkaACLSpec) {
+func (in *ClickhouseGrantSpec) DeepCopyInto(out *ClickhouseGrantSpec) {
 	*out = *in
+	out.Grantee = in.Grantee
+	if in.PrivilegeGrants != nil {
+		in, out := &in.PrivilegeGrants, &out.PrivilegeGrants
+		*out = make([]ClickhousePrivilegeGrant, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.RoleGrants != nil {
+		in, out := &in.RoleGrants, &out.RoleGrants
+		*out = make([]ClickhouseRoleGrant, len(*in))
+		copy(*out, *in)
+	}
+	if in.RoleRefs != nil {
+		in, out := &in.RoleRefs, &out.RoleRefs
+		*out = make([]ResourceReference, len(*in))
+		copy(*out, *in)
+	}
 	out.AuthSecretRef = in.AuthSecretRef
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaACLSpec.
-func (in *KafkaACLSpec) DeepCopy() *KafkaACLSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClickhouseGrantSpec.
+func (in *ClickhouseGrantSpec) DeepCopy() *ClickhouseGrantSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(KafkaACLSpec)
+	out := new(ClickhouseGrantSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KafkaACLStatus) DeepCopyInto(out *KafkaACLStatus) {
+func (in *ClickhouseGrantStatus) DeepCopyInto(out *ClickhouseGrantStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
@@ -702,71 +712,60 @@ func (in *KafkaACLStatus) DeepCopyInto(out *KafkaACLStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaACLStatus.
-func (in *KafkaACLStatus) DeepCopy() *KafkaACLStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClickhouseGrantStatus.
+func (in *ClickhouseGrantStatus) DeepCopy() *ClickhouseGrantStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(KafkaACLStatus)
+	out := new(ClickhouseGrantStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KafkaConnect) DeepCopyInto(out *KafkaConnect) {
+func (in *ClickhouseGrantee) DeepCopyInto(out *ClickhouseGrantee) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaConnect.
-func (in *KafkaConnect) DeepCopy() *KafkaConnect {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClickhouseGrantee.
+func (in *ClickhouseGrantee) DeepCopy() *ClickhouseGrantee {
 	if in == nil {
 		return nil
 	}
-	out := new(KafkaConnect)
+	out := new(ClickhouseGrantee)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *KafkaConnect) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KafkaConnectList) DeepCopyInto(out *KafkaConnectList) {
+func (in *ClickhouseList) DeepCopyInto(out *ClickhouseList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]KafkaConnect, len(*in))
+		*out = make([]Clickhouse, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaConnectList.
-func (in *KafkaConnectList) DeepCopy() *KafkaConnectList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClickhouseList.
+func (in *ClickhouseList) DeepCopy() *ClickhouseList {
 	if in == nil {
 		return nil
 	}
-	out := new(KafkaConnectList)
+	out := new(ClickhouseList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *KafkaConnectList) DeepCopyObject() runtime.Object {
+func (in *ClickhouseList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -774,29 +773,27 @@ func (in *KafkaConnectList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KafkaConnectSpec) DeepCopyInto(out *KafkaConnectSpec) {
+func (in *ClickhousePrivilegeGrant) DeepCopyInto(out *ClickhousePrivilegeGrant) {
 	*out = *in
-	in.ServiceCommonSpec.DeepCopyInto(&out.ServiceCommonSpec)
-	out.AuthSecretRef = in.AuthSecretRef
-	if in.UserConfig != nil {
-		in, out := &in.UserConfig, &out.UserConfig
-		*out = new(kafka_connect.KafkaConnectUserConfig)
-		(*in).DeepCopyInto(*out)
+	if in.Columns != nil {
+		in, out := &in.Columns, &out.Columns
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaConnectSpec.
-func (in *KafkaConnectSpec) DeepCopy() *KafkaConnectSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClickhousePrivilegeGrant.
+func (in *ClickhousePrivilegeGrant) DeepCopy() *ClickhousePrivilegeGrant {
 	if in == nil {
 		return nil
 	}
-	out := new(KafkaConnectSpec)
+	out := new(ClickhousePrivilegeGrant)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KafkaConnector) DeepCopyInto(out *KafkaConnector) {
+func (in *ClickhouseRole) DeepCopyInto(out *ClickhouseRole) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -804,18 +801,18 @@ func (in *KafkaConnector) DeepCopyInto(out *KafkaConnector) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaConnector.
-func (in *KafkaConnector) DeepCopy() *KafkaConnector {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClickhouseRole.
+func (in *ClickhouseRole) DeepCopy() *ClickhouseRole {
 	if in == nil {
 		return nil
 	}
-	out := new(KafkaConnector)
+	out := new(ClickhouseRole)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *KafkaConnector) DeepCopyObject() runtime.Object {
+func (in *ClickhouseRole) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -823,31 +820,46 @@ func (in *KafkaConnector) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KafkaConnectorList) DeepCopyInto(out *KafkaConnectorList) {
+func (in *ClickhouseRoleGrant) DeepCopyInto(out *ClickhouseRoleGrant) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClickhouseRoleGrant.
+func (in *ClickhouseRoleGrant) DeepCopy() *ClickhouseRoleGrant {
+	if in == nil {
+		return nil
+	}
+	out := new(ClickhouseRoleGrant)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClickhouseRoleList) DeepCopyInto(out *ClickhouseRoleList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]KafkaConnector, len(*in))
+		*out = make([]ClickhouseRole, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaConnectorList.
-func (in *KafkaConnectorList) DeepCopy() *KafkaConnectorList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClickhouseRoleList.
+func (in *ClickhouseRoleList) DeepCopy() *ClickhouseRoleList {
 	if in == nil {
 		return nil
 	}
-	out := new(KafkaConnectorList)
+	out := new(ClickhouseRoleList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *KafkaConnectorList) DeepCopyObject() runtime.Object {
+func (in *ClickhouseRoleList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -855,45 +867,23 @@ func (in *KafkaConnectorList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KafkaConnectorPluginStatus) DeepCopyInto(out *KafkaConnectorPluginStatus) {
-	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaConnectorPluginStatus.
-func (in *KafkaConnectorPluginStatus) DeepCopy() *KafkaConnectorPluginStatus {
-	if in == nil {
-		return nil
-	}
-	out := new(KafkaConnectorPluginStatus)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KafkaConnectorSpec) DeepCopyInto(out *KafkaConnectorSpec) {
+func (in *ClickhouseRoleSpec) DeepCopyInto(out *ClickhouseRoleSpec) {
 	*out = *in
 	out.AuthSecretRef = in.AuthSecretRef
-	if in.UserConfig != nil {
-		in, out := &in.UserConfig, &out.UserConfig
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaConnectorSpec.
-func (in *KafkaConnectorSpec) DeepCopy() *KafkaConnectorSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClickhouseRoleSpec.
+func (in *ClickhouseRoleSpec) DeepCopy() *ClickhouseRoleSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(KafkaConnectorSpec)
+	out := new(ClickhouseRoleSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KafkaConnectorStatus) DeepCopyInto(out *KafkaConnectorStatus) {
+func (in *ClickhouseRoleStatus) DeepCopyInto(out *ClickhouseRoleStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
@@ -902,69 +892,44 @@ func (in *KafkaConnectorStatus) DeepCopyInto(out *KafkaConnectorStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	out.PluginStatus = in.PluginStatus
-	out.TasksStatus = in.TasksStatus
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaConnectorStatus.
-func (in *KafkaConnectorStatus) DeepCopy() *KafkaConnectorStatus {
-	if in == nil {
-		return nil
-	}
-	out := new(KafkaConnectorStatus)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KafkaConnectorTasksStatus) DeepCopyInto(out *KafkaConnectorTasksStatus) {
-	*out = *in
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaConnectorTasksStatus.
-func (in *KafkaConnectorTasksStatus) DeepCopy() *KafkaConnectorTasksStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClickhouseRoleStatus.
+func (in *ClickhouseRoleStatus) DeepCopy() *ClickhouseRoleStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(KafkaConnectorTasksStatus)
+	out := new(ClickhouseRoleStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KafkaList) DeepCopyInto(out *KafkaList) {
+func (in *ClickhouseSpec) DeepCopyInto(out *ClickhouseSpec) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]Kafka, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	in.ServiceCommonSpec.DeepCopyInto(&out.ServiceCommonSpec)
+	out.AuthSecretRef = in.AuthSecretRef
+	out.ConnInfoSecretTarget = in.ConnInfoSecretTarget
+	if in.UserConfig != nil {
+		in, out := &in.UserConfig, &out.UserConfig
+		*out = new(clickhouse.ClickhouseUserConfig)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaList.
-func (in *KafkaList) DeepCopy() *KafkaList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClickhouseSpec.
+func (in *ClickhouseSpec) DeepCopy() *ClickhouseSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(KafkaList)
+	out := new(ClickhouseSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *KafkaList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KafkaSchema) DeepCopyInto(out *KafkaSchema) {
+func (in *ClickhouseUser) DeepCopyInto(out *ClickhouseUser) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -972,18 +937,18 @@ func (in *KafkaSchema) DeepCopyInto(out *KafkaSchema) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaSchema.
-func (in *KafkaSchema) DeepCopy() *KafkaSchema {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClickhouseUser.
+func (in *ClickhouseUser) DeepCopy() *ClickhouseUser {
 	if in == nil {
 		return nil
 	}
-	out := new(KafkaSchema)
+	out := new(ClickhouseUser)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *KafkaSchema) DeepCopyObject() runtime.Object {
+func (in *ClickhouseUser) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -991,31 +956,31 @@ func (in *KafkaSchema) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KafkaSchemaList) DeepCopyInto(out *KafkaSchemaList) {
+func (in *ClickhouseUserList) DeepCopyInto(out *ClickhouseUserList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]KafkaSchema, len(*in))
+		*out = make([]ClickhouseUser, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaSchemaList.
-func (in *KafkaSchemaList) DeepCopy() *KafkaSchemaList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClickhouseUserList.
+func (in *ClickhouseUserList) DeepCopy() *ClickhouseUserList {
 	if in == nil {
 		return nil
 	}
-	out := new(KafkaSchemaList)
+	out := new(ClickhouseUserList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *KafkaSchemaList) DeepCopyObject() runtime.Object {
+func (in *ClickhouseUserList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1023,23 +988,24 @@ func (in *KafkaSchemaList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KafkaSchemaSpec) DeepCopyInto(out *KafkaSchemaSpec) {
+func (in *ClickhouseUserSpec) DeepCopyInto(out *ClickhouseUserSpec) {
 	*out = *in
+	out.ConnInfoSecretTarget = in.ConnInfoSecretTarget
 	out.AuthSecretRef = in.AuthSecretRef
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaSchemaSpec.
-func (in *KafkaSchemaSpec) DeepCopy() *KafkaSchemaSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClickhouseUserSpec.
+func (in *ClickhouseUserSpec) DeepCopy() *ClickhouseUserSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(KafkaSchemaSpec)
+	out := new(ClickhouseUserSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KafkaSchemaStatus) DeepCopyInto(out *KafkaSchemaStatus) {
+func (in *ClickhouseUserStatus) DeepCopyInto(out *ClickhouseUserStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
@@ -1048,67 +1014,71 @@ func (in *KafkaSchemaStatus) DeepCopyInto(out *KafkaSchemaStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaSchemaStatus.
-func (in *KafkaSchemaStatus) DeepCopy() *KafkaSchemaStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClickhouseUserStatus.
+func (in *ClickhouseUserStatus) DeepCopy() *ClickhouseUserStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(KafkaSchemaStatus)
+	out := new(ClickhouseUserStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KafkaSpec) DeepCopyInto(out *KafkaSpec) {
+func (in *CloudPolicy) DeepCopyInto(out *CloudPolicy) {
 	*out = *in
-	in.ServiceCommonSpec.DeepCopyInto(&out.ServiceCommonSpec)
-	out.AuthSecretRef = in.AuthSecretRef
-	out.ConnInfoSecretTarget = in.ConnInfoSecretTarget
-	if in.Karapace != nil {
-		in, out := &in.Karapace, &out.Karapace
-		*out = new(bool)
-		**out = **in
-	}
-	if in.UserConfig != nil {
-		in, out := &in.UserConfig, &out.UserConfig
-		*out = new(kafka.KafkaUserConfig)
-		(*in).DeepCopyInto(*out)
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaSpec.
-func (in *KafkaSpec) DeepCopy() *KafkaSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudPolicy.
+func (in *CloudPolicy) DeepCopy() *CloudPolicy {
 	if in == nil {
 		return nil
 	}
-	out := new(KafkaSpec)
+	out := new(CloudPolicy)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CloudPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KafkaTopic) DeepCopyInto(out *KafkaTopic) {
+func (in *CloudPolicyList) DeepCopyInto(out *CloudPolicyList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CloudPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaTopic.
-func (in *KafkaTopic) DeepCopy() *KafkaTopic {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudPolicyList.
+func (in *CloudPolicyList) DeepCopy() *CloudPolicyList {
 	if in == nil {
 		return nil
 	}
-	out := new(KafkaTopic)
+	out := new(CloudPolicyList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *KafkaTopic) DeepCopyObject() runtime.Object {
+func (in *CloudPolicyList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1116,146 +1086,3528 @@ func (in *KafkaTopic) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KafkaTopicConfig) DeepCopyInto(out *KafkaTopicConfig) {
+func (in *CloudPolicySpec) DeepCopyInto(out *CloudPolicySpec) {
 	*out = *in
-	if in.DeleteRetentionMs != nil {
-		in, out := &in.DeleteRetentionMs, &out.DeleteRetentionMs
-		*out = new(int64)
-		**out = **in
-	}
-	if in.FileDeleteDelayMs != nil {
-		in, out := &in.FileDeleteDelayMs, &out.FileDeleteDelayMs
-		*out = new(int64)
-		**out = **in
+	if in.AllowedClouds != nil {
+		in, out := &in.AllowedClouds, &out.AllowedClouds
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
-	if in.FlushMessages != nil {
-		in, out := &in.FlushMessages, &out.FlushMessages
-		*out = new(int64)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudPolicySpec.
+func (in *CloudPolicySpec) DeepCopy() *CloudPolicySpec {
+	if in == nil {
+		return nil
 	}
-	if in.FlushMs != nil {
-		in, out := &in.FlushMs, &out.FlushMs
-		*out = new(int64)
-		**out = **in
+	out := new(CloudPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigMapTarget) DeepCopyInto(out *ConfigMapTarget) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigMapTarget.
+func (in *ConfigMapTarget) DeepCopy() *ConfigMapTarget {
+	if in == nil {
+		return nil
 	}
-	if in.IndexIntervalBytes != nil {
-		in, out := &in.IndexIntervalBytes, &out.IndexIntervalBytes
-		*out = new(int64)
-		**out = **in
+	out := new(ConfigMapTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnInfoSecretTarget) DeepCopyInto(out *ConnInfoSecretTarget) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnInfoSecretTarget.
+func (in *ConnInfoSecretTarget) DeepCopy() *ConnInfoSecretTarget {
+	if in == nil {
+		return nil
 	}
-	if in.MaxCompactionLagMs != nil {
-		in, out := &in.MaxCompactionLagMs, &out.MaxCompactionLagMs
-		*out = new(int64)
-		**out = **in
+	out := new(ConnInfoSecretTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectionPool) DeepCopyInto(out *ConnectionPool) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionPool.
+func (in *ConnectionPool) DeepCopy() *ConnectionPool {
+	if in == nil {
+		return nil
 	}
-	if in.MaxMessageBytes != nil {
-		in, out := &in.MaxMessageBytes, &out.MaxMessageBytes
-		*out = new(int64)
-		**out = **in
+	out := new(ConnectionPool)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ConnectionPool) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	if in.MessageDownconversionEnable != nil {
-		in, out := &in.MessageDownconversionEnable, &out.MessageDownconversionEnable
-		*out = new(bool)
-		**out = **in
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectionPoolList) DeepCopyInto(out *ConnectionPoolList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ConnectionPool, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.MessageTimestampDifferenceMaxMs != nil {
-		in, out := &in.MessageTimestampDifferenceMaxMs, &out.MessageTimestampDifferenceMaxMs
-		*out = new(int64)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionPoolList.
+func (in *ConnectionPoolList) DeepCopy() *ConnectionPoolList {
+	if in == nil {
+		return nil
 	}
-	if in.MinCleanableDirtyRatio != nil {
-		in, out := &in.MinCleanableDirtyRatio, &out.MinCleanableDirtyRatio
-		*out = new(float64)
-		**out = **in
+	out := new(ConnectionPoolList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ConnectionPoolList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	if in.MinCompactionLagMs != nil {
-		in, out := &in.MinCompactionLagMs, &out.MinCompactionLagMs
-		*out = new(int64)
-		**out = **in
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectionPoolSpec) DeepCopyInto(out *ConnectionPoolSpec) {
+	*out = *in
+	out.ConnInfoSecretTarget = in.ConnInfoSecretTarget
+	out.AuthSecretRef = in.AuthSecretRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionPoolSpec.
+func (in *ConnectionPoolSpec) DeepCopy() *ConnectionPoolSpec {
+	if in == nil {
+		return nil
 	}
-	if in.MinInsyncReplicas != nil {
-		in, out := &in.MinInsyncReplicas, &out.MinInsyncReplicas
-		*out = new(int64)
-		**out = **in
+	out := new(ConnectionPoolSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectionPoolStatus) DeepCopyInto(out *ConnectionPoolStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.Preallocate != nil {
-		in, out := &in.Preallocate, &out.Preallocate
-		*out = new(bool)
-		**out = **in
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionPoolStatus.
+func (in *ConnectionPoolStatus) DeepCopy() *ConnectionPoolStatus {
+	if in == nil {
+		return nil
 	}
-	if in.RetentionBytes != nil {
-		in, out := &in.RetentionBytes, &out.RetentionBytes
-		*out = new(int64)
-		**out = **in
+	out := new(ConnectionPoolStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Database) DeepCopyInto(out *Database) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Database.
+func (in *Database) DeepCopy() *Database {
+	if in == nil {
+		return nil
 	}
-	if in.RetentionMs != nil {
-		in, out := &in.RetentionMs, &out.RetentionMs
-		*out = new(int64)
-		**out = **in
+	out := new(Database)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Database) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	if in.SegmentBytes != nil {
-		in, out := &in.SegmentBytes, &out.SegmentBytes
-		*out = new(int64)
-		**out = **in
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatabaseList) DeepCopyInto(out *DatabaseList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Database, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.SegmentIndexBytes != nil {
-		in, out := &in.SegmentIndexBytes, &out.SegmentIndexBytes
-		*out = new(int64)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseList.
+func (in *DatabaseList) DeepCopy() *DatabaseList {
+	if in == nil {
+		return nil
 	}
-	if in.SegmentJitterMs != nil {
-		in, out := &in.SegmentJitterMs, &out.SegmentJitterMs
-		*out = new(int64)
-		**out = **in
+	out := new(DatabaseList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DatabaseList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	if in.SegmentMs != nil {
-		in, out := &in.SegmentMs, &out.SegmentMs
-		*out = new(int64)
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatabaseSpec) DeepCopyInto(out *DatabaseSpec) {
+	*out = *in
+	if in.ServiceRef != nil {
+		in, out := &in.ServiceRef, &out.ServiceRef
+		*out = new(ServiceReference)
 		**out = **in
 	}
-	if in.UncleanLeaderElectionEnable != nil {
-		in, out := &in.UncleanLeaderElectionEnable, &out.UncleanLeaderElectionEnable
+	out.AuthSecretRef = in.AuthSecretRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseSpec.
+func (in *DatabaseSpec) DeepCopy() *DatabaseSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DatabaseSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatabaseStatus) DeepCopyInto(out *DatabaseStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseStatus.
+func (in *DatabaseStatus) DeepCopy() *DatabaseStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DatabaseStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Dragonfly) DeepCopyInto(out *Dragonfly) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Dragonfly.
+func (in *Dragonfly) DeepCopy() *Dragonfly {
+	if in == nil {
+		return nil
+	}
+	out := new(Dragonfly)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Dragonfly) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DragonflyList) DeepCopyInto(out *DragonflyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Dragonfly, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DragonflyList.
+func (in *DragonflyList) DeepCopy() *DragonflyList {
+	if in == nil {
+		return nil
+	}
+	out := new(DragonflyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DragonflyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DragonflySpec) DeepCopyInto(out *DragonflySpec) {
+	*out = *in
+	in.ServiceCommonSpec.DeepCopyInto(&out.ServiceCommonSpec)
+	out.AuthSecretRef = in.AuthSecretRef
+	out.ConnInfoSecretTarget = in.ConnInfoSecretTarget
+	if in.UserConfig != nil {
+		in, out := &in.UserConfig, &out.UserConfig
+		*out = new(dragonfly.DragonflyUserConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DragonflySpec.
+func (in *DragonflySpec) DeepCopy() *DragonflySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DragonflySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EndpointUserConfigSecret) DeepCopyInto(out *EndpointUserConfigSecret) {
+	*out = *in
+	out.ValueFrom = in.ValueFrom
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EndpointUserConfigSecret.
+func (in *EndpointUserConfigSecret) DeepCopy() *EndpointUserConfigSecret {
+	if in == nil {
+		return nil
+	}
+	out := new(EndpointUserConfigSecret)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EndpointUserConfigSecretSource) DeepCopyInto(out *EndpointUserConfigSecretSource) {
+	*out = *in
+	out.SecretKeyRef = in.SecretKeyRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EndpointUserConfigSecretSource.
+func (in *EndpointUserConfigSecretSource) DeepCopy() *EndpointUserConfigSecretSource {
+	if in == nil {
+		return nil
+	}
+	out := new(EndpointUserConfigSecretSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FlinkApplication) DeepCopyInto(out *FlinkApplication) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlinkApplication.
+func (in *FlinkApplication) DeepCopy() *FlinkApplication {
+	if in == nil {
+		return nil
+	}
+	out := new(FlinkApplication)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FlinkApplication) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FlinkApplicationDeployment) DeepCopyInto(out *FlinkApplicationDeployment) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlinkApplicationDeployment.
+func (in *FlinkApplicationDeployment) DeepCopy() *FlinkApplicationDeployment {
+	if in == nil {
+		return nil
+	}
+	out := new(FlinkApplicationDeployment)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FlinkApplicationDeployment) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FlinkApplicationDeploymentList) DeepCopyInto(out *FlinkApplicationDeploymentList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]FlinkApplicationDeployment, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlinkApplicationDeploymentList.
+func (in *FlinkApplicationDeploymentList) DeepCopy() *FlinkApplicationDeploymentList {
+	if in == nil {
+		return nil
+	}
+	out := new(FlinkApplicationDeploymentList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FlinkApplicationDeploymentList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FlinkApplicationDeploymentSpec) DeepCopyInto(out *FlinkApplicationDeploymentSpec) {
+	*out = *in
+	out.ApplicationRef = in.ApplicationRef
+	if in.Version != nil {
+		in, out := &in.Version, &out.Version
+		*out = new(int)
+		**out = **in
+	}
+	if in.RestartEnabled != nil {
+		in, out := &in.RestartEnabled, &out.RestartEnabled
 		*out = new(bool)
 		**out = **in
 	}
+	out.AuthSecretRef = in.AuthSecretRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlinkApplicationDeploymentSpec.
+func (in *FlinkApplicationDeploymentSpec) DeepCopy() *FlinkApplicationDeploymentSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(FlinkApplicationDeploymentSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FlinkApplicationDeploymentStatus) DeepCopyInto(out *FlinkApplicationDeploymentStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlinkApplicationDeploymentStatus.
+func (in *FlinkApplicationDeploymentStatus) DeepCopy() *FlinkApplicationDeploymentStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(FlinkApplicationDeploymentStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FlinkApplicationList) DeepCopyInto(out *FlinkApplicationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]FlinkApplication, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlinkApplicationList.
+func (in *FlinkApplicationList) DeepCopy() *FlinkApplicationList {
+	if in == nil {
+		return nil
+	}
+	out := new(FlinkApplicationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FlinkApplicationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FlinkApplicationSpec) DeepCopyInto(out *FlinkApplicationSpec) {
+	*out = *in
+	in.Version.DeepCopyInto(&out.Version)
+	out.AuthSecretRef = in.AuthSecretRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlinkApplicationSpec.
+func (in *FlinkApplicationSpec) DeepCopy() *FlinkApplicationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(FlinkApplicationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FlinkApplicationStatus) DeepCopyInto(out *FlinkApplicationStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Versions != nil {
+		in, out := &in.Versions, &out.Versions
+		*out = make([]FlinkApplicationVersionStatus, len(*in))
+		copy(*out, *in)
+	}
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlinkApplicationStatus.
+func (in *FlinkApplicationStatus) DeepCopy() *FlinkApplicationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(FlinkApplicationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FlinkApplicationTable) DeepCopyInto(out *FlinkApplicationTable) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlinkApplicationTable.
+func (in *FlinkApplicationTable) DeepCopy() *FlinkApplicationTable {
+	if in == nil {
+		return nil
+	}
+	out := new(FlinkApplicationTable)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FlinkApplicationVersion) DeepCopyInto(out *FlinkApplicationVersion) {
+	*out = *in
+	if in.Sources != nil {
+		in, out := &in.Sources, &out.Sources
+		*out = make([]FlinkApplicationTable, len(*in))
+		copy(*out, *in)
+	}
+	if in.Sinks != nil {
+		in, out := &in.Sinks, &out.Sinks
+		*out = make([]FlinkApplicationTable, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlinkApplicationVersion.
+func (in *FlinkApplicationVersion) DeepCopy() *FlinkApplicationVersion {
+	if in == nil {
+		return nil
+	}
+	out := new(FlinkApplicationVersion)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FlinkApplicationVersionStatus) DeepCopyInto(out *FlinkApplicationVersionStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlinkApplicationVersionStatus.
+func (in *FlinkApplicationVersionStatus) DeepCopy() *FlinkApplicationVersionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(FlinkApplicationVersionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GCPVPCPeeringConnection) DeepCopyInto(out *GCPVPCPeeringConnection) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPVPCPeeringConnection.
+func (in *GCPVPCPeeringConnection) DeepCopy() *GCPVPCPeeringConnection {
+	if in == nil {
+		return nil
+	}
+	out := new(GCPVPCPeeringConnection)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GCPVPCPeeringConnection) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GCPVPCPeeringConnectionList) DeepCopyInto(out *GCPVPCPeeringConnectionList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]GCPVPCPeeringConnection, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPVPCPeeringConnectionList.
+func (in *GCPVPCPeeringConnectionList) DeepCopy() *GCPVPCPeeringConnectionList {
+	if in == nil {
+		return nil
+	}
+	out := new(GCPVPCPeeringConnectionList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GCPVPCPeeringConnectionList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GCPVPCPeeringConnectionSpec) DeepCopyInto(out *GCPVPCPeeringConnectionSpec) {
+	*out = *in
+	if in.ProjectVPCRef != nil {
+		in, out := &in.ProjectVPCRef, &out.ProjectVPCRef
+		*out = new(ResourceReference)
+		**out = **in
+	}
+	out.AuthSecretRef = in.AuthSecretRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPVPCPeeringConnectionSpec.
+func (in *GCPVPCPeeringConnectionSpec) DeepCopy() *GCPVPCPeeringConnectionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GCPVPCPeeringConnectionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GCPVPCPeeringConnectionStatus) DeepCopyInto(out *GCPVPCPeeringConnectionStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPVPCPeeringConnectionStatus.
+func (in *GCPVPCPeeringConnectionStatus) DeepCopy() *GCPVPCPeeringConnectionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(GCPVPCPeeringConnectionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Grafana) DeepCopyInto(out *Grafana) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Grafana.
+func (in *Grafana) DeepCopy() *Grafana {
+	if in == nil {
+		return nil
+	}
+	out := new(Grafana)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Grafana) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GrafanaList) DeepCopyInto(out *GrafanaList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Grafana, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GrafanaList.
+func (in *GrafanaList) DeepCopy() *GrafanaList {
+	if in == nil {
+		return nil
+	}
+	out := new(GrafanaList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GrafanaList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GrafanaSpec) DeepCopyInto(out *GrafanaSpec) {
+	*out = *in
+	in.ServiceCommonSpec.DeepCopyInto(&out.ServiceCommonSpec)
+	out.AuthSecretRef = in.AuthSecretRef
+	out.ConnInfoSecretTarget = in.ConnInfoSecretTarget
+	if in.UserConfig != nil {
+		in, out := &in.UserConfig, &out.UserConfig
+		*out = new(grafana.GrafanaUserConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GrafanaSpec.
+func (in *GrafanaSpec) DeepCopy() *GrafanaSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GrafanaSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Kafka) DeepCopyInto(out *Kafka) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Kafka.
+func (in *Kafka) DeepCopy() *Kafka {
+	if in == nil {
+		return nil
+	}
+	out := new(Kafka)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Kafka) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaACL) DeepCopyInto(out *KafkaACL) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaACL.
+func (in *KafkaACL) DeepCopy() *KafkaACL {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaACL)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KafkaACL) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaACLList) DeepCopyInto(out *KafkaACLList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]KafkaACL, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaACLList.
+func (in *KafkaACLList) DeepCopy() *KafkaACLList {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaACLList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KafkaACLList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaACLSpec) DeepCopyInto(out *KafkaACLSpec) {
+	*out = *in
+	out.AuthSecretRef = in.AuthSecretRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaACLSpec.
+func (in *KafkaACLSpec) DeepCopy() *KafkaACLSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaACLSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaACLStatus) DeepCopyInto(out *KafkaACLStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaACLStatus.
+func (in *KafkaACLStatus) DeepCopy() *KafkaACLStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaACLStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaConnect) DeepCopyInto(out *KafkaConnect) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaConnect.
+func (in *KafkaConnect) DeepCopy() *KafkaConnect {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaConnect)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KafkaConnect) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaConnectList) DeepCopyInto(out *KafkaConnectList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]KafkaConnect, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaConnectList.
+func (in *KafkaConnectList) DeepCopy() *KafkaConnectList {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaConnectList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KafkaConnectList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaConnectSpec) DeepCopyInto(out *KafkaConnectSpec) {
+	*out = *in
+	in.ServiceCommonSpec.DeepCopyInto(&out.ServiceCommonSpec)
+	out.AuthSecretRef = in.AuthSecretRef
+	if in.UserConfig != nil {
+		in, out := &in.UserConfig, &out.UserConfig
+		*out = new(kafka_connect.KafkaConnectUserConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaConnectSpec.
+func (in *KafkaConnectSpec) DeepCopy() *KafkaConnectSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaConnectSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaConnector) DeepCopyInto(out *KafkaConnector) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaConnector.
+func (in *KafkaConnector) DeepCopy() *KafkaConnector {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaConnector)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KafkaConnector) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaConnectorList) DeepCopyInto(out *KafkaConnectorList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]KafkaConnector, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaConnectorList.
+func (in *KafkaConnectorList) DeepCopy() *KafkaConnectorList {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaConnectorList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KafkaConnectorList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaConnectorPluginStatus) DeepCopyInto(out *KafkaConnectorPluginStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaConnectorPluginStatus.
+func (in *KafkaConnectorPluginStatus) DeepCopy() *KafkaConnectorPluginStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaConnectorPluginStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaConnectorSpec) DeepCopyInto(out *KafkaConnectorSpec) {
+	*out = *in
+	out.AuthSecretRef = in.AuthSecretRef
+	if in.UserConfig != nil {
+		in, out := &in.UserConfig, &out.UserConfig
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaConnectorSpec.
+func (in *KafkaConnectorSpec) DeepCopy() *KafkaConnectorSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaConnectorSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaConnectorStatus) DeepCopyInto(out *KafkaConnectorStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	out.PluginStatus = in.PluginStatus
+	out.TasksStatus = in.TasksStatus
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaConnectorStatus.
+func (in *KafkaConnectorStatus) DeepCopy() *KafkaConnectorStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaConnectorStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaConnectorTasksStatus) DeepCopyInto(out *KafkaConnectorTasksStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaConnectorTasksStatus.
+func (in *KafkaConnectorTasksStatus) DeepCopy() *KafkaConnectorTasksStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaConnectorTasksStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaList) DeepCopyInto(out *KafkaList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Kafka, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaList.
+func (in *KafkaList) DeepCopy() *KafkaList {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KafkaList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaNativeACL) DeepCopyInto(out *KafkaNativeACL) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaNativeACL.
+func (in *KafkaNativeACL) DeepCopy() *KafkaNativeACL {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaNativeACL)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KafkaNativeACL) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaNativeACLList) DeepCopyInto(out *KafkaNativeACLList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]KafkaNativeACL, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaNativeACLList.
+func (in *KafkaNativeACLList) DeepCopy() *KafkaNativeACLList {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaNativeACLList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KafkaNativeACLList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaNativeACLSpec) DeepCopyInto(out *KafkaNativeACLSpec) {
+	*out = *in
+	out.AuthSecretRef = in.AuthSecretRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaNativeACLSpec.
+func (in *KafkaNativeACLSpec) DeepCopy() *KafkaNativeACLSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaNativeACLSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaNativeACLStatus) DeepCopyInto(out *KafkaNativeACLStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaNativeACLStatus.
+func (in *KafkaNativeACLStatus) DeepCopy() *KafkaNativeACLStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaNativeACLStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaSchema) DeepCopyInto(out *KafkaSchema) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaSchema.
+func (in *KafkaSchema) DeepCopy() *KafkaSchema {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaSchema)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KafkaSchema) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaSchemaList) DeepCopyInto(out *KafkaSchemaList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]KafkaSchema, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaSchemaList.
+func (in *KafkaSchemaList) DeepCopy() *KafkaSchemaList {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaSchemaList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KafkaSchemaList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaSchemaReference) DeepCopyInto(out *KafkaSchemaReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaSchemaReference.
+func (in *KafkaSchemaReference) DeepCopy() *KafkaSchemaReference {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaSchemaReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaSchemaSpec) DeepCopyInto(out *KafkaSchemaSpec) {
+	*out = *in
+	if in.References != nil {
+		in, out := &in.References, &out.References
+		*out = make([]KafkaSchemaReference, len(*in))
+		copy(*out, *in)
+	}
+	out.AuthSecretRef = in.AuthSecretRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaSchemaSpec.
+func (in *KafkaSchemaSpec) DeepCopy() *KafkaSchemaSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaSchemaSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaSchemaStatus) DeepCopyInto(out *KafkaSchemaStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaSchemaStatus.
+func (in *KafkaSchemaStatus) DeepCopy() *KafkaSchemaStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaSchemaStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaSpec) DeepCopyInto(out *KafkaSpec) {
+	*out = *in
+	in.ServiceCommonSpec.DeepCopyInto(&out.ServiceCommonSpec)
+	out.AuthSecretRef = in.AuthSecretRef
+	out.ConnInfoSecretTarget = in.ConnInfoSecretTarget
+	if in.Karapace != nil {
+		in, out := &in.Karapace, &out.Karapace
+		*out = new(bool)
+		**out = **in
+	}
+	if in.UserConfig != nil {
+		in, out := &in.UserConfig, &out.UserConfig
+		*out = new(kafka.KafkaUserConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TopicDefaults != nil {
+		in, out := &in.TopicDefaults, &out.TopicDefaults
+		*out = new(KafkaTopicConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaSpec.
+func (in *KafkaSpec) DeepCopy() *KafkaSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaTopic) DeepCopyInto(out *KafkaTopic) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaTopic.
+func (in *KafkaTopic) DeepCopy() *KafkaTopic {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaTopic)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KafkaTopic) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaTopicAccess) DeepCopyInto(out *KafkaTopicAccess) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaTopicAccess.
+func (in *KafkaTopicAccess) DeepCopy() *KafkaTopicAccess {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaTopicAccess)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaTopicConfig) DeepCopyInto(out *KafkaTopicConfig) {
+	*out = *in
+	if in.DeleteRetentionMs != nil {
+		in, out := &in.DeleteRetentionMs, &out.DeleteRetentionMs
+		*out = new(int64)
+		**out = **in
+	}
+	if in.FileDeleteDelayMs != nil {
+		in, out := &in.FileDeleteDelayMs, &out.FileDeleteDelayMs
+		*out = new(int64)
+		**out = **in
+	}
+	if in.FlushMessages != nil {
+		in, out := &in.FlushMessages, &out.FlushMessages
+		*out = new(int64)
+		**out = **in
+	}
+	if in.FlushMs != nil {
+		in, out := &in.FlushMs, &out.FlushMs
+		*out = new(int64)
+		**out = **in
+	}
+	if in.IndexIntervalBytes != nil {
+		in, out := &in.IndexIntervalBytes, &out.IndexIntervalBytes
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MaxCompactionLagMs != nil {
+		in, out := &in.MaxCompactionLagMs, &out.MaxCompactionLagMs
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MaxMessageBytes != nil {
+		in, out := &in.MaxMessageBytes, &out.MaxMessageBytes
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MessageDownconversionEnable != nil {
+		in, out := &in.MessageDownconversionEnable, &out.MessageDownconversionEnable
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MessageTimestampDifferenceMaxMs != nil {
+		in, out := &in.MessageTimestampDifferenceMaxMs, &out.MessageTimestampDifferenceMaxMs
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MinCleanableDirtyRatio != nil {
+		in, out := &in.MinCleanableDirtyRatio, &out.MinCleanableDirtyRatio
+		*out = new(float64)
+		**out = **in
+	}
+	if in.MinCompactionLagMs != nil {
+		in, out := &in.MinCompactionLagMs, &out.MinCompactionLagMs
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MinInsyncReplicas != nil {
+		in, out := &in.MinInsyncReplicas, &out.MinInsyncReplicas
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Preallocate != nil {
+		in, out := &in.Preallocate, &out.Preallocate
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RetentionBytes != nil {
+		in, out := &in.RetentionBytes, &out.RetentionBytes
+		*out = new(int64)
+		**out = **in
+	}
+	if in.RetentionMs != nil {
+		in, out := &in.RetentionMs, &out.RetentionMs
+		*out = new(int64)
+		**out = **in
+	}
+	if in.SegmentBytes != nil {
+		in, out := &in.SegmentBytes, &out.SegmentBytes
+		*out = new(int64)
+		**out = **in
+	}
+	if in.SegmentIndexBytes != nil {
+		in, out := &in.SegmentIndexBytes, &out.SegmentIndexBytes
+		*out = new(int64)
+		**out = **in
+	}
+	if in.SegmentJitterMs != nil {
+		in, out := &in.SegmentJitterMs, &out.SegmentJitterMs
+		*out = new(int64)
+		**out = **in
+	}
+	if in.SegmentMs != nil {
+		in, out := &in.SegmentMs, &out.SegmentMs
+		*out = new(int64)
+		**out = **in
+	}
+	if in.UncleanLeaderElectionEnable != nil {
+		in, out := &in.UncleanLeaderElectionEnable, &out.UncleanLeaderElectionEnable
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaTopicConfig.
+func (in *KafkaTopicConfig) DeepCopy() *KafkaTopicConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaTopicConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaTopicConsumerGroup) DeepCopyInto(out *KafkaTopicConsumerGroup) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaTopicConsumerGroup.
+func (in *KafkaTopicConsumerGroup) DeepCopy() *KafkaTopicConsumerGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaTopicConsumerGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaTopicList) DeepCopyInto(out *KafkaTopicList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]KafkaTopic, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaTopicList.
+func (in *KafkaTopicList) DeepCopy() *KafkaTopicList {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaTopicList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KafkaTopicList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaTopicSpec) DeepCopyInto(out *KafkaTopicSpec) {
+	*out = *in
+	if in.ServiceRef != nil {
+		in, out := &in.ServiceRef, &out.ServiceRef
+		*out = new(ServiceReference)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]KafkaTopicTag, len(*in))
+		copy(*out, *in)
+	}
+	in.Config.DeepCopyInto(&out.Config)
+	out.AuthSecretRef = in.AuthSecretRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaTopicSpec.
+func (in *KafkaTopicSpec) DeepCopy() *KafkaTopicSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaTopicSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaTopicStatus) DeepCopyInto(out *KafkaTopicStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ConsumerGroups != nil {
+		in, out := &in.ConsumerGroups, &out.ConsumerGroups
+		*out = make([]KafkaTopicConsumerGroup, len(*in))
+		copy(*out, *in)
+	}
+	if in.InheritedConfig != nil {
+		in, out := &in.InheritedConfig, &out.InheritedConfig
+		*out = new(KafkaTopicConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaTopicStatus.
+func (in *KafkaTopicStatus) DeepCopy() *KafkaTopicStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaTopicStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaTopicTag) DeepCopyInto(out *KafkaTopicTag) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaTopicTag.
+func (in *KafkaTopicTag) DeepCopy() *KafkaTopicTag {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaTopicTag)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *M3Aggregator) DeepCopyInto(out *M3Aggregator) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new M3Aggregator.
+func (in *M3Aggregator) DeepCopy() *M3Aggregator {
+	if in == nil {
+		return nil
+	}
+	out := new(M3Aggregator)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *M3Aggregator) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *M3AggregatorList) DeepCopyInto(out *M3AggregatorList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]M3Aggregator, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new M3AggregatorList.
+func (in *M3AggregatorList) DeepCopy() *M3AggregatorList {
+	if in == nil {
+		return nil
+	}
+	out := new(M3AggregatorList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *M3AggregatorList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *M3AggregatorSpec) DeepCopyInto(out *M3AggregatorSpec) {
+	*out = *in
+	in.ServiceCommonSpec.DeepCopyInto(&out.ServiceCommonSpec)
+	out.AuthSecretRef = in.AuthSecretRef
+	out.ConnInfoSecretTarget = in.ConnInfoSecretTarget
+	if in.UserConfig != nil {
+		in, out := &in.UserConfig, &out.UserConfig
+		*out = new(m3aggregator.M3aggregatorUserConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new M3AggregatorSpec.
+func (in *M3AggregatorSpec) DeepCopy() *M3AggregatorSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(M3AggregatorSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *M3DB) DeepCopyInto(out *M3DB) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new M3DB.
+func (in *M3DB) DeepCopy() *M3DB {
+	if in == nil {
+		return nil
+	}
+	out := new(M3DB)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *M3DB) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *M3DBList) DeepCopyInto(out *M3DBList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]M3DB, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new M3DBList.
+func (in *M3DBList) DeepCopy() *M3DBList {
+	if in == nil {
+		return nil
+	}
+	out := new(M3DBList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *M3DBList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *M3DBSpec) DeepCopyInto(out *M3DBSpec) {
+	*out = *in
+	in.ServiceCommonSpec.DeepCopyInto(&out.ServiceCommonSpec)
+	out.AuthSecretRef = in.AuthSecretRef
+	out.ConnInfoSecretTarget = in.ConnInfoSecretTarget
+	if in.UserConfig != nil {
+		in, out := &in.UserConfig, &out.UserConfig
+		*out = new(m3db.M3dbUserConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new M3DBSpec.
+func (in *M3DBSpec) DeepCopy() *M3DBSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(M3DBSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceFreeze) DeepCopyInto(out *MaintenanceFreeze) {
+	*out = *in
+	in.Start.DeepCopyInto(&out.Start)
+	in.End.DeepCopyInto(&out.End)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceFreeze.
+func (in *MaintenanceFreeze) DeepCopy() *MaintenanceFreeze {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceFreeze)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MySQL) DeepCopyInto(out *MySQL) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQL.
+func (in *MySQL) DeepCopy() *MySQL {
+	if in == nil {
+		return nil
+	}
+	out := new(MySQL)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MySQL) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MySQLGrant) DeepCopyInto(out *MySQLGrant) {
+	*out = *in
+	if in.Privileges != nil {
+		in, out := &in.Privileges, &out.Privileges
+		*out = make([]MySQLPrivilege, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLGrant.
+func (in *MySQLGrant) DeepCopy() *MySQLGrant {
+	if in == nil {
+		return nil
+	}
+	out := new(MySQLGrant)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MySQLList) DeepCopyInto(out *MySQLList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MySQL, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLList.
+func (in *MySQLList) DeepCopy() *MySQLList {
+	if in == nil {
+		return nil
+	}
+	out := new(MySQLList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MySQLList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MySQLSpec) DeepCopyInto(out *MySQLSpec) {
+	*out = *in
+	in.ServiceCommonSpec.DeepCopyInto(&out.ServiceCommonSpec)
+	out.AuthSecretRef = in.AuthSecretRef
+	out.ConnInfoSecretTarget = in.ConnInfoSecretTarget
+	if in.UserConfig != nil {
+		in, out := &in.UserConfig, &out.UserConfig
+		*out = new(mysql.MysqlUserConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLSpec.
+func (in *MySQLSpec) DeepCopy() *MySQLSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MySQLSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenSearch) DeepCopyInto(out *OpenSearch) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenSearch.
+func (in *OpenSearch) DeepCopy() *OpenSearch {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenSearch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OpenSearch) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenSearchACL) DeepCopyInto(out *OpenSearchACL) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenSearchACL.
+func (in *OpenSearchACL) DeepCopy() *OpenSearchACL {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenSearchACL)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OpenSearchACL) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenSearchACLList) DeepCopyInto(out *OpenSearchACLList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OpenSearchACL, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenSearchACLList.
+func (in *OpenSearchACLList) DeepCopy() *OpenSearchACLList {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenSearchACLList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OpenSearchACLList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenSearchACLRule) DeepCopyInto(out *OpenSearchACLRule) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenSearchACLRule.
+func (in *OpenSearchACLRule) DeepCopy() *OpenSearchACLRule {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenSearchACLRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenSearchACLSpec) DeepCopyInto(out *OpenSearchACLSpec) {
+	*out = *in
+	if in.ACLs != nil {
+		in, out := &in.ACLs, &out.ACLs
+		*out = make([]OpenSearchUserACL, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	out.AuthSecretRef = in.AuthSecretRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenSearchACLSpec.
+func (in *OpenSearchACLSpec) DeepCopy() *OpenSearchACLSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenSearchACLSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenSearchACLStatus) DeepCopyInto(out *OpenSearchACLStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenSearchACLStatus.
+func (in *OpenSearchACLStatus) DeepCopy() *OpenSearchACLStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenSearchACLStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenSearchGCSRepositorySettings) DeepCopyInto(out *OpenSearchGCSRepositorySettings) {
+	*out = *in
+	out.CredentialsRef = in.CredentialsRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenSearchGCSRepositorySettings.
+func (in *OpenSearchGCSRepositorySettings) DeepCopy() *OpenSearchGCSRepositorySettings {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenSearchGCSRepositorySettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenSearchList) DeepCopyInto(out *OpenSearchList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OpenSearch, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenSearchList.
+func (in *OpenSearchList) DeepCopy() *OpenSearchList {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenSearchList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OpenSearchList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenSearchS3RepositorySettings) DeepCopyInto(out *OpenSearchS3RepositorySettings) {
+	*out = *in
+	out.AccessKeyRef = in.AccessKeyRef
+	out.SecretKeyRef = in.SecretKeyRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenSearchS3RepositorySettings.
+func (in *OpenSearchS3RepositorySettings) DeepCopy() *OpenSearchS3RepositorySettings {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenSearchS3RepositorySettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenSearchSnapshotRepository) DeepCopyInto(out *OpenSearchSnapshotRepository) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenSearchSnapshotRepository.
+func (in *OpenSearchSnapshotRepository) DeepCopy() *OpenSearchSnapshotRepository {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenSearchSnapshotRepository)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OpenSearchSnapshotRepository) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenSearchSnapshotRepositoryList) DeepCopyInto(out *OpenSearchSnapshotRepositoryList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OpenSearchSnapshotRepository, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenSearchSnapshotRepositoryList.
+func (in *OpenSearchSnapshotRepositoryList) DeepCopy() *OpenSearchSnapshotRepositoryList {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenSearchSnapshotRepositoryList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OpenSearchSnapshotRepositoryList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenSearchSnapshotRepositorySpec) DeepCopyInto(out *OpenSearchSnapshotRepositorySpec) {
+	*out = *in
+	if in.S3 != nil {
+		in, out := &in.S3, &out.S3
+		*out = new(OpenSearchS3RepositorySettings)
+		**out = **in
+	}
+	if in.GCS != nil {
+		in, out := &in.GCS, &out.GCS
+		*out = new(OpenSearchGCSRepositorySettings)
+		**out = **in
+	}
+	out.AuthSecretRef = in.AuthSecretRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenSearchSnapshotRepositorySpec.
+func (in *OpenSearchSnapshotRepositorySpec) DeepCopy() *OpenSearchSnapshotRepositorySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenSearchSnapshotRepositorySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenSearchSnapshotRepositoryStatus) DeepCopyInto(out *OpenSearchSnapshotRepositoryStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenSearchSnapshotRepositoryStatus.
+func (in *OpenSearchSnapshotRepositoryStatus) DeepCopy() *OpenSearchSnapshotRepositoryStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenSearchSnapshotRepositoryStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenSearchSnapshotRestore) DeepCopyInto(out *OpenSearchSnapshotRestore) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenSearchSnapshotRestore.
+func (in *OpenSearchSnapshotRestore) DeepCopy() *OpenSearchSnapshotRestore {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenSearchSnapshotRestore)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OpenSearchSnapshotRestore) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenSearchSnapshotRestoreList) DeepCopyInto(out *OpenSearchSnapshotRestoreList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OpenSearchSnapshotRestore, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenSearchSnapshotRestoreList.
+func (in *OpenSearchSnapshotRestoreList) DeepCopy() *OpenSearchSnapshotRestoreList {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenSearchSnapshotRestoreList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OpenSearchSnapshotRestoreList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenSearchSnapshotRestoreSpec) DeepCopyInto(out *OpenSearchSnapshotRestoreSpec) {
+	*out = *in
+	if in.Indices != nil {
+		in, out := &in.Indices, &out.Indices
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	out.AuthSecretRef = in.AuthSecretRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenSearchSnapshotRestoreSpec.
+func (in *OpenSearchSnapshotRestoreSpec) DeepCopy() *OpenSearchSnapshotRestoreSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenSearchSnapshotRestoreSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenSearchSnapshotRestoreStatus) DeepCopyInto(out *OpenSearchSnapshotRestoreStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Steps != nil {
+		in, out := &in.Steps, &out.Steps
+		*out = make([]OpenSearchSnapshotRestoreStep, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Indices != nil {
+		in, out := &in.Indices, &out.Indices
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenSearchSnapshotRestoreStatus.
+func (in *OpenSearchSnapshotRestoreStatus) DeepCopy() *OpenSearchSnapshotRestoreStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenSearchSnapshotRestoreStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenSearchSnapshotRestoreStep) DeepCopyInto(out *OpenSearchSnapshotRestoreStep) {
+	*out = *in
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenSearchSnapshotRestoreStep.
+func (in *OpenSearchSnapshotRestoreStep) DeepCopy() *OpenSearchSnapshotRestoreStep {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenSearchSnapshotRestoreStep)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenSearchSpec) DeepCopyInto(out *OpenSearchSpec) {
+	*out = *in
+	in.ServiceCommonSpec.DeepCopyInto(&out.ServiceCommonSpec)
+	out.AuthSecretRef = in.AuthSecretRef
+	out.ConnInfoSecretTarget = in.ConnInfoSecretTarget
+	if in.UserConfig != nil {
+		in, out := &in.UserConfig, &out.UserConfig
+		*out = new(opensearch.OpensearchUserConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenSearchSpec.
+func (in *OpenSearchSpec) DeepCopy() *OpenSearchSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenSearchSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenSearchUserACL) DeepCopyInto(out *OpenSearchUserACL) {
+	*out = *in
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]OpenSearchACLRule, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenSearchUserACL.
+func (in *OpenSearchUserACL) DeepCopy() *OpenSearchUserACL {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenSearchUserACL)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Operation) DeepCopyInto(out *Operation) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Operation.
+func (in *Operation) DeepCopy() *Operation {
+	if in == nil {
+		return nil
+	}
+	out := new(Operation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Operation) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperationList) DeepCopyInto(out *OperationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Operation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperationList.
+func (in *OperationList) DeepCopy() *OperationList {
+	if in == nil {
+		return nil
+	}
+	out := new(OperationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OperationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperationSpec) DeepCopyInto(out *OperationSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperationSpec.
+func (in *OperationSpec) DeepCopy() *OperationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OperationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperationStatus) DeepCopyInto(out *OperationStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperationStatus.
+func (in *OperationStatus) DeepCopy() *OperationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OperationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperatorConfig) DeepCopyInto(out *OperatorConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperatorConfig.
+func (in *OperatorConfig) DeepCopy() *OperatorConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(OperatorConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OperatorConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperatorConfigList) DeepCopyInto(out *OperatorConfigList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OperatorConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperatorConfigList.
+func (in *OperatorConfigList) DeepCopy() *OperatorConfigList {
+	if in == nil {
+		return nil
+	}
+	out := new(OperatorConfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OperatorConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperatorConfigSpec) DeepCopyInto(out *OperatorConfigSpec) {
+	*out = *in
+	if in.ResyncInterval != nil {
+		in, out := &in.ResyncInterval, &out.ResyncInterval
+		*out = (*in).DeepCopy()
+	}
+	if in.AivenAPIRateLimit != nil {
+		in, out := &in.AivenAPIRateLimit, &out.AivenAPIRateLimit
+		*out = new(int)
+		**out = **in
+	}
+	if in.DefaultTags != nil {
+		in, out := &in.DefaultTags, &out.DefaultTags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.FeatureGates != nil {
+		in, out := &in.FeatureGates, &out.FeatureGates
+		*out = make(map[string]bool, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperatorConfigSpec.
+func (in *OperatorConfigSpec) DeepCopy() *OperatorConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OperatorConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperatorConfigStatus) DeepCopyInto(out *OperatorConfigStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperatorConfigStatus.
+func (in *OperatorConfigStatus) DeepCopy() *OperatorConfigStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OperatorConfigStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationPermission) DeepCopyInto(out *OrganizationPermission) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationPermission.
+func (in *OrganizationPermission) DeepCopy() *OrganizationPermission {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationPermission)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OrganizationPermission) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationPermissionEntry) DeepCopyInto(out *OrganizationPermissionEntry) {
+	*out = *in
+	if in.Permissions != nil {
+		in, out := &in.Permissions, &out.Permissions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationPermissionEntry.
+func (in *OrganizationPermissionEntry) DeepCopy() *OrganizationPermissionEntry {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationPermissionEntry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationPermissionList) DeepCopyInto(out *OrganizationPermissionList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OrganizationPermission, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationPermissionList.
+func (in *OrganizationPermissionList) DeepCopy() *OrganizationPermissionList {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationPermissionList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OrganizationPermissionList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationPermissionSpec) DeepCopyInto(out *OrganizationPermissionSpec) {
+	*out = *in
+	if in.Permissions != nil {
+		in, out := &in.Permissions, &out.Permissions
+		*out = make([]OrganizationPermissionEntry, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	out.AuthSecretRef = in.AuthSecretRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationPermissionSpec.
+func (in *OrganizationPermissionSpec) DeepCopy() *OrganizationPermissionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationPermissionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationPermissionStatus) DeepCopyInto(out *OrganizationPermissionStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Drift != nil {
+		in, out := &in.Drift, &out.Drift
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationPermissionStatus.
+func (in *OrganizationPermissionStatus) DeepCopy() *OrganizationPermissionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationPermissionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationVPC) DeepCopyInto(out *OrganizationVPC) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationVPC.
+func (in *OrganizationVPC) DeepCopy() *OrganizationVPC {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationVPC)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OrganizationVPC) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationVPCList) DeepCopyInto(out *OrganizationVPCList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OrganizationVPC, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationVPCList.
+func (in *OrganizationVPCList) DeepCopy() *OrganizationVPCList {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationVPCList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OrganizationVPCList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationVPCPeeringConnectionSpec) DeepCopyInto(out *OrganizationVPCPeeringConnectionSpec) {
+	*out = *in
+	if in.UserPeerNetworkCIDRs != nil {
+		in, out := &in.UserPeerNetworkCIDRs, &out.UserPeerNetworkCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationVPCPeeringConnectionSpec.
+func (in *OrganizationVPCPeeringConnectionSpec) DeepCopy() *OrganizationVPCPeeringConnectionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationVPCPeeringConnectionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationVPCSpec) DeepCopyInto(out *OrganizationVPCSpec) {
+	*out = *in
+	if in.PeeringConnections != nil {
+		in, out := &in.PeeringConnections, &out.PeeringConnections
+		*out = make([]OrganizationVPCPeeringConnectionSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	out.AuthSecretRef = in.AuthSecretRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationVPCSpec.
+func (in *OrganizationVPCSpec) DeepCopy() *OrganizationVPCSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationVPCSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationVPCStatus) DeepCopyInto(out *OrganizationVPCStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PeeringConnections != nil {
+		in, out := &in.PeeringConnections, &out.PeeringConnections
+		*out = make([]ProjectVPCPeeringConnection, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationVPCStatus.
+func (in *OrganizationVPCStatus) DeepCopy() *OrganizationVPCStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationVPCStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PostgreSQL) DeepCopyInto(out *PostgreSQL) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgreSQL.
+func (in *PostgreSQL) DeepCopy() *PostgreSQL {
+	if in == nil {
+		return nil
+	}
+	out := new(PostgreSQL)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PostgreSQL) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PostgreSQLLimits) DeepCopyInto(out *PostgreSQLLimits) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgreSQLLimits.
+func (in *PostgreSQLLimits) DeepCopy() *PostgreSQLLimits {
+	if in == nil {
+		return nil
+	}
+	out := new(PostgreSQLLimits)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PostgreSQLList) DeepCopyInto(out *PostgreSQLList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PostgreSQL, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgreSQLList.
+func (in *PostgreSQLList) DeepCopy() *PostgreSQLList {
+	if in == nil {
+		return nil
+	}
+	out := new(PostgreSQLList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PostgreSQLList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PostgreSQLSpec) DeepCopyInto(out *PostgreSQLSpec) {
+	*out = *in
+	in.ServiceCommonSpec.DeepCopyInto(&out.ServiceCommonSpec)
+	out.AuthSecretRef = in.AuthSecretRef
+	out.ConnInfoSecretTarget = in.ConnInfoSecretTarget
+	if in.UserConfig != nil {
+		in, out := &in.UserConfig, &out.UserConfig
+		*out = new(pg.PgUserConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgreSQLSpec.
+func (in *PostgreSQLSpec) DeepCopy() *PostgreSQLSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PostgreSQLSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PostgreSQLStatus) DeepCopyInto(out *PostgreSQLStatus) {
+	*out = *in
+	in.ServiceStatus.DeepCopyInto(&out.ServiceStatus)
+	if in.Limits != nil {
+		in, out := &in.Limits, &out.Limits
+		*out = new(PostgreSQLLimits)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgreSQLStatus.
+func (in *PostgreSQLStatus) DeepCopy() *PostgreSQLStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PostgreSQLStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Project) DeepCopyInto(out *Project) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Project.
+func (in *Project) DeepCopy() *Project {
+	if in == nil {
+		return nil
+	}
+	out := new(Project)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Project) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectAggregateStatus) DeepCopyInto(out *ProjectAggregateStatus) {
+	*out = *in
+	if in.PendingMaintenance != nil {
+		in, out := &in.PendingMaintenance, &out.PendingMaintenance
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectAggregateStatus.
+func (in *ProjectAggregateStatus) DeepCopy() *ProjectAggregateStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectAggregateStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectList) DeepCopyInto(out *ProjectList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Project, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectList.
+func (in *ProjectList) DeepCopy() *ProjectList {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProjectList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectSpec) DeepCopyInto(out *ProjectSpec) {
+	*out = *in
+	if in.BillingEmails != nil {
+		in, out := &in.BillingEmails, &out.BillingEmails
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TechnicalEmails != nil {
+		in, out := &in.TechnicalEmails, &out.TechnicalEmails
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	out.ConnInfoSecretTarget = in.ConnInfoSecretTarget
+	if in.CACertConfigMapTarget != nil {
+		in, out := &in.CACertConfigMapTarget, &out.CACertConfigMapTarget
+		*out = new(ConfigMapTarget)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	out.AuthSecretRef = in.AuthSecretRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectSpec.
+func (in *ProjectSpec) DeepCopy() *ProjectSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectStatus) DeepCopyInto(out *ProjectStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Aggregate != nil {
+		in, out := &in.Aggregate, &out.Aggregate
+		*out = new(ProjectAggregateStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectStatus.
+func (in *ProjectStatus) DeepCopy() *ProjectStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectVPC) DeepCopyInto(out *ProjectVPC) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectVPC.
+func (in *ProjectVPC) DeepCopy() *ProjectVPC {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectVPC)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProjectVPC) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectVPCList) DeepCopyInto(out *ProjectVPCList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ProjectVPC, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectVPCList.
+func (in *ProjectVPCList) DeepCopy() *ProjectVPCList {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectVPCList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProjectVPCList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectVPCPeeringConnection) DeepCopyInto(out *ProjectVPCPeeringConnection) {
+	*out = *in
+	if in.UserPeerNetworkCIDRs != nil {
+		in, out := &in.UserPeerNetworkCIDRs, &out.UserPeerNetworkCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectVPCPeeringConnection.
+func (in *ProjectVPCPeeringConnection) DeepCopy() *ProjectVPCPeeringConnection {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectVPCPeeringConnection)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectVPCSpec) DeepCopyInto(out *ProjectVPCSpec) {
+	*out = *in
+	out.AuthSecretRef = in.AuthSecretRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectVPCSpec.
+func (in *ProjectVPCSpec) DeepCopy() *ProjectVPCSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectVPCSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectVPCStatus) DeepCopyInto(out *ProjectVPCStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PeeringConnections != nil {
+		in, out := &in.PeeringConnections, &out.PeeringConnections
+		*out = make([]ProjectVPCPeeringConnection, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectVPCStatus.
+func (in *ProjectVPCStatus) DeepCopy() *ProjectVPCStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectVPCStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Redis) DeepCopyInto(out *Redis) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Redis.
+func (in *Redis) DeepCopy() *Redis {
+	if in == nil {
+		return nil
+	}
+	out := new(Redis)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Redis) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RedisList) DeepCopyInto(out *RedisList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Redis, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisList.
+func (in *RedisList) DeepCopy() *RedisList {
+	if in == nil {
+		return nil
+	}
+	out := new(RedisList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RedisList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RedisSpec) DeepCopyInto(out *RedisSpec) {
+	*out = *in
+	in.ServiceCommonSpec.DeepCopyInto(&out.ServiceCommonSpec)
+	out.AuthSecretRef = in.AuthSecretRef
+	out.ConnInfoSecretTarget = in.ConnInfoSecretTarget
+	if in.UserConfig != nil {
+		in, out := &in.UserConfig, &out.UserConfig
+		*out = new(redis.RedisUserConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisSpec.
+func (in *RedisSpec) DeepCopy() *RedisSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RedisSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReferenceGrant) DeepCopyInto(out *ReferenceGrant) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReferenceGrant.
+func (in *ReferenceGrant) DeepCopy() *ReferenceGrant {
+	if in == nil {
+		return nil
+	}
+	out := new(ReferenceGrant)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ReferenceGrant) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaTopicConfig.
-func (in *KafkaTopicConfig) DeepCopy() *KafkaTopicConfig {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReferenceGrantFrom) DeepCopyInto(out *ReferenceGrantFrom) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReferenceGrantFrom.
+func (in *ReferenceGrantFrom) DeepCopy() *ReferenceGrantFrom {
 	if in == nil {
 		return nil
 	}
-	out := new(KafkaTopicConfig)
+	out := new(ReferenceGrantFrom)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KafkaTopicList) DeepCopyInto(out *KafkaTopicList) {
+func (in *ReferenceGrantList) DeepCopyInto(out *ReferenceGrantList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]KafkaTopic, len(*in))
+		*out = make([]ReferenceGrant, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaTopicList.
-func (in *KafkaTopicList) DeepCopy() *KafkaTopicList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReferenceGrantList.
+func (in *ReferenceGrantList) DeepCopy() *ReferenceGrantList {
 	if in == nil {
 		return nil
 	}
-	out := new(KafkaTopicList)
+	out := new(ReferenceGrantList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *KafkaTopicList) DeepCopyObject() runtime.Object {
+func (in *ReferenceGrantList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1263,281 +4615,273 @@ func (in *KafkaTopicList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KafkaTopicSpec) DeepCopyInto(out *KafkaTopicSpec) {
+func (in *ReferenceGrantSpec) DeepCopyInto(out *ReferenceGrantSpec) {
 	*out = *in
-	if in.Tags != nil {
-		in, out := &in.Tags, &out.Tags
-		*out = make([]KafkaTopicTag, len(*in))
+	if in.From != nil {
+		in, out := &in.From, &out.From
+		*out = make([]ReferenceGrantFrom, len(*in))
+		copy(*out, *in)
+	}
+	if in.To != nil {
+		in, out := &in.To, &out.To
+		*out = make([]ReferenceGrantTo, len(*in))
 		copy(*out, *in)
 	}
-	in.Config.DeepCopyInto(&out.Config)
-	out.AuthSecretRef = in.AuthSecretRef
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaTopicSpec.
-func (in *KafkaTopicSpec) DeepCopy() *KafkaTopicSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReferenceGrantSpec.
+func (in *ReferenceGrantSpec) DeepCopy() *ReferenceGrantSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(KafkaTopicSpec)
+	out := new(ReferenceGrantSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KafkaTopicStatus) DeepCopyInto(out *KafkaTopicStatus) {
+func (in *ReferenceGrantTo) DeepCopyInto(out *ReferenceGrantTo) {
 	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]v1.Condition, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaTopicStatus.
-func (in *KafkaTopicStatus) DeepCopy() *KafkaTopicStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReferenceGrantTo.
+func (in *ReferenceGrantTo) DeepCopy() *ReferenceGrantTo {
 	if in == nil {
 		return nil
 	}
-	out := new(KafkaTopicStatus)
+	out := new(ReferenceGrantTo)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KafkaTopicTag) DeepCopyInto(out *KafkaTopicTag) {
+func (in *ResourceReference) DeepCopyInto(out *ResourceReference) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaTopicTag.
-func (in *KafkaTopicTag) DeepCopy() *KafkaTopicTag {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceReference.
+func (in *ResourceReference) DeepCopy() *ResourceReference {
 	if in == nil {
 		return nil
 	}
-	out := new(KafkaTopicTag)
+	out := new(ResourceReference)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MySQL) DeepCopyInto(out *MySQL) {
+func (in *ResourceReferenceObject) DeepCopyInto(out *ResourceReferenceObject) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	out.GroupVersionKind = in.GroupVersionKind
+	out.NamespacedName = in.NamespacedName
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQL.
-func (in *MySQL) DeepCopy() *MySQL {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceReferenceObject.
+func (in *ResourceReferenceObject) DeepCopy() *ResourceReferenceObject {
 	if in == nil {
 		return nil
 	}
-	out := new(MySQL)
+	out := new(ResourceReferenceObject)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *MySQL) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretKeyReference) DeepCopyInto(out *SecretKeyReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretKeyReference.
+func (in *SecretKeyReference) DeepCopy() *SecretKeyReference {
+	if in == nil {
+		return nil
 	}
-	return nil
+	out := new(SecretKeyReference)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MySQLList) DeepCopyInto(out *MySQLList) {
+func (in *ServiceCommonSpec) DeepCopyInto(out *ServiceCommonSpec) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]MySQL, len(*in))
+	if in.CloudFallbacks != nil {
+		in, out := &in.CloudFallbacks, &out.CloudFallbacks
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ProjectVPCRef != nil {
+		in, out := &in.ProjectVPCRef, &out.ProjectVPCRef
+		*out = new(ResourceReference)
+		**out = **in
+	}
+	if in.OrganizationVPCRef != nil {
+		in, out := &in.OrganizationVPCRef, &out.OrganizationVPCRef
+		*out = new(ResourceReference)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Owner != nil {
+		in, out := &in.Owner, &out.Owner
+		*out = new(ServiceOwner)
+		**out = **in
+	}
+	if in.ServiceIntegrations != nil {
+		in, out := &in.ServiceIntegrations, &out.ServiceIntegrations
+		*out = make([]*ServiceIntegrationItem, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(ServiceIntegrationItem)
+				**out = **in
+			}
+		}
+	}
+	if in.MaintenanceFreeze != nil {
+		in, out := &in.MaintenanceFreeze, &out.MaintenanceFreeze
+		*out = make([]MaintenanceFreeze, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLList.
-func (in *MySQLList) DeepCopy() *MySQLList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceCommonSpec.
+func (in *ServiceCommonSpec) DeepCopy() *ServiceCommonSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(MySQLList)
+	out := new(ServiceCommonSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *MySQLList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MySQLSpec) DeepCopyInto(out *MySQLSpec) {
+func (in *ServiceConnectionInfo) DeepCopyInto(out *ServiceConnectionInfo) {
 	*out = *in
-	in.ServiceCommonSpec.DeepCopyInto(&out.ServiceCommonSpec)
-	out.AuthSecretRef = in.AuthSecretRef
-	out.ConnInfoSecretTarget = in.ConnInfoSecretTarget
-	if in.UserConfig != nil {
-		in, out := &in.UserConfig, &out.UserConfig
-		*out = new(mysql.MysqlUserConfig)
-		(*in).DeepCopyInto(*out)
+	if in.Endpoints != nil {
+		in, out := &in.Endpoints, &out.Endpoints
+		*out = make([]ServiceEndpoint, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLSpec.
-func (in *MySQLSpec) DeepCopy() *MySQLSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceConnectionInfo.
+func (in *ServiceConnectionInfo) DeepCopy() *ServiceConnectionInfo {
 	if in == nil {
 		return nil
 	}
-	out := new(MySQLSpec)
+	out := new(ServiceConnectionInfo)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OpenSearch) DeepCopyInto(out *OpenSearch) {
+func (in *ServiceCustomCloud) DeepCopyInto(out *ServiceCustomCloud) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenSearch.
-func (in *OpenSearch) DeepCopy() *OpenSearch {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceCustomCloud.
+func (in *ServiceCustomCloud) DeepCopy() *ServiceCustomCloud {
 	if in == nil {
 		return nil
 	}
-	out := new(OpenSearch)
+	out := new(ServiceCustomCloud)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *OpenSearch) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OpenSearchList) DeepCopyInto(out *OpenSearchList) {
+func (in *ServiceDiff) DeepCopyInto(out *ServiceDiff) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]OpenSearch, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.Fields != nil {
+		in, out := &in.Fields, &out.Fields
+		*out = make([]ServiceFieldDiff, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenSearchList.
-func (in *OpenSearchList) DeepCopy() *OpenSearchList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceDiff.
+func (in *ServiceDiff) DeepCopy() *ServiceDiff {
 	if in == nil {
 		return nil
 	}
-	out := new(OpenSearchList)
+	out := new(ServiceDiff)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *OpenSearchList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OpenSearchSpec) DeepCopyInto(out *OpenSearchSpec) {
+func (in *ServiceDiskSpace) DeepCopyInto(out *ServiceDiskSpace) {
 	*out = *in
-	in.ServiceCommonSpec.DeepCopyInto(&out.ServiceCommonSpec)
-	out.AuthSecretRef = in.AuthSecretRef
-	out.ConnInfoSecretTarget = in.ConnInfoSecretTarget
-	if in.UserConfig != nil {
-		in, out := &in.UserConfig, &out.UserConfig
-		*out = new(opensearch.OpensearchUserConfig)
-		(*in).DeepCopyInto(*out)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenSearchSpec.
-func (in *OpenSearchSpec) DeepCopy() *OpenSearchSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceDiskSpace.
+func (in *ServiceDiskSpace) DeepCopy() *ServiceDiskSpace {
 	if in == nil {
 		return nil
 	}
-	out := new(OpenSearchSpec)
+	out := new(ServiceDiskSpace)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PostgreSQL) DeepCopyInto(out *PostgreSQL) {
+func (in *ServiceEndpoint) DeepCopyInto(out *ServiceEndpoint) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgreSQL.
-func (in *PostgreSQL) DeepCopy() *PostgreSQL {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceEndpoint.
+func (in *ServiceEndpoint) DeepCopy() *ServiceEndpoint {
 	if in == nil {
 		return nil
 	}
-	out := new(PostgreSQL)
+	out := new(ServiceEndpoint)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *PostgreSQL) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceFieldDiff) DeepCopyInto(out *ServiceFieldDiff) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceFieldDiff.
+func (in *ServiceFieldDiff) DeepCopy() *ServiceFieldDiff {
+	if in == nil {
+		return nil
 	}
-	return nil
+	out := new(ServiceFieldDiff)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PostgreSQLList) DeepCopyInto(out *PostgreSQLList) {
+func (in *ServiceIntegration) DeepCopyInto(out *ServiceIntegration) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]PostgreSQL, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgreSQLList.
-func (in *PostgreSQLList) DeepCopy() *PostgreSQLList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceIntegration.
+func (in *ServiceIntegration) DeepCopy() *ServiceIntegration {
 	if in == nil {
 		return nil
 	}
-	out := new(PostgreSQLList)
+	out := new(ServiceIntegration)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *PostgreSQLList) DeepCopyObject() runtime.Object {
+func (in *ServiceIntegration) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1545,30 +4889,47 @@ func (in *PostgreSQLList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PostgreSQLSpec) DeepCopyInto(out *PostgreSQLSpec) {
+func (in *ServiceIntegrationDatadogUserConfig) DeepCopyInto(out *ServiceIntegrationDatadogUserConfig) {
 	*out = *in
-	in.ServiceCommonSpec.DeepCopyInto(&out.ServiceCommonSpec)
-	out.AuthSecretRef = in.AuthSecretRef
-	out.ConnInfoSecretTarget = in.ConnInfoSecretTarget
-	if in.UserConfig != nil {
-		in, out := &in.UserConfig, &out.UserConfig
-		*out = new(pg.PgUserConfig)
-		(*in).DeepCopyInto(*out)
+	if in.ExcludeConsumerGroups != nil {
+		in, out := &in.ExcludeConsumerGroups, &out.ExcludeConsumerGroups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExcludeTopics != nil {
+		in, out := &in.ExcludeTopics, &out.ExcludeTopics
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.IncludeConsumerGroups != nil {
+		in, out := &in.IncludeConsumerGroups, &out.IncludeConsumerGroups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.IncludeTopics != nil {
+		in, out := &in.IncludeTopics, &out.IncludeTopics
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.KafkaCustomMetrics != nil {
+		in, out := &in.KafkaCustomMetrics, &out.KafkaCustomMetrics
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgreSQLSpec.
-func (in *PostgreSQLSpec) DeepCopy() *PostgreSQLSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceIntegrationDatadogUserConfig.
+func (in *ServiceIntegrationDatadogUserConfig) DeepCopy() *ServiceIntegrationDatadogUserConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(PostgreSQLSpec)
+	out := new(ServiceIntegrationDatadogUserConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Project) DeepCopyInto(out *Project) {
+func (in *ServiceIntegrationEndpoint) DeepCopyInto(out *ServiceIntegrationEndpoint) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -1576,18 +4937,18 @@ func (in *Project) DeepCopyInto(out *Project) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Project.
-func (in *Project) DeepCopy() *Project {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceIntegrationEndpoint.
+func (in *ServiceIntegrationEndpoint) DeepCopy() *ServiceIntegrationEndpoint {
 	if in == nil {
 		return nil
 	}
-	out := new(Project)
+	out := new(ServiceIntegrationEndpoint)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Project) DeepCopyObject() runtime.Object {
+func (in *ServiceIntegrationEndpoint) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1595,31 +4956,31 @@ func (in *Project) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProjectList) DeepCopyInto(out *ProjectList) {
+func (in *ServiceIntegrationEndpointList) DeepCopyInto(out *ServiceIntegrationEndpointList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Project, len(*in))
+		*out = make([]ServiceIntegrationEndpoint, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectList.
-func (in *ProjectList) DeepCopy() *ProjectList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceIntegrationEndpointList.
+func (in *ServiceIntegrationEndpointList) DeepCopy() *ServiceIntegrationEndpointList {
 	if in == nil {
 		return nil
 	}
-	out := new(ProjectList)
+	out := new(ServiceIntegrationEndpointList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ProjectList) DeepCopyObject() runtime.Object {
+func (in *ServiceIntegrationEndpointList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1627,41 +4988,32 @@ func (in *ProjectList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProjectSpec) DeepCopyInto(out *ProjectSpec) {
+func (in *ServiceIntegrationEndpointSpec) DeepCopyInto(out *ServiceIntegrationEndpointSpec) {
 	*out = *in
-	if in.BillingEmails != nil {
-		in, out := &in.BillingEmails, &out.BillingEmails
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	if in.UserConfig != nil {
+		in, out := &in.UserConfig, &out.UserConfig
+		*out = (*in).DeepCopy()
 	}
-	if in.TechnicalEmails != nil {
-		in, out := &in.TechnicalEmails, &out.TechnicalEmails
-		*out = make([]string, len(*in))
+	if in.UserConfigSecrets != nil {
+		in, out := &in.UserConfigSecrets, &out.UserConfigSecrets
+		*out = make([]EndpointUserConfigSecret, len(*in))
 		copy(*out, *in)
 	}
-	out.ConnInfoSecretTarget = in.ConnInfoSecretTarget
-	if in.Tags != nil {
-		in, out := &in.Tags, &out.Tags
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
-	}
 	out.AuthSecretRef = in.AuthSecretRef
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectSpec.
-func (in *ProjectSpec) DeepCopy() *ProjectSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceIntegrationEndpointSpec.
+func (in *ServiceIntegrationEndpointSpec) DeepCopy() *ServiceIntegrationEndpointSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ProjectSpec)
+	out := new(ServiceIntegrationEndpointSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProjectStatus) DeepCopyInto(out *ProjectStatus) {
+func (in *ServiceIntegrationEndpointStatus) DeepCopyInto(out *ServiceIntegrationEndpointStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
@@ -1670,71 +5022,106 @@ func (in *ProjectStatus) DeepCopyInto(out *ProjectStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectStatus.
-func (in *ProjectStatus) DeepCopy() *ProjectStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceIntegrationEndpointStatus.
+func (in *ServiceIntegrationEndpointStatus) DeepCopy() *ServiceIntegrationEndpointStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ProjectStatus)
+	out := new(ServiceIntegrationEndpointStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProjectVPC) DeepCopyInto(out *ProjectVPC) {
+func (in *ServiceIntegrationItem) DeepCopyInto(out *ServiceIntegrationItem) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
-	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectVPC.
-func (in *ProjectVPC) DeepCopy() *ProjectVPC {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceIntegrationItem.
+func (in *ServiceIntegrationItem) DeepCopy() *ServiceIntegrationItem {
 	if in == nil {
 		return nil
 	}
-	out := new(ProjectVPC)
+	out := new(ServiceIntegrationItem)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ProjectVPC) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceIntegrationKafkaConnect) DeepCopyInto(out *ServiceIntegrationKafkaConnect) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceIntegrationKafkaConnect.
+func (in *ServiceIntegrationKafkaConnect) DeepCopy() *ServiceIntegrationKafkaConnect {
+	if in == nil {
+		return nil
 	}
-	return nil
+	out := new(ServiceIntegrationKafkaConnect)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProjectVPCList) DeepCopyInto(out *ProjectVPCList) {
+func (in *ServiceIntegrationKafkaConnectUserConfig) DeepCopyInto(out *ServiceIntegrationKafkaConnectUserConfig) {
+	*out = *in
+	out.KafkaConnect = in.KafkaConnect
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceIntegrationKafkaConnectUserConfig.
+func (in *ServiceIntegrationKafkaConnectUserConfig) DeepCopy() *ServiceIntegrationKafkaConnectUserConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceIntegrationKafkaConnectUserConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceIntegrationKafkaLogsUserConfig) DeepCopyInto(out *ServiceIntegrationKafkaLogsUserConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceIntegrationKafkaLogsUserConfig.
+func (in *ServiceIntegrationKafkaLogsUserConfig) DeepCopy() *ServiceIntegrationKafkaLogsUserConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceIntegrationKafkaLogsUserConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceIntegrationList) DeepCopyInto(out *ServiceIntegrationList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]ProjectVPC, len(*in))
+		*out = make([]ServiceIntegration, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectVPCList.
-func (in *ProjectVPCList) DeepCopy() *ProjectVPCList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceIntegrationList.
+func (in *ServiceIntegrationList) DeepCopy() *ServiceIntegrationList {
 	if in == nil {
 		return nil
 	}
-	out := new(ProjectVPCList)
+	out := new(ServiceIntegrationList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ProjectVPCList) DeepCopyObject() runtime.Object {
+func (in *ServiceIntegrationList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1742,197 +5129,225 @@ func (in *ProjectVPCList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProjectVPCSpec) DeepCopyInto(out *ProjectVPCSpec) {
+func (in *ServiceIntegrationLogsUserConfig) DeepCopyInto(out *ServiceIntegrationLogsUserConfig) {
 	*out = *in
-	out.AuthSecretRef = in.AuthSecretRef
+	if in.ElasticsearchIndexDaysMax != nil {
+		in, out := &in.ElasticsearchIndexDaysMax, &out.ElasticsearchIndexDaysMax
+		*out = new(int)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectVPCSpec.
-func (in *ProjectVPCSpec) DeepCopy() *ProjectVPCSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceIntegrationLogsUserConfig.
+func (in *ServiceIntegrationLogsUserConfig) DeepCopy() *ServiceIntegrationLogsUserConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(ProjectVPCSpec)
+	out := new(ServiceIntegrationLogsUserConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProjectVPCStatus) DeepCopyInto(out *ProjectVPCStatus) {
+func (in *ServiceIntegrationMetricsUserConfig) DeepCopyInto(out *ServiceIntegrationMetricsUserConfig) {
 	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]v1.Condition, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.RetentionDays != nil {
+		in, out := &in.RetentionDays, &out.RetentionDays
+		*out = new(int)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectVPCStatus.
-func (in *ProjectVPCStatus) DeepCopy() *ProjectVPCStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceIntegrationMetricsUserConfig.
+func (in *ServiceIntegrationMetricsUserConfig) DeepCopy() *ServiceIntegrationMetricsUserConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(ProjectVPCStatus)
+	out := new(ServiceIntegrationMetricsUserConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Redis) DeepCopyInto(out *Redis) {
+func (in *ServiceIntegrationSpec) DeepCopyInto(out *ServiceIntegrationSpec) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	in.DatadogUserConfig.DeepCopyInto(&out.DatadogUserConfig)
+	out.KafkaConnectUserConfig = in.KafkaConnectUserConfig
+	out.KafkaLogsUserConfig = in.KafkaLogsUserConfig
+	in.MetricsUserConfig.DeepCopyInto(&out.MetricsUserConfig)
+	in.LogsUserConfig.DeepCopyInto(&out.LogsUserConfig)
+	out.AuthSecretRef = in.AuthSecretRef
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Redis.
-func (in *Redis) DeepCopy() *Redis {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceIntegrationSpec.
+func (in *ServiceIntegrationSpec) DeepCopy() *ServiceIntegrationSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(Redis)
+	out := new(ServiceIntegrationSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Redis) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RedisList) DeepCopyInto(out *RedisList) {
+func (in *ServiceIntegrationStatus) DeepCopyInto(out *ServiceIntegrationStatus) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]Redis, len(*in))
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisList.
-func (in *RedisList) DeepCopy() *RedisList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceIntegrationStatus.
+func (in *ServiceIntegrationStatus) DeepCopy() *ServiceIntegrationStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(RedisList)
+	out := new(ServiceIntegrationStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *RedisList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceNotification) DeepCopyInto(out *ServiceNotification) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceNotification.
+func (in *ServiceNotification) DeepCopy() *ServiceNotification {
+	if in == nil {
+		return nil
 	}
-	return nil
+	out := new(ServiceNotification)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RedisSpec) DeepCopyInto(out *RedisSpec) {
+func (in *ServiceOperation) DeepCopyInto(out *ServiceOperation) {
 	*out = *in
-	in.ServiceCommonSpec.DeepCopyInto(&out.ServiceCommonSpec)
-	out.AuthSecretRef = in.AuthSecretRef
-	out.ConnInfoSecretTarget = in.ConnInfoSecretTarget
-	if in.UserConfig != nil {
-		in, out := &in.UserConfig, &out.UserConfig
-		*out = new(redis.RedisUserConfig)
-		(*in).DeepCopyInto(*out)
-	}
+	in.Time.DeepCopyInto(&out.Time)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisSpec.
-func (in *RedisSpec) DeepCopy() *RedisSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceOperation.
+func (in *ServiceOperation) DeepCopy() *ServiceOperation {
 	if in == nil {
 		return nil
 	}
-	out := new(RedisSpec)
+	out := new(ServiceOperation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ResourceReference) DeepCopyInto(out *ResourceReference) {
+func (in *ServiceOwner) DeepCopyInto(out *ServiceOwner) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceReference.
-func (in *ResourceReference) DeepCopy() *ResourceReference {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceOwner.
+func (in *ServiceOwner) DeepCopy() *ServiceOwner {
 	if in == nil {
 		return nil
 	}
-	out := new(ResourceReference)
+	out := new(ServiceOwner)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ResourceReferenceObject) DeepCopyInto(out *ResourceReferenceObject) {
+func (in *ServiceReference) DeepCopyInto(out *ServiceReference) {
 	*out = *in
-	out.GroupVersionKind = in.GroupVersionKind
-	out.NamespacedName = in.NamespacedName
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceReferenceObject.
-func (in *ResourceReferenceObject) DeepCopy() *ResourceReferenceObject {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceReference.
+func (in *ServiceReference) DeepCopy() *ServiceReference {
 	if in == nil {
 		return nil
 	}
-	out := new(ResourceReferenceObject)
+	out := new(ServiceReference)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ServiceCommonSpec) DeepCopyInto(out *ServiceCommonSpec) {
+func (in *ServiceStatus) DeepCopyInto(out *ServiceStatus) {
 	*out = *in
-	if in.ProjectVPCRef != nil {
-		in, out := &in.ProjectVPCRef, &out.ProjectVPCRef
-		*out = new(ResourceReference)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ConnectionInfo != nil {
+		in, out := &in.ConnectionInfo, &out.ConnectionInfo
+		*out = new(ServiceConnectionInfo)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MigrationProgress != nil {
+		in, out := &in.MigrationProgress, &out.MigrationProgress
+		*out = new(int)
 		**out = **in
 	}
-	if in.Tags != nil {
-		in, out := &in.Tags, &out.Tags
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(ServiceOperation)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.ServiceIntegrations != nil {
-		in, out := &in.ServiceIntegrations, &out.ServiceIntegrations
-		*out = make([]*ServiceIntegrationItem, len(*in))
+	if in.AppliedRequests != nil {
+		in, out := &in.AppliedRequests, &out.AppliedRequests
+		*out = make([]AppliedRequest, len(*in))
 		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(ServiceIntegrationItem)
-				**out = **in
-			}
+			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ChangesFrozenUntil != nil {
+		in, out := &in.ChangesFrozenUntil, &out.ChangesFrozenUntil
+		*out = (*in).DeepCopy()
+	}
+	if in.MaintenanceWindowEnd != nil {
+		in, out := &in.MaintenanceWindowEnd, &out.MaintenanceWindowEnd
+		*out = (*in).DeepCopy()
+	}
+	if in.CustomCloud != nil {
+		in, out := &in.CustomCloud, &out.CustomCloud
+		*out = new(ServiceCustomCloud)
+		**out = **in
+	}
+	if in.DiskSpace != nil {
+		in, out := &in.DiskSpace, &out.DiskSpace
+		*out = new(ServiceDiskSpace)
+		**out = **in
+	}
+	if in.Diff != nil {
+		in, out := &in.Diff, &out.Diff
+		*out = new(ServiceDiff)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Notifications != nil {
+		in, out := &in.Notifications, &out.Notifications
+		*out = make([]ServiceNotification, len(*in))
+		copy(*out, *in)
+	}
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceCommonSpec.
-func (in *ServiceCommonSpec) DeepCopy() *ServiceCommonSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceStatus.
+func (in *ServiceStatus) DeepCopy() *ServiceStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ServiceCommonSpec)
+	out := new(ServiceStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ServiceIntegration) DeepCopyInto(out *ServiceIntegration) {
+func (in *ServiceUser) DeepCopyInto(out *ServiceUser) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -1940,18 +5355,18 @@ func (in *ServiceIntegration) DeepCopyInto(out *ServiceIntegration) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceIntegration.
-func (in *ServiceIntegration) DeepCopy() *ServiceIntegration {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceUser.
+func (in *ServiceUser) DeepCopy() *ServiceUser {
 	if in == nil {
 		return nil
 	}
-	out := new(ServiceIntegration)
+	out := new(ServiceUser)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ServiceIntegration) DeepCopyObject() runtime.Object {
+func (in *ServiceUser) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1959,132 +5374,192 @@ func (in *ServiceIntegration) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ServiceIntegrationDatadogUserConfig) DeepCopyInto(out *ServiceIntegrationDatadogUserConfig) {
+func (in *ServiceUserKafkaACL) DeepCopyInto(out *ServiceUserKafkaACL) {
 	*out = *in
-	if in.ExcludeConsumerGroups != nil {
-		in, out := &in.ExcludeConsumerGroups, &out.ExcludeConsumerGroups
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.ExcludeTopics != nil {
-		in, out := &in.ExcludeTopics, &out.ExcludeTopics
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.IncludeConsumerGroups != nil {
-		in, out := &in.IncludeConsumerGroups, &out.IncludeConsumerGroups
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.IncludeTopics != nil {
-		in, out := &in.IncludeTopics, &out.IncludeTopics
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.KafkaCustomMetrics != nil {
-		in, out := &in.KafkaCustomMetrics, &out.KafkaCustomMetrics
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceIntegrationDatadogUserConfig.
-func (in *ServiceIntegrationDatadogUserConfig) DeepCopy() *ServiceIntegrationDatadogUserConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceUserKafkaACL.
+func (in *ServiceUserKafkaACL) DeepCopy() *ServiceUserKafkaACL {
 	if in == nil {
 		return nil
 	}
-	out := new(ServiceIntegrationDatadogUserConfig)
+	out := new(ServiceUserKafkaACL)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ServiceIntegrationItem) DeepCopyInto(out *ServiceIntegrationItem) {
+func (in *ServiceUserList) DeepCopyInto(out *ServiceUserList) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ServiceUser, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceIntegrationItem.
-func (in *ServiceIntegrationItem) DeepCopy() *ServiceIntegrationItem {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceUserList.
+func (in *ServiceUserList) DeepCopy() *ServiceUserList {
 	if in == nil {
 		return nil
 	}
-	out := new(ServiceIntegrationItem)
+	out := new(ServiceUserList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ServiceUserList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ServiceIntegrationKafkaConnect) DeepCopyInto(out *ServiceIntegrationKafkaConnect) {
+func (in *ServiceUserSpec) DeepCopyInto(out *ServiceUserSpec) {
 	*out = *in
+	if in.ServiceRef != nil {
+		in, out := &in.ServiceRef, &out.ServiceRef
+		*out = new(ServiceReference)
+		**out = **in
+	}
+	out.ConnInfoSecretTarget = in.ConnInfoSecretTarget
+	out.AuthSecretRef = in.AuthSecretRef
+	if in.OpenSearchACLRules != nil {
+		in, out := &in.OpenSearchACLRules, &out.OpenSearchACLRules
+		*out = make([]OpenSearchACLRule, len(*in))
+		copy(*out, *in)
+	}
+	if in.KafkaTopicAccess != nil {
+		in, out := &in.KafkaTopicAccess, &out.KafkaTopicAccess
+		*out = make([]KafkaTopicAccess, len(*in))
+		copy(*out, *in)
+	}
+	if in.MySQLGrants != nil {
+		in, out := &in.MySQLGrants, &out.MySQLGrants
+		*out = make([]MySQLGrant, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TTL != nil {
+		in, out := &in.TTL, &out.TTL
+		*out = (*in).DeepCopy()
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceIntegrationKafkaConnect.
-func (in *ServiceIntegrationKafkaConnect) DeepCopy() *ServiceIntegrationKafkaConnect {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceUserSpec.
+func (in *ServiceUserSpec) DeepCopy() *ServiceUserSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ServiceIntegrationKafkaConnect)
+	out := new(ServiceUserSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ServiceIntegrationKafkaConnectUserConfig) DeepCopyInto(out *ServiceIntegrationKafkaConnectUserConfig) {
+func (in *ServiceUserStatus) DeepCopyInto(out *ServiceUserStatus) {
 	*out = *in
-	out.KafkaConnect = in.KafkaConnect
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.OpenSearchACLRules != nil {
+		in, out := &in.OpenSearchACLRules, &out.OpenSearchACLRules
+		*out = make([]OpenSearchACLRule, len(*in))
+		copy(*out, *in)
+	}
+	if in.KafkaACLs != nil {
+		in, out := &in.KafkaACLs, &out.KafkaACLs
+		*out = make([]ServiceUserKafkaACL, len(*in))
+		copy(*out, *in)
+	}
+	if in.MySQLGrants != nil {
+		in, out := &in.MySQLGrants, &out.MySQLGrants
+		*out = make([]MySQLGrant, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ExpiresAt != nil {
+		in, out := &in.ExpiresAt, &out.ExpiresAt
+		*out = (*in).DeepCopy()
+	}
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceIntegrationKafkaConnectUserConfig.
-func (in *ServiceIntegrationKafkaConnectUserConfig) DeepCopy() *ServiceIntegrationKafkaConnectUserConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceUserStatus.
+func (in *ServiceUserStatus) DeepCopy() *ServiceUserStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ServiceIntegrationKafkaConnectUserConfig)
+	out := new(ServiceUserStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ServiceIntegrationKafkaLogsUserConfig) DeepCopyInto(out *ServiceIntegrationKafkaLogsUserConfig) {
+func (in *Stack) DeepCopyInto(out *Stack) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceIntegrationKafkaLogsUserConfig.
-func (in *ServiceIntegrationKafkaLogsUserConfig) DeepCopy() *ServiceIntegrationKafkaLogsUserConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Stack.
+func (in *Stack) DeepCopy() *Stack {
 	if in == nil {
 		return nil
 	}
-	out := new(ServiceIntegrationKafkaLogsUserConfig)
+	out := new(Stack)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Stack) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ServiceIntegrationList) DeepCopyInto(out *ServiceIntegrationList) {
+func (in *StackList) DeepCopyInto(out *StackList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]ServiceIntegration, len(*in))
+		*out = make([]Stack, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceIntegrationList.
-func (in *ServiceIntegrationList) DeepCopy() *ServiceIntegrationList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StackList.
+func (in *StackList) DeepCopy() *StackList {
 	if in == nil {
 		return nil
 	}
-	out := new(ServiceIntegrationList)
+	out := new(StackList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ServiceIntegrationList) DeepCopyObject() runtime.Object {
+func (in *StackList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -2092,64 +5567,61 @@ func (in *ServiceIntegrationList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ServiceIntegrationMetricsUserConfig) DeepCopyInto(out *ServiceIntegrationMetricsUserConfig) {
+func (in *StackResource) DeepCopyInto(out *StackResource) {
 	*out = *in
+	in.Spec.DeepCopyInto(&out.Spec)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceIntegrationMetricsUserConfig.
-func (in *ServiceIntegrationMetricsUserConfig) DeepCopy() *ServiceIntegrationMetricsUserConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StackResource.
+func (in *StackResource) DeepCopy() *StackResource {
 	if in == nil {
 		return nil
 	}
-	out := new(ServiceIntegrationMetricsUserConfig)
+	out := new(StackResource)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ServiceIntegrationSpec) DeepCopyInto(out *ServiceIntegrationSpec) {
+func (in *StackResourceStatus) DeepCopyInto(out *StackResourceStatus) {
 	*out = *in
-	in.DatadogUserConfig.DeepCopyInto(&out.DatadogUserConfig)
-	out.KafkaConnectUserConfig = in.KafkaConnectUserConfig
-	out.KafkaLogsUserConfig = in.KafkaLogsUserConfig
-	out.MetricsUserConfig = in.MetricsUserConfig
-	out.AuthSecretRef = in.AuthSecretRef
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceIntegrationSpec.
-func (in *ServiceIntegrationSpec) DeepCopy() *ServiceIntegrationSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StackResourceStatus.
+func (in *StackResourceStatus) DeepCopy() *StackResourceStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ServiceIntegrationSpec)
+	out := new(StackResourceStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ServiceIntegrationStatus) DeepCopyInto(out *ServiceIntegrationStatus) {
+func (in *StackSpec) DeepCopyInto(out *StackSpec) {
 	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]v1.Condition, len(*in))
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]StackResource, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	out.AuthSecretRef = in.AuthSecretRef
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceIntegrationStatus.
-func (in *ServiceIntegrationStatus) DeepCopy() *ServiceIntegrationStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StackSpec.
+func (in *StackSpec) DeepCopy() *StackSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ServiceIntegrationStatus)
+	out := new(StackSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ServiceStatus) DeepCopyInto(out *ServiceStatus) {
+func (in *StackStatus) DeepCopyInto(out *StackStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
@@ -2158,39 +5630,64 @@ func (in *ServiceStatus) DeepCopyInto(out *ServiceStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]StackResourceStatus, len(*in))
+		copy(*out, *in)
+	}
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceStatus.
-func (in *ServiceStatus) DeepCopy() *ServiceStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StackStatus.
+func (in *StackStatus) DeepCopy() *StackStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ServiceStatus)
+	out := new(StackStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ServiceUser) DeepCopyInto(out *ServiceUser) {
+func (in *SyncStatus) DeepCopyInto(out *SyncStatus) {
+	*out = *in
+	if in.LastSyncTime != nil {
+		in, out := &in.LastSyncTime, &out.LastSyncTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncStatus.
+func (in *SyncStatus) DeepCopy() *SyncStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Thanos) DeepCopyInto(out *Thanos) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceUser.
-func (in *ServiceUser) DeepCopy() *ServiceUser {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Thanos.
+func (in *Thanos) DeepCopy() *Thanos {
 	if in == nil {
 		return nil
 	}
-	out := new(ServiceUser)
+	out := new(Thanos)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ServiceUser) DeepCopyObject() runtime.Object {
+func (in *Thanos) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -2198,31 +5695,31 @@ func (in *ServiceUser) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ServiceUserList) DeepCopyInto(out *ServiceUserList) {
+func (in *ThanosList) DeepCopyInto(out *ThanosList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]ServiceUser, len(*in))
+		*out = make([]Thanos, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceUserList.
-func (in *ServiceUserList) DeepCopy() *ServiceUserList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ThanosList.
+func (in *ThanosList) DeepCopy() *ThanosList {
 	if in == nil {
 		return nil
 	}
-	out := new(ServiceUserList)
+	out := new(ThanosList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ServiceUserList) DeepCopyObject() runtime.Object {
+func (in *ThanosList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -2230,40 +5727,106 @@ func (in *ServiceUserList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ServiceUserSpec) DeepCopyInto(out *ServiceUserSpec) {
+func (in *ThanosSpec) DeepCopyInto(out *ThanosSpec) {
 	*out = *in
-	out.ConnInfoSecretTarget = in.ConnInfoSecretTarget
+	in.ServiceCommonSpec.DeepCopyInto(&out.ServiceCommonSpec)
 	out.AuthSecretRef = in.AuthSecretRef
+	out.ConnInfoSecretTarget = in.ConnInfoSecretTarget
+	if in.UserConfig != nil {
+		in, out := &in.UserConfig, &out.UserConfig
+		*out = new(thanos.ThanosUserConfig)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceUserSpec.
-func (in *ServiceUserSpec) DeepCopy() *ServiceUserSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ThanosSpec.
+func (in *ThanosSpec) DeepCopy() *ThanosSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ServiceUserSpec)
+	out := new(ThanosSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ServiceUserStatus) DeepCopyInto(out *ServiceUserStatus) {
+func (in *Valkey) DeepCopyInto(out *Valkey) {
 	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]v1.Condition, len(*in))
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Valkey.
+func (in *Valkey) DeepCopy() *Valkey {
+	if in == nil {
+		return nil
+	}
+	out := new(Valkey)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Valkey) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ValkeyList) DeepCopyInto(out *ValkeyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Valkey, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceUserStatus.
-func (in *ServiceUserStatus) DeepCopy() *ServiceUserStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ValkeyList.
+func (in *ValkeyList) DeepCopy() *ValkeyList {
 	if in == nil {
 		return nil
 	}
-	out := new(ServiceUserStatus)
+	out := new(ValkeyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ValkeyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ValkeySpec) DeepCopyInto(out *ValkeySpec) {
+	*out = *in
+	in.ServiceCommonSpec.DeepCopyInto(&out.ServiceCommonSpec)
+	out.AuthSecretRef = in.AuthSecretRef
+	out.ConnInfoSecretTarget = in.ConnInfoSecretTarget
+	if in.UserConfig != nil {
+		in, out := &in.UserConfig, &out.UserConfig
+		*out = new(valkey.ValkeyUserConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ValkeySpec.
+func (in *ValkeySpec) DeepCopy() *ValkeySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ValkeySpec)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -0,0 +1,125 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aiven/aiven-go-client"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	conditionTypeAccessTransition = "AccessTransition"
+	eventAccessTransition         = "AccessTransition"
+
+	// accessTransitionRetry is how often the service is checked for the new routes during an access transition
+	accessTransitionRetry = time.Minute
+)
+
+// accessRoutes are the user config fields that enable the routes of the service components, by the route of the components
+var accessRoutes = map[string]string{
+	"public_access":      "public",
+	"private_access":     "private",
+	"privatelink_access": "privatelink",
+}
+
+// stageAccessTransition keeps the routes the update disables, until the routes that replace them are available.
+// Turning off public access in favor of privatelink, or the other way around, in one update leaves the clients
+// without a route until the new one is provisioned. Instead, the update enables the new routes first,
+// and the next updates disable the old ones once the service has the components of the new routes.
+// Returns the kept routes, e.g. public_access.pg
+func stageAccessTransition(current *aiven.Service, req *aiven.UpdateServiceRequest) ([]string, error) {
+	if req.UserConfig == nil {
+		return nil, nil
+	}
+
+	want, err := normalizeJSON(req.UserConfig)
+	if err != nil {
+		return nil, err
+	}
+	have, err := normalizeJSON(current.UserConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	// The components that have a route
+	live := make(map[string]bool)
+	known := make(map[string]bool)
+	for _, c := range current.Components {
+		live[c.Component+"/"+c.Route] = true
+		known[c.Component] = true
+	}
+
+	// pending returns true if another route of the component is enabled but not available yet
+	pending := func(field, component string) bool {
+		for f, route := range accessRoutes {
+			if f == field || !accessEnabled(want, f, component) {
+				continue
+			}
+			if !accessEnabled(have, f, component) {
+				return true
+			}
+			// The components the API doesn't list can't be checked, like the ones of the older service versions
+			if known[component] && !live[component+"/"+route] {
+				return true
+			}
+		}
+		return false
+	}
+
+	// Decides on the declared config first, so the kept routes don't count as the replacements
+	keep := make(map[string][]string)
+	for field := range accessRoutes {
+		components, _ := want[field].(map[string]interface{})
+		for component, enabled := range components {
+			if enabled == false && accessEnabled(have, field, component) && pending(field, component) {
+				keep[field] = append(keep[field], component)
+			}
+		}
+	}
+
+	kept := make([]string, 0)
+	for field, components := range keep {
+		routes := want[field].(map[string]interface{})
+		for _, component := range components {
+			routes[component] = true
+			kept = append(kept, field+"."+component)
+		}
+		req.UserConfig[field] = routes
+	}
+
+	if len(kept) == 0 {
+		return nil, nil
+	}
+	sort.Strings(kept)
+	return kept, nil
+}
+
+// accessEnabled returns true if the route of the component is enabled in the user config
+func accessEnabled(userConfig map[string]interface{}, field, component string) bool {
+	components, ok := userConfig[field].(map[string]interface{})
+	return ok && components[component] == true
+}
+
+// getAccessTransitionCondition tells whether the update keeps the old routes until the new ones are available
+func getAccessTransitionCondition(kept []string) metav1.Condition {
+	if len(kept) == 0 {
+		return metav1.Condition{
+			Type:    conditionTypeAccessTransition,
+			Status:  metav1.ConditionFalse,
+			Reason:  "Completed",
+			Message: "The access settings are applied",
+		}
+	}
+
+	return metav1.Condition{
+		Type:    conditionTypeAccessTransition,
+		Status:  metav1.ConditionTrue,
+		Reason:  "WaitingForRoutes",
+		Message: fmt.Sprintf("Keeping %s until the routes that replace them are available", strings.Join(kept, ", ")),
+	}
+}
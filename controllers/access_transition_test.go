@@ -0,0 +1,83 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"testing"
+
+	"github.com/aiven/aiven-go-client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestStageAccessTransition(t *testing.T) {
+	// Public access is replaced with privatelink in one update
+	newRequest := func() *aiven.UpdateServiceRequest {
+		return &aiven.UpdateServiceRequest{UserConfig: map[string]interface{}{
+			"public_access":      map[string]interface{}{"pg": false, "prometheus": false},
+			"privatelink_access": map[string]interface{}{"pg": true},
+		}}
+	}
+	public := &aiven.Service{
+		UserConfig: map[string]interface{}{"public_access": map[string]interface{}{"pg": true, "prometheus": true}},
+		Components: []*aiven.ServiceComponents{{Component: "pg", Route: "dynamic"}, {Component: "pg", Route: "public"}},
+	}
+
+	// The privatelink is enabled first, the public access of pg is kept.
+	// Prometheus has no replacement, its public access is disabled right away
+	req := newRequest()
+	kept, err := stageAccessTransition(public, req)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"public_access.pg"}, kept)
+	assert.Equal(t, map[string]interface{}{"pg": true, "prometheus": false}, req.UserConfig["public_access"])
+	assert.Equal(t, map[string]interface{}{"pg": true}, req.UserConfig["privatelink_access"])
+
+	// The privatelink is enabled, but its route is not available yet
+	both := &aiven.Service{
+		UserConfig: map[string]interface{}{
+			"public_access":      map[string]interface{}{"pg": true, "prometheus": false},
+			"privatelink_access": map[string]interface{}{"pg": true},
+		},
+		Components: public.Components,
+	}
+	kept, err = stageAccessTransition(both, newRequest())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"public_access.pg"}, kept)
+
+	// The privatelink route is available, the public access is disabled
+	both.Components = append(both.Components, &aiven.ServiceComponents{Component: "pg", Route: "privatelink"})
+	req = newRequest()
+	kept, err = stageAccessTransition(both, req)
+	require.NoError(t, err)
+	assert.Empty(t, kept)
+	assert.Equal(t, newRequest().UserConfig, req.UserConfig)
+}
+
+func TestStageAccessTransitionWithoutReplacement(t *testing.T) {
+	// Disabling the public access of a VPC service leaves it VPC-only, which needs no transition
+	current := &aiven.Service{
+		UserConfig: map[string]interface{}{"public_access": map[string]interface{}{"pg": true}},
+		Components: []*aiven.ServiceComponents{{Component: "pg", Route: "dynamic"}, {Component: "pg", Route: "public"}},
+	}
+	req := &aiven.UpdateServiceRequest{UserConfig: map[string]interface{}{
+		"public_access": map[string]interface{}{"pg": false},
+	}}
+	kept, err := stageAccessTransition(current, req)
+	require.NoError(t, err)
+	assert.Empty(t, kept)
+
+	// The frozen changes leave the user config out
+	kept, err = stageAccessTransition(current, &aiven.UpdateServiceRequest{})
+	require.NoError(t, err)
+	assert.Empty(t, kept)
+}
+
+func TestGetAccessTransitionCondition(t *testing.T) {
+	c := getAccessTransitionCondition(nil)
+	assert.Equal(t, metav1.ConditionFalse, c.Status)
+
+	c = getAccessTransitionCondition([]string{"public_access.pg"})
+	assert.Equal(t, metav1.ConditionTrue, c.Status)
+	assert.Equal(t, "Keeping public_access.pg until the routes that replace them are available", c.Message)
+}
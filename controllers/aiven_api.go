@@ -0,0 +1,625 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+
+	"github.com/aiven/aiven-go-client"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+// aivenAPI calls the Aiven API endpoints that are not supported by the go client yet
+type aivenAPI struct {
+	token   string
+	baseURL string
+	http    *http.Client
+}
+
+func newAivenAPI(token string) *aivenAPI {
+	// Same as the go client does
+	baseURL := "https://api.aiven.io"
+	if v := os.Getenv("AIVEN_WEB_URL"); v != "" {
+		baseURL = v
+	}
+
+	return &aivenAPI{
+		token:   token,
+		baseURL: baseURL + "/v1",
+		http:    &http.Client{Timeout: time.Minute, Transport: newChaosTransport(nil)},
+	}
+}
+
+// aivenAPIFor returns the API of the client token, which shares the client transport,
+// so the clients of an AivenClientFactory serve these endpoints too
+func aivenAPIFor(avn *aiven.Client) *aivenAPI {
+	api := newAivenAPI(avn.APIKey)
+	if avn.Client != nil && avn.Client.Transport != nil {
+		api.http.Transport = avn.Client.Transport
+	}
+	return api
+}
+
+type aivenAccessToken struct {
+	FullToken   string `json:"full_token"`
+	TokenPrefix string `json:"token_prefix"`
+}
+
+// createApplicationUserToken creates a token for the application user, which expires after maxAge
+func (c *aivenAPI) createApplicationUserToken(organizationID, userID, description string, maxAge time.Duration) (*aivenAccessToken, error) {
+	req := map[string]interface{}{
+		"description":      description,
+		"max_age_seconds":  int(maxAge.Seconds()),
+		"extend_when_used": false,
+	}
+
+	token := new(aivenAccessToken)
+	err := c.do(http.MethodPost, c.applicationUserTokensPath(organizationID, userID), req, token)
+	if err != nil {
+		return nil, err
+	}
+	return token, nil
+}
+
+// revokeApplicationUserToken revokes the token of the application user, succeeds if it doesn't exist
+func (c *aivenAPI) revokeApplicationUserToken(organizationID, userID, tokenPrefix string) error {
+	err := c.do(http.MethodDelete, c.applicationUserTokensPath(organizationID, userID)+"/"+url.PathEscape(tokenPrefix), nil, nil)
+	if isAivenAPINotFound(err) {
+		return nil
+	}
+	return err
+}
+
+// verifyToken checks that the client token is accepted by the API
+func (c *aivenAPI) verifyToken() error {
+	return c.do(http.MethodGet, "/me", nil, nil)
+}
+
+// getServiceDiskUsage returns the latest disk usage in percent of the most used node of the service
+func (c *aivenAPI) getServiceDiskUsage(project, service string) (float64, bool, error) {
+	var out struct {
+		Metrics map[string]struct {
+			Data struct {
+				// The first column is the time, the rest are the nodes
+				Rows [][]interface{} `json:"rows"`
+			} `json:"data"`
+		} `json:"metrics"`
+	}
+
+	path := fmt.Sprintf("/project/%s/service/%s/metrics", url.PathEscape(project), url.PathEscape(service))
+	err := c.do(http.MethodPost, path, map[string]string{"period": "hour"}, &out)
+	if err != nil {
+		return 0, false, err
+	}
+
+	rows := out.Metrics["disk_usage"].Data.Rows
+	if len(rows) == 0 {
+		return 0, false, nil
+	}
+
+	usage, found := 0.0, false
+	last := rows[len(rows)-1]
+	for _, v := range last[1:] {
+		if f, ok := v.(float64); ok && f >= usage {
+			usage, found = f, true
+		}
+	}
+	return usage, found, nil
+}
+
+// aivenCloud is a cloud available to the project
+type aivenCloud struct {
+	CloudName        string `json:"cloud_name"`
+	CloudDescription string `json:"cloud_description"`
+	GeoRegion        string `json:"geo_region"`
+	Provider         string `json:"provider"`
+}
+
+// listProjectClouds returns the clouds available to the project, including its custom clouds
+func (c *aivenAPI) listProjectClouds(project string) ([]aivenCloud, error) {
+	var out struct {
+		Clouds []aivenCloud `json:"clouds"`
+	}
+	err := c.do(http.MethodGet, fmt.Sprintf("/project/%s/clouds", url.PathEscape(project)), nil, &out)
+	if err != nil {
+		return nil, err
+	}
+	return out.Clouds, nil
+}
+
+// aivenServicePlan has the sizes of the plan, the memory of the nodes depends on the cloud.
+// The disk space can be increased by the steps up to the cap
+type aivenServicePlan struct {
+	DiskSpaceMB     int `json:"disk_space_mb"`
+	DiskSpaceStepMB int `json:"disk_space_step_mb"`
+	DiskSpaceCapMB  int `json:"disk_space_cap_mb"`
+	Regions         map[string]struct {
+		NodeMemoryMB int `json:"node_memory_mb"`
+	} `json:"regions"`
+}
+
+// getServicePlan returns the sizes of the plan
+func (c *aivenAPI) getServicePlan(project, serviceType, plan string) (*aivenServicePlan, error) {
+	out := new(aivenServicePlan)
+	path := fmt.Sprintf("/project/%s/service-types/%s/plans/%s",
+		url.PathEscape(project), url.PathEscape(serviceType), url.PathEscape(plan))
+	err := c.do(http.MethodGet, path, nil, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// getServicePlanNodeMemoryMB returns the memory of a node of the plan in the cloud, zero if the plan is not available in it
+func (c *aivenAPI) getServicePlanNodeMemoryMB(project, serviceType, plan, cloudName string) (int, error) {
+	p, err := c.getServicePlan(project, serviceType, plan)
+	if err != nil {
+		return 0, err
+	}
+	return p.Regions[cloudName].NodeMemoryMB, nil
+}
+
+// getServiceConnectionInfo returns the connection info of the service as is,
+// the client types don't have the fields of the newer service types
+func (c *aivenAPI) getServiceConnectionInfo(project, service string) (map[string]interface{}, error) {
+	var out struct {
+		Service struct {
+			ConnectionInfo map[string]interface{} `json:"connection_info"`
+		} `json:"service"`
+	}
+	err := c.do(http.MethodGet, fmt.Sprintf("/project/%s/service/%s", url.PathEscape(project), url.PathEscape(service)), nil, &out)
+	if err != nil {
+		return nil, err
+	}
+	return out.Service.ConnectionInfo, nil
+}
+
+// aivenServiceNotification is a notice about the service, e.g. the end of life of its version
+type aivenServiceNotification struct {
+	Type    string `json:"type"`
+	Level   string `json:"level"`
+	Message string `json:"message"`
+}
+
+// getServiceWithNotifications returns the service and its notifications with one request, the client types don't have the notifications.
+// Decodes the service and returns the errors the way the client does, so the callers handle them the same
+func (c *aivenAPI) getServiceWithNotifications(project, service string) (*aiven.Service, []aivenServiceNotification, error) {
+	var raw json.RawMessage
+	err := c.do(http.MethodGet, fmt.Sprintf("/project/%s/service/%s", url.PathEscape(project), url.PathEscape(service)), nil, &raw)
+	if e, ok := err.(*aivenAPIError); ok {
+		clientErr := aiven.Error{}
+		if json.Unmarshal([]byte(e.Message), &clientErr) != nil {
+			clientErr.Message = e.Message
+		}
+		clientErr.Status = e.Status
+		return nil, nil, clientErr
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var out struct {
+		Service *aiven.Service `json:"service"`
+	}
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+	if err = dec.Decode(&out); err != nil {
+		return nil, nil, err
+	}
+	if out.Service == nil {
+		return nil, nil, aiven.ErrNoResponseData
+	}
+
+	var notifications struct {
+		Service struct {
+			Notifications []aivenServiceNotification `json:"service_notifications"`
+		} `json:"service"`
+	}
+	if err = json.Unmarshal(raw, &notifications); err != nil {
+		return nil, nil, err
+	}
+	return out.Service, notifications.Service.Notifications, nil
+}
+
+// listAvailableConnectors returns the connector plugins installed on the Kafka Connect service
+func (c *aivenAPI) listAvailableConnectors(project, service string) ([]aiven.KafkaConnectorPlugin, error) {
+	var out struct {
+		Plugins []aiven.KafkaConnectorPlugin `json:"plugins"`
+	}
+	path := fmt.Sprintf("/project/%s/service/%s/available-connectors", url.PathEscape(project), url.PathEscape(service))
+	err := c.do(http.MethodGet, path, nil, &out)
+	if err != nil {
+		return nil, err
+	}
+	return out.Plugins, nil
+}
+
+// deleteKafkaSubjectPermanently hard deletes the soft deleted subject with its schema history, succeeds if it doesn't exist
+func (c *aivenAPI) deleteKafkaSubjectPermanently(project, service, subject string) error {
+	path := fmt.Sprintf("/project/%s/service/%s/kafka/schema/subjects/%s?permanent=true",
+		url.PathEscape(project), url.PathEscape(service), url.PathEscape(subject))
+	err := c.do(http.MethodDelete, path, nil, nil)
+	if isAivenAPINotFound(err) {
+		return nil
+	}
+	return err
+}
+
+// addKafkaSubjectSchema registers a new schema version of the subject with its references,
+// which the go client doesn't support
+func (c *aivenAPI) addKafkaSubjectSchema(project, service, subject, schema, schemaType string, references []v1alpha1.KafkaSchemaReference) error {
+	req := map[string]interface{}{
+		"schema":     schema,
+		"references": references,
+	}
+	if schemaType != "" {
+		req["schemaType"] = schemaType
+	}
+
+	path := fmt.Sprintf("/project/%s/service/%s/kafka/schema/subjects/%s/versions",
+		url.PathEscape(project), url.PathEscape(service), url.PathEscape(subject))
+	return c.do(http.MethodPost, path, req, nil)
+}
+
+// aivenOrganizationVPC is a VPC shared by the projects of the organization
+type aivenOrganizationVPC struct {
+	OrganizationVPCID string `json:"organization_vpc_id"`
+	State             string `json:"state"`
+	Clouds            []struct {
+		CloudName   string `json:"cloud_name"`
+		NetworkCIDR string `json:"network_cidr"`
+	} `json:"clouds"`
+	PeeringConnections []*aivenOrganizationVPCPeeringConnection `json:"peering_connections"`
+}
+
+// aivenOrganizationVPCPeeringConnection has the fields of the project VPC peering connections, and its own ID
+type aivenOrganizationVPCPeeringConnection struct {
+	aiven.VPCPeeringConnection
+	PeeringConnectionID string `json:"peering_connection_id"`
+}
+
+// createOrganizationVPC creates a VPC in the cloud of the organization
+func (c *aivenAPI) createOrganizationVPC(organizationID, cloudName, networkCIDR string) (*aivenOrganizationVPC, error) {
+	req := map[string]interface{}{
+		"clouds": []map[string]string{{
+			"cloud_name":   cloudName,
+			"network_cidr": networkCIDR,
+		}},
+		"peering_connections": []interface{}{},
+	}
+
+	vpc := new(aivenOrganizationVPC)
+	err := c.do(http.MethodPost, c.organizationVPCsPath(organizationID), req, vpc)
+	if err != nil {
+		return nil, err
+	}
+	return vpc, nil
+}
+
+// getOrganizationVPC returns the VPC with its peering connections
+func (c *aivenAPI) getOrganizationVPC(organizationID, vpcID string) (*aivenOrganizationVPC, error) {
+	vpc := new(aivenOrganizationVPC)
+	err := c.do(http.MethodGet, c.organizationVPCsPath(organizationID)+"/"+url.PathEscape(vpcID), nil, vpc)
+	if err != nil {
+		return nil, err
+	}
+	return vpc, nil
+}
+
+// deleteOrganizationVPC deletes the VPC, succeeds if it doesn't exist
+func (c *aivenAPI) deleteOrganizationVPC(organizationID, vpcID string) error {
+	err := c.do(http.MethodDelete, c.organizationVPCsPath(organizationID)+"/"+url.PathEscape(vpcID), nil, nil)
+	if isAivenAPINotFound(err) {
+		return nil
+	}
+	return err
+}
+
+// createOrganizationVPCPeeringConnection requests a peering connection to the peer VPC
+func (c *aivenAPI) createOrganizationVPCPeeringConnection(organizationID, vpcID string, req aiven.CreateVPCPeeringConnectionRequest) error {
+	path := fmt.Sprintf("%s/%s/peering-connections", c.organizationVPCsPath(organizationID), url.PathEscape(vpcID))
+	return c.do(http.MethodPost, path, req, nil)
+}
+
+// deleteOrganizationVPCPeeringConnection deletes the peering connection, succeeds if it doesn't exist
+func (c *aivenAPI) deleteOrganizationVPCPeeringConnection(organizationID, vpcID, peeringConnectionID string) error {
+	path := fmt.Sprintf("%s/%s/peering-connections/%s", c.organizationVPCsPath(organizationID), url.PathEscape(vpcID), url.PathEscape(peeringConnectionID))
+	err := c.do(http.MethodDelete, path, nil, nil)
+	if isAivenAPINotFound(err) {
+		return nil
+	}
+	return err
+}
+
+// aivenOrganizationPermission is the roles of a user or a group of the organization on a resource
+type aivenOrganizationPermission struct {
+	PrincipalType string   `json:"principal_type"`
+	PrincipalID   string   `json:"principal_id"`
+	Permissions   []string `json:"permissions"`
+}
+
+// getOrganizationPermissions returns the permissions on the resource, e.g. on a project
+func (c *aivenAPI) getOrganizationPermissions(organizationID, resourceType, resourceID string) ([]aivenOrganizationPermission, error) {
+	var out struct {
+		Permissions []aivenOrganizationPermission `json:"permissions"`
+	}
+	err := c.do(http.MethodGet, c.organizationPermissionsPath(organizationID, resourceType, resourceID), nil, &out)
+	if err != nil {
+		return nil, err
+	}
+	return out.Permissions, nil
+}
+
+// setOrganizationPermissions replaces the permissions on the resource, the principals that are not listed lose theirs
+func (c *aivenAPI) setOrganizationPermissions(organizationID, resourceType, resourceID string, permissions []aivenOrganizationPermission) error {
+	req := map[string]interface{}{"permissions": permissions}
+	return c.do(http.MethodPut, c.organizationPermissionsPath(organizationID, resourceType, resourceID), req, nil)
+}
+
+// aivenKafkaNativeACL is a Kafka-native ACL entry of the service
+type aivenKafkaNativeACL struct {
+	ID             string `json:"id,omitempty"`
+	ResourceType   string `json:"resource_type"`
+	Name           string `json:"name"`
+	PatternType    string `json:"pattern_type"`
+	Operation      string `json:"operation"`
+	PermissionType string `json:"permission_type"`
+	Principal      string `json:"principal"`
+	Host           string `json:"host"`
+}
+
+// createKafkaNativeACL adds the Kafka-native ACL entry to the service
+func (c *aivenAPI) createKafkaNativeACL(project, service string, acl *aivenKafkaNativeACL) (*aivenKafkaNativeACL, error) {
+	out := new(aivenKafkaNativeACL)
+	err := c.do(http.MethodPost, c.kafkaNativeACLsPath(project, service), acl, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// getKafkaNativeACL returns the Kafka-native ACL entry of the service
+func (c *aivenAPI) getKafkaNativeACL(project, service, id string) (*aivenKafkaNativeACL, error) {
+	out := new(aivenKafkaNativeACL)
+	err := c.do(http.MethodGet, c.kafkaNativeACLsPath(project, service)+"/"+url.PathEscape(id), nil, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// listKafkaNativeACLs returns the Kafka-native ACL entries of the service, without the Aiven ACL entries
+func (c *aivenAPI) listKafkaNativeACLs(project, service string) ([]*aivenKafkaNativeACL, error) {
+	var out struct {
+		KafkaACL []*aivenKafkaNativeACL `json:"kafka_acl"`
+	}
+	err := c.do(http.MethodGet, c.kafkaNativeACLsPath(project, service), nil, &out)
+	if err != nil {
+		return nil, err
+	}
+	return out.KafkaACL, nil
+}
+
+// deleteKafkaNativeACL deletes the Kafka-native ACL entry of the service, succeeds if it doesn't exist
+func (c *aivenAPI) deleteKafkaNativeACL(project, service, id string) error {
+	err := c.do(http.MethodDelete, c.kafkaNativeACLsPath(project, service)+"/"+url.PathEscape(id), nil, nil)
+	if isAivenAPINotFound(err) {
+		return nil
+	}
+	return err
+}
+
+// aivenFlinkApplication is a SQL application of a Flink service with its versions
+type aivenFlinkApplication struct {
+	ID                  string                         `json:"id"`
+	Name                string                         `json:"name"`
+	ApplicationVersions []aivenFlinkApplicationVersion `json:"application_versions"`
+}
+
+// aivenFlinkApplicationVersion is an immutable version of the application
+type aivenFlinkApplicationVersion struct {
+	ID        string                `json:"id,omitempty"`
+	Version   int                   `json:"version,omitempty"`
+	Sources   []aivenFlinkTableSpec `json:"sources"`
+	Sinks     []aivenFlinkTableSpec `json:"sinks"`
+	Statement string                `json:"statement"`
+}
+
+type aivenFlinkTableSpec struct {
+	CreateTable   string `json:"create_table"`
+	IntegrationID string `json:"integration_id,omitempty"`
+}
+
+// aivenFlinkApplicationDeployment is a deployment of an application version, which runs a job
+type aivenFlinkApplicationDeployment struct {
+	ID                string `json:"id,omitempty"`
+	VersionID         string `json:"version_id"`
+	Parallelism       int    `json:"parallelism"`
+	RestartEnabled    bool   `json:"restart_enabled"`
+	StartingSavepoint string `json:"starting_savepoint,omitempty"`
+	Status            string `json:"status,omitempty"`
+	JobID             string `json:"job_id,omitempty"`
+	LastSavepoint     string `json:"last_savepoint,omitempty"`
+	ErrorMsg          string `json:"error_msg,omitempty"`
+}
+
+// createFlinkApplication creates an application without versions
+func (c *aivenAPI) createFlinkApplication(project, service, name string) (*aivenFlinkApplication, error) {
+	app := new(aivenFlinkApplication)
+	err := c.do(http.MethodPost, c.flinkApplicationsPath(project, service), map[string]string{"name": name}, app)
+	if err != nil {
+		return nil, err
+	}
+	return app, nil
+}
+
+// getFlinkApplication returns the application with its versions
+func (c *aivenAPI) getFlinkApplication(project, service, id string) (*aivenFlinkApplication, error) {
+	app := new(aivenFlinkApplication)
+	err := c.do(http.MethodGet, c.flinkApplicationsPath(project, service)+"/"+url.PathEscape(id), nil, app)
+	if err != nil {
+		return nil, err
+	}
+	return app, nil
+}
+
+// listFlinkApplications returns the applications of the service, without their versions
+func (c *aivenAPI) listFlinkApplications(project, service string) ([]aivenFlinkApplication, error) {
+	var out struct {
+		Applications []aivenFlinkApplication `json:"applications"`
+	}
+	err := c.do(http.MethodGet, c.flinkApplicationsPath(project, service), nil, &out)
+	if err != nil {
+		return nil, err
+	}
+	return out.Applications, nil
+}
+
+// deleteFlinkApplication deletes the application with its versions, succeeds if it doesn't exist.
+// The application with a running deployment can't be deleted
+func (c *aivenAPI) deleteFlinkApplication(project, service, id string) error {
+	err := c.do(http.MethodDelete, c.flinkApplicationsPath(project, service)+"/"+url.PathEscape(id), nil, nil)
+	if isAivenAPINotFound(err) {
+		return nil
+	}
+	return err
+}
+
+// createFlinkApplicationVersion adds a new version to the application
+func (c *aivenAPI) createFlinkApplicationVersion(project, service, applicationID string, version *aivenFlinkApplicationVersion) (*aivenFlinkApplicationVersion, error) {
+	out := new(aivenFlinkApplicationVersion)
+	err := c.do(http.MethodPost, c.flinkApplicationPath(project, service, applicationID)+"/version", version, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// createFlinkApplicationDeployment deploys the application version, which starts the job
+func (c *aivenAPI) createFlinkApplicationDeployment(project, service, applicationID string, deployment *aivenFlinkApplicationDeployment) (*aivenFlinkApplicationDeployment, error) {
+	out := new(aivenFlinkApplicationDeployment)
+	err := c.do(http.MethodPost, c.flinkApplicationPath(project, service, applicationID)+"/deployment", deployment, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// getFlinkApplicationDeployment returns the deployment with the state of its job
+func (c *aivenAPI) getFlinkApplicationDeployment(project, service, applicationID, id string) (*aivenFlinkApplicationDeployment, error) {
+	out := new(aivenFlinkApplicationDeployment)
+	err := c.do(http.MethodGet, c.flinkApplicationPath(project, service, applicationID)+"/deployment/"+url.PathEscape(id), nil, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// stopFlinkApplicationDeployment stops the job with a savepoint, or cancels it without one
+func (c *aivenAPI) stopFlinkApplicationDeployment(project, service, applicationID, id string, savepoint bool) error {
+	action := "/cancel"
+	if savepoint {
+		action = "/stop"
+	}
+	return c.do(http.MethodPost, c.flinkApplicationPath(project, service, applicationID)+"/deployment/"+url.PathEscape(id)+action, nil, nil)
+}
+
+// deleteFlinkApplicationDeployment deletes the deployment of the stopped job, succeeds if it doesn't exist
+func (c *aivenAPI) deleteFlinkApplicationDeployment(project, service, applicationID, id string) error {
+	err := c.do(http.MethodDelete, c.flinkApplicationPath(project, service, applicationID)+"/deployment/"+url.PathEscape(id), nil, nil)
+	if isAivenAPINotFound(err) {
+		return nil
+	}
+	return err
+}
+
+func (c *aivenAPI) flinkApplicationsPath(project, service string) string {
+	return fmt.Sprintf("/project/%s/service/%s/flink/application", url.PathEscape(project), url.PathEscape(service))
+}
+
+func (c *aivenAPI) flinkApplicationPath(project, service, applicationID string) string {
+	return c.flinkApplicationsPath(project, service) + "/" + url.PathEscape(applicationID)
+}
+
+func (c *aivenAPI) kafkaNativeACLsPath(project, service string) string {
+	return fmt.Sprintf("/project/%s/service/%s/kafka-native-acls", url.PathEscape(project), url.PathEscape(service))
+}
+
+func (c *aivenAPI) organizationVPCsPath(organizationID string) string {
+	return fmt.Sprintf("/organization/%s/vpcs", url.PathEscape(organizationID))
+}
+
+func (c *aivenAPI) organizationPermissionsPath(organizationID, resourceType, resourceID string) string {
+	return fmt.Sprintf("/organization/%s/permissions/%s/%s", url.PathEscape(organizationID), url.PathEscape(resourceType), url.PathEscape(resourceID))
+}
+
+func (c *aivenAPI) applicationUserTokensPath(organizationID, userID string) string {
+	return fmt.Sprintf("/organization/%s/application-users/%s/access-tokens", url.PathEscape(organizationID), url.PathEscape(userID))
+}
+
+// aivenAPIError is a non-2xx response of the API
+type aivenAPIError struct {
+	Status  int
+	Message string
+}
+
+func (e *aivenAPIError) Error() string {
+	return fmt.Sprintf("aiven api error %d: %s", e.Status, e.Message)
+}
+
+func isAivenAPINotFound(err error) bool {
+	e, ok := err.(*aivenAPIError)
+	return ok && e.Status == http.StatusNotFound
+}
+
+func isAivenAPIConflict(err error) bool {
+	e, ok := err.(*aivenAPIError)
+	return ok && e.Status == http.StatusConflict
+}
+
+func (c *aivenAPI) do(method, path string, in, out interface{}) error {
+	var body io.Reader
+	if in != nil {
+		b, err := json.Marshal(in)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequest(method, c.baseURL+path, body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "aivenv1 "+c.token)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", operatorUserAgent)
+
+	rsp, err := c.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer rsp.Body.Close()
+
+	b, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return err
+	}
+
+	if rsp.StatusCode < 200 || rsp.StatusCode > 299 {
+		return &aivenAPIError{Status: rsp.StatusCode, Message: string(b)}
+	}
+
+	if out == nil {
+		return nil
+	}
+	return json.Unmarshal(b, out)
+}
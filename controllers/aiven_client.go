@@ -0,0 +1,36 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"github.com/aiven/aiven-go-client"
+)
+
+// AivenClientFactory creates the Aiven clients of the controllers.
+// The tests and the integrators replace it to serve the Aiven API with a fake,
+// e.g. a client with a custom http.RoundTripper, instead of the live API
+type AivenClientFactory interface {
+	NewClient(token string) (*aiven.Client, error)
+}
+
+// AivenClientFactoryFunc is a function that implements AivenClientFactory
+type AivenClientFactoryFunc func(token string) (*aiven.Client, error)
+
+func (f AivenClientFactoryFunc) NewClient(token string) (*aiven.Client, error) {
+	return f(token)
+}
+
+// DefaultAivenClientFactory creates the clients of the live Aiven API
+var DefaultAivenClientFactory AivenClientFactory = AivenClientFactoryFunc(newAivenClient)
+
+func aivenClientFactoryOrDefault(f AivenClientFactory) AivenClientFactory {
+	if f == nil {
+		return DefaultAivenClientFactory
+	}
+	return f
+}
+
+// newAivenClient creates a client with the factory of the controller
+func (c *Controller) newAivenClient(token string) (*aiven.Client, error) {
+	return aivenClientFactoryOrDefault(c.AivenClientFactory).NewClient(token)
+}
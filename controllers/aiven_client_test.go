@@ -0,0 +1,160 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/aiven/aiven-go-client"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+// fakeAivenAPI serves the Aiven API of a running Kafka service with its Kafka-native ACL entries
+type fakeAivenAPI struct {
+	mu       sync.Mutex
+	acls     []*aivenKafkaNativeACL
+	requests []string
+}
+
+func (f *fakeAivenAPI) RoundTrip(r *http.Request) (*http.Response, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	path := strings.TrimPrefix(r.URL.Path, "/v1")
+	f.requests = append(f.requests, r.Method+" "+path)
+
+	const service = "/project/foo/service/kafka"
+	var out interface{}
+	switch {
+	case r.Method == http.MethodGet && path == service:
+		out = map[string]interface{}{"service": map[string]string{"service_name": "kafka", "state": "RUNNING"}}
+	case r.Method == http.MethodGet && path == service+"/kafka-native-acls":
+		out = map[string]interface{}{"kafka_acl": f.acls}
+	case r.Method == http.MethodPost && path == service+"/kafka-native-acls":
+		acl := new(aivenKafkaNativeACL)
+		if err := json.NewDecoder(r.Body).Decode(acl); err != nil {
+			return nil, err
+		}
+		acl.ID = "acl1"
+		f.acls = append(f.acls, acl)
+		out = acl
+	case r.Method == http.MethodGet && strings.HasPrefix(path, service+"/kafka-native-acls/"):
+		for _, acl := range f.acls {
+			if path == service+"/kafka-native-acls/"+acl.ID {
+				out = acl
+			}
+		}
+	}
+
+	rsp := &http.Response{StatusCode: http.StatusOK, Header: make(http.Header), Request: r}
+	if out == nil {
+		rsp.StatusCode = http.StatusNotFound
+		out = map[string]string{"message": "Not found"}
+	}
+	b, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	rsp.Body = io.NopCloser(bytes.NewReader(b))
+	return rsp, nil
+}
+
+// newFakeAivenClient returns a client of the fake API
+func newFakeAivenClient(token string, api http.RoundTripper) *aiven.Client {
+	avn := &aiven.Client{APIKey: token, Client: &http.Client{Transport: api}, UserAgent: operatorUserAgent}
+	avn.Init()
+	return avn
+}
+
+func TestAivenClientFactoryOrDefault(t *testing.T) {
+	// The funcs can only be compared by their code pointers
+	assert.Equal(t, reflect.ValueOf(DefaultAivenClientFactory).Pointer(), reflect.ValueOf(aivenClientFactoryOrDefault(nil)).Pointer())
+
+	calls := 0
+	f := AivenClientFactoryFunc(func(token string) (*aiven.Client, error) {
+		calls++
+		return &aiven.Client{APIKey: token}, nil
+	})
+	c := &Controller{AivenClientFactory: f}
+	avn, err := c.newAivenClient("token")
+	require.NoError(t, err)
+	assert.Equal(t, "token", avn.APIKey)
+	assert.Equal(t, 1, calls)
+}
+
+func TestAivenAPIForSharesTransport(t *testing.T) {
+	transport := &fakeAivenAPI{}
+	api := aivenAPIFor(newFakeAivenClient("token", transport))
+	assert.Equal(t, "token", api.token)
+	assert.Equal(t, transport, api.http.Transport)
+}
+
+func TestReconcileWithFakeAivenClient(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, v1alpha1.AddToScheme(scheme))
+
+	acl := &v1alpha1.KafkaNativeACL{
+		ObjectMeta: metav1.ObjectMeta{Name: "orders-read", Namespace: "default"},
+		Spec: v1alpha1.KafkaNativeACLSpec{
+			Project:        "foo",
+			ServiceName:    "kafka",
+			ResourceType:   "Topic",
+			ResourceName:   "orders",
+			PatternType:    "LITERAL",
+			Operation:      "Read",
+			PermissionType: "ALLOW",
+			Principal:      "User:app",
+			Host:           "*",
+		},
+	}
+	k8s := fake.NewClientBuilder().WithScheme(scheme).WithObjects(acl).Build()
+
+	api := &fakeAivenAPI{}
+	r := &KafkaNativeACLReconciler{Controller: Controller{
+		Client:       k8s,
+		Log:          logr.Discard(),
+		Scheme:       scheme,
+		Recorder:     record.NewFakeRecorder(1000),
+		DefaultToken: "token",
+		AivenClientFactory: AivenClientFactoryFunc(func(token string) (*aiven.Client, error) {
+			return newFakeAivenClient(token, api), nil
+		}),
+	}}
+
+	// The first reconciliations migrate the state and add the finalizer
+	key := types.NamespacedName{Name: acl.Name, Namespace: acl.Namespace}
+	for i := 0; i < 5; i++ {
+		_, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: key})
+		require.NoError(t, err)
+	}
+
+	got := new(v1alpha1.KafkaNativeACL)
+	require.NoError(t, k8s.Get(context.Background(), key, got))
+	assert.Equal(t, "acl1", got.Status.ID)
+	assert.True(t, meta.IsStatusConditionTrue(got.Status.Conditions, conditionTypeRunning))
+
+	// The entry is created once, the next reconciliations find it
+	assert.Len(t, api.acls, 1)
+	assert.Contains(t, api.requests, "POST /project/foo/service/kafka/kafka-native-acls")
+}
@@ -0,0 +1,173 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/aiven/aiven-go-client"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+// aivenRequestIDHeader is the response header with the identifier of the Aiven API request,
+// Aiven support finds the backend tasks of the request by it
+const aivenRequestIDHeader = "X-Request-Id"
+
+const (
+	serviceOperationCreate    = "create"
+	serviceOperationFork      = "fork"
+	serviceOperationUpdate    = "update"
+	serviceOperationMigration = "migration"
+	serviceOperationUpgrade   = "upgrade"
+)
+
+// appliedRequestsLimit is how many of the latest applied requests the status of the service keeps
+const appliedRequestsLimit = 10
+
+// aivenOperationRecorder remembers the requests that change something, and the request identifier of the latest one
+type aivenOperationRecorder struct {
+	next http.RoundTripper
+
+	mu        sync.Mutex
+	requestID string
+	requests  []v1alpha1.AppliedRequest
+}
+
+// recordAivenOperations makes the client record the request identifiers.
+// The client is created for every reconciliation, so the recorder sees the requests of the instance only.
+func recordAivenOperations(a *aiven.Client) *aivenOperationRecorder {
+	r := &aivenOperationRecorder{next: http.DefaultTransport}
+	if a.Client == nil {
+		a.Client = &http.Client{}
+	}
+	if a.Client.Transport != nil {
+		r.next = a.Client.Transport
+	}
+
+	// Copies the http client, in case it is shared
+	c := *a.Client
+	c.Transport = r
+	a.Client = &c
+	return r
+}
+
+func (r *aivenOperationRecorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method == http.MethodGet {
+		return r.next.RoundTrip(req)
+	}
+
+	hash, err := hashRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := r.next.RoundTrip(req)
+	if err != nil {
+		return resp, err
+	}
+
+	id := resp.Header.Get(aivenRequestIDHeader)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if id != "" {
+		r.requestID = id
+	}
+	// The failed requests change nothing
+	if resp.StatusCode < http.StatusBadRequest {
+		r.requests = append(r.requests, v1alpha1.AppliedRequest{
+			Time:      metav1.NewTime(time.Now()),
+			Method:    req.Method,
+			Path:      req.URL.Path,
+			Hash:      hash,
+			RequestID: id,
+		})
+	}
+	return resp, err
+}
+
+// hashRequestBody returns the SHA-256 of the request body, so the applied configs can be told apart
+// without keeping them, as they might have secrets. Returns an empty string for the requests without a body
+func hashRequestBody(req *http.Request) (string, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return "", nil
+	}
+
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		return "", err
+	}
+	err = req.Body.Close()
+	if err != nil {
+		return "", err
+	}
+	req.Body = io.NopCloser(bytes.NewReader(body))
+
+	sum := sha256.Sum256(body)
+	return "sha256:" + hex.EncodeToString(sum[:]), nil
+}
+
+func (r *aivenOperationRecorder) lastRequestID() string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.requestID
+}
+
+// appliedRequests returns the recorded requests with the generation of the resource they apply
+func (r *aivenOperationRecorder) appliedRequests(generation int64) []v1alpha1.AppliedRequest {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	requests := make([]v1alpha1.AppliedRequest, len(r.requests))
+	for i, req := range r.requests {
+		req.Generation = generation
+		requests[i] = req
+	}
+	return requests
+}
+
+// appendAppliedRequests adds the requests to the history, keeping the latest appliedRequestsLimit ones
+func appendAppliedRequests(history, requests []v1alpha1.AppliedRequest) []v1alpha1.AppliedRequest {
+	history = append(history, requests...)
+	if len(history) > appliedRequestsLimit {
+		history = history[len(history)-appliedRequestsLimit:]
+	}
+	return history
+}
+
+// serviceUpdateOperation tells what the update of the service does on Aiven side.
+// A version upgrade or a plan or cloud change move the data, which takes long.
+func serviceUpdateOperation(current *aiven.Service, plan, cloud string, userConfig map[string]interface{}) string {
+	for _, k := range serviceVersionKeys {
+		declared, ok := userConfig[k]
+		if !ok || declared == nil {
+			continue
+		}
+
+		actual, ok := current.UserConfig[k]
+		if ok && actual != nil && compareVersions(fmt.Sprint(declared), fmt.Sprint(actual)) > 0 {
+			return serviceOperationUpgrade
+		}
+	}
+
+	if (plan != "" && plan != current.Plan) || (cloud != "" && cloud != current.CloudName) {
+		return serviceOperationMigration
+	}
+	return serviceOperationUpdate
+}
+
+// serviceCreateOperation tells whether the service is created from scratch or forked from another one
+func serviceCreateOperation(userConfig map[string]interface{}) string {
+	if v, ok := userConfig["service_to_fork_from"]; ok && v != nil && v != "" {
+		return serviceOperationFork
+	}
+	return serviceOperationCreate
+}
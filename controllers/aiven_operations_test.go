@@ -0,0 +1,95 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/aiven/aiven-go-client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+func TestServiceOperation(t *testing.T) {
+	current := &aiven.Service{
+		Plan:       "business-4",
+		CloudName:  "google-europe-west1",
+		UserConfig: map[string]interface{}{"pg_version": "14"},
+	}
+
+	assert.Equal(t, serviceOperationUpdate, serviceUpdateOperation(current, "business-4", "", map[string]interface{}{"pg_version": "14"}))
+	assert.Equal(t, serviceOperationUpgrade, serviceUpdateOperation(current, "business-8", "", map[string]interface{}{"pg_version": "15"}))
+	assert.Equal(t, serviceOperationMigration, serviceUpdateOperation(current, "business-8", "", nil))
+	assert.Equal(t, serviceOperationMigration, serviceUpdateOperation(current, "", "aws-eu-west-1", nil))
+
+	assert.Equal(t, serviceOperationCreate, serviceCreateOperation(map[string]interface{}{"pg_version": "14"}))
+	assert.Equal(t, serviceOperationFork, serviceCreateOperation(map[string]interface{}{"service_to_fork_from": "pg-source"}))
+}
+
+func TestAivenOperationRecorder(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(aivenRequestIDHeader, r.Method+"-id")
+	}))
+	defer server.Close()
+
+	a := &aiven.Client{Client: server.Client()}
+	ops := recordAivenOperations(a)
+
+	req, err := http.NewRequest(http.MethodPut, server.URL, nil)
+	require.NoError(t, err)
+	_, err = a.Client.Do(req)
+	require.NoError(t, err)
+
+	// Reads don't change the operation
+	_, err = a.Client.Get(server.URL)
+	require.NoError(t, err)
+	assert.Equal(t, "PUT-id", ops.lastRequestID())
+}
+
+func TestAivenOperationRecorderAppliedRequests(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/bad" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		w.Header().Set(aivenRequestIDHeader, string(body))
+	}))
+	defer server.Close()
+
+	a := &aiven.Client{Client: server.Client()}
+	ops := recordAivenOperations(a)
+
+	for _, path := range []string{"/service", "/bad"} {
+		resp, err := a.Client.Post(server.URL+path, "application/json", strings.NewReader(`{"plan":"business-4"}`))
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+	}
+
+	// The body still reaches the API, the failed request is not recorded
+	requests := ops.appliedRequests(3)
+	require.Len(t, requests, 1)
+	assert.Equal(t, `{"plan":"business-4"}`, requests[0].RequestID)
+	assert.Equal(t, "/service", requests[0].Path)
+	assert.Equal(t, http.MethodPost, requests[0].Method)
+	assert.Equal(t, int64(3), requests[0].Generation)
+	assert.Equal(t, "sha256:688ac7c81e36eb1dd1078cd702b1614edb340210a6f58096267bcedd1b3d7486", requests[0].Hash)
+}
+
+func TestAppendAppliedRequests(t *testing.T) {
+	history := make([]v1alpha1.AppliedRequest, 0)
+	for i := 0; i < appliedRequestsLimit+2; i++ {
+		history = appendAppliedRequests(history, []v1alpha1.AppliedRequest{{Generation: int64(i)}})
+	}
+
+	// The oldest requests are dropped
+	require.Len(t, history, appliedRequestsLimit)
+	assert.Equal(t, int64(2), history[0].Generation)
+	assert.Equal(t, int64(appliedRequestsLimit+1), history[appliedRequestsLimit-1].Generation)
+}
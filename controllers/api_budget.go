@@ -0,0 +1,120 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/aiven/aiven-go-client"
+)
+
+const (
+	// apiBudgetReserve is the share of the budget kept for the resources that are not ready yet
+	apiBudgetReserve = 0.2
+
+	// apiRateLimitedTimeout is how long the ready resources wait after the Aiven API responded with 429
+	apiRateLimitedTimeout = time.Minute
+)
+
+// aivenAPIBudget is shared by all controllers, see SetAivenAPIRateLimit
+var aivenAPIBudget = newAPIBudget(0)
+
+// SetAivenAPIRateLimit sets the number of reconciliations per minute all controllers share.
+// Zero disables the limit, but the ready resources still wait when the Aiven API responds with 429.
+func SetAivenAPIRateLimit(perMinute int) {
+	aivenAPIBudget = newAPIBudget(perMinute)
+}
+
+// apiBudget is a token bucket of the Aiven API calls.
+// Reconciliations of the resources that are not ready yet (create, update, delete) always proceed,
+// while the periodic resyncs of the ready ones are postponed when the budget runs low,
+// so steady-state polling can't starve new provisioning.
+type apiBudget struct {
+	mu sync.Mutex
+
+	// rate tokens per second, zero is unlimited
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+
+	// rateLimitedUntil is set when the Aiven API responds with 429
+	rateLimitedUntil time.Time
+
+	now func() time.Time
+}
+
+func newAPIBudget(perMinute int) *apiBudget {
+	return &apiBudget{
+		rate:   float64(perMinute) / 60,
+		burst:  float64(perMinute),
+		tokens: float64(perMinute),
+		now:    time.Now,
+	}
+}
+
+// setRate changes the rate of the budget, keeping the tokens it has.
+// A budget that was unlimited starts full
+func (b *apiBudget) setRate(perMinute int) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.rate == 0 {
+		b.tokens = float64(perMinute)
+	}
+	b.rate = float64(perMinute) / 60
+	b.burst = float64(perMinute)
+	if b.tokens > b.burst {
+		b.tokens = b.burst
+	}
+}
+
+// take returns true if the reconciliation can proceed.
+// Priority reconciliations always proceed, but consume the budget too.
+func (b *apiBudget) take(priority bool) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := b.now()
+	if b.rate > 0 {
+		if !b.last.IsZero() {
+			b.tokens += now.Sub(b.last).Seconds() * b.rate
+			if b.tokens > b.burst {
+				b.tokens = b.burst
+			}
+		}
+		b.last = now
+	}
+
+	if priority {
+		b.tokens--
+		return true
+	}
+
+	if now.Before(b.rateLimitedUntil) {
+		return false
+	}
+
+	if b.rate > 0 {
+		if b.tokens < b.burst*apiBudgetReserve {
+			return false
+		}
+		b.tokens--
+	}
+	return true
+}
+
+// observe postpones the ready resources reconciliations if the Aiven API has rate limited the request
+func (b *apiBudget) observe(err error) {
+	var e aiven.Error
+	if !errors.As(err, &e) || e.Status != http.StatusTooManyRequests {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.rateLimitedUntil = b.now().Add(apiRateLimitedTimeout)
+}
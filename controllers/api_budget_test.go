@@ -0,0 +1,69 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/aiven/aiven-go-client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPIBudget(t *testing.T) {
+	now := time.Now()
+	b := newAPIBudget(10)
+	b.now = func() time.Time { return now }
+
+	// Spends the budget down to the reserve
+	for i := 0; i < 9; i++ {
+		assert.True(t, b.take(false))
+	}
+	assert.False(t, b.take(false))
+
+	// Priority always proceeds
+	assert.True(t, b.take(true))
+	assert.True(t, b.take(true))
+
+	// 10 per minute, 6 seconds per token, the reserve is 2 tokens
+	now = now.Add(time.Minute)
+	assert.True(t, b.take(false))
+}
+
+func TestAPIBudgetRateLimited(t *testing.T) {
+	now := time.Now()
+	b := newAPIBudget(0)
+	b.now = func() time.Time { return now }
+
+	b.observe(fmt.Errorf("wrapped: %w", aiven.Error{Status: http.StatusBadRequest}))
+	assert.True(t, b.take(false))
+
+	b.observe(fmt.Errorf("wrapped: %w", aiven.Error{Status: http.StatusTooManyRequests}))
+	assert.False(t, b.take(false))
+	assert.True(t, b.take(true))
+
+	now = now.Add(apiRateLimitedTimeout)
+	assert.True(t, b.take(false))
+}
+
+func TestAPIBudgetSetRate(t *testing.T) {
+	now := time.Now()
+	b := newAPIBudget(0)
+	b.now = func() time.Time { return now }
+
+	// The unlimited budget starts full
+	b.setRate(5)
+	for i := 0; i < 5; i++ {
+		assert.True(t, b.take(false))
+	}
+	assert.False(t, b.take(false))
+
+	// Keeps the tokens it has
+	b.setRate(100)
+	assert.False(t, b.take(false))
+
+	b.setRate(0)
+	assert.True(t, b.take(false))
+}
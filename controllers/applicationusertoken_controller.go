@@ -0,0 +1,161 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+const (
+	eventTokenRotated           = "TokenRotated"
+	eventUnableToRotateToken    = "UnableToRotateToken"
+	eventUnableToRevokeOldToken = "UnableToRevokeOldToken"
+)
+
+// ApplicationUserTokenReconciler rotates the application user tokens
+type ApplicationUserTokenReconciler struct {
+	Controller
+}
+
+// +kubebuilder:rbac:groups=aiven.io,resources=applicationusertokens,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=aiven.io,resources=applicationusertokens/status,verbs=get;update;patch
+
+func (r *ApplicationUserTokenReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	t := &v1alpha1.ApplicationUserToken{}
+	if err := r.Get(ctx, req.NamespacedName, t); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	// The tokens are kept on deletion, so the Secret users don't break
+	if isMarkedForDeletion(t) {
+		return ctrl.Result{}, nil
+	}
+
+	// Saved with the status on the next rotation
+	t.UpdateConsoleURL()
+
+	period := t.Spec.RotationPeriod.Duration
+	if t.Status.LastRotationTime != nil {
+		if left := time.Until(t.Status.LastRotationTime.Add(period)); left > 0 {
+			return ctrl.Result{RequeueAfter: left}, nil
+		}
+	}
+
+	if err := r.rotate(ctx, t); err != nil {
+		r.Recorder.Event(t, corev1.EventTypeWarning, eventUnableToRotateToken, err.Error())
+		meta.SetStatusCondition(&t.Status.Conditions,
+			getRunningCondition(metav1.ConditionFalse, "Rotate", err.Error()))
+		if statusErr := r.Status().Update(ctx, t); statusErr != nil {
+			r.Log.Error(statusErr, "unable to update application user token status")
+		}
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{RequeueAfter: period}, nil
+}
+
+func (r *ApplicationUserTokenReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	// The status updates must not trigger the rotation
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&v1alpha1.ApplicationUserToken{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Complete(r)
+}
+
+// rotate creates a new token, verifies it, stores it in the Secret and only then revokes the old one
+func (r *ApplicationUserTokenReconciler) rotate(ctx context.Context, t *v1alpha1.ApplicationUserToken) error {
+	start := time.Now()
+	authToken, err := r.getAuthToken(ctx, t)
+	if err != nil {
+		return err
+	}
+
+	spec := t.Spec
+	api := newAivenAPI(authToken)
+	description := fmt.Sprintf("aiven-operator %s/%s", t.Namespace, t.Name)
+	token, err := api.createApplicationUserToken(spec.OrganizationID, spec.UserID, description, 2*spec.RotationPeriod.Duration)
+	if err != nil {
+		return fmt.Errorf("cannot create token: %w", err)
+	}
+
+	// Keeps the current token if the new one doesn't work
+	if err = newAivenAPI(token.FullToken).verifyToken(); err != nil {
+		if revokeErr := api.revokeApplicationUserToken(spec.OrganizationID, spec.UserID, token.TokenPrefix); revokeErr != nil {
+			r.Log.Error(revokeErr, "unable to revoke the unverified token", "tokenPrefix", token.TokenPrefix)
+		}
+		return fmt.Errorf("new token verification failed: %w", err)
+	}
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      spec.TokenSecretRef.Name,
+			Namespace: t.Namespace,
+		},
+	}
+
+	// No owner reference, the Secret is shared with other resources and outlives the rotation
+	_, err = controllerutil.CreateOrUpdate(ctx, r.Client, secret, func() error {
+		if secret.Data == nil {
+			secret.Data = make(map[string][]byte)
+		}
+		secret.Data[spec.TokenSecretRef.Key] = []byte(token.FullToken)
+		setSecretCacheLabel(secret, secretCacheLabelAuth)
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("cannot store token in secret %q: %w", spec.TokenSecretRef.Name, err)
+	}
+
+	oldPrefix := t.Status.TokenPrefix
+	now := metav1.Now()
+	t.Status.TokenPrefix = token.TokenPrefix
+	t.Status.LastRotationTime = &now
+	meta.SetStatusCondition(&t.Status.Conditions,
+		getInitializedCondition("Rotated", "Token is created and stored in the secret"))
+	meta.SetStatusCondition(&t.Status.Conditions,
+		getRunningCondition(metav1.ConditionTrue, "Rotated", "Token is verified"))
+	updateSyncStatus(t, start)
+
+	// Saves the new prefix before the revocation, so the new token is not lost if the revocation fails
+	if err = r.Status().Update(ctx, t); err != nil {
+		return err
+	}
+
+	r.Recorder.Eventf(t, corev1.EventTypeNormal, eventTokenRotated, "token %s is stored in secret %q", token.TokenPrefix, spec.TokenSecretRef.Name)
+	if oldPrefix == "" || oldPrefix == token.TokenPrefix {
+		return nil
+	}
+
+	if err = api.revokeApplicationUserToken(spec.OrganizationID, spec.UserID, oldPrefix); err != nil {
+		// The old token expires anyway
+		r.Recorder.Eventf(t, corev1.EventTypeWarning, eventUnableToRevokeOldToken, "unable to revoke token %s: %s", oldPrefix, err)
+	}
+	return nil
+}
+
+// getAuthToken returns the default token or the token from the auth secret
+func (r *ApplicationUserTokenReconciler) getAuthToken(ctx context.Context, t *v1alpha1.ApplicationUserToken) (string, error) {
+	if len(r.DefaultToken) > 0 {
+		return r.DefaultToken, nil
+	}
+
+	secret := &corev1.Secret{}
+	if err := r.Get(ctx, types.NamespacedName{Name: t.AuthSecretRef().Name, Namespace: t.Namespace}, secret); err != nil {
+		r.Recorder.Eventf(t, corev1.EventTypeWarning, eventUnableToGetAuthSecret, err.Error())
+		return "", fmt.Errorf("cannot get secret %q: %w", t.AuthSecretRef().Name, err)
+	}
+	return string(secret.Data[t.AuthSecretRef().Key]), nil
+}
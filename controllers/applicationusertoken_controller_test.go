@@ -0,0 +1,118 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"os"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+var _ = Describe("ApplicationUserToken Controller", func() {
+	// Define utility constants for object names and testing timeouts/durations and intervals.
+	const (
+		namespace = "default"
+
+		timeout  = time.Minute * 5
+		interval = time.Second * 10
+
+		tokenKey = "token"
+	)
+
+	var (
+		token      *v1alpha1.ApplicationUserToken
+		tokenName  string
+		secretName string
+		ctx        = context.Background()
+	)
+
+	BeforeEach(func() {
+		token = nil
+		organizationID := os.Getenv("AIVEN_ORGANIZATION_ID")
+		userID := os.Getenv("AIVEN_APPLICATION_USER_ID")
+		if organizationID == "" || userID == "" {
+			Skip("AIVEN_ORGANIZATION_ID and AIVEN_APPLICATION_USER_ID are required")
+		}
+
+		tokenName = "k8s-test-app-token-acc-" + generateRandomID()
+		secretName = tokenName + "-secret"
+		token = applicationUserTokenSpec(tokenName, secretName, tokenKey, organizationID, userID, namespace)
+
+		By("Creating a new ApplicationUserToken instance")
+		Expect(k8sClient.Create(ctx, token)).Should(Succeed())
+
+		By("by waiting the token to be rotated")
+		Eventually(func() bool {
+			err := k8sClient.Get(ctx, types.NamespacedName{Name: tokenName, Namespace: namespace}, token)
+			if err == nil {
+				return meta.IsStatusConditionTrue(token.Status.Conditions, conditionTypeRunning)
+			}
+			return false
+		}, timeout, interval).Should(BeTrue())
+	})
+
+	Context("Validating ApplicationUserToken reconciler behaviour", func() {
+		It("should store a working token in the secret", func() {
+			Expect(token.Status.TokenPrefix).NotTo(BeEmpty())
+			Expect(token.Status.LastRotationTime).NotTo(BeNil())
+
+			secret := &corev1.Secret{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: secretName, Namespace: namespace}, secret)).Should(Succeed())
+			Expect(secret.Data[tokenKey]).NotTo(BeEmpty())
+			Expect(newAivenAPI(string(secret.Data[tokenKey])).verifyToken()).Should(Succeed())
+		})
+	})
+
+	AfterEach(func() {
+		// Skipped
+		if token == nil {
+			return
+		}
+
+		By("Ensures that ApplicationUserToken instance was deleted")
+		ensureDelete(ctx, token)
+
+		By("Revokes the test token")
+		Expect(newAivenAPI(os.Getenv("AIVEN_TOKEN")).revokeApplicationUserToken(
+			token.Spec.OrganizationID, token.Spec.UserID, token.Status.TokenPrefix),
+		).Should(Succeed())
+
+		secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: secretName, Namespace: namespace}}
+		Expect(k8sClient.Delete(ctx, secret)).Should(Succeed())
+	})
+})
+
+func applicationUserTokenSpec(name, secretName, key, organizationID, userID, namespace string) *v1alpha1.ApplicationUserToken {
+	return &v1alpha1.ApplicationUserToken{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "aiven.io/v1alpha1",
+			Kind:       "ApplicationUserToken",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: v1alpha1.ApplicationUserTokenSpec{
+			OrganizationID: organizationID,
+			UserID:         userID,
+			TokenSecretRef: v1alpha1.AuthSecretReference{
+				Name: secretName,
+				Key:  key,
+			},
+			RotationPeriod: metav1.Duration{Duration: time.Hour},
+			AuthSecretRef: v1alpha1.AuthSecretReference{
+				Name: secretRefName,
+				Key:  secretRefKey,
+			},
+		},
+	}
+}
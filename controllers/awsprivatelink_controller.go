@@ -0,0 +1,158 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/aiven/aiven-go-client"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+// AWSPrivateLinkReconciler reconciles a AWSPrivateLink object
+type AWSPrivateLinkReconciler struct {
+	Controller
+}
+
+type AWSPrivateLinkHandler struct{}
+
+// +kubebuilder:rbac:groups=aiven.io,resources=awsprivatelinks,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=aiven.io,resources=awsprivatelinks/status,verbs=get;update;patch
+
+func (r *AWSPrivateLinkReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	return r.reconcileInstance(ctx, req, AWSPrivateLinkHandler{}, &v1alpha1.AWSPrivateLink{})
+}
+
+func (r *AWSPrivateLinkReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&v1alpha1.AWSPrivateLink{}).
+		WithOptions(priorityControllerOptions(&v1alpha1.AWSPrivateLink{})).
+		Complete(r)
+}
+
+func (h AWSPrivateLinkHandler) createOrUpdate(avn *aiven.Client, i client.Object, refs []client.Object) error {
+	link, err := h.convert(i)
+	if err != nil {
+		return err
+	}
+
+	reason := "Updated"
+	pl, err := avn.AWSPrivatelink.Get(link.Spec.Project, link.Spec.ServiceName)
+	switch {
+	case aiven.IsNotFound(err):
+		pl, err = avn.AWSPrivatelink.Create(link.Spec.Project, link.Spec.ServiceName, link.Spec.Principals)
+		reason = "Created"
+	case err == nil && !equalStringSets(pl.Principals, link.Spec.Principals):
+		pl, err = avn.AWSPrivatelink.Update(link.Spec.Project, link.Spec.ServiceName, link.Spec.Principals)
+	}
+	if err != nil {
+		return err
+	}
+
+	link.Status.State = pl.State
+	link.Status.AWSServiceID = pl.AWSServiceID
+	link.Status.AWSServiceName = pl.AWSServiceName
+
+	meta.SetStatusCondition(&link.Status.Conditions,
+		getInitializedCondition(reason,
+			"Instance was created or update on Aiven side"))
+
+	meta.SetStatusCondition(&link.Status.Conditions,
+		getRunningCondition(metav1.ConditionUnknown, reason,
+			"Instance was created or update on Aiven side, status remains unknown"))
+
+	metav1.SetMetaDataAnnotation(&link.ObjectMeta,
+		processedGenerationAnnotation, strconv.FormatInt(link.GetGeneration(), formatIntBaseDecimal))
+
+	return nil
+}
+
+// equalStringSets compares the values regardless of their order
+func equalStringSets(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	a = append([]string(nil), a...)
+	b = append([]string(nil), b...)
+	sort.Strings(a)
+	sort.Strings(b)
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func (h AWSPrivateLinkHandler) delete(avn *aiven.Client, i client.Object) (bool, error) {
+	link, err := h.convert(i)
+	if err != nil {
+		return false, err
+	}
+
+	err = avn.AWSPrivatelink.Delete(link.Spec.Project, link.Spec.ServiceName)
+	if err != nil && !aiven.IsNotFound(err) {
+		return false, fmt.Errorf("unable to delete AWS PrivateLink: %w", err)
+	}
+	return true, nil
+}
+
+func (h AWSPrivateLinkHandler) get(avn *aiven.Client, i client.Object) (*corev1.Secret, error) {
+	link, err := h.convert(i)
+	if err != nil {
+		return nil, err
+	}
+
+	pl, err := avn.AWSPrivatelink.Get(link.Spec.Project, link.Spec.ServiceName)
+	if err != nil {
+		return nil, err
+	}
+
+	link.Status.State = pl.State
+	link.Status.AWSServiceID = pl.AWSServiceID
+	link.Status.AWSServiceName = pl.AWSServiceName
+
+	// The endpoint service name is known once the PrivateLink is active
+	if pl.State != "active" {
+		return nil, nil
+	}
+
+	meta.SetStatusCondition(&link.Status.Conditions,
+		getRunningCondition(metav1.ConditionTrue, "CheckRunning",
+			"Instance is running on Aiven side"))
+
+	metav1.SetMetaDataAnnotation(&link.ObjectMeta, instanceIsRunningAnnotation, "true")
+
+	return nil, nil
+}
+
+func (h AWSPrivateLinkHandler) checkPreconditions(avn *aiven.Client, i client.Object) (bool, error) {
+	link, err := h.convert(i)
+	if err != nil {
+		return false, err
+	}
+
+	meta.SetStatusCondition(&link.Status.Conditions,
+		getInitializedCondition("Preconditions", "Checking preconditions"))
+
+	return checkServiceIsRunning(avn, link.Spec.Project, link.Spec.ServiceName)
+}
+
+func (h AWSPrivateLinkHandler) convert(i client.Object) (*v1alpha1.AWSPrivateLink, error) {
+	link, ok := i.(*v1alpha1.AWSPrivateLink)
+	if !ok {
+		return nil, fmt.Errorf("cannot convert object to AWSPrivateLink")
+	}
+
+	return link, nil
+}
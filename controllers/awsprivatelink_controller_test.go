@@ -0,0 +1,96 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"os"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+var _ = Describe("AWSPrivateLink Controller", func() {
+	const (
+		namespace = "default"
+		timeout   = time.Minute * 20
+		interval  = time.Second * 10
+	)
+
+	It("enables AWS PrivateLink for a service, updates its principals, and deletes it", func() {
+		// The service must be in a project VPC in AWS, which takes long to create
+		serviceName := os.Getenv("AIVEN_AWS_VPC_SERVICE_NAME")
+		if serviceName == "" {
+			Skip("AIVEN_AWS_VPC_SERVICE_NAME is required")
+		}
+
+		ctx := context.Background()
+		projectName := os.Getenv("AIVEN_PROJECT_NAME")
+		linkName := "k8s-test-aws-privatelink-acc-" + generateRandomID()
+		linkObj := awsPrivateLinkSpec(linkName, namespace, projectName, serviceName)
+		linkLookupKey := types.NamespacedName{Name: linkName, Namespace: namespace}
+
+		By("Creating a new AWSPrivateLink")
+		Expect(k8sClient.Create(ctx, linkObj)).Should(Succeed())
+
+		By("by waiting the PrivateLink to be active")
+		createdLink := &v1alpha1.AWSPrivateLink{}
+		Eventually(func() bool {
+			err := k8sClient.Get(ctx, linkLookupKey, createdLink)
+			return err == nil && createdLink.Status.State == "active"
+		}, timeout, interval).Should(BeTrue())
+		Expect(createdLink.Status.AWSServiceID).NotTo(BeEmpty())
+		Expect(createdLink.Status.AWSServiceName).NotTo(BeEmpty())
+
+		pl, err := aivenClient.AWSPrivatelink.Get(projectName, serviceName)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(pl.AWSServiceName).Should(Equal(createdLink.Status.AWSServiceName))
+		Expect(pl.Principals).Should(ConsistOf(linkObj.Spec.Principals))
+
+		By("Updating the principals")
+		createdLink.Spec.Principals = append(createdLink.Spec.Principals, "arn:aws:iam::012345678902:root")
+		Expect(k8sClient.Update(ctx, createdLink)).Should(Succeed())
+		Eventually(func() []string {
+			pl, err := aivenClient.AWSPrivatelink.Get(projectName, serviceName)
+			if err != nil {
+				return nil
+			}
+			return pl.Principals
+		}, timeout, interval).Should(ConsistOf(createdLink.Spec.Principals))
+
+		By("Deletes the PrivateLink")
+		Expect(k8sClient.Delete(ctx, createdLink)).Should(Succeed())
+		Eventually(func() bool {
+			err := k8sClient.Get(ctx, linkLookupKey, &v1alpha1.AWSPrivateLink{})
+			return apierrors.IsNotFound(err)
+		}, timeout, interval).Should(BeTrue())
+	})
+})
+
+func awsPrivateLinkSpec(name, namespace, projectName, serviceName string) *v1alpha1.AWSPrivateLink {
+	return &v1alpha1.AWSPrivateLink{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "aiven.io/v1alpha1",
+			Kind:       "AWSPrivateLink",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: v1alpha1.AWSPrivateLinkSpec{
+			Project:     projectName,
+			ServiceName: serviceName,
+			Principals:  []string{"arn:aws:iam::012345678901:root"},
+			AuthSecretRef: v1alpha1.AuthSecretReference{
+				Name: secretRefName,
+				Key:  secretRefKey,
+			},
+		},
+	}
+}
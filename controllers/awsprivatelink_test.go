@@ -0,0 +1,86 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/aiven/aiven-go-client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+// fakeAWSPrivatelinkAPI serves the AWS PrivateLink of a service, and records the changing requests
+type fakeAWSPrivatelinkAPI struct {
+	link    *aiven.AWSPrivatelinkResponse
+	methods []string
+}
+
+func (f *fakeAWSPrivatelinkAPI) RoundTrip(r *http.Request) (*http.Response, error) {
+	rsp := &http.Response{StatusCode: http.StatusOK, Header: make(http.Header), Request: r}
+	var out interface{} = f.link
+	switch {
+	case r.URL.Path != "/v1/project/foo/service/bar/privatelink/aws":
+		rsp.StatusCode = http.StatusNotFound
+	case r.Method == http.MethodPost || r.Method == http.MethodPut:
+		f.methods = append(f.methods, r.Method)
+		var in aiven.AWSPrivatelinkRequest
+		if err := json.NewDecoder(r.Body).Decode(&in); err != nil {
+			return nil, err
+		}
+		f.link = &aiven.AWSPrivatelinkResponse{State: "creating", Principals: in.Principals}
+		out = f.link
+	case f.link == nil:
+		rsp.StatusCode = http.StatusNotFound
+	}
+	if rsp.StatusCode == http.StatusNotFound {
+		out = map[string]string{"message": "Not found"}
+	}
+	b, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	rsp.Body = io.NopCloser(bytes.NewReader(b))
+	return rsp, nil
+}
+
+func TestAWSPrivateLinkCreateOrUpdate(t *testing.T) {
+	api := &fakeAWSPrivatelinkAPI{}
+	avn := newFakeAivenClient("token", api)
+	link := &v1alpha1.AWSPrivateLink{Spec: v1alpha1.AWSPrivateLinkSpec{
+		Project:     "foo",
+		ServiceName: "bar",
+		Principals:  []string{"arn:aws:iam::012345678901:root", "arn:aws:iam::012345678902:root"},
+	}}
+
+	// Creates the PrivateLink
+	require.NoError(t, AWSPrivateLinkHandler{}.createOrUpdate(avn, link, nil))
+	assert.Equal(t, []string{http.MethodPost}, api.methods)
+	assert.Equal(t, "creating", link.Status.State)
+
+	// The order of the principals doesn't matter
+	link.Spec.Principals = []string{"arn:aws:iam::012345678902:root", "arn:aws:iam::012345678901:root"}
+	require.NoError(t, AWSPrivateLinkHandler{}.createOrUpdate(avn, link, nil))
+	assert.Equal(t, []string{http.MethodPost}, api.methods)
+
+	// Updates the principals
+	link.Spec.Principals = []string{"arn:aws:iam::012345678901:root"}
+	require.NoError(t, AWSPrivateLinkHandler{}.createOrUpdate(avn, link, nil))
+	assert.Equal(t, []string{http.MethodPost, http.MethodPut}, api.methods)
+	assert.Equal(t, link.Spec.Principals, api.link.Principals)
+
+	// The endpoint service is known once it's active
+	api.link.State = "active"
+	api.link.AWSServiceID = "vpce-svc-0123456789abcdef0"
+	api.link.AWSServiceName = "com.amazonaws.vpce.eu-west-1.vpce-svc-0123456789abcdef0"
+	_, err := AWSPrivateLinkHandler{}.get(avn, link)
+	require.NoError(t, err)
+	assert.Equal(t, "com.amazonaws.vpce.eu-west-1.vpce-svc-0123456789abcdef0", link.Status.AWSServiceName)
+	assert.True(t, isAlreadyRunning(link))
+}
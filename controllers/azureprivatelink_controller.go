@@ -0,0 +1,201 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/aiven/aiven-go-client"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+const (
+	// azurePrivateLinkRefreshInterval picks up the private endpoints created since the last refresh
+	azurePrivateLinkRefreshInterval = time.Hour
+
+	// azurePrivateLinkPendingRefreshInterval follows the Private Link and its connections until they are active
+	azurePrivateLinkPendingRefreshInterval = 5 * time.Minute
+)
+
+// AzurePrivateLinkReconciler reconciles a AzurePrivateLink object
+type AzurePrivateLinkReconciler struct {
+	Controller
+}
+
+type AzurePrivateLinkHandler struct{}
+
+// +kubebuilder:rbac:groups=aiven.io,resources=azureprivatelinks,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=aiven.io,resources=azureprivatelinks/status,verbs=get;update;patch
+
+func (r *AzurePrivateLinkReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	return r.reconcileInstance(ctx, req, AzurePrivateLinkHandler{}, &v1alpha1.AzurePrivateLink{})
+}
+
+func (r *AzurePrivateLinkReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&v1alpha1.AzurePrivateLink{}).
+		WithOptions(priorityControllerOptions(&v1alpha1.AzurePrivateLink{})).
+		Complete(r)
+}
+
+func (h AzurePrivateLinkHandler) createOrUpdate(avn *aiven.Client, i client.Object, refs []client.Object) error {
+	link, err := h.convert(i)
+	if err != nil {
+		return err
+	}
+
+	req := aiven.AzurePrivatelinkRequest{UserSubscriptionIDs: link.Spec.SubscriptionIDs}
+	reason := "Updated"
+	pl, err := avn.AzurePrivatelink.Get(link.Spec.Project, link.Spec.ServiceName)
+	switch {
+	case aiven.IsNotFound(err):
+		pl, err = avn.AzurePrivatelink.Create(link.Spec.Project, link.Spec.ServiceName, req)
+		reason = "Created"
+	case err == nil && !equalStringSets(pl.UserSubscriptionIDs, link.Spec.SubscriptionIDs):
+		pl, err = avn.AzurePrivatelink.Update(link.Spec.Project, link.Spec.ServiceName, req)
+	}
+	if err != nil {
+		return err
+	}
+
+	h.setStatus(link, pl)
+
+	meta.SetStatusCondition(&link.Status.Conditions,
+		getInitializedCondition(reason,
+			"Instance was created or update on Aiven side"))
+
+	meta.SetStatusCondition(&link.Status.Conditions,
+		getRunningCondition(metav1.ConditionUnknown, reason,
+			"Instance was created or update on Aiven side, status remains unknown"))
+
+	metav1.SetMetaDataAnnotation(&link.ObjectMeta,
+		processedGenerationAnnotation, strconv.FormatInt(link.GetGeneration(), formatIntBaseDecimal))
+
+	return nil
+}
+
+func (h AzurePrivateLinkHandler) delete(avn *aiven.Client, i client.Object) (bool, error) {
+	link, err := h.convert(i)
+	if err != nil {
+		return false, err
+	}
+
+	err = avn.AzurePrivatelink.Delete(link.Spec.Project, link.Spec.ServiceName)
+	if err != nil && !aiven.IsNotFound(err) {
+		return false, fmt.Errorf("unable to delete Azure Private Link: %w", err)
+	}
+	return true, nil
+}
+
+func (h AzurePrivateLinkHandler) get(avn *aiven.Client, i client.Object) (*corev1.Secret, error) {
+	link, err := h.convert(i)
+	if err != nil {
+		return nil, err
+	}
+
+	pl, err := avn.AzurePrivatelink.Get(link.Spec.Project, link.Spec.ServiceName)
+	if err != nil {
+		return nil, err
+	}
+	h.setStatus(link, pl)
+
+	if pl.State != "active" {
+		return nil, nil
+	}
+
+	// Aiven finds the new private endpoints on refresh only
+	err = avn.AzurePrivatelink.Refresh(link.Spec.Project, link.Spec.ServiceName)
+	if err != nil {
+		return nil, fmt.Errorf("unable to refresh Azure Private Link connections: %w", err)
+	}
+
+	connections, err := avn.AzurePrivatelink.ConnectionsList(link.Spec.Project, link.Spec.ServiceName)
+	if err != nil {
+		return nil, err
+	}
+	link.Status.Connections = newAzurePrivateLinkConnections(connections.Connections)
+
+	meta.SetStatusCondition(&link.Status.Conditions,
+		getRunningCondition(metav1.ConditionTrue, "CheckRunning",
+			"Instance is running on Aiven side"))
+
+	metav1.SetMetaDataAnnotation(&link.ObjectMeta, instanceIsRunningAnnotation, "true")
+
+	return nil, nil
+}
+
+func (h AzurePrivateLinkHandler) setStatus(link *v1alpha1.AzurePrivateLink, pl *aiven.AzurePrivatelinkResponse) {
+	link.Status.State = pl.State
+	link.Status.AzureServiceID = pl.AzureServiceID
+	link.Status.AzureServiceAlias = pl.AzureServiceAlias
+	link.Status.Message = pl.Message
+}
+
+// newAzurePrivateLinkConnections returns the state of the connections
+func newAzurePrivateLinkConnections(connections []aiven.AzurePrivatelinkConnectionResponse) []v1alpha1.AzurePrivateLinkConnection {
+	if len(connections) == 0 {
+		return nil
+	}
+
+	result := make([]v1alpha1.AzurePrivateLinkConnection, 0, len(connections))
+	for _, c := range connections {
+		result = append(result, v1alpha1.AzurePrivateLinkConnection{
+			ID:                c.PrivatelinkConnectionID,
+			PrivateEndpointID: c.PrivateEndpointID,
+			State:             c.State,
+			UserIPAddress:     c.UserIPAddress,
+		})
+	}
+	return result
+}
+
+// resyncAfter refreshes the Private Link often while it or its connections are pending
+func (h AzurePrivateLinkHandler) resyncAfter(o client.Object) time.Duration {
+	link, err := h.convert(o)
+	if err != nil || link.Status.State == "" {
+		return 0
+	}
+	return jitter(azurePrivateLinkResyncInterval(&link.Status))
+}
+
+func azurePrivateLinkResyncInterval(status *v1alpha1.AzurePrivateLinkStatus) time.Duration {
+	if status.State != "active" {
+		return azurePrivateLinkPendingRefreshInterval
+	}
+	for _, c := range status.Connections {
+		if c.State != "active" {
+			return azurePrivateLinkPendingRefreshInterval
+		}
+	}
+	return azurePrivateLinkRefreshInterval
+}
+
+func (h AzurePrivateLinkHandler) checkPreconditions(avn *aiven.Client, i client.Object) (bool, error) {
+	link, err := h.convert(i)
+	if err != nil {
+		return false, err
+	}
+
+	meta.SetStatusCondition(&link.Status.Conditions,
+		getInitializedCondition("Preconditions", "Checking preconditions"))
+
+	return checkServiceIsRunning(avn, link.Spec.Project, link.Spec.ServiceName)
+}
+
+func (h AzurePrivateLinkHandler) convert(i client.Object) (*v1alpha1.AzurePrivateLink, error) {
+	link, ok := i.(*v1alpha1.AzurePrivateLink)
+	if !ok {
+		return nil, fmt.Errorf("cannot convert object to AzurePrivateLink")
+	}
+
+	return link, nil
+}
@@ -0,0 +1,97 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"os"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+var _ = Describe("AzurePrivateLink Controller", func() {
+	const (
+		namespace = "default"
+		timeout   = time.Minute * 20
+		interval  = time.Second * 10
+	)
+
+	It("enables Azure Private Link for a service, updates its subscriptions, and deletes it", func() {
+		// The service must be in a project VPC in Azure, which takes long to create
+		serviceName := os.Getenv("AIVEN_AZURE_VPC_SERVICE_NAME")
+		subscriptionID := os.Getenv("AIVEN_AZURE_SUBSCRIPTION_ID")
+		if serviceName == "" || subscriptionID == "" {
+			Skip("AIVEN_AZURE_VPC_SERVICE_NAME and AIVEN_AZURE_SUBSCRIPTION_ID are required")
+		}
+
+		ctx := context.Background()
+		projectName := os.Getenv("AIVEN_PROJECT_NAME")
+		linkName := "k8s-test-azure-privatelink-acc-" + generateRandomID()
+		linkObj := azurePrivateLinkSpec(linkName, namespace, projectName, serviceName, subscriptionID)
+		linkLookupKey := types.NamespacedName{Name: linkName, Namespace: namespace}
+
+		By("Creating a new AzurePrivateLink")
+		Expect(k8sClient.Create(ctx, linkObj)).Should(Succeed())
+
+		By("by waiting the PrivateLink to be active")
+		createdLink := &v1alpha1.AzurePrivateLink{}
+		Eventually(func() bool {
+			err := k8sClient.Get(ctx, linkLookupKey, createdLink)
+			return err == nil && createdLink.Status.State == "active"
+		}, timeout, interval).Should(BeTrue())
+		Expect(createdLink.Status.AzureServiceID).NotTo(BeEmpty())
+		Expect(createdLink.Status.AzureServiceAlias).NotTo(BeEmpty())
+
+		pl, err := aivenClient.AzurePrivatelink.Get(projectName, serviceName)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(pl.AzureServiceAlias).Should(Equal(createdLink.Status.AzureServiceAlias))
+		Expect(pl.UserSubscriptionIDs).Should(ConsistOf(linkObj.Spec.SubscriptionIDs))
+
+		By("Updating the subscriptions")
+		createdLink.Spec.SubscriptionIDs = append(createdLink.Spec.SubscriptionIDs, "00000000-0000-0000-0000-000000000001")
+		Expect(k8sClient.Update(ctx, createdLink)).Should(Succeed())
+		Eventually(func() []string {
+			pl, err := aivenClient.AzurePrivatelink.Get(projectName, serviceName)
+			if err != nil {
+				return nil
+			}
+			return pl.UserSubscriptionIDs
+		}, timeout, interval).Should(ConsistOf(createdLink.Spec.SubscriptionIDs))
+
+		By("Deletes the Private Link")
+		Expect(k8sClient.Delete(ctx, createdLink)).Should(Succeed())
+		Eventually(func() bool {
+			err := k8sClient.Get(ctx, linkLookupKey, &v1alpha1.AzurePrivateLink{})
+			return apierrors.IsNotFound(err)
+		}, timeout, interval).Should(BeTrue())
+	})
+})
+
+func azurePrivateLinkSpec(name, namespace, projectName, serviceName, subscriptionID string) *v1alpha1.AzurePrivateLink {
+	return &v1alpha1.AzurePrivateLink{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "aiven.io/v1alpha1",
+			Kind:       "AzurePrivateLink",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: v1alpha1.AzurePrivateLinkSpec{
+			Project:         projectName,
+			ServiceName:     serviceName,
+			SubscriptionIDs: []string{subscriptionID},
+			AuthSecretRef: v1alpha1.AuthSecretReference{
+				Name: secretRefName,
+				Key:  secretRefKey,
+			},
+		},
+	}
+}
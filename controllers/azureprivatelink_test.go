@@ -0,0 +1,83 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/aiven/aiven-go-client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+// fakeAzurePrivatelinkAPI serves an active Azure Private Link of a service with its connections
+type fakeAzurePrivatelinkAPI struct {
+	connections []aiven.AzurePrivatelinkConnectionResponse
+	refreshed   int
+}
+
+func (f *fakeAzurePrivatelinkAPI) RoundTrip(r *http.Request) (*http.Response, error) {
+	rsp := &http.Response{StatusCode: http.StatusOK, Header: make(http.Header), Request: r}
+	var out interface{}
+	switch r.Method + " " + r.URL.Path {
+	case "GET /v1/project/foo/service/bar/privatelink/azure":
+		out = &aiven.AzurePrivatelinkResponse{
+			State:               "active",
+			AzureServiceAlias:   "bar-privatelink.0123.westeurope.azure.privatelinkservice",
+			UserSubscriptionIDs: []string{"00000000-0000-0000-0000-000000000001"},
+		}
+	case "POST /v1/project/foo/service/bar/privatelink/azure/refresh":
+		f.refreshed++
+		out = map[string]string{}
+	case "GET /v1/project/foo/service/bar/privatelink/azure/connections":
+		out = map[string]interface{}{"connections": f.connections}
+	default:
+		rsp.StatusCode = http.StatusNotFound
+		out = map[string]string{"message": "Not found"}
+	}
+	b, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	rsp.Body = io.NopCloser(bytes.NewReader(b))
+	return rsp, nil
+}
+
+func TestAzurePrivateLinkGet(t *testing.T) {
+	api := &fakeAzurePrivatelinkAPI{connections: []aiven.AzurePrivatelinkConnectionResponse{
+		{PrivatelinkConnectionID: "plc1", PrivateEndpointID: "/subscriptions/1/pe1", State: "active", UserIPAddress: "10.0.0.5"},
+		{PrivatelinkConnectionID: "plc2", PrivateEndpointID: "/subscriptions/1/pe2", State: "pending-user-approval"},
+	}}
+	avn := newFakeAivenClient("token", api)
+	link := &v1alpha1.AzurePrivateLink{Spec: v1alpha1.AzurePrivateLinkSpec{Project: "foo", ServiceName: "bar"}}
+
+	_, err := AzurePrivateLinkHandler{}.get(avn, link)
+	require.NoError(t, err)
+	assert.Equal(t, 1, api.refreshed)
+	assert.Equal(t, "active", link.Status.State)
+	assert.Equal(t, "bar-privatelink.0123.westeurope.azure.privatelinkservice", link.Status.AzureServiceAlias)
+	assert.Equal(t, []v1alpha1.AzurePrivateLinkConnection{
+		{ID: "plc1", PrivateEndpointID: "/subscriptions/1/pe1", State: "active", UserIPAddress: "10.0.0.5"},
+		{ID: "plc2", PrivateEndpointID: "/subscriptions/1/pe2", State: "pending-user-approval"},
+	}, link.Status.Connections)
+	assert.True(t, isAlreadyRunning(link))
+
+	// The pending connection is followed closely
+	assert.Equal(t, azurePrivateLinkPendingRefreshInterval, azurePrivateLinkResyncInterval(&link.Status))
+	link.Status.Connections[1].State = "active"
+	assert.Equal(t, azurePrivateLinkRefreshInterval, azurePrivateLinkResyncInterval(&link.Status))
+	assert.Equal(t, time.Duration(0), AzurePrivateLinkHandler{}.resyncAfter(&v1alpha1.AzurePrivateLink{}))
+}
+
+func TestEqualStringSets(t *testing.T) {
+	assert.True(t, equalStringSets([]string{"a", "b"}, []string{"b", "a"}))
+	assert.False(t, equalStringSets([]string{"a", "b"}, []string{"a"}))
+	assert.False(t, equalStringSets([]string{"a", "a"}, []string{"a", "b"}))
+}
@@ -0,0 +1,254 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/aiven/aiven-go-client"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+// conditionTypePeeringPending tells which step of a multi-stage peering is pending
+const conditionTypePeeringPending = "PeeringPending"
+
+// AzureVNetPeeringConnectionReconciler reconciles a AzureVNetPeeringConnection object
+type AzureVNetPeeringConnectionReconciler struct {
+	Controller
+}
+
+type AzureVNetPeeringConnectionHandler struct{}
+
+// +kubebuilder:rbac:groups=aiven.io,resources=azurevnetpeeringconnections,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=aiven.io,resources=azurevnetpeeringconnections/status,verbs=get;update;patch
+
+func (r *AzureVNetPeeringConnectionReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	return r.reconcileInstance(ctx, req, AzureVNetPeeringConnectionHandler{}, &v1alpha1.AzureVNetPeeringConnection{})
+}
+
+func (r *AzureVNetPeeringConnectionReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&v1alpha1.AzureVNetPeeringConnection{}).
+		WithOptions(priorityControllerOptions(&v1alpha1.AzureVNetPeeringConnection{})).
+		Complete(r)
+}
+
+func (h AzureVNetPeeringConnectionHandler) createOrUpdate(avn *aiven.Client, i client.Object, refs []client.Object) error {
+	peering, err := h.convert(i)
+	if err != nil {
+		return err
+	}
+
+	vpcID := peeringVPCID(peering.Spec.VPCID, refs)
+	if vpcID == "" {
+		return fmt.Errorf("the project VPC has no ID yet")
+	}
+
+	// The peering connection is immutable, an existing one is adopted
+	reason := "Updated"
+	_, pc, err := h.getPeeringConnection(avn, peering, vpcID)
+	if err != nil {
+		return err
+	}
+	if pc == nil {
+		pc, err = avn.VPCPeeringConnections.Create(peering.Spec.Project, vpcID, aiven.CreateVPCPeeringConnectionRequest{
+			PeerCloudAccount:  peering.Spec.AzureSubscriptionID,
+			PeerVPC:           peering.Spec.VNetName,
+			PeerResourceGroup: peering.Spec.ResourceGroup,
+			PeerAzureAppId:    peering.Spec.AzureAppID,
+			PeerAzureTenantId: peering.Spec.AzureTenantID,
+		})
+		if err != nil {
+			return err
+		}
+		reason = "Created"
+	}
+
+	peering.Status.VPCID = vpcID
+	peering.Status.State = pc.State
+
+	meta.SetStatusCondition(&peering.Status.Conditions,
+		getInitializedCondition(reason,
+			"Instance was created or update on Aiven side"))
+
+	meta.SetStatusCondition(&peering.Status.Conditions,
+		getRunningCondition(metav1.ConditionUnknown, reason,
+			"Instance was created or update on Aiven side, status remains unknown"))
+
+	metav1.SetMetaDataAnnotation(&peering.ObjectMeta,
+		processedGenerationAnnotation, strconv.FormatInt(peering.GetGeneration(), formatIntBaseDecimal))
+
+	return nil
+}
+
+func (h AzureVNetPeeringConnectionHandler) delete(avn *aiven.Client, i client.Object) (bool, error) {
+	peering, err := h.convert(i)
+	if err != nil {
+		return false, err
+	}
+
+	if peering.Status.VPCID == "" {
+		return true, nil
+	}
+
+	_, pc, err := h.getPeeringConnection(avn, peering, peering.Status.VPCID)
+	if aiven.IsNotFound(err) {
+		return true, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	if pc == nil || pc.State == "DELETED" {
+		return true, nil
+	}
+	if pc.State == "DELETING" {
+		return false, nil
+	}
+
+	err = avn.VPCPeeringConnections.DeleteVPCPeeringWithResourceGroup(peering.Spec.Project, peering.Status.VPCID,
+		peering.Spec.AzureSubscriptionID, peering.Spec.VNetName, peering.Spec.ResourceGroup, nil)
+	if aiven.IsNotFound(err) {
+		return true, nil
+	}
+	return false, err
+}
+
+func (h AzureVNetPeeringConnectionHandler) get(avn *aiven.Client, i client.Object) (*corev1.Secret, error) {
+	peering, err := h.convert(i)
+	if err != nil {
+		return nil, err
+	}
+
+	if peering.Status.VPCID == "" {
+		return nil, nil
+	}
+
+	vpc, pc, err := h.getPeeringConnection(avn, peering, peering.Status.VPCID)
+	if err != nil {
+		return nil, err
+	}
+	if pc == nil {
+		return nil, fmt.Errorf("peering connection to virtual network %q of resource group %q is not found", peering.Spec.VNetName, peering.Spec.ResourceGroup)
+	}
+
+	peering.Status.State = pc.State
+	peering.Status.ToNetworkID, peering.Status.ToTenantID = azurePeeringTarget(pc)
+	c := getAzurePeeringPendingCondition(peering, vpc, pc)
+	meta.SetStatusCondition(&peering.Status.Conditions, c)
+	peering.Status.Message = ""
+	if c.Status == metav1.ConditionTrue {
+		peering.Status.Message = c.Message
+	}
+
+	if pc.State != "ACTIVE" {
+		return nil, nil
+	}
+
+	meta.SetStatusCondition(&peering.Status.Conditions,
+		getRunningCondition(metav1.ConditionTrue, "CheckRunning",
+			"Instance is running on Aiven side"))
+
+	metav1.SetMetaDataAnnotation(&peering.ObjectMeta, instanceIsRunningAnnotation, "true")
+
+	return nil, nil
+}
+
+// getPeeringConnection returns the VPC and its peering connection to the virtual network, nil if there is none
+func (h AzureVNetPeeringConnectionHandler) getPeeringConnection(avn *aiven.Client, peering *v1alpha1.AzureVNetPeeringConnection, vpcID string) (*aiven.VPC, *aiven.VPCPeeringConnection, error) {
+	return getVPCPeeringConnection(avn, peering.Spec.Project, vpcID, func(pc *aiven.VPCPeeringConnection) bool {
+		return pc.PeerCloudAccount == peering.Spec.AzureSubscriptionID &&
+			pc.PeerVPC == peering.Spec.VNetName &&
+			stringValue(pc.PeerResourceGroup) == peering.Spec.ResourceGroup
+	})
+}
+
+// azurePeeringTarget returns the Aiven virtual network and its tenant the peer virtual network is peered with.
+// Aiven tells them once its side of the peering is created
+func azurePeeringTarget(pc *aiven.VPCPeeringConnection) (string, string) {
+	if pc.StateInfo == nil {
+		return "", ""
+	}
+
+	info := *pc.StateInfo
+	network, _ := info["to-network-id"].(string)
+	tenant, _ := info["to-tenant-id"].(string)
+	return network, tenant
+}
+
+// getAzurePeeringPendingCondition tells which step of the peering is pending:
+// Aiven validates the access of the application to the virtual network, creates its side of the peering,
+// and then the peering is created from the virtual network to the Aiven one
+func getAzurePeeringPendingCondition(peering *v1alpha1.AzureVNetPeeringConnection, vpc *aiven.VPC, pc *aiven.VPCPeeringConnection) metav1.Condition {
+	c := metav1.Condition{
+		Type:   conditionTypePeeringPending,
+		Status: metav1.ConditionTrue,
+	}
+
+	// The messages of the failures include the details Aiven returns
+	status := newProjectVPCPeeringConnection(vpc, pc)
+	switch pc.State {
+	case "ACTIVE":
+		c.Status = metav1.ConditionFalse
+		c.Reason = "Active"
+		c.Message = "The peering connection is active"
+	case "PENDING_VALIDATION":
+		c.Reason = "AivenValidation"
+		c.Message = fmt.Sprintf("Aiven is checking that the application %q can access the virtual network %q", peering.Spec.AzureAppID, peering.Spec.VNetName)
+	case "APPROVED":
+		c.Reason = "AivenPeering"
+		c.Message = "Aiven is creating its side of the peering"
+	case "PENDING_PEER":
+		c.Reason = "AzurePeering"
+		c.Message = fmt.Sprintf("Create the peering from the virtual network %q to the Aiven virtual network %s, signed in to the Aiven tenant %s with the application %q",
+			peering.Spec.VNetName, peering.Status.ToNetworkID, peering.Status.ToTenantID, peering.Spec.AzureAppID)
+	case "INVALID_SPECIFICATION":
+		c.Reason = "InvalidSpecification"
+		c.Message = status.Message + ". The application must have a role that allows peering on the virtual network, " +
+			"and a service principal of the Aiven application in the tenant"
+	case "REJECTED_BY_PEER":
+		c.Reason = "RejectedByPeer"
+		c.Message = status.Message
+	case "DELETED_BY_PEER":
+		c.Reason = "DeletedByPeer"
+		c.Message = status.Message
+	default:
+		c.Reason = "Unknown"
+		c.Message = fmt.Sprintf("The peering connection is %s", pc.State)
+		if status.Message != "" {
+			c.Message += ". " + status.Message
+		}
+	}
+	return c
+}
+
+// resyncAfter refreshes the state of the peering connection, which is accepted or deleted on Azure side
+func (h AzureVNetPeeringConnectionHandler) resyncAfter(o client.Object) time.Duration {
+	peering, err := h.convert(o)
+	if err != nil {
+		return 0
+	}
+	return vpcPeeringResyncAfter(peering.Status.VPCID, peering.Status.State)
+}
+
+func (h AzureVNetPeeringConnectionHandler) checkPreconditions(_ *aiven.Client, _ client.Object) (bool, error) {
+	return true, nil
+}
+
+func (h AzureVNetPeeringConnectionHandler) convert(i client.Object) (*v1alpha1.AzureVNetPeeringConnection, error) {
+	peering, ok := i.(*v1alpha1.AzureVNetPeeringConnection)
+	if !ok {
+		return nil, fmt.Errorf("cannot convert object to AzureVNetPeeringConnection")
+	}
+
+	return peering, nil
+}
@@ -0,0 +1,103 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"os"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+var _ = Describe("AzureVNetPeeringConnection Controller", func() {
+	const (
+		namespace = "default"
+		timeout   = time.Minute * 20
+		interval  = time.Second * 10
+	)
+
+	It("creates a peering connection of a ProjectVPC, and deletes it", func() {
+		tenantID := os.Getenv("AIVEN_AZURE_TENANT_ID")
+		subscriptionID := os.Getenv("AIVEN_AZURE_SUBSCRIPTION_ID")
+		appID := os.Getenv("AIVEN_AZURE_APP_ID")
+		if tenantID == "" || subscriptionID == "" || appID == "" {
+			Skip("AIVEN_AZURE_TENANT_ID, AIVEN_AZURE_SUBSCRIPTION_ID and AIVEN_AZURE_APP_ID are required")
+		}
+
+		ctx := context.Background()
+		projectName := os.Getenv("AIVEN_PROJECT_NAME")
+		vpcName := "k8s-test-project-vpc-acc-" + generateRandomID()
+		peeringName := "k8s-test-azure-peering-acc-" + generateRandomID()
+		vpcObj := projectVPC(vpcName, namespace, projectName)
+		peeringObj := azureVNetPeeringConnectionSpec(peeringName, namespace, projectName, vpcName, tenantID, subscriptionID, appID)
+		peeringLookupKey := types.NamespacedName{Name: peeringName, Namespace: namespace}
+
+		By("Creating a new ProjectVPC and a peering connection of it")
+		Expect(k8sClient.Create(ctx, vpcObj)).Should(Succeed())
+		Expect(k8sClient.Create(ctx, peeringObj)).Should(Succeed())
+
+		By("by waiting the peering connection to be created on Aiven side")
+		createdPeering := &v1alpha1.AzureVNetPeeringConnection{}
+		Eventually(func() string {
+			err := k8sClient.Get(ctx, peeringLookupKey, createdPeering)
+			if err != nil {
+				return ""
+			}
+			return createdPeering.Status.State
+		}, timeout, interval).Should(BeElementOf("PENDING_PEER", "INVALID_SPECIFICATION"))
+		Expect(createdPeering.Status.VPCID).NotTo(BeEmpty())
+		Expect(createdPeering.Status.Message).NotTo(BeEmpty())
+
+		pending := meta.FindStatusCondition(createdPeering.Status.Conditions, conditionTypePeeringPending)
+		Expect(pending).NotTo(BeNil())
+		Expect(pending.Status).Should(Equal(metav1.ConditionTrue))
+		Expect(pending.Reason).Should(BeElementOf("AzurePeering", "InvalidSpecification"))
+
+		By("Deletes the peering connection, and the VPC after it")
+		Expect(k8sClient.Delete(ctx, peeringObj)).Should(Succeed())
+		Eventually(func() bool {
+			err := k8sClient.Get(ctx, peeringLookupKey, &v1alpha1.AzureVNetPeeringConnection{})
+			return apierrors.IsNotFound(err)
+		}, timeout, interval).Should(BeTrue())
+
+		Expect(k8sClient.Delete(ctx, vpcObj)).Should(Succeed())
+		Eventually(func() bool {
+			err := k8sClient.Get(ctx, types.NamespacedName{Name: vpcName, Namespace: namespace}, &v1alpha1.ProjectVPC{})
+			return apierrors.IsNotFound(err)
+		}, timeout, interval).Should(BeTrue())
+	})
+})
+
+func azureVNetPeeringConnectionSpec(name, namespace, projectName, vpcName, tenantID, subscriptionID, appID string) *v1alpha1.AzureVNetPeeringConnection {
+	return &v1alpha1.AzureVNetPeeringConnection{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "aiven.io/v1alpha1",
+			Kind:       "AzureVNetPeeringConnection",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: v1alpha1.AzureVNetPeeringConnectionSpec{
+			Project:             projectName,
+			ProjectVPCRef:       &v1alpha1.ResourceReference{Name: vpcName, Namespace: namespace},
+			AzureTenantID:       tenantID,
+			AzureSubscriptionID: subscriptionID,
+			ResourceGroup:       "k8s-test-group",
+			VNetName:            "k8s-test-vnet",
+			AzureAppID:          appID,
+			AuthSecretRef: v1alpha1.AuthSecretReference{
+				Name: secretRefName,
+				Key:  secretRefKey,
+			},
+		},
+	}
+}
@@ -0,0 +1,92 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"testing"
+
+	"github.com/aiven/aiven-go-client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+func TestAzureVNetPeeringConnectionGet(t *testing.T) {
+	resourceGroup := "my-group"
+	otherGroup := "other-group"
+	api := &fakeVPCAPI{vpc: &aiven.VPC{
+		ProjectVPCID: "vpc1",
+		NetworkCIDR:  "10.0.0.0/24",
+		PeeringConnections: []*aiven.VPCPeeringConnection{
+			{PeerCloudAccount: "my-subscription", PeerVPC: "my-vnet", PeerResourceGroup: &otherGroup, State: "ACTIVE"},
+			{PeerCloudAccount: "my-subscription", PeerVPC: "my-vnet", PeerResourceGroup: &resourceGroup, State: "PENDING_VALIDATION"},
+		},
+	}}
+	avn := newFakeAivenClient("token", api)
+
+	peering := &v1alpha1.AzureVNetPeeringConnection{
+		Spec: v1alpha1.AzureVNetPeeringConnectionSpec{
+			Project:             "foo",
+			AzureSubscriptionID: "my-subscription",
+			ResourceGroup:       resourceGroup,
+			VNetName:            "my-vnet",
+			AzureAppID:          "my-app",
+		},
+		Status: v1alpha1.AzureVNetPeeringConnectionStatus{VPCID: "vpc1"},
+	}
+	pending := func() *metav1.Condition {
+		return meta.FindStatusCondition(peering.Status.Conditions, conditionTypePeeringPending)
+	}
+
+	// Aiven validates the access of the application
+	_, err := AzureVNetPeeringConnectionHandler{}.get(avn, peering)
+	require.NoError(t, err)
+	assert.Equal(t, "PENDING_VALIDATION", peering.Status.State)
+	require.NotNil(t, pending())
+	assert.Equal(t, metav1.ConditionTrue, pending().Status)
+	assert.Equal(t, "AivenValidation", pending().Reason)
+	assert.Equal(t, pending().Message, peering.Status.Message)
+	assert.False(t, isAlreadyRunning(peering))
+
+	// The peering from the Azure side is missing
+	api.vpc.PeeringConnections[1].State = "PENDING_PEER"
+	api.vpc.PeeringConnections[1].StateInfo = &map[string]interface{}{"to-network-id": "/subscriptions/aiven/vnet", "to-tenant-id": "aiven-tenant"}
+	_, err = AzureVNetPeeringConnectionHandler{}.get(avn, peering)
+	require.NoError(t, err)
+	assert.Equal(t, "/subscriptions/aiven/vnet", peering.Status.ToNetworkID)
+	assert.Equal(t, "aiven-tenant", peering.Status.ToTenantID)
+	assert.Equal(t, "AzurePeering", pending().Reason)
+	assert.Equal(t, `Create the peering from the virtual network "my-vnet" to the Aiven virtual network /subscriptions/aiven/vnet, `+
+		`signed in to the Aiven tenant aiven-tenant with the application "my-app"`, peering.Status.Message)
+	assert.False(t, isAlreadyRunning(peering))
+
+	// Both sides are peered
+	api.vpc.PeeringConnections[1].State = "ACTIVE"
+	_, err = AzureVNetPeeringConnectionHandler{}.get(avn, peering)
+	require.NoError(t, err)
+	assert.Equal(t, metav1.ConditionFalse, pending().Status)
+	assert.Equal(t, "Active", pending().Reason)
+	assert.Empty(t, peering.Status.Message)
+	assert.True(t, isAlreadyRunning(peering))
+}
+
+func TestAzurePeeringPendingCondition(t *testing.T) {
+	vpc := &aiven.VPC{NetworkCIDR: "10.0.0.0/24"}
+	peering := &v1alpha1.AzureVNetPeeringConnection{}
+	cases := map[string]string{
+		"APPROVED":              "AivenPeering",
+		"INVALID_SPECIFICATION": "InvalidSpecification",
+		"REJECTED_BY_PEER":      "RejectedByPeer",
+		"DELETED_BY_PEER":       "DeletedByPeer",
+		"DELETING":              "Unknown",
+	}
+	for state, reason := range cases {
+		c := getAzurePeeringPendingCondition(peering, vpc, &aiven.VPCPeeringConnection{State: state})
+		assert.Equal(t, metav1.ConditionTrue, c.Status, state)
+		assert.Equal(t, reason, c.Reason, state)
+		assert.NotEmpty(t, c.Message, state)
+	}
+}
@@ -13,6 +13,8 @@ import (
 	"github.com/liip/sheriff"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
@@ -38,6 +40,9 @@ type (
 		Scheme       *runtime.Scheme
 		Recorder     record.EventRecorder
 		DefaultToken string
+
+		// AivenClientFactory creates the Aiven clients, DefaultAivenClientFactory if nil
+		AivenClientFactory AivenClientFactory
 	}
 
 	// Handlers represents Aiven API handlers
@@ -61,23 +66,73 @@ type (
 		checkPreconditions(*aiven.Client, client.Object) (bool, error)
 	}
 
+	// postponingHandler postpones some changes of the instance,
+	// which are applied when it's reconciled after the duration
+	postponingHandler interface {
+		postponedFor(client.Object) time.Duration
+	}
+
+	// resyncingHandler knows when the instance changes on Aiven side, e.g. after a maintenance,
+	// and is resynced then
+	resyncingHandler interface {
+		resyncAfter(client.Object) time.Duration
+	}
+
+	// refsChangingHandler applies the referenced resources to the instance,
+	// which is updated again when they change
+	refsChangingHandler interface {
+		refsChanged(o client.Object, refs []client.Object) bool
+	}
+
 	aivenManagedObject interface {
 		client.Object
 
 		AuthSecretRef() v1alpha1.AuthSecretReference
 	}
 
+	// connInfoSecretObject has the connection secret settings
+	connInfoSecretObject interface {
+		client.Object
+
+		GetConnInfoSecretTarget() v1alpha1.ConnInfoSecretTarget
+	}
+
 	// refsObject returns references to dependent resources
 	refsObject interface {
 		client.Object
 
 		GetRefs() []*v1alpha1.ResourceReferenceObject
 	}
+
+	// serviceRefObject refers to a service resource, which could be in another namespace
+	serviceRefObject interface {
+		client.Object
+
+		GetServiceRef() *v1alpha1.ServiceReference
+		GetProjectAndServiceName() (string, string)
+	}
+
+	// consoleURLObject links to its page in the Aiven Console in the status
+	consoleURLObject interface {
+		client.Object
+
+		UpdateConsoleURL()
+	}
+
+	// syncStatusObject tells in the status when it was last reconciled successfully
+	syncStatusObject interface {
+		client.Object
+
+		GetSyncStatus() *v1alpha1.SyncStatus
+	}
 )
 
 const (
 	// Lifecycle event types we expose to the user
 	eventUnableToGetAuthSecret              = "UnableToGetAuthSecret"
+	eventUnableToGetReferencedService       = "UnableToGetReferencedService"
+	eventChangesFrozen                      = "ChangesFrozen"
+	eventCloudFallback                      = "CloudFallback"
 	eventUnableToCreateClient               = "UnableToCreateClient"
 	eventReconciliationStarted              = "ReconcilationStarted"
 	eventTryingToDeleteAtAiven              = "TryingToDeleteAtAiven"
@@ -102,40 +157,139 @@ const (
 // +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
 
 func (c *Controller) reconcileInstance(ctx context.Context, req ctrl.Request, h Handlers, o aivenManagedObject) (ctrl.Result, error) {
+	start := time.Now()
 	if err := c.Get(ctx, req.NamespacedName, o); err != nil {
+		if apierrors.IsNotFound(err) {
+			forgetPriority(o, req)
+		}
 		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
 
 	instanceLogger := setupLogger(c.Log, o)
+	rec := newOwnerEventRecorder(c.Recorder)
+	rememberPriority(o)
+	if !isValidPriority(o) {
+		rec.Eventf(o, corev1.EventTypeWarning, eventInvalidPriority, "invalid %s annotation %q, must be high, normal or low", priorityAnnotation, o.GetAnnotations()[priorityAnnotation])
+	}
+
+	// The objects the older operator versions left are brought up to date first
+	migrated, err := migrateState(ctx, c.Client, o)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if migrated {
+		instanceLogger.Info("object state migrated", "version", objectStateVersion(o))
+		return ctrl.Result{Requeue: true}, nil
+	}
+
+	// Nothing changes on Aiven side while paused, not even the expiry or the deletion.
+	// Removing the annotation updates the instance, which reconciles it again
+	if isPaused(o) {
+		instanceLogger.Info("reconciliation is paused with the annotation")
+		rec.Eventf(o, corev1.EventTypeNormal, eventReconciliationPaused, "reconciliation is paused, remove the %s annotation to resume", pausedAnnotation)
+		return ctrl.Result{}, nil
+	}
+
+	expired, expiresIn, err := c.checkExpiry(ctx, o)
+	if err != nil || expired {
+		return ctrl.Result{}, err
+	}
+
+	if c.checkReconcileNow(o, rec) {
+		instanceLogger.Info("reconciliation requested with the annotation", "value", o.GetAnnotations()[reconcileNowAnnotation])
+	}
+
+	// Periodic resyncs of the ready instances yield to the ones that are in progress
+	ready := !isMarkedForDeletion(o) && isAlreadyProcessed(o) && isAlreadyRunning(o)
+	if ready {
+		if delay := startupResyncDelay(o, time.Now()); delay > 0 {
+			instanceLogger.Info("postponing the first reconciliation of the ready instance", "delay", delay)
+			return requeueBeforeExpiry(ctrl.Result{RequeueAfter: delay}, expiresIn), nil
+		}
+	}
+
+	// The instances of a deleted or inaccessible project are retried rarely, instead of failing on every reconciliation
+	project := objectProject(o)
+	if project != "" && !isMarkedForDeletion(o) {
+		if d := unavailableProjects.unavailableFor(project); d > 0 {
+			instanceLogger.Info("project is unavailable, postponing reconciliation", "project", project, "after", d)
+			result, err := c.suspendForUnavailableProject(ctx, o, project, d, nil)
+			return requeueBeforeExpiry(result, expiresIn), err
+		}
+	}
+
+	// The high priority instances proceed like the ones in progress
+	if !aivenAPIBudget.take(!ready || getPriority(o) == priorityHigh) {
+		instanceLogger.Info("aiven api budget is low, postponing reconciliation of the ready instance")
+		return ctrl.Result{RequeueAfter: requeueTimeout}, nil
+	}
+
 	instanceLogger.Info("setting up aiven client with instance secret")
 
+	// A resource without its own token uses the token of the referenced service
+	authSecretRef, authNamespace := o.AuthSecretRef(), req.Namespace
+	if refObj, ok := o.(serviceRefObject); ok {
+		service, err := getReferencedService(ctx, c.Client, refObj)
+		if err != nil {
+			// The resources of a deleted service are gone on Aiven side too
+			if isMarkedForDeletion(o) && apierrors.IsNotFound(err) {
+				instanceLogger.Info("referenced service is deleted, removing finalizer")
+				return ctrl.Result{}, removeFinalizer(ctx, c.Client, o, instanceDeletionFinalizer)
+			}
+			rec.Event(o, corev1.EventTypeWarning, eventUnableToGetReferencedService, err.Error())
+			return ctrl.Result{}, fmt.Errorf("cannot get referenced service: %w", err)
+		}
+		if service != nil && !authSecretRef.IsValid() {
+			authSecretRef, authNamespace = service.AuthSecretRef(), service.GetNamespace()
+		}
+	}
+
 	var token string
 	var clientAuthSecret *corev1.Secret
 	if len(c.DefaultToken) > 0 {
 		token = c.DefaultToken
 	} else {
-		clientAuthSecret = &corev1.Secret{}
-		if err := c.Get(ctx, types.NamespacedName{Name: o.AuthSecretRef().Name, Namespace: req.Namespace}, clientAuthSecret); err != nil {
-			c.Recorder.Eventf(o, corev1.EventTypeWarning, eventUnableToGetAuthSecret, err.Error())
-			return ctrl.Result{}, fmt.Errorf("cannot get secret %q: %w", o.AuthSecretRef().Name, err)
+		secret := &corev1.Secret{}
+		if err := c.Get(ctx, types.NamespacedName{Name: authSecretRef.Name, Namespace: authNamespace}, secret); err != nil {
+			rec.Eventf(o, corev1.EventTypeWarning, eventUnableToGetAuthSecret, err.Error())
+			return ctrl.Result{}, fmt.Errorf("cannot get secret %q: %w", authSecretRef.Name, err)
+		}
+		token = string(secret.Data[authSecretRef.Key])
+
+		// The secret of the referenced service is protected by the service
+		if authNamespace == req.Namespace {
+			clientAuthSecret = secret
 		}
-		token = string(clientAuthSecret.Data[o.AuthSecretRef().Key])
 	}
 
-	avn, err := aiven.NewTokenClient(token, operatorUserAgent)
+	avn, err := c.newAivenClient(token)
 	if err != nil {
-		c.Recorder.Event(o, corev1.EventTypeWarning, eventUnableToCreateClient, err.Error())
+		rec.Event(o, corev1.EventTypeWarning, eventUnableToCreateClient, err.Error())
 		return ctrl.Result{}, fmt.Errorf("cannot initialize aiven client: %w", err)
 	}
 
-	return instanceReconcilerHelper{
-		avn: avn,
-		k8s: c.Client,
-		h:   h,
-		log: instanceLogger,
-		s:   clientAuthSecret,
-		rec: c.Recorder,
+	result, err := instanceReconcilerHelper{
+		avn:   avn,
+		k8s:   c.Client,
+		h:     h,
+		log:   instanceLogger,
+		s:     clientAuthSecret,
+		rec:   rec,
+		start: start,
 	}.reconcileInstance(ctx, o)
+	aivenAPIBudget.observe(err)
+	if project != "" && !isMarkedForDeletion(o) {
+		if err == nil {
+			err = c.clearProjectUnavailable(ctx, o)
+		} else if isProjectAccessError(err) {
+			if reason := checkProjectAvailable(avn, project); reason != nil {
+				instanceLogger.Info("project is unavailable, suspending its instances", "project", project, "after", projectUnavailableRetry)
+				unavailableProjects.markUnavailable(project)
+				result, err = c.suspendForUnavailableProject(ctx, o, project, projectUnavailableRetry, reason)
+			}
+		}
+	}
+	return requeueBeforeExpiry(result, expiresIn), err
 }
 
 // a helper that closes over all instance specific fields
@@ -157,6 +311,9 @@ type instanceReconcilerHelper struct {
 
 	// rec, recorder to record events for the object
 	rec record.EventRecorder
+
+	// start, time the reconciliation started
+	start time.Time
 }
 
 func (i instanceReconcilerHelper) reconcileInstance(ctx context.Context, o client.Object) (ctrl.Result, error) {
@@ -173,8 +330,10 @@ func (i instanceReconcilerHelper) reconcileInstance(ctx context.Context, o clien
 	// Add finalizers to an instance and associated secret, only if they haven't
 	// been added in the previous reconciliation loops
 	if i.s != nil {
-		if !controllerutil.ContainsFinalizer(i.s, secretProtectionFinalizer) {
+		if !controllerutil.ContainsFinalizer(i.s, secretProtectionFinalizer) || !hasSecretCacheLabel(i.s) {
 			i.log.Info("adding finalizer to secret")
+			// The label makes the operator cache the secret
+			setSecretCacheLabel(i.s, secretCacheLabelAuth)
 			if err := addFinalizer(ctx, i.k8s, i.s, secretProtectionFinalizer); err != nil {
 				return ctrl.Result{}, fmt.Errorf("unable to add finalizer to secret: %w", err)
 			}
@@ -207,7 +366,7 @@ func (i instanceReconcilerHelper) reconcileInstance(ctx context.Context, o clien
 		return ctrl.Result{}, err
 	}
 
-	if !isAlreadyProcessed(o) {
+	if !isAlreadyProcessed(o) || i.refsChanged(o, refs) {
 		i.rec.Event(o, corev1.EventTypeNormal, eventCreateOrUpdatedAtAiven, "about to create instance at aiven")
 		if err := i.createOrUpdateInstance(o, refs); err != nil {
 			i.rec.Event(o, corev1.EventTypeWarning, eventUnableToCreateOrUpdateAtAiven, err.Error())
@@ -242,7 +401,26 @@ func (i instanceReconcilerHelper) reconcileInstance(ctx context.Context, o clien
 	i.rec.Event(o, corev1.EventTypeNormal, eventInstanceIsRunning, "instance is in a RUNNING state")
 	i.log.Info("instance was successfully reconciled")
 
-	return ctrl.Result{}, nil
+	result := ctrl.Result{}
+	if p, ok := i.h.(postponingHandler); ok {
+		if d := p.postponedFor(o); d > 0 {
+			i.log.Info("some changes are postponed, requeueing", "after", d)
+			result.RequeueAfter = d
+		}
+	}
+
+	if r, ok := i.h.(resyncingHandler); ok {
+		if d := r.resyncAfter(o); d > 0 && (result.RequeueAfter == 0 || d < result.RequeueAfter) {
+			i.log.Info("scheduling a resync", "after", d)
+			result.RequeueAfter = d
+		}
+	}
+
+	// The OperatorConfig resync interval, the controller cache resyncs rarely
+	if d := getOperatorConfig().resyncInterval; d > 0 && (result.RequeueAfter == 0 || d < result.RequeueAfter) {
+		result.RequeueAfter = jitter(d)
+	}
+	return result, nil
 }
 
 func (i instanceReconcilerHelper) checkPreconditions(ctx context.Context, o client.Object, refs []client.Object) (bool, error) {
@@ -274,6 +452,16 @@ func (i instanceReconcilerHelper) checkPreconditions(ctx context.Context, o clie
 	return false, nil
 }
 
+// refsChanged returns true if the instance must be updated on Aiven side because the referenced resources changed
+func (i instanceReconcilerHelper) refsChanged(o client.Object, refs []client.Object) bool {
+	h, ok := i.h.(refsChangingHandler)
+	if !ok || !h.refsChanged(o, refs) {
+		return false
+	}
+	i.log.Info("referenced resources changed, updating the instance")
+	return true
+}
+
 func (i instanceReconcilerHelper) getObjectRefs(ctx context.Context, o client.Object) ([]client.Object, error) {
 	refsObj, ok := o.(refsObject)
 	if !ok {
@@ -326,11 +514,12 @@ func (i instanceReconcilerHelper) finalize(ctx context.Context, o client.Object)
 	// If the deletion failed, don't remove the finalizer so that we can retry during the next reconciliation.
 	// Unless the error is invalid token and resource is not running, in that case we remove the finalizer
 	// and let the instance be deleted.
+	// The error is returned, so the retries back off and the reason shows up, e.g. the termination protection
 	if err != nil {
 		if i.isInvalidTokenError(err) && !isAlreadyRunning(o) {
 			i.log.Info("invalid token error on deletion, removing finalizer", "apiError", err)
 			finalised = true
-		} else if aiven.IsNotFound(err) {
+		} else {
 			i.rec.Event(o, corev1.EventTypeWarning, eventUnableToDeleteAtAiven, err.Error())
 			return ctrl.Result{}, fmt.Errorf("unable to delete instance at aiven: %w", err)
 		}
@@ -370,11 +559,15 @@ func (i instanceReconcilerHelper) createOrUpdateInstance(o client.Object, refs [
 	a := o.GetAnnotations()
 	delete(a, processedGenerationAnnotation)
 	delete(a, instanceIsRunningAnnotation)
+	delete(a, runningConfirmedAnnotation)
 
 	if err := i.h.createOrUpdate(i.avn, o, refs); err != nil {
 		return fmt.Errorf("unable to create or update aiven instance: %w", err)
 	}
 
+	// The confirmation is for the applied update only, the next downsize must be confirmed again
+	delete(o.GetAnnotations(), v1alpha1.ConfirmDownsizeAnnotation)
+
 	i.log.Info(
 		"processed instance, updating annotations",
 		"generation", o.GetGeneration(),
@@ -400,29 +593,60 @@ func (i instanceReconcilerHelper) updateInstanceStateAndSecretUntilRunning(ctx c
 		o.SetResourceVersion(clone.GetResourceVersion())
 
 		// It's ready to cast its status
-		err = multierror.Append(err, i.k8s.Status().Update(ctx, o))
+		statusErr := i.k8s.Status().Update(ctx, o)
+		if apierrors.IsInvalid(statusErr) {
+			i.reportStatusSchemaRejection(o, statusErr)
+		}
+		err = multierror.Append(err, statusErr)
 		err = err.(*multierror.Error).ErrorOrNil()
 	}()
 
+	if c, ok := o.(consoleURLObject); ok {
+		c.UpdateConsoleURL()
+	}
+
 	serviceSecret, err := i.h.get(i.avn, o)
 	if err != nil {
 		return false, err
 	} else if serviceSecret != nil {
-		if err = i.createOrUpdateSecret(ctx, o, serviceSecret); err != nil {
-			return false, fmt.Errorf("unable to create or update aiven secret: %w", err)
+		for _, secret := range splitCertSecret(o, serviceSecret) {
+			if err = i.createOrUpdateSecret(ctx, o, secret); err != nil {
+				return false, fmt.Errorf("unable to create or update aiven secret: %w", err)
+			}
 		}
 	}
-	return isAlreadyRunning(o), nil
+
+	running := isAlreadyRunning(o)
+	if running {
+		updateRunningCheckpoint(o)
+		updateSyncStatus(o, i.start)
+	}
+	return running, nil
 
 }
 
 func (i instanceReconcilerHelper) createOrUpdateSecret(ctx context.Context, owner client.Object, want *corev1.Secret) error {
 	_, err := controllerutil.CreateOrUpdate(ctx, i.k8s, want, func() error {
+		setSecretCacheLabel(want, secretCacheLabelConnection)
 		return ctrl.SetControllerReference(owner, want, i.k8s.Scheme())
 	})
 	return err
 }
 
+// updateSyncStatus records the successful reconciliation that started at the time
+func updateSyncStatus(o client.Object, start time.Time) {
+	s, ok := o.(syncStatusObject)
+	if !ok {
+		return
+	}
+
+	now := metav1.Now()
+	status := s.GetSyncStatus()
+	status.LastSyncTime = &now
+	status.LastSyncDuration = now.Sub(start).Round(time.Millisecond).String()
+	status.OperatorVersion = operatorVersion
+}
+
 func setupLogger(log logr.Logger, o client.Object) logr.Logger {
 	a := make(map[string]string)
 	if r, ok := o.GetAnnotations()[instanceIsRunningAnnotation]; ok {
@@ -3,12 +3,21 @@
 package controllers
 
 import (
+	"context"
+	"errors"
 	"reflect"
 	"testing"
 
+	"github.com/aiven/aiven-go-client"
 	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
 )
 
 func Test_ensureSecretDataIsNotEmpty(t *testing.T) {
@@ -101,3 +110,65 @@ func Test_ensureSecretDataIsNotEmpty(t *testing.T) {
 		})
 	}
 }
+
+// createOrUpdateHandler fails the updates with err, the other calls do nothing
+type createOrUpdateHandler struct {
+	err error
+}
+
+func (h createOrUpdateHandler) createOrUpdate(*aiven.Client, client.Object, []client.Object) error {
+	return h.err
+}
+
+func (createOrUpdateHandler) delete(*aiven.Client, client.Object) (bool, error) {
+	return true, nil
+}
+
+func (createOrUpdateHandler) get(*aiven.Client, client.Object) (*corev1.Secret, error) {
+	return nil, nil
+}
+
+func (createOrUpdateHandler) checkPreconditions(*aiven.Client, client.Object) (bool, error) {
+	return true, nil
+}
+
+func TestCreateOrUpdateInstanceClearsDownsizeConfirmation(t *testing.T) {
+	pg := &v1alpha1.PostgreSQL{}
+	pg.Annotations = map[string]string{v1alpha1.ConfirmDownsizeAnnotation: "true"}
+
+	// Kept until the update is applied
+	i := instanceReconcilerHelper{h: createOrUpdateHandler{err: errors.New("boom")}, log: logr.Discard()}
+	require.Error(t, i.createOrUpdateInstance(pg, nil))
+	assert.Contains(t, pg.Annotations, v1alpha1.ConfirmDownsizeAnnotation)
+
+	i.h = createOrUpdateHandler{}
+	require.NoError(t, i.createOrUpdateInstance(pg, nil))
+	assert.NotContains(t, pg.Annotations, v1alpha1.ConfirmDownsizeAnnotation)
+}
+
+// deleteHandler fails the deletions with err
+type deleteHandler struct {
+	createOrUpdateHandler
+	err error
+}
+
+func (h deleteHandler) delete(*aiven.Client, client.Object) (bool, error) {
+	return false, h.err
+}
+
+func TestFinalizeReportsDeleteError(t *testing.T) {
+	db := &v1alpha1.Database{}
+	recorder := record.NewFakeRecorder(10)
+	i := instanceReconcilerHelper{
+		h:   deleteHandler{err: errDatabaseTerminationProtection},
+		log: logr.Discard(),
+		rec: recorder,
+	}
+
+	// The finalizer is kept, the error is returned and told in a warning event
+	_, err := i.finalize(context.Background(), db)
+	assert.ErrorIs(t, err, errDatabaseTerminationProtection)
+	require.Len(t, recorder.Events, 2)
+	<-recorder.Events
+	assert.Equal(t, "Warning UnableToDeleteAtAiven "+errDatabaseTerminationProtection.Error(), <-recorder.Events)
+}
@@ -26,27 +26,29 @@ type CassandraReconciler struct {
 // +kubebuilder:rbac:groups=aiven.io,resources=cassandras/finalizers,verbs=update
 
 func (r *CassandraReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
-	return r.reconcileInstance(ctx, req, newGenericServiceHandler(newCassandraAdapter), &v1alpha1.Cassandra{})
+	return r.reconcileInstance(ctx, req, newGenericServiceHandler(newCassandraAdapter, r.Recorder), &v1alpha1.Cassandra{})
 }
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *CassandraReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&v1alpha1.Cassandra{}).
+		WithOptions(priorityControllerOptions(&v1alpha1.Cassandra{})).
 		Owns(&corev1.Secret{}).
 		Complete(r)
 }
 
-func newCassandraAdapter(_ *aiven.Client, object client.Object) (serviceAdapter, error) {
+func newCassandraAdapter(avn *aiven.Client, object client.Object) (serviceAdapter, error) {
 	cassandra, ok := object.(*v1alpha1.Cassandra)
 	if !ok {
 		return nil, fmt.Errorf("object is not of type v1alpha1.Cassandra")
 	}
-	return &cassandraAdapter{cassandra}, nil
+	return &cassandraAdapter{avn: avn, Cassandra: cassandra}, nil
 }
 
 // cassandraAdapter handles an Aiven Cassandra service
 type cassandraAdapter struct {
+	avn *aiven.Client
 	*v1alpha1.Cassandra
 }
 
@@ -72,6 +74,16 @@ func (a *cassandraAdapter) newSecret(s *aiven.Service) (*corev1.Secret, error) {
 		name = a.Name
 	}
 
+	// CQL connections require TLS, the drivers verify the server with the project CA
+	var caCert string
+	if !a.Spec.ConnInfoSecretTarget.OmitCACert {
+		var err error
+		caCert, err = a.avn.CA.Get(a.getServiceCommonSpec().Project)
+		if err != nil {
+			return nil, fmt.Errorf("aiven client error %w", err)
+		}
+	}
+
 	stringData := map[string]string{
 		"CASSANDRA_HOST":     s.URIParams["host"],
 		"CASSANDRA_PORT":     s.URIParams["port"],
@@ -79,6 +91,7 @@ func (a *cassandraAdapter) newSecret(s *aiven.Service) (*corev1.Secret, error) {
 		"CASSANDRA_PASSWORD": s.URIParams["password"],
 		"CASSANDRA_URI":      s.URI,
 		"CASSANDRA_HOSTS":    strings.Join(s.ConnectionInfo.CassandraHosts, ","),
+		"CASSANDRA_CA_CERT":  caCert,
 	}
 
 	// Removes empties
@@ -88,6 +101,10 @@ func (a *cassandraAdapter) newSecret(s *aiven.Service) (*corev1.Secret, error) {
 		}
 	}
 
+	if a.Spec.ConnInfoSecretTarget.TLSKeys && caCert != "" {
+		stringData["ca.crt"] = caCert
+	}
+
 	return &corev1.Secret{
 		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: a.Namespace},
 		StringData: stringData,
@@ -101,3 +118,7 @@ func (a *cassandraAdapter) getServiceType() string {
 func (a *cassandraAdapter) getDiskSpace() string {
 	return a.Spec.DiskSpace
 }
+
+func (a *cassandraAdapter) getAdditionalDiskSpace() string {
+	return a.Spec.AdditionalDiskSpace
+}
@@ -86,6 +86,7 @@ var _ = Describe("Cassandra Controller", func() {
 			Expect(createdSecret.Data["CASSANDRA_PASSWORD"]).NotTo(BeEmpty())
 			Expect(createdSecret.Data["CASSANDRA_URI"]).NotTo(BeEmpty())
 			Expect(createdSecret.Data["CASSANDRA_HOSTS"]).NotTo(BeEmpty())
+			Expect(createdSecret.Data["CASSANDRA_CA_CERT"]).NotTo(BeEmpty())
 
 			// User config test
 			Expect(*createdCassandra.Spec.UserConfig.MigrateSstableloader).Should(Equal(true))
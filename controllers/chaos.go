@@ -0,0 +1,125 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aiven/aiven-go-client"
+)
+
+// chaosErrorMessage is the body of the injected error responses, so they are told apart from the real ones in the logs
+const chaosErrorMessage = `{"message": "Injected by the operator chaos mode", "errors": [{"message": "Injected by the operator chaos mode", "status": 503}]}`
+
+var (
+	// chaosErrorRate is the share of the Aiven API requests that fail, from 0 to 1
+	chaosErrorRate float64
+
+	// chaosLatency is the maximum delay added to the Aiven API requests
+	chaosLatency time.Duration
+
+	// chaosRand is shared by the clients, rand.Rand is not safe for concurrent use
+	chaosRand   = rand.New(rand.NewSource(time.Now().UnixNano()))
+	chaosRandMu sync.Mutex
+)
+
+// SetAivenChaos makes the Aiven API requests fail at the error rate and adds a random delay up to the latency.
+// It is meant for testing the alerts, retries and GitOps health checks against the operator failures,
+// never enable it in production. Zero values disable it.
+func SetAivenChaos(errorRate float64, latency time.Duration) error {
+	if errorRate < 0 || errorRate > 1 {
+		return fmt.Errorf("invalid chaos error rate %v, must be from 0 to 1", errorRate)
+	}
+	if latency < 0 {
+		return fmt.Errorf("invalid chaos latency %s, must not be negative", latency)
+	}
+
+	chaosErrorRate = errorRate
+	chaosLatency = latency
+	return nil
+}
+
+func isChaosEnabled() bool {
+	return chaosErrorRate > 0 || chaosLatency > 0
+}
+
+// newAivenClient creates an Aiven client, which injects the failures when the chaos mode is on
+func newAivenClient(token string) (*aiven.Client, error) {
+	avn, err := aiven.NewTokenClient(token, operatorUserAgent)
+	if err != nil {
+		return nil, err
+	}
+
+	if isChaosEnabled() {
+		// Copies the http client, in case it is shared
+		c := *avn.Client
+		c.Transport = newChaosTransport(c.Transport)
+		avn.Client = &c
+	}
+	return avn, nil
+}
+
+// chaosTransport delays the requests and replaces the responses with errors
+type chaosTransport struct {
+	next      http.RoundTripper
+	errorRate float64
+	latency   time.Duration
+	random    func() float64
+}
+
+func newChaosTransport(next http.RoundTripper) http.RoundTripper {
+	if !isChaosEnabled() {
+		return next
+	}
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	return &chaosTransport{
+		next:      next,
+		errorRate: chaosErrorRate,
+		latency:   chaosLatency,
+		random: func() float64 {
+			chaosRandMu.Lock()
+			defer chaosRandMu.Unlock()
+			return chaosRand.Float64()
+		},
+	}
+}
+
+func (t *chaosTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.latency > 0 {
+		delay := time.Duration(t.random() * float64(t.latency))
+		select {
+		case <-time.After(delay):
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		}
+	}
+
+	if t.errorRate > 0 && t.random() < t.errorRate {
+		// The request is not sent, but the transport must close the body anyway
+		if req.Body != nil {
+			_ = req.Body.Close()
+		}
+		chaosInjectedErrors.WithLabelValues(req.Method).Inc()
+		return &http.Response{
+			Status:        "503 Service Unavailable",
+			StatusCode:    http.StatusServiceUnavailable,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        http.Header{"Content-Type": []string{"application/json"}},
+			Body:          io.NopCloser(strings.NewReader(chaosErrorMessage)),
+			ContentLength: int64(len(chaosErrorMessage)),
+			Request:       req,
+		}, nil
+	}
+	return t.next.RoundTrip(req)
+}
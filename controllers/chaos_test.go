@@ -0,0 +1,62 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetAivenChaos(t *testing.T) {
+	defer func() { require.NoError(t, SetAivenChaos(0, 0)) }()
+
+	assert.Error(t, SetAivenChaos(-0.1, 0))
+	assert.Error(t, SetAivenChaos(1.1, 0))
+	assert.Error(t, SetAivenChaos(0, -time.Second))
+
+	require.NoError(t, SetAivenChaos(0, 0))
+	assert.False(t, isChaosEnabled())
+	assert.Nil(t, newChaosTransport(nil), "the default transport is kept")
+
+	require.NoError(t, SetAivenChaos(0.5, time.Second))
+	assert.True(t, isChaosEnabled())
+	assert.IsType(t, &chaosTransport{}, newChaosTransport(nil))
+}
+
+func TestChaosTransport(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+	}))
+	defer server.Close()
+
+	random := 0.0
+	transport := &chaosTransport{
+		next:      server.Client().Transport,
+		errorRate: 0.3,
+		latency:   100 * time.Millisecond,
+		random:    func() float64 { return random },
+	}
+	c := &http.Client{Transport: transport}
+
+	// Below the rate, the error is injected without the delay
+	random = 0.2
+	rsp, err := c.Get(server.URL)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusServiceUnavailable, rsp.StatusCode)
+	assert.Equal(t, 0, calls)
+
+	// Above the rate, the request is delayed and sent
+	random = 0.5
+	start := time.Now()
+	rsp, err = c.Get(server.URL)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rsp.StatusCode)
+	assert.Equal(t, 1, calls)
+	assert.GreaterOrEqual(t, time.Since(start), 50*time.Millisecond)
+}
@@ -25,13 +25,14 @@ type ClickhouseReconciler struct {
 //+kubebuilder:rbac:groups=aiven.io,resources=clickhouses/finalizers,verbs=update
 
 func (r *ClickhouseReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
-	return r.reconcileInstance(ctx, req, newGenericServiceHandler(newClickhouseAdapter), &v1alpha1.Clickhouse{})
+	return r.reconcileInstance(ctx, req, newGenericServiceHandler(newClickhouseAdapter, r.Recorder), &v1alpha1.Clickhouse{})
 }
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *ClickhouseReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&v1alpha1.Clickhouse{}).
+		WithOptions(priorityControllerOptions(&v1alpha1.Clickhouse{})).
 		Owns(&corev1.Secret{}).
 		Complete(r)
 }
@@ -98,3 +99,7 @@ func (a *clickhouseAdapter) getServiceType() string {
 func (a *clickhouseAdapter) getDiskSpace() string {
 	return a.Spec.DiskSpace
 }
+
+func (a *clickhouseAdapter) getAdditionalDiskSpace() string {
+	return a.Spec.AdditionalDiskSpace
+}
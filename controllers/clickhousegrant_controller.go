@@ -0,0 +1,422 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/aiven/aiven-go-client"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+// ClickhouseGrantReconciler reconciles a ClickhouseGrant object
+type ClickhouseGrantReconciler struct {
+	Controller
+}
+
+type ClickhouseGrantHandler struct{}
+
+// +kubebuilder:rbac:groups=aiven.io,resources=clickhousegrants,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=aiven.io,resources=clickhousegrants/status,verbs=get;update;patch
+
+func (r *ClickhouseGrantReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	return r.reconcileInstance(ctx, req, ClickhouseGrantHandler{}, &v1alpha1.ClickhouseGrant{})
+}
+
+func (r *ClickhouseGrantReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&v1alpha1.ClickhouseGrant{}).
+		WithOptions(priorityControllerOptions(&v1alpha1.ClickhouseGrant{})).
+		Complete(r)
+}
+
+func (h ClickhouseGrantHandler) createOrUpdate(avn *aiven.Client, i client.Object, refs []client.Object) error {
+	g, err := h.convert(i)
+	if err != nil {
+		return err
+	}
+
+	s, err := avn.Services.Get(g.Spec.Project, g.Spec.ServiceName)
+	if err != nil {
+		return err
+	}
+	if s.Type != "clickhouse" {
+		return fmt.Errorf("ClickhouseGrant can be used with ClickHouse services only, got %q service type", s.Type)
+	}
+
+	// The roles of roleRefs are created by now, they must be on the same service
+	for _, r := range refs {
+		role, ok := r.(*v1alpha1.ClickhouseRole)
+		if ok && (role.Spec.Project != g.Spec.Project || role.Spec.ServiceName != g.Spec.ServiceName) {
+			return fmt.Errorf("roleRefs ClickhouseRole %q is a role of service %q of project %q, but the grant is for service %q of project %q",
+				role.Name, role.Spec.ServiceName, role.Spec.Project, g.Spec.ServiceName, g.Spec.Project)
+		}
+	}
+
+	_, err = syncClickhouseGrants(avn, &g.Spec)
+	if err != nil {
+		return err
+	}
+
+	meta.SetStatusCondition(&g.Status.Conditions,
+		getInitializedCondition("Updated",
+			"Instance was created or update on Aiven side"))
+
+	meta.SetStatusCondition(&g.Status.Conditions,
+		getRunningCondition(metav1.ConditionUnknown, "Updated",
+			"Instance was created or update on Aiven side, status remains unknown"))
+
+	metav1.SetMetaDataAnnotation(&g.ObjectMeta,
+		processedGenerationAnnotation, strconv.FormatInt(g.GetGeneration(), formatIntBaseDecimal))
+
+	return nil
+}
+
+func (h ClickhouseGrantHandler) delete(avn *aiven.Client, i client.Object) (bool, error) {
+	g, err := h.convert(i)
+	if err != nil {
+		return false, err
+	}
+
+	// The grants are gone with the service
+	_, err = avn.Services.Get(g.Spec.Project, g.Spec.ServiceName)
+	if aiven.IsNotFound(err) {
+		return true, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	// Revokes all the grants of the grantee, as if the spec listed none
+	spec := v1alpha1.ClickhouseGrantSpec{Project: g.Spec.Project, ServiceName: g.Spec.ServiceName, Grantee: g.Spec.Grantee}
+	_, err = syncClickhouseGrants(avn, &spec)
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (h ClickhouseGrantHandler) get(avn *aiven.Client, i client.Object) (*corev1.Secret, error) {
+	g, err := h.convert(i)
+	if err != nil {
+		return nil, err
+	}
+
+	// The grants are applied by createOrUpdate, they are ready once applied
+	meta.SetStatusCondition(&g.Status.Conditions,
+		getRunningCondition(metav1.ConditionTrue, "CheckRunning",
+			"Instance is running on Aiven side"))
+
+	metav1.SetMetaDataAnnotation(&g.ObjectMeta, instanceIsRunningAnnotation, "true")
+
+	return nil, nil
+}
+
+func (h ClickhouseGrantHandler) checkPreconditions(avn *aiven.Client, i client.Object) (bool, error) {
+	g, err := h.convert(i)
+	if err != nil {
+		return false, err
+	}
+
+	meta.SetStatusCondition(&g.Status.Conditions,
+		getInitializedCondition("Preconditions", "Checking preconditions"))
+
+	return checkServiceIsRunning(avn, g.Spec.Project, g.Spec.ServiceName)
+}
+
+func (h ClickhouseGrantHandler) convert(i client.Object) (*v1alpha1.ClickhouseGrant, error) {
+	g, ok := i.(*v1alpha1.ClickhouseGrant)
+	if !ok {
+		return nil, fmt.Errorf("cannot convert object to ClickhouseGrant")
+	}
+
+	return g, nil
+}
+
+// clickhousePrivilege is a privilege granted on a database, a table or a column.
+// The empty database, table or column stands for all of them
+type clickhousePrivilege struct {
+	Privilege string
+	Database  string
+	Table     string
+	Column    string
+}
+
+// clickhouseGrants are the privileges and the roles of a grantee, with their grant and admin options
+type clickhouseGrants struct {
+	Privileges map[clickhousePrivilege]bool
+	Roles      map[string]bool
+}
+
+// clickhousePrivilegeFormat matches the privilege names, which are keywords and can't be quoted in the statements
+var clickhousePrivilegeFormat = regexp.MustCompile(`^[A-Za-z]+( [A-Za-z]+)*$`)
+
+// checkClickhousePrivileges returns an error if a privilege of the spec is not a privilege name.
+// The CRD validates them too, this covers the resources created before
+func checkClickhousePrivileges(spec *v1alpha1.ClickhouseGrantSpec) error {
+	for _, p := range spec.PrivilegeGrants {
+		if !clickhousePrivilegeFormat.MatchString(p.Privilege) {
+			return fmt.Errorf("invalid privilege %q", p.Privilege)
+		}
+	}
+	return nil
+}
+
+// newClickhouseGrants returns the grants of the spec, the privileges on the columns are listed by column
+func newClickhouseGrants(spec *v1alpha1.ClickhouseGrantSpec) clickhouseGrants {
+	grants := clickhouseGrants{Privileges: make(map[clickhousePrivilege]bool), Roles: make(map[string]bool)}
+	for _, p := range spec.PrivilegeGrants {
+		privilege := clickhousePrivilege{
+			Privilege: strings.Join(strings.Fields(strings.ToUpper(p.Privilege)), " "),
+			Database:  p.Database,
+			Table:     p.Table,
+		}
+		if privilege.Database == "*" {
+			privilege.Database = ""
+		}
+		if privilege.Table == "*" {
+			privilege.Table = ""
+		}
+		if len(p.Columns) == 0 {
+			grants.Privileges[privilege] = grants.Privileges[privilege] || p.WithGrantOption
+			continue
+		}
+		for _, c := range p.Columns {
+			privilege.Column = c
+			grants.Privileges[privilege] = grants.Privileges[privilege] || p.WithGrantOption
+		}
+	}
+	for _, r := range spec.RoleGrants {
+		grants.Roles[r.Role] = grants.Roles[r.Role] || r.WithAdminOption
+	}
+	return grants
+}
+
+// syncClickhouseGrants runs the statements that turn the grants of the grantee into the ones of the spec,
+// and returns them. The grants that are already there are left as they are
+func syncClickhouseGrants(avn *aiven.Client, spec *v1alpha1.ClickhouseGrantSpec) ([]string, error) {
+	err := checkClickhousePrivileges(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	actual, err := getClickhouseGrants(avn, spec.Project, spec.ServiceName, spec.Grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	statements := clickhouseGrantStatements(spec.Grantee, newClickhouseGrants(spec), actual)
+	for _, q := range statements {
+		_, err = avn.ClickHouseQuery.Query(spec.Project, spec.ServiceName, clickhouseSystemDatabase, q)
+		if err != nil {
+			return nil, fmt.Errorf("cannot run %q: %w", q, err)
+		}
+	}
+	return statements, nil
+}
+
+const clickhouseSystemDatabase = "system"
+
+// getClickhouseGrants returns the grants of the grantee from the system tables.
+// The partial revokes are left out, the grants they narrow are revoked or granted as a whole
+func getClickhouseGrants(avn *aiven.Client, project, serviceName string, grantee v1alpha1.ClickhouseGrantee) (clickhouseGrants, error) {
+	grants := clickhouseGrants{Privileges: make(map[clickhousePrivilege]bool), Roles: make(map[string]bool)}
+	filter := clickhouseGranteeFilter(grantee)
+
+	r, err := avn.ClickHouseQuery.Query(project, serviceName, clickhouseSystemDatabase,
+		"SELECT access_type, database, table, column, grant_option FROM system.grants WHERE "+filter+" AND is_partial_revoke = 0")
+	if err != nil {
+		return grants, fmt.Errorf("cannot get the privileges of the grantee: %w", err)
+	}
+	for _, row := range clickhouseQueryRows(r) {
+		p := clickhousePrivilege{
+			Privilege: clickhouseString(row["access_type"]),
+			Database:  clickhouseString(row["database"]),
+			Table:     clickhouseString(row["table"]),
+			Column:    clickhouseString(row["column"]),
+		}
+		grants.Privileges[p] = clickhouseBool(row["grant_option"])
+	}
+
+	r, err = avn.ClickHouseQuery.Query(project, serviceName, clickhouseSystemDatabase,
+		"SELECT granted_role_name, with_admin_option FROM system.role_grants WHERE "+filter)
+	if err != nil {
+		return grants, fmt.Errorf("cannot get the roles of the grantee: %w", err)
+	}
+	for _, row := range clickhouseQueryRows(r) {
+		grants.Roles[clickhouseString(row["granted_role_name"])] = clickhouseBool(row["with_admin_option"])
+	}
+	return grants, nil
+}
+
+// clickhouseStatement is a GRANT or REVOKE statement of a privilege, without the columns
+type clickhouseStatement struct {
+	verb      string
+	privilege string
+	on        string
+	suffix    string
+	columns   bool
+}
+
+func (s clickhouseStatement) format(columns []string) string {
+	privilege := s.privilege
+	if s.columns {
+		sort.Strings(columns)
+		privilege += "(" + strings.Join(columns, ", ") + ")"
+	}
+	return s.verb + " " + privilege + " ON " + s.on + s.suffix
+}
+
+// clickhouseGrantStatements returns the GRANT and REVOKE statements that turn the actual grants into the desired ones.
+// The privileges that differ by the column only are granted and revoked together. The revokes run first
+func clickhouseGrantStatements(grantee v1alpha1.ClickhouseGrantee, desired, actual clickhouseGrants) []string {
+	to := clickhouseGranteeName(grantee)
+	revokes := make(map[clickhouseStatement][]string)
+	grants := make(map[clickhouseStatement][]string)
+	add := func(m map[clickhouseStatement][]string, verb, suffix string, p clickhousePrivilege) {
+		s := clickhouseStatement{verb: verb, privilege: p.Privilege, on: clickhousePrivilegeOn(p), suffix: suffix, columns: p.Column != ""}
+		if s.columns {
+			m[s] = append(m[s], escapeClickhouseIdentifier(p.Column))
+		} else {
+			m[s] = nil
+		}
+	}
+
+	for p, option := range actual.Privileges {
+		want, ok := desired.Privileges[p]
+		switch {
+		case !ok:
+			add(revokes, "REVOKE", " FROM "+to, p)
+		case option && !want:
+			add(revokes, "REVOKE GRANT OPTION FOR", " FROM "+to, p)
+		}
+	}
+	for p, option := range desired.Privileges {
+		has, ok := actual.Privileges[p]
+		switch {
+		case option && !has:
+			add(grants, "GRANT", " TO "+to+" WITH GRANT OPTION", p)
+		case !ok:
+			add(grants, "GRANT", " TO "+to, p)
+		}
+	}
+
+	roleRevokes := make([]string, 0)
+	roleGrants := make([]string, 0)
+	for role, option := range actual.Roles {
+		want, ok := desired.Roles[role]
+		switch {
+		case !ok:
+			roleRevokes = append(roleRevokes, "REVOKE "+escapeClickhouseIdentifier(role)+" FROM "+to)
+		case option && !want:
+			roleRevokes = append(roleRevokes, "REVOKE ADMIN OPTION FOR "+escapeClickhouseIdentifier(role)+" FROM "+to)
+		}
+	}
+	for role, option := range desired.Roles {
+		has, ok := actual.Roles[role]
+		switch {
+		case option && !has:
+			roleGrants = append(roleGrants, "GRANT "+escapeClickhouseIdentifier(role)+" TO "+to+" WITH ADMIN OPTION")
+		case !ok:
+			roleGrants = append(roleGrants, "GRANT "+escapeClickhouseIdentifier(role)+" TO "+to)
+		}
+	}
+
+	statements := make([]string, 0)
+	for _, group := range []struct {
+		privileges map[clickhouseStatement][]string
+		roles      []string
+	}{{revokes, roleRevokes}, {grants, roleGrants}} {
+		part := group.roles
+		for s, columns := range group.privileges {
+			part = append(part, s.format(columns))
+		}
+		sort.Strings(part)
+		statements = append(statements, part...)
+	}
+	return statements
+}
+
+// clickhousePrivilegeOn returns the database and the table of the privilege, e.g. `db`.*,
+// or *.* for the global privileges, which have NULL database in system.grants
+func clickhousePrivilegeOn(p clickhousePrivilege) string {
+	if p.Database == "" {
+		return "*.*"
+	}
+	table := "*"
+	if p.Table != "" {
+		table = escapeClickhouseIdentifier(p.Table)
+	}
+	return escapeClickhouseIdentifier(p.Database) + "." + table
+}
+
+func clickhouseGranteeName(grantee v1alpha1.ClickhouseGrantee) string {
+	if grantee.Role != "" {
+		return escapeClickhouseIdentifier(grantee.Role)
+	}
+	return escapeClickhouseIdentifier(grantee.User)
+}
+
+func clickhouseGranteeFilter(grantee v1alpha1.ClickhouseGrantee) string {
+	if grantee.Role != "" {
+		return "role_name = " + escapeClickhouseString(grantee.Role)
+	}
+	return "user_name = " + escapeClickhouseString(grantee.User)
+}
+
+func escapeClickhouseIdentifier(s string) string {
+	return "`" + strings.NewReplacer(`\`, `\\`, "`", "\\`").Replace(s) + "`"
+}
+
+func escapeClickhouseString(s string) string {
+	return "'" + strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(s) + "'"
+}
+
+// clickhouseQueryRows returns the rows of the query result by the column names
+func clickhouseQueryRows(r *aiven.ClickhouseQueryResponse) []map[string]interface{} {
+	rows := make([]map[string]interface{}, 0, len(r.Data))
+	for _, d := range r.Data {
+		values, ok := d.([]interface{})
+		if !ok {
+			continue
+		}
+		row := make(map[string]interface{}, len(r.Meta))
+		for i, c := range r.Meta {
+			if i < len(values) {
+				row[c.Name] = values[i]
+			}
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+// clickhouseString returns the string value, empty for NULL
+func clickhouseString(v interface{}) string {
+	s, _ := v.(string)
+	return s
+}
+
+// clickhouseBool returns the boolean value, ClickHouse returns them as UInt8 or Bool depending on the version
+func clickhouseBool(v interface{}) bool {
+	switch b := v.(type) {
+	case bool:
+		return b
+	case float64:
+		return b != 0
+	case string:
+		return b == "1" || b == "true"
+	}
+	return false
+}
@@ -0,0 +1,133 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"os"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+var _ = Describe("ClickhouseGrant Controller", func() {
+	// Define utility constants for object names and testing timeouts/durations and intervals.
+	const (
+		namespace = "default"
+
+		timeout  = time.Minute * 20
+		interval = time.Second * 10
+	)
+
+	var (
+		ch          *v1alpha1.Clickhouse
+		u           *v1alpha1.ClickhouseUser
+		g           *v1alpha1.ClickhouseGrant
+		serviceName string
+		userName    string
+		grantName   string
+		ctx         context.Context
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		serviceName = "k8s-test-ch-grant-acc-" + generateRandomID()
+		userName = "k8s-ch-grant-user-" + generateRandomID()
+		grantName = "k8s-ch-grant-" + generateRandomID()
+
+		By("Creating a new Clickhouse CR instance")
+		ch = chSpec(serviceName, namespace)
+		Expect(k8sClient.Create(ctx, ch)).Should(Succeed())
+
+		By("Creating a new ClickhouseUser CR instance")
+		u = clickhouseUserSpec(serviceName, userName, namespace)
+		Expect(k8sClient.Create(ctx, u)).Should(Succeed())
+
+		By("Creating a new ClickhouseGrant CR instance")
+		g = clickhouseGrantSpec(serviceName, userName, grantName, namespace)
+		Expect(k8sClient.Create(ctx, g)).Should(Succeed())
+
+		By("by waiting ClickhouseGrant to become RUNNING")
+		Eventually(func() bool {
+			lookupKey := types.NamespacedName{Name: grantName, Namespace: namespace}
+			created := &v1alpha1.ClickhouseGrant{}
+			err := k8sClient.Get(ctx, lookupKey, created)
+			if err == nil {
+				return meta.IsStatusConditionTrue(created.Status.Conditions, conditionTypeRunning)
+			}
+			return false
+		}, timeout, interval).Should(BeTrue())
+	})
+
+	Context("Validating ClickhouseGrant reconciler behaviour", func() {
+		It("should grant and revoke the privileges of the user", func() {
+			created := &v1alpha1.ClickhouseGrant{}
+			lookupKey := types.NamespacedName{Name: grantName, Namespace: namespace}
+			Expect(k8sClient.Get(ctx, lookupKey, created)).Should(Succeed())
+
+			By("by checking the grants on Aiven side")
+			actual, err := getClickhouseGrants(aivenClient, created.Spec.Project, serviceName, created.Spec.Grantee)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(actual).To(Equal(newClickhouseGrants(&created.Spec)))
+
+			By("by adding the grant option")
+			created.Spec.PrivilegeGrants[0].WithGrantOption = true
+			Expect(k8sClient.Update(ctx, created)).Should(Succeed())
+			Eventually(func() bool {
+				actual, err := getClickhouseGrants(aivenClient, created.Spec.Project, serviceName, created.Spec.Grantee)
+				return err == nil && actual.Privileges[clickhousePrivilege{Privilege: "SELECT", Database: "default"}]
+			}, timeout, interval).Should(BeTrue())
+
+			By("by checking the privileges are revoked on deletion")
+			ensureDelete(ctx, g)
+			actual, err = getClickhouseGrants(aivenClient, created.Spec.Project, serviceName, created.Spec.Grantee)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(actual.Privileges).To(BeEmpty())
+			g = nil
+		})
+	})
+
+	AfterEach(func() {
+		if g != nil {
+			By("Ensures that ClickhouseGrant instance was deleted")
+			ensureDelete(ctx, g)
+		}
+
+		By("Ensures that ClickhouseUser instance was deleted")
+		ensureDelete(ctx, u)
+
+		By("Ensures that Clickhouse instance was deleted")
+		ensureDelete(ctx, ch)
+	})
+})
+
+func clickhouseGrantSpec(serviceName, userName, name, namespace string) *v1alpha1.ClickhouseGrant {
+	return &v1alpha1.ClickhouseGrant{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "aiven.io/v1alpha1",
+			Kind:       "ClickhouseGrant",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: v1alpha1.ClickhouseGrantSpec{
+			Project:     os.Getenv("AIVEN_PROJECT_NAME"),
+			ServiceName: serviceName,
+			Grantee:     v1alpha1.ClickhouseGrantee{User: userName},
+			PrivilegeGrants: []v1alpha1.ClickhousePrivilegeGrant{
+				{Privilege: "SELECT", Database: "default"},
+			},
+			AuthSecretRef: v1alpha1.AuthSecretReference{
+				Name: secretRefName,
+				Key:  secretRefKey,
+			},
+		},
+	}
+}
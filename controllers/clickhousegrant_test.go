@@ -0,0 +1,190 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/aiven/aiven-go-client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+// fakeClickhouseQueryAPI serves the grants and the roles of the system tables, and records the other queries
+type fakeClickhouseQueryAPI struct {
+	grants     [][]interface{}
+	roleGrants [][]interface{}
+	roles      [][]interface{}
+	queries    []string
+}
+
+func (f *fakeClickhouseQueryAPI) RoundTrip(r *http.Request) (*http.Response, error) {
+	rsp := &http.Response{StatusCode: http.StatusOK, Header: make(http.Header), Request: r}
+	var out interface{}
+	switch r.Method + " " + r.URL.Path {
+	case "GET /v1/project/foo/service/bar":
+		out = map[string]interface{}{"service": aiven.Service{Name: "bar", Type: "clickhouse", State: "RUNNING"}}
+	case "POST /v1/project/foo/service/bar/clickhouse/query":
+		var in aiven.ClickhouseQueryRequest
+		if err := json.NewDecoder(r.Body).Decode(&in); err != nil {
+			return nil, err
+		}
+		switch {
+		case strings.Contains(in.Query, "FROM system.grants"):
+			out = map[string]interface{}{
+				"meta": []aiven.ClickhouseQueryColumnMeta{{Name: "access_type"}, {Name: "database"}, {Name: "table"}, {Name: "column"}, {Name: "grant_option"}},
+				"data": f.grants,
+			}
+		case strings.Contains(in.Query, "FROM system.role_grants"):
+			out = map[string]interface{}{
+				"meta": []aiven.ClickhouseQueryColumnMeta{{Name: "granted_role_name"}, {Name: "with_admin_option"}},
+				"data": f.roleGrants,
+			}
+		case strings.Contains(in.Query, "FROM system.roles"):
+			out = map[string]interface{}{
+				"meta": []aiven.ClickhouseQueryColumnMeta{{Name: "name"}},
+				"data": f.roles,
+			}
+		default:
+			f.queries = append(f.queries, in.Query)
+			out = map[string]interface{}{"meta": []interface{}{}, "data": []interface{}{}}
+		}
+	default:
+		rsp.StatusCode = http.StatusNotFound
+		out = map[string]string{"message": "Not found"}
+	}
+	b, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	rsp.Body = io.NopCloser(bytes.NewReader(b))
+	return rsp, nil
+}
+
+func TestClickhouseGrantStatements(t *testing.T) {
+	grantee := v1alpha1.ClickhouseGrantee{User: "app"}
+	desired := newClickhouseGrants(&v1alpha1.ClickhouseGrantSpec{
+		PrivilegeGrants: []v1alpha1.ClickhousePrivilegeGrant{
+			{Privilege: "select", Database: "sales"},
+			{Privilege: "INSERT", Database: "sales", Table: "orders", WithGrantOption: true},
+			{Privilege: "SELECT", Database: "hr", Table: "people", Columns: []string{"name", "team"}},
+		},
+		RoleGrants: []v1alpha1.ClickhouseRoleGrant{{Role: "reader"}, {Role: "writer", WithAdminOption: true}},
+	})
+
+	// Nothing is granted yet
+	empty := clickhouseGrants{Privileges: map[clickhousePrivilege]bool{}, Roles: map[string]bool{}}
+	assert.Equal(t, []string{
+		"GRANT INSERT ON `sales`.`orders` TO `app` WITH GRANT OPTION",
+		"GRANT SELECT ON `sales`.* TO `app`",
+		"GRANT SELECT(`name`, `team`) ON `hr`.`people` TO `app`",
+		"GRANT `reader` TO `app`",
+		"GRANT `writer` TO `app` WITH ADMIN OPTION",
+	}, clickhouseGrantStatements(grantee, desired, empty))
+
+	// The grants that are there already are left as they are
+	actual := clickhouseGrants{
+		Privileges: map[clickhousePrivilege]bool{
+			{Privilege: "SELECT", Database: "sales"}:                                 false,
+			{Privilege: "INSERT", Database: "sales", Table: "orders"}:                false,
+			{Privilege: "SELECT", Database: "hr", Table: "people", Column: "name"}:   false,
+			{Privilege: "SELECT", Database: "hr", Table: "people", Column: "salary"}: false,
+			{Privilege: "DROP TABLE", Database: "sales"}:                             true,
+		},
+		Roles: map[string]bool{"reader": true, "admin": false, "writer": true},
+	}
+	assert.Equal(t, []string{
+		"REVOKE ADMIN OPTION FOR `reader` FROM `app`",
+		"REVOKE DROP TABLE ON `sales`.* FROM `app`",
+		"REVOKE SELECT(`salary`) ON `hr`.`people` FROM `app`",
+		"REVOKE `admin` FROM `app`",
+		"GRANT INSERT ON `sales`.`orders` TO `app` WITH GRANT OPTION",
+		"GRANT SELECT(`team`) ON `hr`.`people` TO `app`",
+	}, clickhouseGrantStatements(grantee, desired, actual))
+
+	assert.Empty(t, clickhouseGrantStatements(grantee, desired, desired))
+
+	// The global privileges are on all the databases
+	global := newClickhouseGrants(&v1alpha1.ClickhouseGrantSpec{
+		PrivilegeGrants: []v1alpha1.ClickhousePrivilegeGrant{{Privilege: "CREATE USER", Database: "*"}},
+	})
+	assert.Equal(t, []string{"GRANT CREATE USER ON *.* TO `app`"}, clickhouseGrantStatements(grantee, global, empty))
+}
+
+func TestClickhouseGrant(t *testing.T) {
+	api := &fakeClickhouseQueryAPI{
+		grants: [][]interface{}{
+			{"SELECT", "sales", nil, nil, float64(0)},
+			{"DROP TABLE", "sales", nil, nil, float64(1)},
+		},
+		roleGrants: [][]interface{}{{"reader", float64(0)}},
+	}
+	avn := newFakeAivenClient("token", api)
+	g := &v1alpha1.ClickhouseGrant{Spec: v1alpha1.ClickhouseGrantSpec{
+		Project:     "foo",
+		ServiceName: "bar",
+		Grantee:     v1alpha1.ClickhouseGrantee{Role: "o'brien"},
+		PrivilegeGrants: []v1alpha1.ClickhousePrivilegeGrant{
+			{Privilege: "SELECT", Database: "sales", Table: "*"},
+		},
+		RoleGrants: []v1alpha1.ClickhouseRoleGrant{{Role: "reader"}},
+	}}
+
+	h := ClickhouseGrantHandler{}
+	require.NoError(t, h.createOrUpdate(avn, g, nil))
+	assert.Equal(t, []string{"REVOKE DROP TABLE ON `sales`.* FROM `o'brien`"}, api.queries)
+
+	// Deleting revokes all the grants of the grantee
+	api.queries = nil
+	api.grants = api.grants[:1]
+	deleted, err := h.delete(avn, g)
+	require.NoError(t, err)
+	assert.True(t, deleted)
+	assert.Equal(t, []string{"REVOKE SELECT ON `sales`.* FROM `o'brien`", "REVOKE `reader` FROM `o'brien`"}, api.queries)
+
+	assert.Equal(t, "role_name = 'o\\'brien'", clickhouseGranteeFilter(g.Spec.Grantee))
+
+	// The global grants have NULL database, they are granted and revoked on *.*
+	api.queries = nil
+	api.grants = [][]interface{}{
+		{"SELECT", "sales", nil, nil, float64(0)},
+		{"CREATE USER", nil, nil, nil, float64(0)},
+		{"SHOW USERS", nil, nil, nil, float64(0)},
+	}
+	g.Spec.PrivilegeGrants = append(g.Spec.PrivilegeGrants, v1alpha1.ClickhousePrivilegeGrant{Privilege: "SHOW USERS", Database: "*"})
+	require.NoError(t, h.createOrUpdate(avn, g, nil))
+	assert.Equal(t, []string{"REVOKE CREATE USER ON *.* FROM `o'brien`"}, api.queries)
+
+	// Checking the readiness doesn't change the grants
+	api.queries = nil
+	_, err = h.get(avn, g)
+	require.NoError(t, err)
+	assert.Empty(t, api.queries)
+
+	// The grants are gone with the service
+	gone := &v1alpha1.ClickhouseGrant{Spec: v1alpha1.ClickhouseGrantSpec{Project: "foo", ServiceName: "gone", Grantee: g.Spec.Grantee}}
+	deleted, err = h.delete(avn, gone)
+	require.NoError(t, err)
+	assert.True(t, deleted)
+	assert.Empty(t, api.queries)
+
+	// The roles of roleRefs must be on the same service
+	api.queries = nil
+	role := &v1alpha1.ClickhouseRole{Spec: v1alpha1.ClickhouseRoleSpec{Project: "foo", ServiceName: "other", Role: "o'brien"}}
+	assert.ErrorContains(t, h.createOrUpdate(avn, g, []client.Object{role}), `is a role of service "other"`)
+	assert.Empty(t, api.queries)
+
+	// The privileges are not quoted, so anything but a privilege name is rejected before running the statements
+	api.queries = nil
+	g.Spec.PrivilegeGrants[0].Privilege = "SELECT ON *.* TO admin; DROP"
+	assert.ErrorContains(t, h.createOrUpdate(avn, g, nil), "invalid privilege")
+	assert.Empty(t, api.queries)
+}
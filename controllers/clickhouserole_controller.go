@@ -0,0 +1,140 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/aiven/aiven-go-client"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+// ClickhouseRoleReconciler reconciles a ClickhouseRole object
+type ClickhouseRoleReconciler struct {
+	Controller
+}
+
+type ClickhouseRoleHandler struct{}
+
+// +kubebuilder:rbac:groups=aiven.io,resources=clickhouseroles,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=aiven.io,resources=clickhouseroles/status,verbs=get;update;patch
+
+func (r *ClickhouseRoleReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	return r.reconcileInstance(ctx, req, ClickhouseRoleHandler{}, &v1alpha1.ClickhouseRole{})
+}
+
+func (r *ClickhouseRoleReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&v1alpha1.ClickhouseRole{}).
+		WithOptions(priorityControllerOptions(&v1alpha1.ClickhouseRole{})).
+		Complete(r)
+}
+
+func (h ClickhouseRoleHandler) createOrUpdate(avn *aiven.Client, i client.Object, refs []client.Object) error {
+	role, err := h.convert(i)
+	if err != nil {
+		return err
+	}
+
+	s, err := avn.Services.Get(role.Spec.Project, role.Spec.ServiceName)
+	if err != nil {
+		return err
+	}
+	if s.Type != "clickhouse" {
+		return fmt.Errorf("ClickhouseRole can be used with ClickHouse services only, got %q service type", s.Type)
+	}
+
+	_, err = avn.ClickHouseQuery.Query(role.Spec.Project, role.Spec.ServiceName, clickhouseSystemDatabase,
+		"CREATE ROLE IF NOT EXISTS "+escapeClickhouseIdentifier(role.Spec.Role))
+	if err != nil {
+		return fmt.Errorf("cannot create the role: %w", err)
+	}
+
+	meta.SetStatusCondition(&role.Status.Conditions,
+		getInitializedCondition("Created",
+			"Instance was created or update on Aiven side"))
+
+	meta.SetStatusCondition(&role.Status.Conditions,
+		getRunningCondition(metav1.ConditionUnknown, "Created",
+			"Instance was created or update on Aiven side, status remains unknown"))
+
+	metav1.SetMetaDataAnnotation(&role.ObjectMeta,
+		processedGenerationAnnotation, strconv.FormatInt(role.GetGeneration(), formatIntBaseDecimal))
+
+	return nil
+}
+
+func (h ClickhouseRoleHandler) delete(avn *aiven.Client, i client.Object) (bool, error) {
+	role, err := h.convert(i)
+	if err != nil {
+		return false, err
+	}
+
+	// The role is revoked from the users and the roles it's granted to
+	_, err = avn.ClickHouseQuery.Query(role.Spec.Project, role.Spec.ServiceName, clickhouseSystemDatabase,
+		"DROP ROLE IF EXISTS "+escapeClickhouseIdentifier(role.Spec.Role))
+	if err != nil && !aiven.IsNotFound(err) {
+		return false, err
+	}
+	return true, nil
+}
+
+func (h ClickhouseRoleHandler) get(avn *aiven.Client, i client.Object) (*corev1.Secret, error) {
+	role, err := h.convert(i)
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := avn.ClickHouseQuery.Query(role.Spec.Project, role.Spec.ServiceName, clickhouseSystemDatabase,
+		"SELECT name FROM system.roles WHERE name = "+escapeClickhouseString(role.Spec.Role))
+	if err != nil {
+		return nil, fmt.Errorf("cannot get the role: %w", err)
+	}
+
+	// The role dropped with SQL is created again on the next reconciliation
+	if len(clickhouseQueryRows(r)) == 0 {
+		meta.SetStatusCondition(&role.Status.Conditions,
+			getRunningCondition(metav1.ConditionFalse, "NotFound",
+				"The role is not found on Aiven side, creating it again"))
+		delete(role.Annotations, processedGenerationAnnotation)
+		delete(role.Annotations, instanceIsRunningAnnotation)
+		return nil, nil
+	}
+
+	meta.SetStatusCondition(&role.Status.Conditions,
+		getRunningCondition(metav1.ConditionTrue, "CheckRunning",
+			"Instance is running on Aiven side"))
+
+	metav1.SetMetaDataAnnotation(&role.ObjectMeta, instanceIsRunningAnnotation, "true")
+
+	return nil, nil
+}
+
+func (h ClickhouseRoleHandler) checkPreconditions(avn *aiven.Client, i client.Object) (bool, error) {
+	role, err := h.convert(i)
+	if err != nil {
+		return false, err
+	}
+
+	meta.SetStatusCondition(&role.Status.Conditions,
+		getInitializedCondition("Preconditions", "Checking preconditions"))
+
+	return checkServiceIsRunning(avn, role.Spec.Project, role.Spec.ServiceName)
+}
+
+func (h ClickhouseRoleHandler) convert(i client.Object) (*v1alpha1.ClickhouseRole, error) {
+	role, ok := i.(*v1alpha1.ClickhouseRole)
+	if !ok {
+		return nil, fmt.Errorf("cannot convert object to ClickhouseRole")
+	}
+
+	return role, nil
+}
@@ -0,0 +1,134 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"os"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+var _ = Describe("ClickhouseRole Controller", func() {
+	// Define utility constants for object names and testing timeouts/durations and intervals.
+	const (
+		namespace = "default"
+
+		timeout  = time.Minute * 20
+		interval = time.Second * 10
+	)
+
+	var (
+		ch          *v1alpha1.Clickhouse
+		role        *v1alpha1.ClickhouseRole
+		grant       *v1alpha1.ClickhouseGrant
+		serviceName string
+		roleName    string
+		ctx         context.Context
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		serviceName = "k8s-test-ch-role-acc-" + generateRandomID()
+		roleName = "k8s-ch-role-" + generateRandomID()
+
+		By("Creating a new Clickhouse CR instance")
+		ch = chSpec(serviceName, namespace)
+		Expect(k8sClient.Create(ctx, ch)).Should(Succeed())
+
+		By("Creating a new ClickhouseRole CR instance")
+		role = clickhouseRoleSpec(serviceName, roleName, namespace)
+		Expect(k8sClient.Create(ctx, role)).Should(Succeed())
+
+		By("Creating a new ClickhouseGrant CR instance that grants the privileges to the role")
+		grant = &v1alpha1.ClickhouseGrant{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: "aiven.io/v1alpha1",
+				Kind:       "ClickhouseGrant",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      roleName + "-grant",
+				Namespace: namespace,
+			},
+			Spec: v1alpha1.ClickhouseGrantSpec{
+				Project:     os.Getenv("AIVEN_PROJECT_NAME"),
+				ServiceName: serviceName,
+				Grantee:     v1alpha1.ClickhouseGrantee{Role: roleName},
+				PrivilegeGrants: []v1alpha1.ClickhousePrivilegeGrant{
+					{Privilege: "SELECT", Database: "default"},
+				},
+				RoleRefs: []v1alpha1.ResourceReference{{Name: roleName}},
+				AuthSecretRef: v1alpha1.AuthSecretReference{
+					Name: secretRefName,
+					Key:  secretRefKey,
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, grant)).Should(Succeed())
+
+		By("by waiting ClickhouseGrant to become RUNNING")
+		Eventually(func() bool {
+			lookupKey := types.NamespacedName{Name: grant.Name, Namespace: namespace}
+			created := &v1alpha1.ClickhouseGrant{}
+			err := k8sClient.Get(ctx, lookupKey, created)
+			if err == nil {
+				return meta.IsStatusConditionTrue(created.Status.Conditions, conditionTypeRunning)
+			}
+			return false
+		}, timeout, interval).Should(BeTrue())
+	})
+
+	Context("Validating ClickhouseRole reconciler behaviour", func() {
+		It("should create the role before the grant is applied", func() {
+			created := &v1alpha1.ClickhouseRole{}
+			lookupKey := types.NamespacedName{Name: roleName, Namespace: namespace}
+			Expect(k8sClient.Get(ctx, lookupKey, created)).Should(Succeed())
+			Expect(meta.IsStatusConditionTrue(created.Status.Conditions, conditionTypeRunning)).To(BeTrue())
+
+			By("by checking the privileges of the role on Aiven side")
+			actual, err := getClickhouseGrants(aivenClient, created.Spec.Project, serviceName, v1alpha1.ClickhouseGrantee{Role: roleName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(actual.Privileges).To(HaveKey(clickhousePrivilege{Privilege: "SELECT", Database: "default"}))
+		})
+	})
+
+	AfterEach(func() {
+		By("Ensures that ClickhouseGrant instance was deleted")
+		ensureDelete(ctx, grant)
+
+		By("Ensures that ClickhouseRole instance was deleted")
+		ensureDelete(ctx, role)
+
+		By("Ensures that Clickhouse instance was deleted")
+		ensureDelete(ctx, ch)
+	})
+})
+
+func clickhouseRoleSpec(serviceName, name, namespace string) *v1alpha1.ClickhouseRole {
+	return &v1alpha1.ClickhouseRole{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "aiven.io/v1alpha1",
+			Kind:       "ClickhouseRole",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: v1alpha1.ClickhouseRoleSpec{
+			Project:     os.Getenv("AIVEN_PROJECT_NAME"),
+			ServiceName: serviceName,
+			Role:        name,
+			AuthSecretRef: v1alpha1.AuthSecretReference{
+				Name: secretRefName,
+				Key:  secretRefKey,
+			},
+		},
+	}
+}
@@ -0,0 +1,45 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+func TestClickhouseRole(t *testing.T) {
+	api := &fakeClickhouseQueryAPI{}
+	avn := newFakeAivenClient("token", api)
+	role := &v1alpha1.ClickhouseRole{Spec: v1alpha1.ClickhouseRoleSpec{
+		Project:     "foo",
+		ServiceName: "bar",
+		Role:        "read`only",
+	}}
+
+	h := ClickhouseRoleHandler{}
+	require.NoError(t, h.createOrUpdate(avn, role, nil))
+	assert.Equal(t, []string{"CREATE ROLE IF NOT EXISTS `read\\`only`"}, api.queries)
+	assert.True(t, isAlreadyProcessed(role))
+
+	// The role dropped with SQL is created again
+	_, err := h.get(avn, role)
+	require.NoError(t, err)
+	assert.False(t, isAlreadyProcessed(role))
+	assert.True(t, meta.IsStatusConditionFalse(role.Status.Conditions, conditionTypeRunning))
+
+	api.roles = [][]interface{}{{"read`only"}}
+	_, err = h.get(avn, role)
+	require.NoError(t, err)
+	assert.True(t, isAlreadyRunning(role))
+
+	api.queries = nil
+	deleted, err := h.delete(avn, role)
+	require.NoError(t, err)
+	assert.True(t, deleted)
+	assert.Equal(t, []string{"DROP ROLE IF EXISTS `read\\`only`"}, api.queries)
+}
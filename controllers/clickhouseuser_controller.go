@@ -32,6 +32,7 @@ type ClickhouseUserReconciler struct {
 //+kubebuilder:rbac:groups=aiven.io,resources=clickhouseusers/status,verbs=get;update;patch
 
 func (r *ClickhouseUserReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	start := time.Now()
 	log := r.Log.WithValues("clickhouseuser", req.NamespacedName)
 
 	// fetch the clickhouse userinstance
@@ -58,7 +59,7 @@ func (r *ClickhouseUserReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 		return ctrl.Result{}, fmt.Errorf("cannot get secret %q: %w", user.AuthSecretRef().Name, err)
 	}
 
-	avn, err := aiven.NewTokenClient(string(clientAuthSecret.Data[user.AuthSecretRef().Key]), operatorUserAgent)
+	avn, err := r.Controller.newAivenClient(string(clientAuthSecret.Data[user.AuthSecretRef().Key]))
 	if err != nil {
 		r.Controller.Recorder.Event(user, corev1.EventTypeWarning, eventUnableToCreateClient, err.Error())
 		return ctrl.Result{}, fmt.Errorf("cannot initialize aiven client: %w", err)
@@ -173,6 +174,8 @@ func (r *ClickhouseUserReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 
 		// updating clickhouse user resource status
 		user.Status.UUID = uuid
+		user.UpdateConsoleURL()
+		updateSyncStatus(user, start)
 		err = r.Status().Update(context.Background(), user)
 		if err != nil {
 			log.Error(err, "failed to update a clickhouse user cr status")
@@ -214,6 +217,7 @@ func (r *ClickhouseUserReconciler) createSecret(ctx context.Context, avn *aiven.
 		},
 	}
 	_, err = controllerutil.CreateOrUpdate(ctx, r.Client, secret, func() error {
+		setSecretCacheLabel(secret, secretCacheLabelConnection)
 		return ctrl.SetControllerReference(user, secret, r.Scheme)
 	})
 
@@ -79,6 +79,26 @@ var _ = Describe("ClickhouseUser Controller", func() {
 			Expect(createdSecret.Data["HOST"]).NotTo(BeEmpty())
 			Expect(createdSecret.Data["PORT"]).NotTo(BeEmpty())
 		})
+
+		It("should write the credentials to the Secret of connInfoSecretTarget", func() {
+			createdUser := &v1alpha1.ClickhouseUser{}
+			lookupKey := types.NamespacedName{Name: userName, Namespace: namespace}
+			Expect(k8sClient.Get(ctx, lookupKey, createdUser)).Should(Succeed())
+
+			By("by setting the name of the Secret")
+			secretName := userName + "-credentials"
+			createdUser.Spec.ConnInfoSecretTarget.Name = secretName
+			Expect(k8sClient.Update(ctx, createdUser)).Should(Succeed())
+
+			createdSecret := &corev1.Secret{}
+			Eventually(func() error {
+				return k8sClient.Get(ctx, types.NamespacedName{Name: secretName, Namespace: namespace}, createdSecret)
+			}, timeout, interval).Should(Succeed())
+			Expect(string(createdSecret.Data["USERNAME"])).To(Equal(userName))
+			Expect(createdSecret.Data["PASSWORD"]).NotTo(BeEmpty())
+			Expect(createdSecret.OwnerReferences).To(HaveLen(1))
+			Expect(createdSecret.OwnerReferences[0].Name).To(Equal(userName))
+		})
 	})
 
 	AfterEach(func() {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"strconv"
 
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -20,10 +21,14 @@ const (
 
 	processedGenerationAnnotation = "controllers.aiven.io/generation-was-processed"
 	instanceIsRunningAnnotation   = "controllers.aiven.io/instance-is-running"
+	runningConfirmedAnnotation    = "controllers.aiven.io/running-confirmed-at"
 )
 
 var operatorUserAgent = "k8s-operator/" + aiven.Version()
 
+// operatorVersion is written to the status of the resources, it is set at build time with -ldflags
+var operatorVersion = "dev"
+
 func checkServiceIsRunning(c *aiven.Client, project, serviceName string) (bool, error) {
 	s, err := c.Services.Get(project, serviceName)
 	if err != nil {
@@ -73,6 +78,46 @@ func isAlreadyRunning(o client.Object) bool {
 	return found
 }
 
+// addTLSKeys copies the client certificate to cert-manager compatible keys
+func addTLSKeys(stringData map[string]string) {
+	keys := map[string]string{
+		"ACCESS_CERT": "tls.crt",
+		"ACCESS_KEY":  "tls.key",
+		"CA_CERT":     "ca.crt",
+	}
+	for k, tlsKey := range keys {
+		if v, ok := stringData[k]; ok && v != "" {
+			stringData[tlsKey] = v
+		}
+	}
+}
+
+// certSecretKeys are the keys moved to the certificate secret
+var certSecretKeys = []string{"ACCESS_CERT", "ACCESS_KEY", "CA_CERT", "tls.crt", "tls.key", "ca.crt", "client.properties", "librdkafka.json"}
+
+// splitCertSecret moves the certificates and keys to a separate secret, if the object asks for that
+func splitCertSecret(o client.Object, secret *corev1.Secret) []*corev1.Secret {
+	target, ok := o.(connInfoSecretObject)
+	if !ok || target.GetConnInfoSecretTarget().CertSecretName == "" {
+		return []*corev1.Secret{secret}
+	}
+
+	certSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      target.GetConnInfoSecretTarget().CertSecretName,
+			Namespace: secret.Namespace,
+		},
+		StringData: make(map[string]string),
+	}
+	for _, k := range certSecretKeys {
+		if v, ok := secret.StringData[k]; ok {
+			certSecret.StringData[k] = v
+			delete(secret.StringData, k)
+		}
+	}
+	return []*corev1.Secret{secret, certSecret}
+}
+
 func optionalStringPointer(u string) *string {
 	if len(u) == 0 {
 		return nil
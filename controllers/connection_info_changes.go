@@ -0,0 +1,82 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+const (
+	eventConnectionInfoChanged = "ConnectionInfoChanged"
+
+	// connectionInfoSettleWindow is how long after an operation the hosts and the ports of the service could still change,
+	// e.g. when a plan migration completes or a privatelink connection is approved
+	connectionInfoSettleWindow = time.Hour
+
+	// connectionInfoResync is how often the service is resynced during the settle window, to refresh its secret
+	connectionInfoResync = 5 * time.Minute
+)
+
+// connectionInfoChanges returns the changed hosts and ports of the service, e.g. "pg/public: a:1 -> b:2".
+// Returns nil if the service had no connection info before
+func connectionInfoChanges(previous, current *v1alpha1.ServiceConnectionInfo) []string {
+	if previous == nil || current == nil {
+		return nil
+	}
+
+	changes := make([]string, 0)
+	if previous.Endpoint != current.Endpoint || previous.Scheme != current.Scheme {
+		changes = append(changes, fmt.Sprintf("uri: %s -> %s", serviceURIString(previous), serviceURIString(current)))
+	}
+
+	endpoints := func(info *v1alpha1.ServiceConnectionInfo) map[string]string {
+		m := make(map[string]string, len(info.Endpoints))
+		for _, e := range info.Endpoints {
+			m[e.Component+"/"+e.Route] = fmt.Sprintf("%s:%d", e.Host, e.Port)
+		}
+		return m
+	}
+
+	was, is := endpoints(previous), endpoints(current)
+	endpointChanges := make([]string, 0)
+	for k, v := range is {
+		switch old, ok := was[k]; {
+		case !ok:
+			endpointChanges = append(endpointChanges, fmt.Sprintf("%s: added %s", k, v))
+		case old != v:
+			endpointChanges = append(endpointChanges, fmt.Sprintf("%s: %s -> %s", k, old, v))
+		}
+	}
+	for k, v := range was {
+		if _, ok := is[k]; !ok {
+			endpointChanges = append(endpointChanges, fmt.Sprintf("%s: removed %s", k, v))
+		}
+	}
+	sort.Strings(endpointChanges)
+	changes = append(changes, endpointChanges...)
+
+	if len(changes) == 0 {
+		return nil
+	}
+	return changes
+}
+
+func serviceURIString(info *v1alpha1.ServiceConnectionInfo) string {
+	if info.Scheme == "" {
+		return info.Endpoint
+	}
+	return info.Scheme + "://" + info.Endpoint
+}
+
+// connectionInfoResyncAfter returns the resync interval that picks up the late changes of the hosts and the ports
+// after the last operation of the service, zero if the operation is older than the settle window
+func connectionInfoResyncAfter(status *v1alpha1.ServiceStatus, now time.Time) time.Duration {
+	if status.LastOperation == nil || now.Sub(status.LastOperation.Time.Time) > connectionInfoSettleWindow {
+		return 0
+	}
+	return connectionInfoResync
+}
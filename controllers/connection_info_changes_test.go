@@ -0,0 +1,56 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+func TestConnectionInfoChanges(t *testing.T) {
+	previous := &v1alpha1.ServiceConnectionInfo{
+		Endpoint: "pg-sample.aivencloud.com:13039",
+		Scheme:   "postgres",
+		Endpoints: []v1alpha1.ServiceEndpoint{
+			{Component: "pg", Host: "pg-sample.aivencloud.com", Port: 13039, Route: "dynamic"},
+			{Component: "pgbouncer", Host: "pg-sample.aivencloud.com", Port: 13040, Route: "dynamic"},
+		},
+	}
+
+	// The first connection info is not a change
+	assert.Nil(t, connectionInfoChanges(nil, previous))
+	assert.Nil(t, connectionInfoChanges(previous, previous.DeepCopy()))
+
+	// The plan migration moves pg, and the privatelink is approved
+	current := &v1alpha1.ServiceConnectionInfo{
+		Endpoint: "pg-sample.aivencloud.com:13041",
+		Scheme:   "postgres",
+		Endpoints: []v1alpha1.ServiceEndpoint{
+			{Component: "pg", Host: "pg-sample.aivencloud.com", Port: 13041, Route: "dynamic"},
+			{Component: "pg", Host: "privatelink-pg-sample.aivencloud.com", Port: 13041, Route: "privatelink"},
+		},
+	}
+	assert.Equal(t, []string{
+		"uri: postgres://pg-sample.aivencloud.com:13039 -> postgres://pg-sample.aivencloud.com:13041",
+		"pg/dynamic: pg-sample.aivencloud.com:13039 -> pg-sample.aivencloud.com:13041",
+		"pg/privatelink: added privatelink-pg-sample.aivencloud.com:13041",
+		"pgbouncer/dynamic: removed pg-sample.aivencloud.com:13040",
+	}, connectionInfoChanges(previous, current))
+}
+
+func TestConnectionInfoResyncAfter(t *testing.T) {
+	now := time.Date(2023, 1, 20, 12, 0, 0, 0, time.UTC)
+	status := &v1alpha1.ServiceStatus{}
+	assert.Equal(t, time.Duration(0), connectionInfoResyncAfter(status, now))
+
+	status.LastOperation = &v1alpha1.ServiceOperation{Type: "update", Time: metav1.NewTime(now.Add(-10 * time.Minute))}
+	assert.Equal(t, connectionInfoResync, connectionInfoResyncAfter(status, now))
+
+	status.LastOperation.Time = metav1.NewTime(now.Add(-2 * time.Hour))
+	assert.Equal(t, time.Duration(0), connectionInfoResyncAfter(status, now))
+}
@@ -5,6 +5,7 @@ package controllers
 import (
 	"context"
 	"fmt"
+	"net/url"
 	"strconv"
 
 	"github.com/aiven/aiven-go-client"
@@ -35,6 +36,7 @@ func (r *ConnectionPoolReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 func (r *ConnectionPoolReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&v1alpha1.ConnectionPool{}).
+		WithOptions(priorityControllerOptions(&v1alpha1.ConnectionPool{})).
 		Owns(&corev1.Secret{}).
 		Complete(r)
 }
@@ -140,27 +142,20 @@ func (h ConnectionPoolHandler) get(avn *aiven.Client, i client.Object) (*corev1.
 		getRunningCondition(metav1.ConditionTrue, "CheckRunning",
 			"Instance is running on Aiven side"))
 
-	if len(connPool.Spec.Username) == 0 {
-		return &corev1.Secret{
-			ObjectMeta: metav1.ObjectMeta{
-				Name:      h.getSecretName(connPool),
-				Namespace: connPool.Namespace,
-			},
-			StringData: map[string]string{
-				"PGHOST":       s.URIParams["host"],
-				"PGPORT":       s.URIParams["port"],
-				"PGDATABASE":   cp.Database,
-				"PGUSER":       s.URIParams["user"],
-				"PGPASSWORD":   s.URIParams["password"],
-				"PGSSLMODE":    s.URIParams["sslmode"],
-				"DATABASE_URI": cp.ConnectionURI,
-			},
-		}, nil
-	}
-
-	u, err := avn.ServiceUsers.Get(connPool.Spec.Project, connPool.Spec.ServiceName, connPool.Spec.Username)
+	user := s.URIParams["user"]
+	password := s.URIParams["password"]
+	if len(connPool.Spec.Username) > 0 {
+		u, err := avn.ServiceUsers.Get(connPool.Spec.Project, connPool.Spec.ServiceName, connPool.Spec.Username)
+		if err != nil {
+			return nil, fmt.Errorf("cannot get user: %w", err)
+		}
+		user = cp.Username
+		password = u.Password
+	}
+
+	pooled, err := url.Parse(cp.ConnectionURI)
 	if err != nil {
-		return nil, fmt.Errorf("cannot get user: %w", err)
+		return nil, fmt.Errorf("cannot parse pool connection URI: %w", err)
 	}
 
 	return &corev1.Secret{
@@ -172,10 +167,17 @@ func (h ConnectionPoolHandler) get(avn *aiven.Client, i client.Object) (*corev1.
 			"PGHOST":       s.URIParams["host"],
 			"PGPORT":       s.URIParams["port"],
 			"PGDATABASE":   cp.Database,
-			"PGUSER":       cp.Username,
-			"PGPASSWORD":   u.Password,
+			"PGUSER":       user,
+			"PGPASSWORD":   password,
 			"PGSSLMODE":    s.URIParams["sslmode"],
 			"DATABASE_URI": cp.ConnectionURI,
+			// The pool is the database name for PgBouncer
+			"PGHOST_POOLED":       pooled.Hostname(),
+			"PGPORT_POOLED":       pooled.Port(),
+			"PGDATABASE_POOLED":   connPool.Name,
+			"DATABASE_URI_POOLED": cp.ConnectionURI,
+			"PGPORT_DIRECT":       s.URIParams["port"],
+			"DATABASE_URI_DIRECT": pgURI(user, password, s.URIParams["host"], s.URIParams["port"], cp.Database, s.URIParams["sslmode"]),
 		},
 	}, nil
 }
@@ -90,6 +90,9 @@ var _ = Describe("ConnectionPool Controller", func() {
 			Expect(createdSecret.Data["PGPASSWORD"]).NotTo(BeEmpty())
 			Expect(createdSecret.Data["PGSSLMODE"]).NotTo(BeEmpty())
 			Expect(createdSecret.Data["DATABASE_URI"]).NotTo(BeEmpty())
+			Expect(createdSecret.Data["DATABASE_URI_POOLED"]).To(Equal(createdSecret.Data["DATABASE_URI"]))
+			Expect(createdSecret.Data["DATABASE_URI_DIRECT"]).NotTo(BeEmpty())
+			Expect(createdSecret.Data["PGPORT_POOLED"]).NotTo(Equal(createdSecret.Data["PGPORT_DIRECT"]))
 		})
 	})
 
@@ -174,6 +177,9 @@ var _ = Describe("ConnectionPool Controller re-use incoming user", func() {
 			Expect(createdSecret.Data["PGPASSWORD"]).NotTo(BeEmpty())
 			Expect(createdSecret.Data["PGSSLMODE"]).NotTo(BeEmpty())
 			Expect(createdSecret.Data["DATABASE_URI"]).NotTo(BeEmpty())
+			Expect(createdSecret.Data["DATABASE_URI_POOLED"]).To(Equal(createdSecret.Data["DATABASE_URI"]))
+			Expect(createdSecret.Data["DATABASE_URI_DIRECT"]).NotTo(BeEmpty())
+			Expect(createdSecret.Data["PGPORT_POOLED"]).NotTo(Equal(createdSecret.Data["PGPORT_DIRECT"]))
 		})
 	})
 
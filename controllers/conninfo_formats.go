@@ -0,0 +1,82 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+const (
+	connInfoFormatClientProperties = "clientProperties"
+	connInfoFormatLibrdkafka       = "librdkafka"
+	connInfoFormatPgpass           = "pgpass"
+)
+
+// addConnInfoFormat adds the credentials in the given client configuration format.
+// Expects the secret keys of the ServiceUser: HOST, PORT, USERNAME, PASSWORD, ACCESS_CERT, ACCESS_KEY and CA_CERT
+func addConnInfoFormat(stringData map[string]string, format, serviceType string) error {
+	if format == "" {
+		return nil
+	}
+
+	var want string
+	switch format {
+	case connInfoFormatClientProperties, connInfoFormatLibrdkafka:
+		want = "kafka"
+	case connInfoFormatPgpass:
+		want = "pg"
+	default:
+		return fmt.Errorf("unknown connection info format %q", format)
+	}
+
+	if serviceType != want {
+		return fmt.Errorf("connection info format %q is not supported by %q services", format, serviceType)
+	}
+
+	bootstrap := stringData["HOST"] + ":" + stringData["PORT"]
+	switch format {
+	case connInfoFormatClientProperties:
+		// Java properties unescape "\n", so the PEM certificates fit a line
+		escape := func(s string) string {
+			return strings.ReplaceAll(strings.TrimSpace(s), "\n", `\n`)
+		}
+
+		lines := []string{
+			"bootstrap.servers=" + bootstrap,
+			"security.protocol=SSL",
+			"ssl.keystore.type=PEM",
+			"ssl.keystore.certificate.chain=" + escape(stringData["ACCESS_CERT"]),
+			"ssl.keystore.key=" + escape(stringData["ACCESS_KEY"]),
+		}
+		if ca := stringData["CA_CERT"]; ca != "" {
+			lines = append(lines, "ssl.truststore.type=PEM", "ssl.truststore.certificates="+escape(ca))
+		}
+		stringData["client.properties"] = strings.Join(lines, "\n") + "\n"
+	case connInfoFormatLibrdkafka:
+		// The config file format of librdkafka doesn't support multiline values, a JSON object does
+		config := map[string]string{
+			"bootstrap.servers":   bootstrap,
+			"security.protocol":   "ssl",
+			"ssl.certificate.pem": stringData["ACCESS_CERT"],
+			"ssl.key.pem":         stringData["ACCESS_KEY"],
+		}
+		if ca := stringData["CA_CERT"]; ca != "" {
+			config["ssl.ca.pem"] = ca
+		}
+		b, err := json.MarshalIndent(config, "", "  ")
+		if err != nil {
+			return err
+		}
+		stringData["librdkafka.json"] = string(b)
+	case connInfoFormatPgpass:
+		// hostname:port:database:username:password, the database matches any
+		escape := strings.NewReplacer(`\`, `\\`, ":", `\:`).Replace
+		stringData[".pgpass"] = fmt.Sprintf(
+			"%s:%s:*:%s:%s\n",
+			escape(stringData["HOST"]), escape(stringData["PORT"]), escape(stringData["USERNAME"]), escape(stringData["PASSWORD"]),
+		)
+	}
+	return nil
+}
@@ -4,6 +4,7 @@ package controllers
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strconv"
 
@@ -25,6 +26,8 @@ type DatabaseReconciler struct {
 // DatabaseHandler handles an Aiven Database
 type DatabaseHandler struct{}
 
+var errDatabaseTerminationProtection = errors.New("cannot drop the database, termination protection is on")
+
 // +kubebuilder:rbac:groups=aiven.io,resources=databases,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=aiven.io,resources=databases/status,verbs=get;update;patch
 
@@ -35,6 +38,7 @@ func (r *DatabaseReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 func (r *DatabaseReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&v1alpha1.Database{}).
+		WithOptions(priorityControllerOptions(&v1alpha1.Database{})).
 		Complete(r)
 }
 
@@ -44,6 +48,16 @@ func (h DatabaseHandler) createOrUpdate(avn *aiven.Client, i client.Object, refs
 		return err
 	}
 
+	s, err := avn.Services.Get(db.Spec.Project, db.Spec.ServiceName)
+	if err != nil {
+		return err
+	}
+
+	isMySQL := s.Type == "mysql"
+	if !isMySQL && (db.Spec.Charset != "" || db.Spec.Collation != "") {
+		return fmt.Errorf("charset and collation can be used with MySQL services only, got %q service type", s.Type)
+	}
+
 	exists, err := h.exists(avn, db)
 
 	if err != nil {
@@ -51,16 +65,27 @@ func (h DatabaseHandler) createOrUpdate(avn *aiven.Client, i client.Object, refs
 	}
 
 	if !exists {
-		_, err := avn.Databases.Create(db.Spec.Project, db.Spec.ServiceName, aiven.CreateDatabaseRequest{
-			Database:  db.Name,
-			LcCollate: db.Spec.LcCollate,
-			LcType:    db.Spec.LcCtype,
-		})
+		req := aiven.CreateDatabaseRequest{Database: db.Name}
+
+		// MySQL has no locale settings, the charset and the collation are set below
+		if !isMySQL {
+			req.LcCollate = db.Spec.LcCollate
+			req.LcType = db.Spec.LcCtype
+		}
+
+		_, err := avn.Databases.Create(db.Spec.Project, db.Spec.ServiceName, req)
 		if err != nil {
 			return fmt.Errorf("cannot create database on Aiven side: %w", err)
 		}
 	}
 
+	if isMySQL && (db.Spec.Charset != "" || db.Spec.Collation != "") {
+		err = h.alterMySQLDatabase(avn, db)
+		if err != nil {
+			return err
+		}
+	}
+
 	meta.SetStatusCondition(&db.Status.Conditions,
 		getInitializedCondition("Created",
 			"Instance was created or update on Aiven side"))
@@ -75,12 +100,57 @@ func (h DatabaseHandler) createOrUpdate(avn *aiven.Client, i client.Object, refs
 	return nil
 }
 
+// alterMySQLDatabase sets the defaults of the database, applies to the tables created afterwards
+func (h DatabaseHandler) alterMySQLDatabase(avn *aiven.Client, db *v1alpha1.Database) error {
+	c, err := newMySQLClient(avn, db.Spec.Project, db.Spec.ServiceName)
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+
+	statement, err := mysqlAlterDatabaseStatement(db.Name, db.Spec.Charset, db.Spec.Collation)
+	if err != nil {
+		return err
+	}
+
+	err = c.exec(statement)
+	if err != nil {
+		return fmt.Errorf("cannot set database charset and collation: %w", err)
+	}
+	return nil
+}
+
+// mysqlAlterDatabaseStatement returns the ALTER DATABASE statement.
+// The charset and the collation are validated by the CRD, and again here as they are not quoted
+func mysqlAlterDatabaseStatement(name, charset, collation string) (string, error) {
+	statement := "ALTER DATABASE " + quoteMySQLIdentifier(name)
+	if charset != "" {
+		if !mysqlNameFormat.MatchString(charset) {
+			return "", fmt.Errorf("invalid charset %q", charset)
+		}
+		statement += " CHARACTER SET " + charset
+	}
+	if collation != "" {
+		if !mysqlNameFormat.MatchString(collation) {
+			return "", fmt.Errorf("invalid collation %q", collation)
+		}
+		statement += " COLLATE " + collation
+	}
+	return statement, nil
+}
+
 func (h DatabaseHandler) delete(avn *aiven.Client, i client.Object) (bool, error) {
 	db, err := h.convert(i)
 	if err != nil {
 		return false, err
 	}
 
+	// The webhook rejects the deletion, unless the webhooks are disabled.
+	// The database is kept until the protection is turned off
+	if db.Spec.TerminationProtection {
+		return false, errDatabaseTerminationProtection
+	}
+
 	err = avn.Databases.Delete(
 		db.Spec.Project,
 		db.Spec.ServiceName,
@@ -0,0 +1,36 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+func TestDatabaseDeleteTerminationProtection(t *testing.T) {
+	api := &fakeAivenAPI{}
+	avn := newFakeAivenClient("token", api)
+
+	db := &v1alpha1.Database{Spec: v1alpha1.DatabaseSpec{
+		Project:               "foo",
+		ServiceName:           "pg",
+		TerminationProtection: true,
+	}}
+	db.Name = "orders"
+
+	// The protected database is kept, without calling the API
+	deleted, err := DatabaseHandler{}.delete(avn, db)
+	assert.ErrorIs(t, err, errDatabaseTerminationProtection)
+	assert.False(t, deleted)
+	assert.Empty(t, api.requests)
+
+	// The fake API has no databases, which is a successful deletion
+	db.Spec.TerminationProtection = false
+	deleted, err = DatabaseHandler{}.delete(avn, db)
+	assert.NoError(t, err)
+	assert.True(t, deleted)
+	assert.Equal(t, []string{"DELETE /project/foo/service/pg/db/orders"}, api.requests)
+}
@@ -0,0 +1,109 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/aiven/aiven-go-client"
+	"github.com/prometheus/client_golang/prometheus"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	conditionTypeDiskPressure = "DiskPressure"
+	eventDiskPressure         = "DiskPressure"
+)
+
+// diskPressureThresholds are the disk usage percents in ascending order, crossing each emits a warning
+var diskPressureThresholds = []int{80, 90}
+
+// SetDiskPressureThresholds sets the comma separated disk usage percents, e.g. "80,90".
+// An empty value disables the disk usage check.
+func SetDiskPressureThresholds(s string) error {
+	thresholds := make([]int, 0)
+	for _, v := range strings.Split(s, ",") {
+		v = strings.TrimSpace(v)
+		if v == "" {
+			continue
+		}
+
+		n, err := strconv.Atoi(v)
+		if err != nil || n <= 0 || n > 100 {
+			return fmt.Errorf("invalid disk pressure threshold %q, must be a percent from 1 to 100", v)
+		}
+		thresholds = append(thresholds, n)
+	}
+
+	sort.Ints(thresholds)
+	diskPressureThresholds = thresholds
+	return nil
+}
+
+// diskPressureLevel returns the highest crossed threshold, zero if none
+func diskPressureLevel(usage float64) int {
+	level := 0
+	for _, t := range diskPressureThresholds {
+		if usage >= float64(t) {
+			level = t
+		}
+	}
+	return level
+}
+
+func diskPressureReason(level int) string {
+	if level == 0 {
+		return "DiskUsageBelowThresholds"
+	}
+	return fmt.Sprintf("DiskUsageAbove%dPercent", level)
+}
+
+// checkDiskPressure sets the DiskPressure condition and the disk usage metric,
+// and emits a warning when the usage crosses a higher threshold
+func (h *genericServiceHandler) checkDiskPressure(a *aiven.Client, object client.Object, o serviceAdapter) {
+	if len(diskPressureThresholds) == 0 || !featureEnabled(featureDiskPressure) {
+		return
+	}
+
+	ometa := o.getObjectMeta()
+	usage, found, err := aivenAPIFor(a).getServiceDiskUsage(o.getServiceCommonSpec().Project, ometa.Name)
+	if err != nil || !found {
+		// The metrics are not critical, the check is retried on the next reconciliation
+		return
+	}
+
+	serviceDiskUsage.With(prometheus.Labels{
+		"service_type": o.getServiceType(),
+		"namespace":    ometa.Namespace,
+		"name":         ometa.Name,
+	}).Set(usage)
+
+	status := o.getServiceStatus()
+	previous := 0
+	if c := meta.FindStatusCondition(status.Conditions, conditionTypeDiskPressure); c != nil {
+		_, _ = fmt.Sscanf(c.Reason, "DiskUsageAbove%dPercent", &previous)
+	}
+
+	level := diskPressureLevel(usage)
+	condition := metav1.Condition{
+		Type:    conditionTypeDiskPressure,
+		Status:  metav1.ConditionFalse,
+		Reason:  diskPressureReason(level),
+		Message: fmt.Sprintf("Disk usage is %.0f%%", usage),
+	}
+
+	if level > 0 {
+		condition.Status = metav1.ConditionTrue
+		condition.Message = fmt.Sprintf("Disk usage is %.0f%%, above the %d%% threshold", usage, level)
+		if level > previous && h.rec != nil {
+			h.rec.Event(object, corev1.EventTypeWarning, eventDiskPressure, condition.Message)
+		}
+	}
+	meta.SetStatusCondition(&status.Conditions, condition)
+}
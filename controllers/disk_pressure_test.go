@@ -0,0 +1,28 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiskPressureLevel(t *testing.T) {
+	defaults := diskPressureThresholds
+	defer func() { diskPressureThresholds = defaults }()
+
+	require.NoError(t, SetDiskPressureThresholds("95, 75"))
+	assert.Equal(t, []int{75, 95}, diskPressureThresholds)
+	assert.Equal(t, 0, diskPressureLevel(74.9))
+	assert.Equal(t, 75, diskPressureLevel(75))
+	assert.Equal(t, 95, diskPressureLevel(99))
+	assert.Equal(t, "DiskUsageAbove95Percent", diskPressureReason(95))
+
+	require.NoError(t, SetDiskPressureThresholds(""))
+	assert.Empty(t, diskPressureThresholds)
+
+	assert.Error(t, SetDiskPressureThresholds("80,101"))
+	assert.Error(t, SetDiskPressureThresholds("eighty"))
+}
@@ -0,0 +1,135 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+
+	"github.com/aiven/aiven-go-client"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+// DragonflyReconciler reconciles a Dragonfly object
+type DragonflyReconciler struct {
+	Controller
+}
+
+//+kubebuilder:rbac:groups=aiven.io,resources=dragonflies,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=aiven.io,resources=dragonflies/status,verbs=get;update;patch
+
+func (r *DragonflyReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	return r.reconcileInstance(ctx, req, newGenericServiceHandler(newDragonflyAdapter, r.Recorder), &v1alpha1.Dragonfly{})
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *DragonflyReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&v1alpha1.Dragonfly{}).
+		WithOptions(priorityControllerOptions(&v1alpha1.Dragonfly{})).
+		Owns(&corev1.Secret{}).
+		Complete(r)
+}
+
+func newDragonflyAdapter(_ *aiven.Client, object client.Object) (serviceAdapter, error) {
+	dragonfly, ok := object.(*v1alpha1.Dragonfly)
+	if !ok {
+		return nil, fmt.Errorf("object is not of type v1alpha1.Dragonfly")
+	}
+	return &dragonflyAdapter{dragonfly}, nil
+}
+
+// dragonflyAdapter handles an Aiven Dragonfly service
+type dragonflyAdapter struct {
+	*v1alpha1.Dragonfly
+}
+
+func (a *dragonflyAdapter) getObjectMeta() *metav1.ObjectMeta {
+	return &a.ObjectMeta
+}
+
+func (a *dragonflyAdapter) getServiceStatus() *v1alpha1.ServiceStatus {
+	return &a.Status
+}
+
+func (a *dragonflyAdapter) getServiceCommonSpec() *v1alpha1.ServiceCommonSpec {
+	return &a.Spec.ServiceCommonSpec
+}
+
+func (a *dragonflyAdapter) getUserConfig() any {
+	return &a.Spec.UserConfig
+}
+
+func (a *dragonflyAdapter) newSecret(s *aiven.Service) (*corev1.Secret, error) {
+	name := a.Spec.ConnInfoSecretTarget.Name
+	if name == "" {
+		name = a.Name
+	}
+
+	stringData := map[string]string{
+		"HOST":     s.URIParams["host"],
+		"PASSWORD": s.URIParams["password"],
+		"PORT":     s.URIParams["port"],
+		"SSL":      s.URIParams["ssl"],
+		"USER":     s.URIParams["user"],
+		"DB":       redisDefaultDB,
+	}
+
+	if s.URIParams["host"] != "" {
+		scheme := "rediss"
+		if !a.sslEnforced() {
+			scheme = "redis"
+		}
+
+		u := url.URL{
+			Scheme: scheme,
+			User:   url.UserPassword(s.URIParams["user"], s.URIParams["password"]),
+			Host:   net.JoinHostPort(s.URIParams["host"], s.URIParams["port"]),
+			Path:   "/" + redisDefaultDB,
+		}
+		stringData["URI"] = u.String()
+		stringData["DRAGONFLY_URI"] = u.String()
+
+		// Dragonfly speaks the Redis protocol, the Redis clients and buildpacks look for the name
+		stringData["REDIS_URI"] = u.String()
+	}
+
+	// Removes empties
+	for k, v := range stringData {
+		if v == "" {
+			delete(stringData, k)
+		}
+	}
+
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: a.Namespace},
+		StringData: stringData,
+	}, nil
+}
+
+// sslEnforced returns false if SSL is disabled in the user config, Aiven enforces it by default
+func (a *dragonflyAdapter) sslEnforced() bool {
+	if a.Spec.UserConfig == nil || a.Spec.UserConfig.DragonflySsl == nil {
+		return true
+	}
+	return *a.Spec.UserConfig.DragonflySsl
+}
+
+func (a *dragonflyAdapter) getServiceType() string {
+	return "dragonfly"
+}
+
+func (a *dragonflyAdapter) getDiskSpace() string {
+	return a.Spec.DiskSpace
+}
+
+func (a *dragonflyAdapter) getAdditionalDiskSpace() string {
+	return a.Spec.AdditionalDiskSpace
+}
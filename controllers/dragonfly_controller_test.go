@@ -0,0 +1,121 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"os"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+	dragonflyuserconfig "github.com/aiven/aiven-operator/api/v1alpha1/userconfigs/dragonfly"
+)
+
+var _ = Describe("Dragonfly Controller", func() {
+	// Define utility constants for object names and testing timeouts/durations and intervals.
+	const (
+		namespace = "default"
+
+		timeout  = time.Minute * 20
+		interval = time.Second * 10
+	)
+
+	var (
+		dragonfly   *v1alpha1.Dragonfly
+		serviceName string
+		ctx         context.Context
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		serviceName = "k8s-test-dragonfly-acc-" + generateRandomID()
+		dragonfly = dragonflySpec(serviceName, namespace)
+
+		By("Creating a new Dragonfly CR instance")
+		Expect(k8sClient.Create(ctx, dragonfly)).Should(Succeed())
+
+		lookupKey := types.NamespacedName{Name: serviceName, Namespace: namespace}
+		created := &v1alpha1.Dragonfly{}
+
+		By("by waiting Dragonfly service status to become RUNNING")
+		Eventually(func() bool {
+			err := k8sClient.Get(ctx, lookupKey, created)
+			if err == nil {
+				return meta.IsStatusConditionTrue(created.Status.Conditions, conditionTypeRunning)
+			}
+			return false
+		}, timeout, interval).Should(BeTrue())
+
+		By("by checking finalizers")
+		Expect(created.GetFinalizers()).ToNot(BeEmpty())
+	})
+
+	Context("Validating Dragonfly reconciler behaviour", func() {
+		It("should createOrUpdate a new Dragonfly service", func() {
+			created := &v1alpha1.Dragonfly{}
+			lookupKey := types.NamespacedName{Name: serviceName, Namespace: namespace}
+
+			Expect(k8sClient.Get(ctx, lookupKey, created)).Should(Succeed())
+			Expect(created.Status.State).Should(Equal("RUNNING"))
+
+			By("by checking that after creation of a Dragonfly service secret is created")
+			createdSecret := &corev1.Secret{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: serviceName, Namespace: namespace}, createdSecret)).Should(Succeed())
+
+			Expect(createdSecret.Data["HOST"]).NotTo(BeEmpty())
+			Expect(createdSecret.Data["PORT"]).NotTo(BeEmpty())
+			Expect(createdSecret.Data["USER"]).NotTo(BeEmpty())
+			Expect(createdSecret.Data["PASSWORD"]).NotTo(BeEmpty())
+			Expect(createdSecret.Data["DB"]).To(Equal([]byte("0")))
+			Expect(string(createdSecret.Data["URI"])).To(HavePrefix("rediss://"))
+			Expect(createdSecret.Data["DRAGONFLY_URI"]).To(Equal(createdSecret.Data["URI"]))
+			Expect(createdSecret.Data["REDIS_URI"]).To(Equal(createdSecret.Data["URI"]))
+
+			// Userconfig test
+			Expect(created.Spec.UserConfig.IpFilter).Should(Equal([]*dragonflyuserconfig.IpFilter{{Network: "10.20.0.0/16"}}))
+		})
+	})
+
+	AfterEach(func() {
+		By("Ensures that Dragonfly instance was deleted")
+		ensureDelete(ctx, dragonfly)
+	})
+})
+
+func dragonflySpec(serviceName, namespace string) *v1alpha1.Dragonfly {
+	return &v1alpha1.Dragonfly{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "aiven.io/v1alpha1",
+			Kind:       "Dragonfly",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      serviceName,
+			Namespace: namespace,
+		},
+		Spec: v1alpha1.DragonflySpec{
+			ServiceCommonSpec: v1alpha1.ServiceCommonSpec{
+				Project:   os.Getenv("AIVEN_PROJECT_NAME"),
+				Plan:      "startup-4",
+				CloudName: "google-europe-west1",
+			},
+			UserConfig: &dragonflyuserconfig.DragonflyUserConfig{
+				IpFilter: []*dragonflyuserconfig.IpFilter{
+					{
+						Network: "10.20.0.0/16",
+					},
+				},
+			},
+			AuthSecretRef: v1alpha1.AuthSecretReference{
+				Name: secretRefName,
+				Key:  secretRefKey,
+			},
+		},
+	}
+}
@@ -0,0 +1,311 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aiven/aiven-go-client"
+	"github.com/go-logr/logr"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+// DryRunPath is served by the webhook server, so it gets the same TLS certificate
+const DryRunPath = "/dry-run"
+
+// dryRunMaxBodySize limits the size of the posted resource
+const dryRunMaxBodySize = 1 << 20
+
+// DryRunHandler previews the Aiven API request of a prospective service resource without applying it.
+// The caller authenticates with a Kubernetes bearer token, and must be allowed to update the resource kind in its namespace.
+type DryRunHandler struct {
+	Client       client.Client
+	Log          logr.Logger
+	DefaultToken string
+
+	// AivenClientFactory creates the Aiven clients, DefaultAivenClientFactory if nil
+	AivenClientFactory AivenClientFactory
+}
+
+// +kubebuilder:rbac:groups=authentication.k8s.io,resources=tokenreviews,verbs=create
+// +kubebuilder:rbac:groups=authorization.k8s.io,resources=subjectaccessreviews,verbs=create
+
+// dryRunResult is what the operator would do with the posted resource
+type dryRunResult struct {
+	// Operation is one of create, fork, update, migration or upgrade
+	Operation string `json:"operation"`
+
+	// Request is the body of the Aiven API request
+	Request interface{} `json:"request"`
+
+	// Changes are the fields the update changes, like "plan" or "user_config.pg.max_connections"
+	Changes []string `json:"changes,omitempty"`
+
+	// RestartRequiredFields are the changed fields that restart the service
+	RestartRequiredFields []string `json:"restartRequiredFields,omitempty"`
+
+	// VersionDrift are the versions that are newer on Aiven side and are not downgraded
+	VersionDrift map[string]string `json:"versionDrift,omitempty"`
+
+	// FrozenChanges are the changes postponed by a maintenance freeze, the request leaves them out
+	FrozenChanges []string `json:"frozenChanges,omitempty"`
+
+	// KeptAccessRoutes are the routes the request keeps until the routes that replace them are available, e.g. public_access.pg
+	KeptAccessRoutes []string `json:"keptAccessRoutes,omitempty"`
+}
+
+func (h *DryRunHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "only POST is allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	ctx := r.Context()
+	user, err := h.authenticate(ctx, r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, dryRunMaxBodySize))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	u := &unstructured.Unstructured{}
+	if err = u.UnmarshalJSON(body); err != nil {
+		http.Error(w, fmt.Sprintf("invalid resource: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	gvk := u.GroupVersionKind()
+	fabric, ok := serviceKindAdapters[gvk.Kind]
+	if gvk.GroupVersion() != v1alpha1.GroupVersion || !ok {
+		http.Error(w, fmt.Sprintf("dry run is not supported for %s", gvk), http.StatusBadRequest)
+		return
+	}
+
+	if u.GetNamespace() == "" {
+		u.SetNamespace(corev1.NamespaceDefault)
+	}
+
+	allowed, err := h.authorize(ctx, user, u)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if !allowed {
+		http.Error(w, fmt.Sprintf("%s can't update %s in namespace %q", user.Username, gvk.Kind, u.GetNamespace()), http.StatusForbidden)
+		return
+	}
+
+	obj, err := h.Client.Scheme().New(gvk)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err = runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, obj); err != nil {
+		http.Error(w, fmt.Sprintf("invalid %s: %s", gvk.Kind, err), http.StatusBadRequest)
+		return
+	}
+
+	result, err := h.dryRun(ctx, obj.(aivenManagedObject), fabric)
+	if err != nil {
+		h.Log.Error(err, "unable to dry run", "kind", gvk.Kind, "namespace", u.GetNamespace(), "name", u.GetName())
+		http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err = json.NewEncoder(w).Encode(result); err != nil {
+		h.Log.Error(err, "unable to write dry run result")
+	}
+}
+
+// authenticate checks the bearer token of the request with the Kubernetes API
+func (h *DryRunHandler) authenticate(ctx context.Context, r *http.Request) (*authenticationv1.UserInfo, error) {
+	token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if token == "" || token == r.Header.Get("Authorization") {
+		return nil, fmt.Errorf("bearer token is required")
+	}
+
+	review := &authenticationv1.TokenReview{Spec: authenticationv1.TokenReviewSpec{Token: token}}
+	if err := h.Client.Create(ctx, review); err != nil {
+		return nil, fmt.Errorf("unable to review token: %w", err)
+	}
+	if !review.Status.Authenticated {
+		return nil, fmt.Errorf("invalid token")
+	}
+	return &review.Status.User, nil
+}
+
+// authorize checks that the user can update the resource
+func (h *DryRunHandler) authorize(ctx context.Context, user *authenticationv1.UserInfo, o client.Object) (bool, error) {
+	gvk := o.GetObjectKind().GroupVersionKind()
+	mapping, err := h.Client.RESTMapper().RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		return false, err
+	}
+
+	extra := make(map[string]authorizationv1.ExtraValue, len(user.Extra))
+	for k, v := range user.Extra {
+		extra[k] = authorizationv1.ExtraValue(v)
+	}
+
+	review := &authorizationv1.SubjectAccessReview{
+		Spec: authorizationv1.SubjectAccessReviewSpec{
+			User:   user.Username,
+			Groups: user.Groups,
+			UID:    user.UID,
+			Extra:  extra,
+			ResourceAttributes: &authorizationv1.ResourceAttributes{
+				Namespace: o.GetNamespace(),
+				Verb:      "update",
+				Group:     gvk.Group,
+				Version:   gvk.Version,
+				Resource:  mapping.Resource.Resource,
+				Name:      o.GetName(),
+			},
+		},
+	}
+	if err = h.Client.Create(ctx, review); err != nil {
+		return false, fmt.Errorf("unable to review access: %w", err)
+	}
+	return review.Status.Allowed, nil
+}
+
+// dryRun builds the request the generic service handler would send for the resource
+func (h *DryRunHandler) dryRun(ctx context.Context, o aivenManagedObject, fabric serviceAdapterFabric) (*dryRunResult, error) {
+	token := h.DefaultToken
+	if token == "" {
+		secret := &corev1.Secret{}
+		err := h.Client.Get(ctx, types.NamespacedName{Name: o.AuthSecretRef().Name, Namespace: o.GetNamespace()}, secret)
+		if err != nil {
+			return nil, fmt.Errorf("cannot get secret %q: %w", o.AuthSecretRef().Name, err)
+		}
+		token = string(secret.Data[o.AuthSecretRef().Key])
+	}
+
+	avn, err := aivenClientFactoryOrDefault(h.AivenClientFactory).NewClient(token)
+	if err != nil {
+		return nil, fmt.Errorf("cannot initialize aiven client: %w", err)
+	}
+
+	refs, err := instanceReconcilerHelper{k8s: h.Client}.getObjectRefs(ctx, o)
+	if err != nil {
+		return nil, err
+	}
+
+	a, err := fabric(avn, o)
+	if err != nil {
+		return nil, err
+	}
+
+	spec := a.getServiceCommonSpec()
+	projectVPCID := serviceProjectVPCID(spec, refs)
+	current, err := avn.Services.Get(spec.Project, o.GetName())
+	if aiven.IsNotFound(err) {
+		req, err := newCreateServiceRequest(a, projectVPCID)
+		if err != nil {
+			return nil, err
+		}
+		req.DiskSpaceMB, err = serviceDiskSpaceMB(avn, a)
+		if err != nil {
+			return nil, err
+		}
+		req.Cloud, err = selectServiceCloud(avn, a)
+		if err != nil {
+			return nil, err
+		}
+		return &dryRunResult{Operation: serviceCreateOperation(req.UserConfig), Request: req}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch service: %w", err)
+	}
+
+	req, drift, err := newUpdateServiceRequest(a, projectVPCID, current)
+	if err != nil {
+		return nil, err
+	}
+	req.DiskSpaceMB, err = serviceDiskSpaceMB(avn, a)
+	if err != nil {
+		return nil, err
+	}
+
+	restartFields, err := serviceRestartRequiredFields(a, current)
+	if err != nil {
+		return nil, err
+	}
+
+	frozen, _, err := freezeServiceChanges(spec, current, req, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	kept, err := stageAccessTransition(current, req)
+	if err != nil {
+		return nil, err
+	}
+
+	changes, err := serviceUpdateChanges(current, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dryRunResult{
+		Operation:             serviceUpdateOperation(current, spec.Plan, req.Cloud, req.UserConfig),
+		Request:               req,
+		Changes:               changes,
+		RestartRequiredFields: restartFields,
+		VersionDrift:          drift,
+		FrozenChanges:         frozen,
+		KeptAccessRoutes:      kept,
+	}, nil
+}
+
+// serviceUpdateChanges returns the fields the update request changes
+func serviceUpdateChanges(current *aiven.Service, req *aiven.UpdateServiceRequest) ([]string, error) {
+	changes := make([]string, 0)
+	if req.Plan != "" && req.Plan != current.Plan {
+		changes = append(changes, "plan")
+	}
+	if req.Cloud != "" && req.Cloud != current.CloudName {
+		changes = append(changes, "cloud_name")
+	}
+	if req.DiskSpaceMB != 0 && req.DiskSpaceMB != current.DiskSpaceMB {
+		changes = append(changes, "disk_space_mb")
+	}
+	if req.TerminationProtection != current.TerminationProtection {
+		changes = append(changes, "termination_protection")
+	}
+
+	want, err := normalizeJSON(req.UserConfig)
+	if err != nil {
+		return nil, err
+	}
+	got, err := normalizeJSON(current.UserConfig)
+	if err != nil {
+		return nil, err
+	}
+	diffUserConfigFields("user_config.", want, got, func(name string, _, _ interface{}) {
+		changes = append(changes, name)
+	})
+	sort.Strings(changes)
+	return changes, nil
+}
@@ -0,0 +1,51 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"testing"
+
+	"github.com/aiven/aiven-go-client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServiceUpdateChanges(t *testing.T) {
+	current := &aiven.Service{
+		Plan:      "startup-4",
+		CloudName: "google-europe-west1",
+		UserConfig: map[string]interface{}{
+			"pg_version": "14",
+			"pg": map[string]interface{}{
+				"max_connections": float64(100),
+			},
+		},
+	}
+
+	// Same values, numbers of different types
+	changes, err := serviceUpdateChanges(current, &aiven.UpdateServiceRequest{
+		Plan:  "startup-4",
+		Cloud: "google-europe-west1",
+		UserConfig: map[string]interface{}{
+			"pg": map[string]interface{}{
+				"max_connections": 100,
+			},
+		},
+	})
+	require.NoError(t, err)
+	assert.Empty(t, changes)
+
+	changes, err = serviceUpdateChanges(current, &aiven.UpdateServiceRequest{
+		Plan:                  "business-4",
+		Cloud:                 "google-europe-west1",
+		TerminationProtection: true,
+		UserConfig: map[string]interface{}{
+			"pg_version": "15",
+			"pg": map[string]interface{}{
+				"max_connections": 200,
+			},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"plan", "termination_protection", "user_config.pg.max_connections", "user_config.pg_version"}, changes)
+}
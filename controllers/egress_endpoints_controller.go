@@ -0,0 +1,218 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+const (
+	// egressEndpointsLabel marks the ConfigMaps the operator writes, the others with the same name are left intact
+	egressEndpointsLabel = "aiven.io/egress-endpoints"
+
+	// egressEndpointsKey is the ConfigMap key of the endpoint list
+	egressEndpointsKey = "endpoints.json"
+)
+
+// EgressEndpointsReconciler writes a ConfigMap to every namespace with services,
+// which lists the hosts and ports of the services for the NetworkPolicy and egress firewall automation
+type EgressEndpointsReconciler struct {
+	client.Client
+
+	Log logr.Logger
+
+	// ConfigMapName is the name of the ConfigMap in every namespace
+	ConfigMapName string
+
+	// kinds are the watched service kinds
+	kinds []string
+}
+
+// egressEndpoint is an entry of the ConfigMap
+type egressEndpoint struct {
+	Kind      string `json:"kind"`
+	Name      string `json:"name"`
+	Project   string `json:"project"`
+	Component string `json:"component"`
+	Host      string `json:"host"`
+	Port      int    `json:"port"`
+	Route     string `json:"route,omitempty"`
+}
+
+// +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch;create;update;delete
+
+func (r *EgressEndpointsReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	endpoints, err := r.listEndpoints(ctx, req.Namespace)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	configMap := &corev1.ConfigMap{}
+	err = r.Get(ctx, req.NamespacedName, configMap)
+	exists := err == nil
+	if err != nil && !apierrors.IsNotFound(err) {
+		return ctrl.Result{}, err
+	}
+
+	if exists && configMap.GetLabels()[egressEndpointsLabel] != "true" {
+		r.Log.Info("ConfigMap is not managed by the operator, skipping egress endpoints", "configMap", req.NamespacedName)
+		return ctrl.Result{}, nil
+	}
+
+	if len(endpoints) == 0 {
+		if exists {
+			return ctrl.Result{}, client.IgnoreNotFound(r.Delete(ctx, configMap))
+		}
+		return ctrl.Result{}, nil
+	}
+
+	data, err := json.MarshalIndent(endpoints, "", "  ")
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if !exists {
+		configMap = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      req.Name,
+				Namespace: req.Namespace,
+				Labels:    map[string]string{egressEndpointsLabel: "true"},
+			},
+			Data: map[string]string{egressEndpointsKey: string(data)},
+		}
+		return ctrl.Result{}, r.Create(ctx, configMap)
+	}
+
+	if configMap.Data[egressEndpointsKey] == string(data) {
+		return ctrl.Result{}, nil
+	}
+	configMap.Data = map[string]string{egressEndpointsKey: string(data)}
+	return ctrl.Result{}, r.Update(ctx, configMap)
+}
+
+// listEndpoints returns the endpoints of the services in the namespace, sorted for stable ConfigMap contents
+func (r *EgressEndpointsReconciler) listEndpoints(ctx context.Context, namespace string) ([]egressEndpoint, error) {
+	endpoints := make([]egressEndpoint, 0)
+	for _, kind := range r.kinds {
+		obj, err := r.Scheme().New(v1alpha1.GroupVersion.WithKind(kind + "List"))
+		if err != nil {
+			return nil, err
+		}
+
+		list := obj.(client.ObjectList)
+		err = r.List(ctx, list, client.InNamespace(namespace))
+		if err != nil {
+			return nil, err
+		}
+
+		items, err := meta.ExtractList(list)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range items {
+			a, err := serviceKindAdapters[kind](nil, item.(client.Object))
+			if err != nil {
+				return nil, err
+			}
+			endpoints = append(endpoints, serviceEgressEndpoints(kind, a)...)
+		}
+	}
+
+	sortEgressEndpoints(endpoints)
+	return endpoints, nil
+}
+
+// serviceEgressEndpoints returns the endpoints of the service, or none if it is being deleted
+func serviceEgressEndpoints(kind string, a serviceAdapter) []egressEndpoint {
+	info := a.getServiceStatus().ConnectionInfo
+	if info == nil || a.getObjectMeta().DeletionTimestamp != nil {
+		return nil
+	}
+
+	endpoints := make([]egressEndpoint, 0, len(info.Endpoints))
+	for _, e := range info.Endpoints {
+		endpoints = append(endpoints, egressEndpoint{
+			Kind:      kind,
+			Name:      a.getObjectMeta().Name,
+			Project:   a.getServiceCommonSpec().Project,
+			Component: e.Component,
+			Host:      e.Host,
+			Port:      e.Port,
+			Route:     e.Route,
+		})
+	}
+	return endpoints
+}
+
+func sortEgressEndpoints(endpoints []egressEndpoint) {
+	sort.Slice(endpoints, func(i, j int) bool {
+		a, b := endpoints[i], endpoints[j]
+		if a.Kind != b.Kind {
+			return a.Kind < b.Kind
+		}
+		if a.Name != b.Name {
+			return a.Name < b.Name
+		}
+		if a.Component != b.Component {
+			return a.Component < b.Component
+		}
+		if a.Host != b.Host {
+			return a.Host < b.Host
+		}
+		if a.Port != b.Port {
+			return a.Port < b.Port
+		}
+		return a.Route < b.Route
+	})
+}
+
+// SetupWithManager watches the services of the kinds, and the ConfigMaps to restore the changed or deleted ones
+func (r *EgressEndpointsReconciler) SetupWithManager(mgr ctrl.Manager, kinds []string) error {
+	isConfigMap := predicate.NewPredicateFuncs(func(o client.Object) bool {
+		return o.GetName() == r.ConfigMapName
+	})
+
+	toConfigMap := handler.EnqueueRequestsFromMapFunc(func(o client.Object) []reconcile.Request {
+		return []reconcile.Request{
+			{NamespacedName: types.NamespacedName{Name: r.ConfigMapName, Namespace: o.GetNamespace()}},
+		}
+	})
+
+	b := ctrl.NewControllerManagedBy(mgr).
+		Named("egress-endpoints").
+		For(&corev1.ConfigMap{}, builder.WithPredicates(isConfigMap))
+
+	for _, kind := range kinds {
+		if _, ok := serviceKindAdapters[kind]; !ok {
+			continue
+		}
+
+		obj, err := mgr.GetScheme().New(v1alpha1.GroupVersion.WithKind(kind))
+		if err != nil {
+			return fmt.Errorf("unknown service kind %q: %w", kind, err)
+		}
+		b = b.Watches(&source.Kind{Type: obj.(client.Object)}, toConfigMap)
+		r.kinds = append(r.kinds, kind)
+	}
+	return b.Complete(r)
+}
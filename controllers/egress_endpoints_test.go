@@ -0,0 +1,45 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+func TestServiceEgressEndpoints(t *testing.T) {
+	kafka := &v1alpha1.Kafka{
+		ObjectMeta: metav1.ObjectMeta{Name: "kafka", Namespace: "default"},
+		Spec: v1alpha1.KafkaSpec{
+			ServiceCommonSpec: v1alpha1.ServiceCommonSpec{Project: "project"},
+		},
+	}
+
+	a, err := newKafkaAdapter(nil, kafka)
+	require.NoError(t, err)
+	assert.Empty(t, serviceEgressEndpoints("Kafka", a), "the service is not created yet")
+
+	kafka.Status.ConnectionInfo = &v1alpha1.ServiceConnectionInfo{
+		Endpoint: "kafka.aivencloud.com:12691",
+		Endpoints: []v1alpha1.ServiceEndpoint{
+			{Component: "schema_registry", Host: "kafka.aivencloud.com", Port: 12694, Route: "dynamic"},
+			{Component: "kafka", Host: "kafka.aivencloud.com", Port: 12691, Route: "dynamic"},
+		},
+	}
+
+	endpoints := serviceEgressEndpoints("Kafka", a)
+	sortEgressEndpoints(endpoints)
+	assert.Equal(t, []egressEndpoint{
+		{Kind: "Kafka", Name: "kafka", Project: "project", Component: "kafka", Host: "kafka.aivencloud.com", Port: 12691, Route: "dynamic"},
+		{Kind: "Kafka", Name: "kafka", Project: "project", Component: "schema_registry", Host: "kafka.aivencloud.com", Port: 12694, Route: "dynamic"},
+	}, endpoints)
+
+	now := metav1.Now()
+	kafka.DeletionTimestamp = &now
+	assert.Empty(t, serviceEgressEndpoints("Kafka", a), "the service is being deleted")
+}
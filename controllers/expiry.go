@@ -0,0 +1,98 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// expiresAtAnnotation is the RFC3339 time after which the instance is deleted
+	expiresAtAnnotation = "aiven.io/expires-at"
+
+	// expiryWarningPeriod is how long before the expiry the warning event is emitted
+	expiryWarningPeriod = time.Hour
+
+	eventInvalidExpiresAt    = "InvalidExpiresAt"
+	eventInstanceExpiresSoon = "InstanceExpiresSoon"
+	eventInstanceExpired     = "InstanceExpired"
+)
+
+// ttlObject is deleted after its time to live since the creation
+type ttlObject interface {
+	client.Object
+
+	GetTTL() *metav1.Duration
+}
+
+// objectExpiresAt returns the earliest of the expires-at annotation and the end of the time to live,
+// the zero time if the object doesn't expire
+func objectExpiresAt(o client.Object) (time.Time, error) {
+	var expiresAt time.Time
+	if value, ok := o.GetAnnotations()[expiresAtAnnotation]; ok {
+		t, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return time.Time{}, err
+		}
+		expiresAt = t
+	}
+
+	if t, ok := o.(ttlObject); ok && t.GetTTL() != nil {
+		end := o.GetCreationTimestamp().Add(t.GetTTL().Duration)
+		if expiresAt.IsZero() || end.Before(expiresAt) {
+			expiresAt = end
+		}
+	}
+	return expiresAt, nil
+}
+
+// checkExpiry deletes the instance if its expires-at annotation or its time to live has passed,
+// and warns about the deletion within the expiryWarningPeriod.
+// Returns true if the instance has been deleted,
+// otherwise the duration after which the instance should be reconciled again to handle the expiry, zero if none.
+func (c *Controller) checkExpiry(ctx context.Context, o client.Object) (bool, time.Duration, error) {
+	if isMarkedForDeletion(o) {
+		return false, 0, nil
+	}
+
+	expiresAt, err := objectExpiresAt(o)
+	if err != nil {
+		c.Recorder.Eventf(o, corev1.EventTypeWarning, eventInvalidExpiresAt, "invalid %s annotation: %s", expiresAtAnnotation, err)
+		return false, 0, nil
+	}
+	if expiresAt.IsZero() {
+		return false, 0, nil
+	}
+
+	value := expiresAt.UTC().Format(time.RFC3339)
+	left := time.Until(expiresAt)
+	if left <= 0 {
+		c.Recorder.Eventf(o, corev1.EventTypeWarning, eventInstanceExpired, "instance expired at %s, deleting", value)
+		if err = c.Delete(ctx, o); client.IgnoreNotFound(err) != nil {
+			return false, 0, fmt.Errorf("unable to delete expired instance: %w", err)
+		}
+		return true, 0, nil
+	}
+
+	if left <= expiryWarningPeriod {
+		c.Recorder.Eventf(o, corev1.EventTypeWarning, eventInstanceExpiresSoon, "instance expires at %s and will be deleted", value)
+		return false, left, nil
+	}
+
+	return false, left - expiryWarningPeriod, nil
+}
+
+// requeueBeforeExpiry shortens the requeue so the expiry is handled on time
+func requeueBeforeExpiry(result ctrl.Result, expiresIn time.Duration) ctrl.Result {
+	if expiresIn > 0 && (result.RequeueAfter == 0 || expiresIn < result.RequeueAfter) {
+		result.RequeueAfter = expiresIn
+	}
+	return result
+}
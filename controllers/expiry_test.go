@@ -0,0 +1,53 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+func TestObjectExpiresAt(t *testing.T) {
+	created := time.Date(2023, 1, 10, 12, 0, 0, 0, time.UTC)
+	user := &v1alpha1.ServiceUser{}
+	user.CreationTimestamp = metav1.NewTime(created)
+
+	// Doesn't expire
+	expiresAt, err := objectExpiresAt(user)
+	assert.NoError(t, err)
+	assert.True(t, expiresAt.IsZero())
+
+	// The time to live since the creation
+	user.Spec.TTL = &metav1.Duration{Duration: 24 * time.Hour}
+	expiresAt, err = objectExpiresAt(user)
+	assert.NoError(t, err)
+	assert.Equal(t, created.Add(24*time.Hour), expiresAt.UTC())
+
+	// The annotation wins when it's earlier
+	metav1.SetMetaDataAnnotation(&user.ObjectMeta, expiresAtAnnotation, "2023-01-10T18:00:00Z")
+	expiresAt, err = objectExpiresAt(user)
+	assert.NoError(t, err)
+	assert.Equal(t, created.Add(6*time.Hour), expiresAt.UTC())
+
+	// The time to live wins when it's earlier
+	user.Spec.TTL = &metav1.Duration{Duration: time.Hour}
+	expiresAt, err = objectExpiresAt(user)
+	assert.NoError(t, err)
+	assert.Equal(t, created.Add(time.Hour), expiresAt.UTC())
+
+	// The kinds without a time to live
+	project := &v1alpha1.Project{}
+	metav1.SetMetaDataAnnotation(&project.ObjectMeta, expiresAtAnnotation, "2023-01-10T18:00:00Z")
+	expiresAt, err = objectExpiresAt(project)
+	assert.NoError(t, err)
+	assert.Equal(t, created.Add(6*time.Hour), expiresAt.UTC())
+
+	metav1.SetMetaDataAnnotation(&project.ObjectMeta, expiresAtAnnotation, "tomorrow")
+	_, err = objectExpiresAt(project)
+	assert.Error(t, err)
+}
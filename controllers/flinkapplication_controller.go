@@ -0,0 +1,252 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/aiven/aiven-go-client"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+// FlinkApplicationReconciler reconciles a FlinkApplication object
+type FlinkApplicationReconciler struct {
+	Controller
+}
+
+type FlinkApplicationHandler struct{}
+
+// +kubebuilder:rbac:groups=aiven.io,resources=flinkapplications,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=aiven.io,resources=flinkapplications/status,verbs=get;update;patch
+
+func (r *FlinkApplicationReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	return r.reconcileInstance(ctx, req, FlinkApplicationHandler{}, &v1alpha1.FlinkApplication{})
+}
+
+func (r *FlinkApplicationReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&v1alpha1.FlinkApplication{}).
+		WithOptions(priorityControllerOptions(&v1alpha1.FlinkApplication{})).
+		Complete(r)
+}
+
+func (h FlinkApplicationHandler) createOrUpdate(avn *aiven.Client, i client.Object, refs []client.Object) error {
+	app, err := h.convert(i)
+	if err != nil {
+		return err
+	}
+
+	s, err := avn.Services.Get(app.Spec.Project, app.Spec.ServiceName)
+	if err != nil {
+		return err
+	}
+	if s.Type != "flink" {
+		return fmt.Errorf("FlinkApplication can be used with Flink services only, got %q service type", s.Type)
+	}
+
+	api := aivenAPIFor(avn)
+	id, err := h.findOrCreate(api, app)
+	if err != nil {
+		return err
+	}
+
+	r, err := api.getFlinkApplication(app.Spec.Project, app.Spec.ServiceName, id)
+	if err != nil {
+		return err
+	}
+
+	// The versions can't be modified, a change of the spec adds a new one
+	want := newAivenFlinkApplicationVersion(&app.Spec.Version)
+	latest := latestFlinkApplicationVersion(r.ApplicationVersions)
+	if latest == nil || !flinkApplicationVersionEqual(latest, want) {
+		v, err := api.createFlinkApplicationVersion(app.Spec.Project, app.Spec.ServiceName, id, want)
+		if err != nil {
+			return fmt.Errorf("unable to create Flink application version: %w", err)
+		}
+		r.ApplicationVersions = append(r.ApplicationVersions, *v)
+	}
+
+	app.Status.ID = id
+	app.Status.Versions = flinkApplicationVersionsStatus(r.ApplicationVersions)
+
+	meta.SetStatusCondition(&app.Status.Conditions,
+		getInitializedCondition("CreatedOrUpdate",
+			"Instance was created or update on Aiven side"))
+
+	meta.SetStatusCondition(&app.Status.Conditions,
+		getRunningCondition(metav1.ConditionUnknown, "CreatedOrUpdate",
+			"Instance was created or update on Aiven side, status remains unknown"))
+
+	metav1.SetMetaDataAnnotation(&app.ObjectMeta,
+		processedGenerationAnnotation, strconv.FormatInt(app.GetGeneration(), formatIntBaseDecimal))
+
+	return nil
+}
+
+// findOrCreate returns the ID of the application. Adopts the application with the name of the resource if it exists
+func (h FlinkApplicationHandler) findOrCreate(api *aivenAPI, app *v1alpha1.FlinkApplication) (string, error) {
+	if app.Status.ID != "" {
+		_, err := api.getFlinkApplication(app.Spec.Project, app.Spec.ServiceName, app.Status.ID)
+		if err == nil {
+			return app.Status.ID, nil
+		}
+		if !isAivenAPINotFound(err) {
+			return "", err
+		}
+	}
+
+	list, err := api.listFlinkApplications(app.Spec.Project, app.Spec.ServiceName)
+	if err != nil {
+		return "", err
+	}
+	for _, a := range list {
+		if a.Name == app.Name {
+			return a.ID, nil
+		}
+	}
+
+	r, err := api.createFlinkApplication(app.Spec.Project, app.Spec.ServiceName, app.Name)
+	if err != nil {
+		return "", fmt.Errorf("unable to create Flink application: %w", err)
+	}
+	return r.ID, nil
+}
+
+func (h FlinkApplicationHandler) delete(avn *aiven.Client, i client.Object) (bool, error) {
+	app, err := h.convert(i)
+	if err != nil {
+		return false, err
+	}
+
+	if app.Status.ID == "" {
+		return true, nil
+	}
+
+	// The application can't be deleted while it's deployed, waits for the FlinkApplicationDeployment to be deleted
+	err = aivenAPIFor(avn).deleteFlinkApplication(app.Spec.Project, app.Spec.ServiceName, app.Status.ID)
+	if isAivenAPIConflict(err) {
+		return false, fmt.Errorf("%w: %s", v1alpha1.ErrDeleteDependencies, err)
+	}
+	if err != nil {
+		return false, fmt.Errorf("unable to delete Flink application: %w", err)
+	}
+	return true, nil
+}
+
+func (h FlinkApplicationHandler) get(avn *aiven.Client, i client.Object) (*corev1.Secret, error) {
+	app, err := h.convert(i)
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := aivenAPIFor(avn).getFlinkApplication(app.Spec.Project, app.Spec.ServiceName, app.Status.ID)
+	if isAivenAPINotFound(err) {
+		// The application deleted outside the operator is created again on the next reconciliation
+		meta.SetStatusCondition(&app.Status.Conditions,
+			getRunningCondition(metav1.ConditionFalse, "NotFound",
+				"The application is not found on Aiven side, creating it again"))
+		delete(app.Annotations, processedGenerationAnnotation)
+		delete(app.Annotations, instanceIsRunningAnnotation)
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	app.Status.Versions = flinkApplicationVersionsStatus(r.ApplicationVersions)
+
+	meta.SetStatusCondition(&app.Status.Conditions,
+		getRunningCondition(metav1.ConditionTrue, "CheckRunning",
+			"Instance is running on Aiven side"))
+
+	metav1.SetMetaDataAnnotation(&app.ObjectMeta, instanceIsRunningAnnotation, "true")
+
+	return nil, nil
+}
+
+func (h FlinkApplicationHandler) checkPreconditions(avn *aiven.Client, i client.Object) (bool, error) {
+	app, err := h.convert(i)
+	if err != nil {
+		return false, err
+	}
+
+	meta.SetStatusCondition(&app.Status.Conditions,
+		getInitializedCondition("Preconditions", "Checking preconditions"))
+
+	return checkServiceIsRunning(avn, app.Spec.Project, app.Spec.ServiceName)
+}
+
+func (h FlinkApplicationHandler) convert(i client.Object) (*v1alpha1.FlinkApplication, error) {
+	app, ok := i.(*v1alpha1.FlinkApplication)
+	if !ok {
+		return nil, fmt.Errorf("cannot convert object to FlinkApplication")
+	}
+
+	return app, nil
+}
+
+// newAivenFlinkApplicationVersion returns the application version of the spec
+func newAivenFlinkApplicationVersion(spec *v1alpha1.FlinkApplicationVersion) *aivenFlinkApplicationVersion {
+	v := &aivenFlinkApplicationVersion{
+		Sources:   make([]aivenFlinkTableSpec, 0, len(spec.Sources)),
+		Sinks:     make([]aivenFlinkTableSpec, 0, len(spec.Sinks)),
+		Statement: spec.Statement,
+	}
+	for _, t := range spec.Sources {
+		v.Sources = append(v.Sources, aivenFlinkTableSpec{CreateTable: t.CreateTable, IntegrationID: t.IntegrationID})
+	}
+	for _, t := range spec.Sinks {
+		v.Sinks = append(v.Sinks, aivenFlinkTableSpec{CreateTable: t.CreateTable, IntegrationID: t.IntegrationID})
+	}
+	return v
+}
+
+// latestFlinkApplicationVersion returns the version with the highest number, nil if there are none
+func latestFlinkApplicationVersion(list []aivenFlinkApplicationVersion) *aivenFlinkApplicationVersion {
+	var latest *aivenFlinkApplicationVersion
+	for i := range list {
+		if latest == nil || list[i].Version > latest.Version {
+			latest = &list[i]
+		}
+	}
+	return latest
+}
+
+// flinkApplicationVersionEqual compares the SQL of the versions
+func flinkApplicationVersionEqual(a, b *aivenFlinkApplicationVersion) bool {
+	if a.Statement != b.Statement || len(a.Sources) != len(b.Sources) || len(a.Sinks) != len(b.Sinks) {
+		return false
+	}
+	for i := range a.Sources {
+		if a.Sources[i] != b.Sources[i] {
+			return false
+		}
+	}
+	for i := range a.Sinks {
+		if a.Sinks[i] != b.Sinks[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// flinkApplicationVersionsStatus returns the versions sorted by number, the latest last
+func flinkApplicationVersionsStatus(list []aivenFlinkApplicationVersion) []v1alpha1.FlinkApplicationVersionStatus {
+	versions := make([]v1alpha1.FlinkApplicationVersionStatus, 0, len(list))
+	for _, v := range list {
+		versions = append(versions, v1alpha1.FlinkApplicationVersionStatus{Version: v.Version, ID: v.ID})
+	}
+	sort.Slice(versions, func(i, j int) bool {
+		return versions[i].Version < versions[j].Version
+	})
+	return versions
+}
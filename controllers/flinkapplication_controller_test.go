@@ -0,0 +1,159 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/aiven/aiven-go-client"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+var _ = Describe("FlinkApplication Controller", func() {
+	// Define utility constants for object names and testing timeouts/durations and intervals.
+	const (
+		namespace = "default"
+
+		timeout  = time.Minute * 20
+		interval = time.Second * 10
+	)
+
+	var (
+		app         *v1alpha1.FlinkApplication
+		deployment  *v1alpha1.FlinkApplicationDeployment
+		serviceName string
+		appName     string
+		ctx         context.Context
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		serviceName = "k8s-test-flink-acc-" + generateRandomID()
+		appName = "k8s-flink-app-" + generateRandomID()
+
+		// There is no Flink kind, the service is created with the client
+		By("Creating a new Flink service")
+		_, err := aivenClient.Services.Create(os.Getenv("AIVEN_PROJECT_NAME"), aiven.CreateServiceRequest{
+			Cloud:       "google-europe-west1",
+			Plan:        "business-4",
+			ServiceName: serviceName,
+			ServiceType: "flink",
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		By("Creating a new FlinkApplication CR instance")
+		app = flinkApplicationSpec(serviceName, appName, namespace)
+		Expect(k8sClient.Create(ctx, app)).Should(Succeed())
+
+		By("Creating a new FlinkApplicationDeployment CR instance")
+		deployment = &v1alpha1.FlinkApplicationDeployment{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: "aiven.io/v1alpha1",
+				Kind:       "FlinkApplicationDeployment",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      appName,
+				Namespace: namespace,
+			},
+			Spec: v1alpha1.FlinkApplicationDeploymentSpec{
+				Project:        os.Getenv("AIVEN_PROJECT_NAME"),
+				ServiceName:    serviceName,
+				ApplicationRef: v1alpha1.ResourceReference{Name: appName},
+				State:          "Running",
+				Parallelism:    1,
+				AuthSecretRef: v1alpha1.AuthSecretReference{
+					Name: secretRefName,
+					Key:  secretRefKey,
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, deployment)).Should(Succeed())
+
+		By("by waiting FlinkApplicationDeployment to become RUNNING")
+		Eventually(func() bool {
+			lookupKey := types.NamespacedName{Name: deployment.Name, Namespace: namespace}
+			created := &v1alpha1.FlinkApplicationDeployment{}
+			err := k8sClient.Get(ctx, lookupKey, created)
+			if err == nil {
+				return meta.IsStatusConditionTrue(created.Status.Conditions, conditionTypeRunning)
+			}
+			return false
+		}, timeout, interval).Should(BeTrue())
+	})
+
+	Context("Validating FlinkApplication reconciler behaviour", func() {
+		It("should deploy the latest version of the application", func() {
+			createdApp := &v1alpha1.FlinkApplication{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: appName, Namespace: namespace}, createdApp)).Should(Succeed())
+			Expect(createdApp.Status.ID).NotTo(BeEmpty())
+			Expect(createdApp.Status.Versions).To(HaveLen(1))
+
+			created := &v1alpha1.FlinkApplicationDeployment{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: appName, Namespace: namespace}, created)).Should(Succeed())
+			Expect(created.Status.VersionID).To(Equal(createdApp.Status.Versions[0].ID))
+			Expect(created.Status.State).To(Equal(flinkDeploymentRunning))
+			Expect(created.Status.JobID).NotTo(BeEmpty())
+
+			By("by stopping the job")
+			created.Spec.State = "Stopped"
+			Expect(k8sClient.Update(ctx, created)).Should(Succeed())
+			Eventually(func() string {
+				stopped := &v1alpha1.FlinkApplicationDeployment{}
+				err := k8sClient.Get(ctx, types.NamespacedName{Name: appName, Namespace: namespace}, stopped)
+				if err != nil || !meta.IsStatusConditionTrue(stopped.Status.Conditions, conditionTypeRunning) {
+					return ""
+				}
+				return stopped.Status.State
+			}, timeout, interval).Should(Equal(flinkDeploymentFinished))
+		})
+	})
+
+	AfterEach(func() {
+		By("Ensures that FlinkApplicationDeployment instance was deleted")
+		ensureDelete(ctx, deployment)
+
+		By("Ensures that FlinkApplication instance was deleted")
+		ensureDelete(ctx, app)
+
+		By("Ensures that Flink service was deleted")
+		Expect(aivenClient.Services.Delete(os.Getenv("AIVEN_PROJECT_NAME"), serviceName)).Should(Succeed())
+	})
+})
+
+func flinkApplicationSpec(serviceName, name, namespace string) *v1alpha1.FlinkApplication {
+	return &v1alpha1.FlinkApplication{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "aiven.io/v1alpha1",
+			Kind:       "FlinkApplication",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: v1alpha1.FlinkApplicationSpec{
+			Project:     os.Getenv("AIVEN_PROJECT_NAME"),
+			ServiceName: serviceName,
+			Version: v1alpha1.FlinkApplicationVersion{
+				Sources: []v1alpha1.FlinkApplicationTable{{
+					CreateTable: "CREATE TABLE source (id INT) WITH ('connector' = 'datagen', 'rows-per-second' = '1')",
+				}},
+				Sinks: []v1alpha1.FlinkApplicationTable{{
+					CreateTable: "CREATE TABLE sink (id INT) WITH ('connector' = 'blackhole')",
+				}},
+				Statement: "INSERT INTO sink SELECT id FROM source",
+			},
+			AuthSecretRef: v1alpha1.AuthSecretReference{
+				Name: secretRefName,
+				Key:  secretRefKey,
+			},
+		},
+	}
+}
@@ -0,0 +1,259 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/aiven/aiven-go-client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+// fakeFlinkAPI serves the applications and the deployments of a running Flink service
+type fakeFlinkAPI struct {
+	apps        []*aivenFlinkApplication
+	deployments []*aivenFlinkApplicationDeployment
+	requests    []string
+}
+
+func (f *fakeFlinkAPI) RoundTrip(r *http.Request) (*http.Response, error) {
+	path := strings.TrimPrefix(r.URL.Path, "/v1")
+	f.requests = append(f.requests, r.Method+" "+path)
+
+	const apps = "/project/foo/service/flink/flink/application"
+	var out interface{}
+	parts := strings.Split(strings.TrimPrefix(path, apps), "/")
+	switch {
+	case r.Method == http.MethodGet && path == "/project/foo/service/flink":
+		out = map[string]interface{}{"service": aiven.Service{Name: "flink", Type: "flink", State: "RUNNING"}}
+	case r.Method == http.MethodGet && path == apps:
+		out = map[string]interface{}{"applications": f.apps}
+	case r.Method == http.MethodPost && path == apps:
+		app := new(aivenFlinkApplication)
+		if err := json.NewDecoder(r.Body).Decode(app); err != nil {
+			return nil, err
+		}
+		app.ID = fmt.Sprintf("app%d", len(f.apps)+1)
+		f.apps = append(f.apps, app)
+		out = app
+	case !strings.HasPrefix(path, apps+"/"):
+	case r.Method == http.MethodGet && len(parts) == 2:
+		for _, a := range f.apps {
+			if a.ID == parts[1] {
+				out = a
+			}
+		}
+	case r.Method == http.MethodPost && len(parts) == 3 && parts[2] == "version":
+		v := new(aivenFlinkApplicationVersion)
+		if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+			return nil, err
+		}
+		for _, a := range f.apps {
+			if a.ID == parts[1] {
+				v.Version = len(a.ApplicationVersions) + 1
+				v.ID = fmt.Sprintf("%s-v%d", a.ID, v.Version)
+				a.ApplicationVersions = append(a.ApplicationVersions, *v)
+				out = v
+			}
+		}
+	case r.Method == http.MethodPost && len(parts) == 3 && parts[2] == "deployment":
+		d := new(aivenFlinkApplicationDeployment)
+		if err := json.NewDecoder(r.Body).Decode(d); err != nil {
+			return nil, err
+		}
+		d.ID = fmt.Sprintf("deployment%d", len(f.deployments)+1)
+		d.Status = "INITIALIZING"
+		f.deployments = append(f.deployments, d)
+		out = d
+	case len(parts) >= 4 && parts[2] == "deployment":
+		for _, d := range f.deployments {
+			if d.ID != parts[3] {
+				continue
+			}
+			switch {
+			case r.Method == http.MethodGet && len(parts) == 4:
+				out = d
+			case r.Method == http.MethodPost && len(parts) == 5 && parts[4] == "stop":
+				d.Status = "SAVING_AND_STOP_REQUESTED"
+				out = d
+			case r.Method == http.MethodPost && len(parts) == 5 && parts[4] == "cancel":
+				d.Status = "CANCELLING_REQUESTED"
+				out = d
+			case r.Method == http.MethodDelete && len(parts) == 4:
+				out = d
+			}
+		}
+	}
+
+	rsp := &http.Response{StatusCode: http.StatusOK, Header: make(http.Header), Request: r}
+	if out == nil {
+		rsp.StatusCode = http.StatusNotFound
+		out = map[string]string{"message": "Not found"}
+	}
+	b, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	rsp.Body = io.NopCloser(bytes.NewReader(b))
+	return rsp, nil
+}
+
+func newTestFlinkApplication() *v1alpha1.FlinkApplication {
+	app := &v1alpha1.FlinkApplication{}
+	app.Name = "orders"
+	app.Spec.Project = "foo"
+	app.Spec.ServiceName = "flink"
+	app.Spec.Version = v1alpha1.FlinkApplicationVersion{
+		Sources:   []v1alpha1.FlinkApplicationTable{{CreateTable: "CREATE TABLE source (id INT)", IntegrationID: "kafka"}},
+		Sinks:     []v1alpha1.FlinkApplicationTable{{CreateTable: "CREATE TABLE sink (id INT)", IntegrationID: "pg"}},
+		Statement: "INSERT INTO sink SELECT * FROM source",
+	}
+	return app
+}
+
+func TestFlinkApplicationVersions(t *testing.T) {
+	api := &fakeFlinkAPI{}
+	avn := newFakeAivenClient("token", api)
+	h := FlinkApplicationHandler{}
+
+	app := newTestFlinkApplication()
+	require.NoError(t, h.createOrUpdate(avn, app, nil))
+	assert.Equal(t, "app1", app.Status.ID)
+	assert.Equal(t, []v1alpha1.FlinkApplicationVersionStatus{{Version: 1, ID: "app1-v1"}}, app.Status.Versions)
+
+	// The same spec doesn't add a version
+	require.NoError(t, h.createOrUpdate(avn, app, nil))
+	assert.Len(t, api.apps[0].ApplicationVersions, 1)
+
+	// The change of the statement adds a version
+	app.Spec.Version.Statement = "INSERT INTO sink SELECT id FROM source"
+	require.NoError(t, h.createOrUpdate(avn, app, nil))
+	assert.Equal(t, 2, app.Status.LatestVersion().Version)
+
+	// The application of the same name is adopted
+	adopted := newTestFlinkApplication()
+	require.NoError(t, h.createOrUpdate(avn, adopted, nil))
+	assert.Equal(t, "app1", adopted.Status.ID)
+	assert.Len(t, api.apps, 1)
+
+	_, err := h.get(avn, app)
+	require.NoError(t, err)
+	assert.True(t, meta.IsStatusConditionTrue(app.Status.Conditions, conditionTypeRunning))
+}
+
+func TestFlinkApplicationDeploymentLifecycle(t *testing.T) {
+	api := &fakeFlinkAPI{}
+	avn := newFakeAivenClient("token", api)
+
+	app := newTestFlinkApplication()
+	require.NoError(t, FlinkApplicationHandler{}.createOrUpdate(avn, app, nil))
+
+	h := FlinkApplicationDeploymentHandler{}
+	d := &v1alpha1.FlinkApplicationDeployment{}
+	d.Name = "orders"
+	d.Spec.Project = "foo"
+	d.Spec.ServiceName = "flink"
+	d.Spec.ApplicationRef = v1alpha1.ResourceReference{Name: "orders"}
+	d.Spec.StartingSavepoint = "s3://savepoints/initial"
+	refs := []client.Object{app}
+
+	// The latest version is deployed from the starting savepoint
+	require.NoError(t, h.createOrUpdate(avn, d, refs))
+	require.Len(t, api.deployments, 1)
+	assert.Equal(t, "app1-v1", api.deployments[0].VersionID)
+	assert.Equal(t, "s3://savepoints/initial", api.deployments[0].StartingSavepoint)
+	assert.Equal(t, 1, api.deployments[0].Parallelism)
+	assert.True(t, api.deployments[0].RestartEnabled)
+
+	_, err := h.get(avn, d)
+	require.NoError(t, err)
+	assert.Equal(t, "INITIALIZING", d.Status.State)
+	assert.False(t, meta.IsStatusConditionTrue(d.Status.Conditions, conditionTypeRunning))
+
+	api.deployments[0].Status = flinkDeploymentRunning
+	api.deployments[0].JobID = "job1"
+	_, err = h.get(avn, d)
+	require.NoError(t, err)
+	assert.Equal(t, "job1", d.Status.JobID)
+	assert.True(t, meta.IsStatusConditionTrue(d.Status.Conditions, conditionTypeRunning))
+
+	// The change of the parallelism stops the job with a savepoint, then deploys it again from the savepoint
+	d.Spec.Parallelism = 2
+	require.NoError(t, h.createOrUpdate(avn, d, refs))
+	assert.Equal(t, "SAVING_AND_STOP_REQUESTED", api.deployments[0].Status)
+	assert.Len(t, api.deployments, 1)
+
+	api.deployments[0].Status = flinkDeploymentFinished
+	api.deployments[0].LastSavepoint = "s3://savepoints/1"
+	_, err = h.get(avn, d)
+	require.NoError(t, err)
+	require.Len(t, api.deployments, 2)
+	assert.Equal(t, "deployment2", d.Status.DeploymentID)
+	assert.Equal(t, "s3://savepoints/1", api.deployments[1].StartingSavepoint)
+	assert.Equal(t, 2, api.deployments[1].Parallelism)
+
+	// The stopped job stays stopped
+	api.deployments[1].Status = flinkDeploymentRunning
+	d.Spec.State = "Stopped"
+	require.NoError(t, h.createOrUpdate(avn, d, refs))
+	assert.Equal(t, "SAVING_AND_STOP_REQUESTED", api.deployments[1].Status)
+
+	api.deployments[1].Status = flinkDeploymentFinished
+	_, err = h.get(avn, d)
+	require.NoError(t, err)
+	assert.Len(t, api.deployments, 2)
+	assert.True(t, meta.IsStatusConditionTrue(d.Status.Conditions, conditionTypeRunning))
+
+	// The failed job is reported, and is not deployed again until the spec changes
+	d.Spec.State = "Running"
+	api.deployments[1].Status = flinkDeploymentFailed
+	api.deployments[1].ErrorMsg = "boom"
+	_, err = h.get(avn, d)
+	require.NoError(t, err)
+	assert.Len(t, api.deployments, 2)
+	assert.Equal(t, "boom", d.Status.Error)
+	assert.True(t, meta.IsStatusConditionFalse(d.Status.Conditions, conditionTypeRunning))
+
+	// The deletion cancels the running job first
+	require.NoError(t, h.createOrUpdate(avn, d, refs))
+	require.Len(t, api.deployments, 3)
+	deleted, err := h.delete(avn, d)
+	require.NoError(t, err)
+	assert.False(t, deleted)
+	assert.Equal(t, "CANCELLING_REQUESTED", api.deployments[2].Status)
+
+	api.deployments[2].Status = flinkDeploymentCanceled
+	deleted, err = h.delete(avn, d)
+	require.NoError(t, err)
+	assert.True(t, deleted)
+	assert.Contains(t, api.requests, "DELETE /project/foo/service/flink/flink/application/app1/deployment/deployment3")
+}
+
+func TestFlinkApplicationDeploymentValidatesRefs(t *testing.T) {
+	avn := newFakeAivenClient("token", &fakeFlinkAPI{})
+	app := newTestFlinkApplication()
+	app.Status.Versions = []v1alpha1.FlinkApplicationVersionStatus{{Version: 1, ID: "v1"}}
+
+	d := &v1alpha1.FlinkApplicationDeployment{}
+	d.Spec.Project = "foo"
+	d.Spec.ServiceName = "other"
+	err := FlinkApplicationDeploymentHandler{}.createOrUpdate(avn, d, []client.Object{app})
+	assert.ErrorContains(t, err, `but the deployment is for service "other"`)
+
+	version := 2
+	d.Spec.ServiceName = "flink"
+	d.Spec.Version = &version
+	err = FlinkApplicationDeploymentHandler{}.createOrUpdate(avn, d, []client.Object{app})
+	assert.ErrorContains(t, err, "has no version 2")
+}
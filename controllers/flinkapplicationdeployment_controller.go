@@ -0,0 +1,311 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/aiven/aiven-go-client"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+// FlinkApplicationDeploymentReconciler reconciles a FlinkApplicationDeployment object
+type FlinkApplicationDeploymentReconciler struct {
+	Controller
+}
+
+type FlinkApplicationDeploymentHandler struct{}
+
+// +kubebuilder:rbac:groups=aiven.io,resources=flinkapplicationdeployments,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=aiven.io,resources=flinkapplicationdeployments/status,verbs=get;update;patch
+
+func (r *FlinkApplicationDeploymentReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	return r.reconcileInstance(ctx, req, FlinkApplicationDeploymentHandler{}, &v1alpha1.FlinkApplicationDeployment{})
+}
+
+func (r *FlinkApplicationDeploymentReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&v1alpha1.FlinkApplicationDeployment{}).
+		WithOptions(priorityControllerOptions(&v1alpha1.FlinkApplicationDeployment{})).
+		Complete(r)
+}
+
+// Deployment states on Aiven side
+const (
+	flinkDeploymentRunning  = "RUNNING"
+	flinkDeploymentFailed   = "FAILED"
+	flinkDeploymentFinished = "FINISHED"
+	flinkDeploymentCanceled = "CANCELED"
+)
+
+// isFlinkDeploymentStopped returns true if the job of the deployment is stopped for good
+func isFlinkDeploymentStopped(d *aivenFlinkApplicationDeployment) bool {
+	switch d.Status {
+	case flinkDeploymentFailed, flinkDeploymentFinished, flinkDeploymentCanceled:
+		return true
+	}
+	return false
+}
+
+// isFlinkDeploymentStopping returns true if the job is being stopped
+func isFlinkDeploymentStopping(d *aivenFlinkApplicationDeployment) bool {
+	switch d.Status {
+	case "SAVING_AND_STOP", "SAVING_AND_STOP_REQUESTED", "CANCELLING", "CANCELLING_REQUESTED", "DELETE_REQUESTED", "DELETING":
+		return true
+	}
+	return false
+}
+
+func (h FlinkApplicationDeploymentHandler) createOrUpdate(avn *aiven.Client, i client.Object, refs []client.Object) error {
+	d, err := h.convert(i)
+	if err != nil {
+		return err
+	}
+
+	// The application of applicationRef is created by now, it must be on the same service
+	var app *v1alpha1.FlinkApplication
+	for _, r := range refs {
+		if a, ok := r.(*v1alpha1.FlinkApplication); ok {
+			app = a
+		}
+	}
+	if app == nil {
+		return fmt.Errorf("applicationRef FlinkApplication %q is not found", d.Spec.ApplicationRef.Name)
+	}
+	if app.Spec.Project != d.Spec.Project || app.Spec.ServiceName != d.Spec.ServiceName {
+		return fmt.Errorf("applicationRef FlinkApplication %q is an application of service %q of project %q, but the deployment is for service %q of project %q",
+			app.Name, app.Spec.ServiceName, app.Spec.Project, d.Spec.ServiceName, d.Spec.Project)
+	}
+
+	versionID, err := findFlinkApplicationVersionID(app, d.Spec.Version)
+	if err != nil {
+		return err
+	}
+
+	d.Status.ApplicationID = app.Status.ID
+	d.Status.VersionID = versionID
+
+	// The spec has changed, so the failed job is deployed again
+	_, err = h.sync(aivenAPIFor(avn), d, true)
+	if err != nil {
+		return err
+	}
+
+	meta.SetStatusCondition(&d.Status.Conditions,
+		getInitializedCondition("CreatedOrUpdate",
+			"Instance was created or update on Aiven side"))
+
+	meta.SetStatusCondition(&d.Status.Conditions,
+		getRunningCondition(metav1.ConditionUnknown, "CreatedOrUpdate",
+			"Instance was created or update on Aiven side, status remains unknown"))
+
+	metav1.SetMetaDataAnnotation(&d.ObjectMeta,
+		processedGenerationAnnotation, strconv.FormatInt(d.GetGeneration(), formatIntBaseDecimal))
+
+	return nil
+}
+
+// findFlinkApplicationVersionID returns the ID of the version to deploy, the latest one if the version is not set
+func findFlinkApplicationVersionID(app *v1alpha1.FlinkApplication, version *int) (string, error) {
+	if version == nil {
+		latest := app.Status.LatestVersion()
+		if latest == nil {
+			return "", fmt.Errorf("FlinkApplication %q has no versions yet", app.Name)
+		}
+		return latest.ID, nil
+	}
+
+	for _, v := range app.Status.Versions {
+		if v.Version == *version {
+			return v.ID, nil
+		}
+	}
+	return "", fmt.Errorf("FlinkApplication %q has no version %d", app.Name, *version)
+}
+
+// sync moves the current deployment towards the spec one step at a time, returns true once it's there.
+// The job that differs from the spec or is stopped on purpose is stopped with a savepoint first,
+// then the stopped job is deployed again from the savepoint. The failed job is deployed again only with redeployFailed
+func (h FlinkApplicationDeploymentHandler) sync(api *aivenAPI, d *v1alpha1.FlinkApplicationDeployment, redeployFailed bool) (bool, error) {
+	project, service, appID := d.Spec.Project, d.Spec.ServiceName, d.Status.ApplicationID
+
+	var cur *aivenFlinkApplicationDeployment
+	if d.Status.DeploymentID != "" {
+		r, err := api.getFlinkApplicationDeployment(project, service, appID, d.Status.DeploymentID)
+		if err != nil && !isAivenAPINotFound(err) {
+			return false, err
+		}
+		cur = r
+	}
+
+	want := &aivenFlinkApplicationDeployment{
+		VersionID:      d.Status.VersionID,
+		Parallelism:    d.GetParallelism(),
+		RestartEnabled: d.IsRestartEnabled(),
+	}
+
+	done := false
+	switch {
+	case cur == nil:
+		if d.IsStopped() {
+			done = true
+			break
+		}
+		// The deployment deleted outside the operator resumes from its last savepoint
+		want.StartingSavepoint = d.Spec.StartingSavepoint
+		if d.Status.LastSavepoint != "" {
+			want.StartingSavepoint = d.Status.LastSavepoint
+		}
+		r, err := api.createFlinkApplicationDeployment(project, service, appID, want)
+		if err != nil {
+			return false, fmt.Errorf("unable to create Flink application deployment: %w", err)
+		}
+		cur = r
+	case isFlinkDeploymentStopped(cur):
+		if d.IsStopped() || (cur.Status == flinkDeploymentFailed && !redeployFailed) {
+			done = d.IsStopped()
+			break
+		}
+		want.StartingSavepoint = cur.LastSavepoint
+		if want.StartingSavepoint == "" {
+			want.StartingSavepoint = d.Status.LastSavepoint
+		}
+		r, err := api.createFlinkApplicationDeployment(project, service, appID, want)
+		if err != nil {
+			return false, fmt.Errorf("unable to create Flink application deployment: %w", err)
+		}
+		cur = r
+	case isFlinkDeploymentStopping(cur):
+		// Waits for the job to stop
+	case d.IsStopped() || !flinkDeploymentMatches(cur, want):
+		err := api.stopFlinkApplicationDeployment(project, service, appID, cur.ID, true)
+		if err != nil {
+			return false, fmt.Errorf("unable to stop Flink application deployment: %w", err)
+		}
+	default:
+		done = cur.Status == flinkDeploymentRunning
+	}
+
+	d.Status.DeploymentID, d.Status.State, d.Status.JobID, d.Status.Error = "", "", "", ""
+	if cur != nil {
+		d.Status.DeploymentID = cur.ID
+		d.Status.State = cur.Status
+		d.Status.JobID = cur.JobID
+		d.Status.Error = cur.ErrorMsg
+		if cur.LastSavepoint != "" {
+			d.Status.LastSavepoint = cur.LastSavepoint
+		}
+	}
+	return done, nil
+}
+
+// flinkDeploymentMatches returns true if the deployment runs the version of the spec the way the spec says
+func flinkDeploymentMatches(cur, want *aivenFlinkApplicationDeployment) bool {
+	return cur.VersionID == want.VersionID && cur.Parallelism == want.Parallelism && cur.RestartEnabled == want.RestartEnabled
+}
+
+func (h FlinkApplicationDeploymentHandler) delete(avn *aiven.Client, i client.Object) (bool, error) {
+	d, err := h.convert(i)
+	if err != nil {
+		return false, err
+	}
+
+	if d.Status.DeploymentID == "" {
+		return true, nil
+	}
+
+	api := aivenAPIFor(avn)
+	project, service, appID := d.Spec.Project, d.Spec.ServiceName, d.Status.ApplicationID
+	cur, err := api.getFlinkApplicationDeployment(project, service, appID, d.Status.DeploymentID)
+	if isAivenAPINotFound(err) {
+		return true, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	// The running job is canceled without a savepoint, the deployment is deleted once it's stopped
+	if !isFlinkDeploymentStopped(cur) {
+		if !isFlinkDeploymentStopping(cur) {
+			err = api.stopFlinkApplicationDeployment(project, service, appID, cur.ID, false)
+			if err != nil {
+				return false, fmt.Errorf("unable to cancel Flink application deployment: %w", err)
+			}
+		}
+		return false, nil
+	}
+
+	err = api.deleteFlinkApplicationDeployment(project, service, appID, cur.ID)
+	if err != nil {
+		return false, fmt.Errorf("unable to delete Flink application deployment: %w", err)
+	}
+	return true, nil
+}
+
+func (h FlinkApplicationDeploymentHandler) get(avn *aiven.Client, i client.Object) (*corev1.Secret, error) {
+	d, err := h.convert(i)
+	if err != nil {
+		return nil, err
+	}
+
+	done, err := h.sync(aivenAPIFor(avn), d, false)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case done && d.IsStopped():
+		meta.SetStatusCondition(&d.Status.Conditions,
+			getRunningCondition(metav1.ConditionTrue, "Stopped",
+				"The job is stopped"))
+	case done:
+		meta.SetStatusCondition(&d.Status.Conditions,
+			getRunningCondition(metav1.ConditionTrue, "CheckRunning",
+				"Instance is running on Aiven side"))
+	case d.Status.State == flinkDeploymentFailed:
+		meta.SetStatusCondition(&d.Status.Conditions,
+			getRunningCondition(metav1.ConditionFalse, "Failed",
+				fmt.Sprintf("The job has failed: %s", d.Status.Error)))
+		delete(d.Annotations, instanceIsRunningAnnotation)
+		return nil, nil
+	default:
+		meta.SetStatusCondition(&d.Status.Conditions,
+			getRunningCondition(metav1.ConditionUnknown, "Transitioning",
+				fmt.Sprintf("The deployment is in %s state", d.Status.State)))
+		delete(d.Annotations, instanceIsRunningAnnotation)
+		return nil, nil
+	}
+
+	metav1.SetMetaDataAnnotation(&d.ObjectMeta, instanceIsRunningAnnotation, "true")
+
+	return nil, nil
+}
+
+func (h FlinkApplicationDeploymentHandler) checkPreconditions(avn *aiven.Client, i client.Object) (bool, error) {
+	d, err := h.convert(i)
+	if err != nil {
+		return false, err
+	}
+
+	meta.SetStatusCondition(&d.Status.Conditions,
+		getInitializedCondition("Preconditions", "Checking preconditions"))
+
+	return checkServiceIsRunning(avn, d.Spec.Project, d.Spec.ServiceName)
+}
+
+func (h FlinkApplicationDeploymentHandler) convert(i client.Object) (*v1alpha1.FlinkApplicationDeployment, error) {
+	d, ok := i.(*v1alpha1.FlinkApplicationDeployment)
+	if !ok {
+		return nil, fmt.Errorf("cannot convert object to FlinkApplicationDeployment")
+	}
+
+	return d, nil
+}
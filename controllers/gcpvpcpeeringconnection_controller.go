@@ -0,0 +1,202 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/aiven/aiven-go-client"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+// GCPVPCPeeringConnectionReconciler reconciles a GCPVPCPeeringConnection object
+type GCPVPCPeeringConnectionReconciler struct {
+	Controller
+}
+
+type GCPVPCPeeringConnectionHandler struct{}
+
+// +kubebuilder:rbac:groups=aiven.io,resources=gcpvpcpeeringconnections,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=aiven.io,resources=gcpvpcpeeringconnections/status,verbs=get;update;patch
+
+func (r *GCPVPCPeeringConnectionReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	return r.reconcileInstance(ctx, req, GCPVPCPeeringConnectionHandler{}, &v1alpha1.GCPVPCPeeringConnection{})
+}
+
+func (r *GCPVPCPeeringConnectionReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&v1alpha1.GCPVPCPeeringConnection{}).
+		WithOptions(priorityControllerOptions(&v1alpha1.GCPVPCPeeringConnection{})).
+		Complete(r)
+}
+
+func (h GCPVPCPeeringConnectionHandler) createOrUpdate(avn *aiven.Client, i client.Object, refs []client.Object) error {
+	peering, err := h.convert(i)
+	if err != nil {
+		return err
+	}
+
+	vpcID := peeringVPCID(peering.Spec.VPCID, refs)
+	if vpcID == "" {
+		return fmt.Errorf("the project VPC has no ID yet")
+	}
+
+	// The peering connection is immutable, an existing one is adopted
+	reason := "Updated"
+	_, pc, err := h.getPeeringConnection(avn, peering, vpcID)
+	if err != nil {
+		return err
+	}
+	if pc == nil {
+		pc, err = avn.VPCPeeringConnections.Create(peering.Spec.Project, vpcID, aiven.CreateVPCPeeringConnectionRequest{
+			PeerCloudAccount: peering.Spec.GCPProjectID,
+			PeerVPC:          peering.Spec.PeerVPC,
+		})
+		if err != nil {
+			return err
+		}
+		reason = "Created"
+	}
+
+	peering.Status.VPCID = vpcID
+	peering.Status.State = pc.State
+
+	meta.SetStatusCondition(&peering.Status.Conditions,
+		getInitializedCondition(reason,
+			"Instance was created or update on Aiven side"))
+
+	meta.SetStatusCondition(&peering.Status.Conditions,
+		getRunningCondition(metav1.ConditionUnknown, reason,
+			"Instance was created or update on Aiven side, status remains unknown"))
+
+	metav1.SetMetaDataAnnotation(&peering.ObjectMeta,
+		processedGenerationAnnotation, strconv.FormatInt(peering.GetGeneration(), formatIntBaseDecimal))
+
+	return nil
+}
+
+func (h GCPVPCPeeringConnectionHandler) delete(avn *aiven.Client, i client.Object) (bool, error) {
+	peering, err := h.convert(i)
+	if err != nil {
+		return false, err
+	}
+
+	if peering.Status.VPCID == "" {
+		return true, nil
+	}
+
+	_, pc, err := h.getPeeringConnection(avn, peering, peering.Status.VPCID)
+	if aiven.IsNotFound(err) {
+		return true, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	if pc == nil || pc.State == "DELETED" {
+		return true, nil
+	}
+	if pc.State == "DELETING" {
+		return false, nil
+	}
+
+	err = avn.VPCPeeringConnections.Delete(peering.Spec.Project, peering.Status.VPCID, peering.Spec.GCPProjectID, peering.Spec.PeerVPC)
+	if aiven.IsNotFound(err) {
+		return true, nil
+	}
+	return false, err
+}
+
+func (h GCPVPCPeeringConnectionHandler) get(avn *aiven.Client, i client.Object) (*corev1.Secret, error) {
+	peering, err := h.convert(i)
+	if err != nil {
+		return nil, err
+	}
+
+	if peering.Status.VPCID == "" {
+		return nil, nil
+	}
+
+	vpc, pc, err := h.getPeeringConnection(avn, peering, peering.Status.VPCID)
+	if err != nil {
+		return nil, err
+	}
+	if pc == nil {
+		return nil, fmt.Errorf("peering connection to VPC network %q of GCP project %q is not found", peering.Spec.PeerVPC, peering.Spec.GCPProjectID)
+	}
+
+	status := newProjectVPCPeeringConnection(vpc, pc)
+	peering.Status.State = status.State
+	peering.Status.Message = status.Message
+	peering.Status.SelfLink = gcpPeeringSelfLink(pc)
+	if pc.State == "PENDING_PEER" && peering.Status.SelfLink != "" {
+		peering.Status.Message = fmt.Sprintf("Create a peering from the VPC network %q of the GCP project %q to %s",
+			peering.Spec.PeerVPC, peering.Spec.GCPProjectID, peering.Status.SelfLink)
+	}
+
+	if pc.State != "ACTIVE" {
+		return nil, nil
+	}
+
+	meta.SetStatusCondition(&peering.Status.Conditions,
+		getRunningCondition(metav1.ConditionTrue, "CheckRunning",
+			"Instance is running on Aiven side"))
+
+	metav1.SetMetaDataAnnotation(&peering.ObjectMeta, instanceIsRunningAnnotation, "true")
+
+	return nil, nil
+}
+
+// getPeeringConnection returns the VPC and its peering connection to the GCP network, nil if there is none
+func (h GCPVPCPeeringConnectionHandler) getPeeringConnection(avn *aiven.Client, peering *v1alpha1.GCPVPCPeeringConnection, vpcID string) (*aiven.VPC, *aiven.VPCPeeringConnection, error) {
+	return getVPCPeeringConnection(avn, peering.Spec.Project, vpcID, func(pc *aiven.VPCPeeringConnection) bool {
+		return pc.PeerCloudAccount == peering.Spec.GCPProjectID && pc.PeerVPC == peering.Spec.PeerVPC
+	})
+}
+
+// gcpPeeringSelfLink returns the link to the Aiven VPC network the peer VPC network is peered with.
+// Aiven tells the network once the peering connection is created on its side
+func gcpPeeringSelfLink(pc *aiven.VPCPeeringConnection) string {
+	if pc.StateInfo == nil {
+		return ""
+	}
+
+	info := *pc.StateInfo
+	project, _ := info["to_project_id"].(string)
+	network, _ := info["to_vpc_network"].(string)
+	if project == "" || network == "" {
+		return ""
+	}
+	return fmt.Sprintf("https://www.googleapis.com/compute/v1/projects/%s/global/networks/%s", project, network)
+}
+
+// resyncAfter refreshes the state of the peering connection, which is accepted or deleted on GCP side
+func (h GCPVPCPeeringConnectionHandler) resyncAfter(o client.Object) time.Duration {
+	peering, err := h.convert(o)
+	if err != nil {
+		return 0
+	}
+	return vpcPeeringResyncAfter(peering.Status.VPCID, peering.Status.State)
+}
+
+func (h GCPVPCPeeringConnectionHandler) checkPreconditions(_ *aiven.Client, _ client.Object) (bool, error) {
+	return true, nil
+}
+
+func (h GCPVPCPeeringConnectionHandler) convert(i client.Object) (*v1alpha1.GCPVPCPeeringConnection, error) {
+	peering, ok := i.(*v1alpha1.GCPVPCPeeringConnection)
+	if !ok {
+		return nil, fmt.Errorf("cannot convert object to GCPVPCPeeringConnection")
+	}
+
+	return peering, nil
+}
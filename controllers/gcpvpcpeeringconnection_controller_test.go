@@ -0,0 +1,96 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"os"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+var _ = Describe("GCPVPCPeeringConnection Controller", func() {
+	const (
+		namespace = "default"
+		timeout   = time.Minute * 20
+		interval  = time.Second * 10
+	)
+
+	It("creates a peering connection of a ProjectVPC, and deletes it", func() {
+		gcpProjectID := os.Getenv("AIVEN_GCP_PROJECT_ID")
+		if gcpProjectID == "" {
+			Skip("AIVEN_GCP_PROJECT_ID is required")
+		}
+
+		ctx := context.Background()
+		projectName := os.Getenv("AIVEN_PROJECT_NAME")
+		vpcName := "k8s-test-project-vpc-acc-" + generateRandomID()
+		peeringName := "k8s-test-gcp-peering-acc-" + generateRandomID()
+		vpcObj := projectVPC(vpcName, namespace, projectName)
+		peeringObj := gcpVPCPeeringConnectionSpec(peeringName, namespace, projectName, vpcName, gcpProjectID)
+		peeringLookupKey := types.NamespacedName{Name: peeringName, Namespace: namespace}
+
+		By("Creating a new ProjectVPC and a peering connection of it")
+		Expect(k8sClient.Create(ctx, vpcObj)).Should(Succeed())
+		Expect(k8sClient.Create(ctx, peeringObj)).Should(Succeed())
+
+		By("by waiting the peering connection to be created on Aiven side")
+		createdPeering := &v1alpha1.GCPVPCPeeringConnection{}
+		Eventually(func() string {
+			err := k8sClient.Get(ctx, peeringLookupKey, createdPeering)
+			if err != nil {
+				return ""
+			}
+			return createdPeering.Status.State
+		}, timeout, interval).Should(BeElementOf("PENDING_PEER", "INVALID_SPECIFICATION"))
+		Expect(createdPeering.Status.VPCID).NotTo(BeEmpty())
+		Expect(createdPeering.Status.Message).NotTo(BeEmpty())
+
+		pc, err := aivenClient.VPCPeeringConnections.Get(projectName, createdPeering.Status.VPCID, gcpProjectID, peeringObj.Spec.PeerVPC)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(pc.State).Should(Equal(createdPeering.Status.State))
+
+		By("Deletes the peering connection, and the VPC after it")
+		Expect(k8sClient.Delete(ctx, peeringObj)).Should(Succeed())
+		Eventually(func() bool {
+			err := k8sClient.Get(ctx, peeringLookupKey, &v1alpha1.GCPVPCPeeringConnection{})
+			return apierrors.IsNotFound(err)
+		}, timeout, interval).Should(BeTrue())
+
+		Expect(k8sClient.Delete(ctx, vpcObj)).Should(Succeed())
+		Eventually(func() bool {
+			err := k8sClient.Get(ctx, types.NamespacedName{Name: vpcName, Namespace: namespace}, &v1alpha1.ProjectVPC{})
+			return apierrors.IsNotFound(err)
+		}, timeout, interval).Should(BeTrue())
+	})
+})
+
+func gcpVPCPeeringConnectionSpec(name, namespace, projectName, vpcName, gcpProjectID string) *v1alpha1.GCPVPCPeeringConnection {
+	return &v1alpha1.GCPVPCPeeringConnection{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "aiven.io/v1alpha1",
+			Kind:       "GCPVPCPeeringConnection",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: v1alpha1.GCPVPCPeeringConnectionSpec{
+			Project:       projectName,
+			ProjectVPCRef: &v1alpha1.ResourceReference{Name: vpcName, Namespace: namespace},
+			GCPProjectID:  gcpProjectID,
+			PeerVPC:       "k8s-test-network",
+			AuthSecretRef: v1alpha1.AuthSecretReference{
+				Name: secretRefName,
+				Key:  secretRefKey,
+			},
+		},
+	}
+}
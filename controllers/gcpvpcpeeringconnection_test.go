@@ -0,0 +1,83 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/aiven/aiven-go-client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+// fakeVPCAPI serves a project VPC with its peering connections
+type fakeVPCAPI struct {
+	vpc *aiven.VPC
+}
+
+func (f *fakeVPCAPI) RoundTrip(r *http.Request) (*http.Response, error) {
+	rsp := &http.Response{StatusCode: http.StatusOK, Header: make(http.Header), Request: r}
+	var out interface{} = f.vpc
+	if r.Method != http.MethodGet || r.URL.Path != "/v1/project/foo/vpcs/"+f.vpc.ProjectVPCID {
+		rsp.StatusCode = http.StatusNotFound
+		out = map[string]string{"message": "Not found"}
+	}
+	b, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	rsp.Body = io.NopCloser(bytes.NewReader(b))
+	return rsp, nil
+}
+
+func TestGCPPeeringSelfLink(t *testing.T) {
+	pc := &aiven.VPCPeeringConnection{}
+	assert.Empty(t, gcpPeeringSelfLink(pc))
+
+	pc.StateInfo = &map[string]interface{}{"to_project_id": "aiven-prod-1", "to_vpc_network": "aiven-vpc-1"}
+	assert.Equal(t, "https://www.googleapis.com/compute/v1/projects/aiven-prod-1/global/networks/aiven-vpc-1", gcpPeeringSelfLink(pc))
+}
+
+func TestGCPVPCPeeringConnectionGet(t *testing.T) {
+	api := &fakeVPCAPI{vpc: &aiven.VPC{
+		ProjectVPCID: "vpc1",
+		NetworkCIDR:  "10.0.0.0/24",
+		PeeringConnections: []*aiven.VPCPeeringConnection{
+			{PeerCloudAccount: "other-project", PeerVPC: "my-network", State: "ACTIVE"},
+			{
+				PeerCloudAccount: "my-project",
+				PeerVPC:          "my-network",
+				State:            "PENDING_PEER",
+				StateInfo:        &map[string]interface{}{"to_project_id": "aiven-prod-1", "to_vpc_network": "aiven-vpc-1"},
+			},
+		},
+	}}
+	avn := newFakeAivenClient("token", api)
+
+	peering := &v1alpha1.GCPVPCPeeringConnection{
+		Spec:   v1alpha1.GCPVPCPeeringConnectionSpec{Project: "foo", GCPProjectID: "my-project", PeerVPC: "my-network"},
+		Status: v1alpha1.GCPVPCPeeringConnectionStatus{VPCID: "vpc1"},
+	}
+
+	// The peering from the GCP side is missing
+	_, err := GCPVPCPeeringConnectionHandler{}.get(avn, peering)
+	require.NoError(t, err)
+	assert.Equal(t, "PENDING_PEER", peering.Status.State)
+	assert.Equal(t, "https://www.googleapis.com/compute/v1/projects/aiven-prod-1/global/networks/aiven-vpc-1", peering.Status.SelfLink)
+	assert.Equal(t, `Create a peering from the VPC network "my-network" of the GCP project "my-project" to `+peering.Status.SelfLink, peering.Status.Message)
+	assert.False(t, isAlreadyRunning(peering))
+
+	// Both sides are peered
+	api.vpc.PeeringConnections[1].State = "ACTIVE"
+	_, err = GCPVPCPeeringConnectionHandler{}.get(avn, peering)
+	require.NoError(t, err)
+	assert.Equal(t, "ACTIVE", peering.Status.State)
+	assert.Empty(t, peering.Status.Message)
+	assert.True(t, isAlreadyRunning(peering))
+}
@@ -2,25 +2,49 @@ package controllers
 
 import (
 	"fmt"
+	"net/url"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/aiven/aiven-go-client"
+	"github.com/prometheus/client_golang/prometheus"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/aiven/aiven-operator/api/v1alpha1"
 )
 
-func newGenericServiceHandler(fabric serviceAdapterFabric) Handlers {
-	return &genericServiceHandler{fabric: fabric}
+func newGenericServiceHandler(fabric serviceAdapterFabric, rec record.EventRecorder) Handlers {
+	return &genericServiceHandler{fabric: fabric, rec: newOwnerEventRecorder(rec)}
+}
+
+// serviceKindAdapters are the adapters of the service kinds, by kind
+var serviceKindAdapters = map[string]serviceAdapterFabric{
+	"Cassandra":    newCassandraAdapter,
+	"Clickhouse":   newClickhouseAdapter,
+	"Dragonfly":    newDragonflyAdapter,
+	"Grafana":      newGrafanaAdapter,
+	"Kafka":        newKafkaAdapter,
+	"KafkaConnect": newKafkaConnectAdapter,
+	"M3Aggregator": newM3AggregatorAdapter,
+	"M3DB":         newM3DBAdapter,
+	"MySQL":        newMySQLAdapter,
+	"OpenSearch":   newOpenSearchAdapter,
+	"PostgreSQL":   newPostgresSQLAdapter,
+	"Redis":        newRedisAdapter,
+	"Thanos":       newThanosAdapter,
+	"Valkey":       newValkeyAdapter,
 }
 
 // genericServiceHandler provides common CRUD management for all service types using serviceAdapter,
 // which turns specific service (mysql, redis) into a generic.
 type genericServiceHandler struct {
 	fabric serviceAdapterFabric
+	rec    record.EventRecorder
 }
 
 func (h *genericServiceHandler) createOrUpdate(a *aiven.Client, object client.Object, refs []client.Object) error {
@@ -31,86 +55,166 @@ func (h *genericServiceHandler) createOrUpdate(a *aiven.Client, object client.Ob
 
 	spec := o.getServiceCommonSpec()
 	ometa := o.getObjectMeta()
+	projectVPCID := serviceProjectVPCID(spec, refs)
 
-	// Project id reference
-	// Could be right in spec or referenced (has ref)
-	projectVPCID := spec.ProjectVPCID
-	if projectVPCID == "" {
-		if p := v1alpha1.FindProjectVPC(refs); p != nil {
-			projectVPCID = p.Status.ID
-		}
-	}
-
-	_, err = a.Services.Get(spec.Project, ometa.Name)
+	current, err := a.Services.Get(spec.Project, ometa.Name)
 	exists := err == nil
 	if !exists && !aiven.IsNotFound(err) {
 		return fmt.Errorf("failed to fetch service: %w", err)
 	}
 
+	// The service deleted out of band is not recreated empty without the confirmation
+	if !exists && serviceWasRunning(object, o.getServiceStatus()) && h.checkResourceMissing(object, o.getServiceStatus()) {
+		return nil
+	}
+
+	// A rebuilt cluster takes over the services it has applied before, without updating them
+	if exists && serviceStateTagsEnabled {
+		tags, err := a.ServiceTags.Get(spec.Project, ometa.Name)
+		if err != nil {
+			return fmt.Errorf("failed to fetch service tags: %w", err)
+		}
+		adopted, err := adoptedByStateTags(object, o.getServiceStatus(), tags.Tags)
+		if err != nil {
+			return err
+		}
+		if adopted {
+			h.adopt(object, o)
+			return nil
+		}
+	}
+
 	// Creates if not exists or updates existing service
+	ops := recordAivenOperations(a)
+	var operation string
 	var reason string
+	var restartCondition *metav1.Condition
+	var frozenCondition *metav1.Condition
+	var accessCondition *metav1.Condition
+	var kept []string
+	var freeze *v1alpha1.MaintenanceFreeze
 	if !exists {
 		reason = "Created"
-		userConfig, err := UserConfigurationToAPIV2(o.getUserConfig(), []string{"create", "update"})
+		req, err := newCreateServiceRequest(o, projectVPCID)
 		if err != nil {
 			return err
 		}
+		operation = serviceCreateOperation(req.UserConfig)
 
-		req := aiven.CreateServiceRequest{
-			Cloud:                 spec.CloudName,
-			DiskSpaceMB:           v1alpha1.ConvertDiscSpace(o.getDiskSpace()),
-			MaintenanceWindow:     getMaintenanceWindow(spec.MaintenanceWindowDow, spec.MaintenanceWindowTime),
-			Plan:                  spec.Plan,
-			ProjectVPCID:          toOptionalStringPointer(projectVPCID),
-			ServiceIntegrations:   nil,
-			ServiceName:           ometa.Name,
-			ServiceType:           o.getServiceType(),
-			TerminationProtection: spec.TerminationProtection,
-			UserConfig:            userConfig,
-		}
-
-		for _, s := range spec.ServiceIntegrations {
-			i := aiven.NewServiceIntegration{
-				IntegrationType: s.IntegrationType,
-				SourceService:   &s.SourceServiceName,
-				// todo: fix in go client, sends None
-				UserConfig: make(map[string]interface{}),
-			}
-			req.ServiceIntegrations = append(req.ServiceIntegrations, i)
+		req.DiskSpaceMB, err = serviceDiskSpaceMB(a, o)
+		if err != nil {
+			return err
 		}
 
-		_, err = a.Services.Create(spec.Project, req)
+		req.Cloud, err = selectServiceCloud(a, o)
+		if err != nil {
+			return err
+		}
+		if req.Cloud != spec.CloudName && h.rec != nil {
+			h.rec.Eventf(object, corev1.EventTypeWarning, eventCloudFallback,
+				"plan %q is not available in cloud %q, creating the service in %q", spec.Plan, spec.CloudName, req.Cloud)
+		}
+
+		_, err = a.Services.Create(spec.Project, *req)
 		if err != nil {
 			return fmt.Errorf("failed to create service: %w", err)
 		}
 	} else {
 		reason = "Updated"
-		userConfig, err := UserConfigurationToAPIV2(o.getUserConfig(), []string{"update"})
+
+		// Tells the fields that restart the service, so disruptive updates are not a surprise
+		restartFields, err := serviceRestartRequiredFields(o, current)
+		if err != nil {
+			return err
+		}
+		c := getRestartRequiredCondition(restartFields)
+		restartCondition = &c
+
+		req, drift, err := newUpdateServiceRequest(o, projectVPCID, current)
+		if err != nil {
+			return err
+		}
+		req.DiskSpaceMB, err = serviceDiskSpaceMB(a, o)
 		if err != nil {
 			return err
 		}
+		meta.SetStatusCondition(&o.getServiceStatus().Conditions, getVersionDriftCondition(drift))
 
-		req := aiven.UpdateServiceRequest{
-			Cloud:                 spec.CloudName,
-			DiskSpaceMB:           v1alpha1.ConvertDiscSpace(o.getDiskSpace()),
-			MaintenanceWindow:     getMaintenanceWindow(spec.MaintenanceWindowDow, spec.MaintenanceWindowTime),
-			Plan:                  spec.Plan,
-			Powered:               true,
-			ProjectVPCID:          toOptionalStringPointer(projectVPCID),
-			TerminationProtection: spec.TerminationProtection,
-			UserConfig:            userConfig,
+		// The other changes are applied during a maintenance freeze
+		var frozen []string
+		frozen, freeze, err = freezeServiceChanges(spec, current, req, time.Now())
+		if err != nil {
+			return err
+		}
+		fc := getChangesFrozenCondition(frozen, freeze)
+		frozenCondition = &fc
+		if freeze != nil && h.rec != nil {
+			h.rec.Event(object, corev1.EventTypeWarning, eventChangesFrozen, fc.Message)
+		}
+		// The old routes are disabled in the next updates, once the new ones are available
+		kept, err = stageAccessTransition(current, req)
+		if err != nil {
+			return err
+		}
+		ac := getAccessTransitionCondition(kept)
+		accessCondition = &ac
+		if len(kept) > 0 && h.rec != nil {
+			h.rec.Event(object, corev1.EventTypeNormal, eventAccessTransition, ac.Message)
 		}
-		_, err = a.Services.Update(spec.Project, ometa.Name, req)
+		operation = serviceUpdateOperation(current, spec.Plan, req.Cloud, req.UserConfig)
+
+		_, err = a.Services.Update(spec.Project, ometa.Name, *req)
 		if err != nil {
 			return fmt.Errorf("failed to update service: %w", err)
 		}
 	}
 
+	// The service requests of the client have no tags, they are set with the tags endpoint
+	tags := serviceTags(spec)
+	if serviceStateTagsEnabled {
+		stateTags, err := serviceStateTags(object)
+		if err != nil {
+			return err
+		}
+		for k, v := range stateTags {
+			tags[k] = v
+		}
+	}
+	err = updateServiceTags(a, spec.Project, ometa.Name, tags)
+	if err != nil {
+		return fmt.Errorf("failed to update service tags: %w", err)
+	}
+
 	status := o.getServiceStatus()
 	meta.SetStatusCondition(&status.Conditions,
 		getInitializedCondition(reason, "Instance was created or update on Aiven side"))
 	meta.SetStatusCondition(&status.Conditions,
 		getRunningCondition(metav1.ConditionUnknown, reason, "Instance was created or update on Aiven side, status remains unknown"))
+	if restartCondition != nil {
+		meta.SetStatusCondition(&status.Conditions, *restartCondition)
+	}
+	if frozenCondition != nil {
+		meta.SetStatusCondition(&status.Conditions, *frozenCondition)
+	}
+	if accessCondition != nil {
+		meta.SetStatusCondition(&status.Conditions, *accessCondition)
+	}
+	status.LastOperation = &v1alpha1.ServiceOperation{
+		Type:      operation,
+		RequestID: ops.lastRequestID(),
+		Time:      metav1.Now(),
+	}
+	status.AppliedRequests = appendAppliedRequests(status.AppliedRequests, ops.appliedRequests(object.GetGeneration()))
+
+	// The generation is processed once the postponed changes are applied
+	status.ChangesFrozenUntil = nil
+	if freeze != nil {
+		status.ChangesFrozenUntil = freeze.End.DeepCopy()
+		return nil
+	}
+	if len(kept) > 0 {
+		return nil
+	}
 
 	metav1.SetMetaDataAnnotation(
 		o.getObjectMeta(),
@@ -120,6 +224,152 @@ func (h *genericServiceHandler) createOrUpdate(a *aiven.Client, object client.Ob
 	return nil
 }
 
+// adopt marks the generation processed, the service already has the spec applied by the previous cluster
+func (h *genericServiceHandler) adopt(object client.Object, o serviceAdapter) {
+	status := o.getServiceStatus()
+	meta.SetStatusCondition(&status.Conditions,
+		getInitializedCondition("Adopted", "Instance was adopted, the state tags on Aiven side match the spec"))
+	meta.SetStatusCondition(&status.Conditions,
+		getRunningCondition(metav1.ConditionUnknown, "Adopted", "Instance was adopted, status remains unknown"))
+	metav1.SetMetaDataAnnotation(
+		o.getObjectMeta(),
+		processedGenerationAnnotation,
+		strconv.FormatInt(object.GetGeneration(), formatIntBaseDecimal),
+	)
+	if h.rec != nil {
+		h.rec.Event(object, corev1.EventTypeNormal, eventAdoptedByStateTags, "the service has the spec applied, adopted without an update")
+	}
+}
+
+// postponedFor returns the time left until the postponed changes can be applied:
+// the end of the maintenance freeze, or the next check of the routes of an access transition
+func (h *genericServiceHandler) postponedFor(object client.Object) time.Duration {
+	o, err := h.fabric(nil, object)
+	if err != nil {
+		return 0
+	}
+
+	if isAlreadyProcessed(object) {
+		return 0
+	}
+
+	// The old routes of an access transition are disabled once the new ones are available
+	status := o.getServiceStatus()
+	if meta.IsStatusConditionTrue(status.Conditions, conditionTypeAccessTransition) {
+		return accessTransitionRetry
+	}
+
+	until := status.ChangesFrozenUntil
+	if until == nil {
+		return 0
+	}
+	return time.Until(until.Time)
+}
+
+// resyncAfter returns the time left until the maintenance window of the service ends,
+// so the changes of the maintenance, like a new version or rotated certificates, are picked up soon.
+// Shortly after an operation, the service is resynced often, so the secret gets the changed hosts and ports soon too
+func (h *genericServiceHandler) resyncAfter(object client.Object) time.Duration {
+	o, err := h.fabric(nil, object)
+	if err != nil {
+		return 0
+	}
+
+	status := o.getServiceStatus()
+	d := connectionInfoResyncAfter(status, time.Now())
+	if end := status.MaintenanceWindowEnd; end != nil {
+		if m := time.Until(end.Time) + maintenanceResyncDelay; m > 0 && (d == 0 || m < d) {
+			d = m
+		}
+	}
+	return d
+}
+
+// serviceProjectVPCID returns the project VPC id, which could be right in spec or referenced.
+// The services use the ID of an organization VPC the same way
+func serviceProjectVPCID(spec *v1alpha1.ServiceCommonSpec, refs []client.Object) string {
+	if spec.ProjectVPCID != "" {
+		return spec.ProjectVPCID
+	}
+	if p := v1alpha1.FindProjectVPC(refs); p != nil {
+		return p.Status.ID
+	}
+	if p := v1alpha1.FindOrganizationVPC(refs); p != nil {
+		return p.Status.ID
+	}
+	return ""
+}
+
+// newCreateServiceRequest returns the request that creates the service
+func newCreateServiceRequest(o serviceAdapter, projectVPCID string) (*aiven.CreateServiceRequest, error) {
+	spec := o.getServiceCommonSpec()
+	userConfig, err := UserConfigurationToAPIV2(o.getUserConfig(), []string{"create", "update"})
+	if err != nil {
+		return nil, err
+	}
+
+	req := &aiven.CreateServiceRequest{
+		Cloud:                 spec.CloudName,
+		DiskSpaceMB:           v1alpha1.ConvertDiscSpace(o.getDiskSpace()),
+		MaintenanceWindow:     getMaintenanceWindow(spec.MaintenanceWindowDow, spec.MaintenanceWindowTime),
+		Plan:                  spec.Plan,
+		ProjectVPCID:          toOptionalStringPointer(projectVPCID),
+		ServiceIntegrations:   nil,
+		ServiceName:           o.getObjectMeta().Name,
+		ServiceType:           o.getServiceType(),
+		TerminationProtection: spec.TerminationProtection,
+		UserConfig:            userConfig,
+	}
+
+	for _, s := range spec.ServiceIntegrations {
+		i := aiven.NewServiceIntegration{
+			IntegrationType: s.IntegrationType,
+			SourceService:   &s.SourceServiceName,
+			// todo: fix in go client, sends None
+			UserConfig: make(map[string]interface{}),
+		}
+		req.ServiceIntegrations = append(req.ServiceIntegrations, i)
+	}
+	return req, nil
+}
+
+// newUpdateServiceRequest returns the request that updates the service,
+// and the versions that are newer on Aiven side, which the request leaves as they are
+func newUpdateServiceRequest(o serviceAdapter, projectVPCID string, current *aiven.Service) (*aiven.UpdateServiceRequest, map[string]string, error) {
+	spec := o.getServiceCommonSpec()
+	userConfig, err := UserConfigurationToAPIV2(o.getUserConfig(), []string{"update"})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Doesn't try to converge the version that was upgraded on Aiven side, downgrades are destructive
+	drift := findVersionDrift(userConfig, current.UserConfig)
+	for k := range drift {
+		delete(userConfig, k)
+	}
+
+	req := &aiven.UpdateServiceRequest{
+		Cloud:                 serviceUpdateCloud(spec, current.CloudName),
+		DiskSpaceMB:           v1alpha1.ConvertDiscSpace(o.getDiskSpace()),
+		MaintenanceWindow:     getMaintenanceWindow(spec.MaintenanceWindowDow, spec.MaintenanceWindowTime),
+		Plan:                  spec.Plan,
+		Powered:               true,
+		ProjectVPCID:          toOptionalStringPointer(projectVPCID),
+		TerminationProtection: spec.TerminationProtection,
+		UserConfig:            userConfig,
+	}
+	return req, drift, nil
+}
+
+// serviceRestartRequiredFields returns the changed user config fields that restart the service
+func serviceRestartRequiredFields(o serviceAdapter, current *aiven.Service) ([]string, error) {
+	restartConfig, err := UserConfigurationToAPIV2(o.getUserConfig(), []string{userConfigRestartGroup})
+	if err != nil {
+		return nil, err
+	}
+	return restartRequiredFields(restartConfig, current.UserConfig)
+}
+
 func (h *genericServiceHandler) delete(a *aiven.Client, object client.Object) (bool, error) {
 	o, err := h.fabric(a, object)
 	if err != nil {
@@ -128,6 +378,13 @@ func (h *genericServiceHandler) delete(a *aiven.Client, object client.Object) (b
 
 	err = a.Services.Delete(o.getServiceCommonSpec().Project, o.getObjectMeta().Name)
 	if err == nil || aiven.IsNotFound(err) {
+		labels := prometheus.Labels{
+			"service_type": o.getServiceType(),
+			"namespace":    o.getObjectMeta().Namespace,
+			"name":         o.getObjectMeta().Name,
+		}
+		serviceMigrationProgress.Delete(labels)
+		serviceDiskUsage.Delete(labels)
 		return true, nil
 	}
 
@@ -140,18 +397,80 @@ func (h *genericServiceHandler) get(a *aiven.Client, object client.Object) (*cor
 		return nil, err
 	}
 
-	s, err := a.Services.Get(o.getServiceCommonSpec().Project, o.getObjectMeta().Name)
+	status := o.getServiceStatus()
+	// The notifications come with the service, the client types don't have them
+	s, notifications, err := aivenAPIFor(a).getServiceWithNotifications(o.getServiceCommonSpec().Project, o.getObjectMeta().Name)
 	if err != nil {
+		// The missing service is recreated on the next reconciliation if confirmed
+		if aiven.IsNotFound(err) && serviceWasRunning(object, status) {
+			h.checkResourceMissing(object, status)
+			return nil, nil
+		}
 		return nil, fmt.Errorf("failed to get service from Aiven: %w", err)
 	}
 
-	status := o.getServiceStatus()
+	clearResourceMissing(status)
 	status.State = s.State
+
+	// The secret is updated in this reconciliation, the event tells the workloads may need a restart
+	connectionInfo := newServiceConnectionInfo(s)
+	if changes := connectionInfoChanges(status.ConnectionInfo, connectionInfo); len(changes) > 0 && h.rec != nil {
+		h.rec.Eventf(object, corev1.EventTypeNormal, eventConnectionInfoChanged,
+			"the hosts or the ports of the service changed, updating the secret: %s", strings.Join(changes, ", "))
+	}
+	status.ConnectionInfo = connectionInfo
+	status.MigrationProgress = serviceMigrationProgressPercent(s)
+	status.CloudName = s.CloudName
+	status.MaintenanceWindowEnd = nil
+	if end, ok := maintenanceWindowEnd(s.MaintenanceWindow, time.Now()); ok {
+		status.MaintenanceWindowEnd = &metav1.Time{Time: end}
+	}
+	if err = updateServiceCustomCloud(a, status, o.getServiceCommonSpec().Project, s.CloudName); err != nil {
+		return nil, err
+	}
+	if err = updateServiceDiskSpace(a, status, o.getServiceCommonSpec().Project, o.getServiceType(), s); err != nil {
+		return nil, err
+	}
+
+	declared, err := UserConfigurationToAPIV2(o.getUserConfig(), []string{"create", "update"})
+	if err != nil {
+		return nil, err
+	}
+	meta.SetStatusCondition(&status.Conditions, getVersionDriftCondition(findVersionDrift(declared, s.UserConfig)))
+	h.checkServiceNotifications(object, o, notifications)
+
+	progressLabels := prometheus.Labels{
+		"service_type": o.getServiceType(),
+		"namespace":    o.getObjectMeta().Namespace,
+		"name":         o.getObjectMeta().Name,
+	}
+	if status.MigrationProgress != nil {
+		serviceMigrationProgress.With(progressLabels).Set(float64(*status.MigrationProgress))
+	} else {
+		serviceMigrationProgress.Delete(progressLabels)
+	}
 	if s.State == "RUNNING" {
 		meta.SetStatusCondition(&status.Conditions,
 			getRunningCondition(metav1.ConditionTrue, "CheckRunning", "Instance is running on Aiven side"))
 
+		// The changes made on Aiven side are not reverted until the next update, the diff tells what it would change.
+		// The postponed changes are not a drift
+		status.Diff = nil
+		if isAlreadyProcessed(object) && status.ChangesFrozenUntil == nil && featureEnabled(featureServiceDiff) {
+			status.Diff, err = newServiceDiff(o, s)
+			if err != nil {
+				return nil, err
+			}
+		}
+
 		metav1.SetMetaDataAnnotation(o.getObjectMeta(), instanceIsRunningAnnotation, "true")
+		h.checkDiskPressure(a, object, o)
+
+		if u, ok := o.(serviceStatusUpdater); ok {
+			if err = u.updateStatus(a, s); err != nil {
+				return nil, err
+			}
+		}
 
 		// Some services get secrets after they are running only,
 		// like ip addresses (hosts)
@@ -160,6 +479,67 @@ func (h *genericServiceHandler) get(a *aiven.Client, object client.Object) (*cor
 	return nil, nil
 }
 
+// newServiceConnectionInfo returns the connection summary without credentials
+func newServiceConnectionInfo(s *aiven.Service) *v1alpha1.ServiceConnectionInfo {
+	if s.URI == "" {
+		return nil
+	}
+
+	info := &v1alpha1.ServiceConnectionInfo{
+		Endpoint:   s.URI,
+		Components: len(s.Components),
+	}
+	for _, c := range s.Components {
+		info.Endpoints = append(info.Endpoints, v1alpha1.ServiceEndpoint{
+			Component: c.Component,
+			Host:      c.Host,
+			Port:      c.Port,
+			Route:     c.Route,
+		})
+	}
+
+	// Some services, like Kafka, have host:port URI without a scheme
+	if strings.Contains(s.URI, "://") {
+		u, err := url.Parse(s.URI)
+		if err != nil {
+			return nil
+		}
+		info.Endpoint = u.Host
+		info.Scheme = u.Scheme
+	}
+	return info
+}
+
+// serviceMigrationProgressPercent sums up the progress of the nodes data migration or rebalance,
+// returns nil if there is nothing in progress
+func serviceMigrationProgressPercent(s *aiven.Service) *int {
+	var done, total int
+	inProgress := false
+	for _, n := range s.NodeStates {
+		for _, u := range n.ProgressUpdates {
+			if u.Max <= u.Min {
+				continue
+			}
+
+			total += u.Max - u.Min
+			if u.Completed {
+				done += u.Max - u.Min
+				continue
+			}
+
+			inProgress = true
+			done += u.Current - u.Min
+		}
+	}
+
+	if !inProgress {
+		return nil
+	}
+
+	percent := done * 100 / total
+	return &percent
+}
+
 // checkPreconditions not required for now by services to be implemented
 func (h *genericServiceHandler) checkPreconditions(a *aiven.Client, object client.Object) (bool, error) {
 	o, err := h.fabric(a, object)
@@ -191,6 +571,12 @@ type serviceAdapter interface {
 	getServiceCommonSpec() *v1alpha1.ServiceCommonSpec
 	getServiceType() string
 	getDiskSpace() string
+	getAdditionalDiskSpace() string
 	getUserConfig() any
 	newSecret(*aiven.Service) (*corev1.Secret, error)
 }
+
+// serviceStatusUpdater is a serviceAdapter with status fields of its own, updated when the service is running
+type serviceStatusUpdater interface {
+	updateStatus(*aiven.Client, *aiven.Service) error
+}
@@ -26,13 +26,14 @@ type GrafanaReconciler struct {
 // +kubebuilder:rbac:groups=aiven.io,resources=grafanas/finalizers,verbs=update
 
 func (r *GrafanaReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
-	return r.reconcileInstance(ctx, req, newGenericServiceHandler(newGrafanaAdapter), &v1alpha1.Grafana{})
+	return r.reconcileInstance(ctx, req, newGenericServiceHandler(newGrafanaAdapter, r.Recorder), &v1alpha1.Grafana{})
 }
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *GrafanaReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&v1alpha1.Grafana{}).
+		WithOptions(priorityControllerOptions(&v1alpha1.Grafana{})).
 		Owns(&corev1.Secret{}).
 		Complete(r)
 }
@@ -101,3 +102,7 @@ func (a *grafanaAdapter) getServiceType() string {
 func (a *grafanaAdapter) getDiskSpace() string {
 	return a.Spec.DiskSpace
 }
+
+func (a *grafanaAdapter) getAdditionalDiskSpace() string {
+	return a.Spec.AdditionalDiskSpace
+}
@@ -24,12 +24,13 @@ type KafkaReconciler struct {
 // +kubebuilder:rbac:groups=aiven.io,resources=kafkas/status,verbs=get;update;patch
 
 func (r *KafkaReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
-	return r.reconcileInstance(ctx, req, newGenericServiceHandler(newKafkaAdapter), &v1alpha1.Kafka{})
+	return r.reconcileInstance(ctx, req, newGenericServiceHandler(newKafkaAdapter, r.Recorder), &v1alpha1.Kafka{})
 }
 
 func (r *KafkaReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&v1alpha1.Kafka{}).
+		WithOptions(priorityControllerOptions(&v1alpha1.Kafka{})).
 		Owns(&corev1.Secret{}).
 		Complete(r)
 }
@@ -76,9 +77,13 @@ func (a *kafkaAdapter) newSecret(s *aiven.Service) (*corev1.Secret, error) {
 		password = s.Users[0].Password
 	}
 
-	caCert, err := a.avn.CA.Get(a.getServiceCommonSpec().Project)
-	if err != nil {
-		return nil, fmt.Errorf("aiven client error %w", err)
+	var caCert string
+	if !a.Spec.ConnInfoSecretTarget.OmitCACert {
+		var err error
+		caCert, err = a.avn.CA.Get(a.getServiceCommonSpec().Project)
+		if err != nil {
+			return nil, fmt.Errorf("aiven client error %w", err)
+		}
 	}
 
 	stringData := map[string]string{
@@ -98,6 +103,10 @@ func (a *kafkaAdapter) newSecret(s *aiven.Service) (*corev1.Secret, error) {
 		}
 	}
 
+	if a.Spec.ConnInfoSecretTarget.TLSKeys {
+		addTLSKeys(stringData)
+	}
+
 	return &corev1.Secret{
 		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: a.Namespace},
 		StringData: stringData,
@@ -111,3 +120,7 @@ func (a *kafkaAdapter) getServiceType() string {
 func (a *kafkaAdapter) getDiskSpace() string {
 	return a.Spec.DiskSpace
 }
+
+func (a *kafkaAdapter) getAdditionalDiskSpace() string {
+	return a.Spec.AdditionalDiskSpace
+}
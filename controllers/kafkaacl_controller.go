@@ -35,6 +35,7 @@ func (r *KafkaACLReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 func (r *KafkaACLReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&v1alpha1.KafkaACL{}).
+		WithOptions(priorityControllerOptions(&v1alpha1.KafkaACL{})).
 		Complete(r)
 }
 
@@ -44,29 +45,40 @@ func (h KafkaACLHandler) createOrUpdate(avn *aiven.Client, i client.Object, refs
 		return err
 	}
 
-	// ACL can't be really modified
-	// Tries to delete it instead
-	_, err = h.delete(avn, i)
+	list, err := avn.KafkaACLs.List(acl.Spec.Project, acl.Spec.ServiceName)
 	if err != nil {
 		return err
 	}
 
-	// Creates it from scratch
-	r, err := avn.KafkaACLs.Create(
-		acl.Spec.Project,
-		acl.Spec.ServiceName,
-		aiven.CreateKafkaACLRequest{
-			Permission: acl.Spec.Permission,
-			Topic:      acl.Spec.Topic,
-			Username:   acl.Spec.Username,
-		},
-	)
-	if err != nil {
-		return err
+	// Adopts the entry of the spec if it exists,
+	// so a repeated reconciliation doesn't revoke the access for a moment by recreating it
+	id := findKafkaACLID(list, acl)
+
+	// ACL can't be really modified, deletes the entry of the previous spec instead
+	if acl.Status.ID != "" && acl.Status.ID != id {
+		err = avn.KafkaACLs.Delete(acl.Spec.Project, acl.Spec.ServiceName, acl.Status.ID)
+		if err != nil && !aiven.IsNotFound(err) {
+			return fmt.Errorf("aiven client delete Kafka ACL error: %w", err)
+		}
 	}
 
-	// New created ACL id set
-	acl.Status.ID = r.ID
+	if id == "" {
+		r, err := avn.KafkaACLs.Create(
+			acl.Spec.Project,
+			acl.Spec.ServiceName,
+			aiven.CreateKafkaACLRequest{
+				Permission: acl.Spec.Permission,
+				Topic:      acl.Spec.Topic,
+				Username:   acl.Spec.Username,
+			},
+		)
+		if err != nil {
+			return err
+		}
+		id = r.ID
+	}
+
+	acl.Status.ID = id
 	meta.SetStatusCondition(&acl.Status.Conditions,
 		getInitializedCondition("CreatedOrUpdate",
 			"Instance was created or update on Aiven side"))
@@ -115,16 +127,24 @@ func (h KafkaACLHandler) getID(avn *aiven.Client, acl *v1alpha1.KafkaACL) (strin
 		return "", err
 	}
 
-	for _, a := range list {
-		if acl.Spec.Topic == a.Topic && acl.Spec.Username == a.Username && acl.Spec.Permission == a.Permission {
-			return a.ID, nil
-		}
+	if id := findKafkaACLID(list, acl); id != "" {
+		return id, nil
 	}
 
 	// Error should mimic client error to play well with aiven.IsNotFound(err)
 	return "", aiven.Error{Status: http.StatusNotFound, Message: fmt.Sprintf("Kafka ACL %q not found", acl.Name)}
 }
 
+// findKafkaACLID returns the ID of the entry with the topic, username and permission of the spec, empty if there is none
+func findKafkaACLID(list []*aiven.KafkaACL, acl *v1alpha1.KafkaACL) string {
+	for _, a := range list {
+		if acl.Spec.Topic == a.Topic && acl.Spec.Username == a.Username && acl.Spec.Permission == a.Permission {
+			return a.ID
+		}
+	}
+	return ""
+}
+
 func (h KafkaACLHandler) get(avn *aiven.Client, i client.Object) (*corev1.Secret, error) {
 	acl, err := h.convert(i)
 	if err != nil {
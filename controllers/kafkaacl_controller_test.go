@@ -108,6 +108,27 @@ var _ = Describe("Kafka ACL Controller", func() {
 			Expect(o).To(BeNil())
 			Expect(aiven.IsNotFound(err)).To(BeTrue())
 		})
+
+		It("should keep the Kafka ACL entry when reconciled again", func() {
+			createdACL := &v1alpha1.KafkaACL{}
+			lookupKey := types.NamespacedName{Name: userName, Namespace: namespace}
+			Expect(k8sClient.Get(ctx, lookupKey, createdACL)).Should(Succeed())
+
+			By("forcing the reconciliation with the reconcile-now annotation")
+			now := time.Now().UTC().Format(time.RFC3339)
+			metav1.SetMetaDataAnnotation(&createdACL.ObjectMeta, reconcileNowAnnotation, now)
+			Expect(k8sClient.Update(ctx, createdACL)).Should(Succeed())
+
+			reconciledACL := &v1alpha1.KafkaACL{}
+			Eventually(func() bool {
+				err := k8sClient.Get(ctx, lookupKey, reconciledACL)
+				return err == nil && reconciledACL.Annotations[reconcileNowHandledAnnotation] == now &&
+					meta.IsStatusConditionTrue(reconciledACL.Status.Conditions, conditionTypeRunning)
+			}, timeout, interval).Should(BeTrue())
+
+			By("checking that the entry was not recreated")
+			Expect(reconciledACL.Status.ID).Should(Equal(createdACL.Status.ID))
+		})
 	})
 
 	AfterEach(func() {
@@ -0,0 +1,27 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"testing"
+
+	"github.com/aiven/aiven-go-client"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+func TestFindKafkaACLID(t *testing.T) {
+	list := []*aiven.KafkaACL{
+		{ID: "acl1", Topic: "orders", Username: "app", Permission: "read"},
+		{ID: "acl2", Topic: "orders", Username: "app", Permission: "write"},
+		{ID: "acl3", Topic: "orders-*", Username: "app", Permission: "read"},
+	}
+
+	acl := &v1alpha1.KafkaACL{Spec: v1alpha1.KafkaACLSpec{Topic: "orders", Username: "app", Permission: "write"}}
+	assert.Equal(t, "acl2", findKafkaACLID(list, acl))
+
+	acl.Spec.Permission = "admin"
+	assert.Equal(t, "", findKafkaACLID(list, acl))
+	assert.Equal(t, "", findKafkaACLID(nil, acl))
+}
@@ -24,12 +24,13 @@ type KafkaConnectReconciler struct {
 // +kubebuilder:rbac:groups=aiven.io,resources=kafkaconnects/status,verbs=get;update;patch
 
 func (r *KafkaConnectReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
-	return r.reconcileInstance(ctx, req, newGenericServiceHandler(newKafkaConnectAdapter), &v1alpha1.KafkaConnect{})
+	return r.reconcileInstance(ctx, req, newGenericServiceHandler(newKafkaConnectAdapter, r.Recorder), &v1alpha1.KafkaConnect{})
 }
 
 func (r *KafkaConnectReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&v1alpha1.KafkaConnect{}).
+		WithOptions(priorityControllerOptions(&v1alpha1.KafkaConnect{})).
 		Complete(r)
 }
 
@@ -73,3 +74,7 @@ func (a *kafkaConnectAdapter) getServiceType() string {
 func (a *kafkaConnectAdapter) getDiskSpace() string {
 	return ""
 }
+
+func (a *kafkaConnectAdapter) getAdditionalDiskSpace() string {
+	return ""
+}
@@ -5,8 +5,11 @@ package controllers
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
+	"sort"
 	"strconv"
+	"strings"
 	"text/template"
 
 	"github.com/aiven/aiven-go-client"
@@ -20,6 +23,9 @@ import (
 	"github.com/aiven/aiven-operator/api/v1alpha1"
 )
 
+// conditionTypePluginAvailable tells whether the connector class is installed on the Kafka Connect service
+const conditionTypePluginAvailable = "PluginAvailable"
+
 // KafkaConnectorReconciler reconciles a KafkaConnector object
 type KafkaConnectorReconciler struct {
 	Controller
@@ -40,6 +46,7 @@ func (r *KafkaConnectorReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 func (r *KafkaConnectorReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&v1alpha1.KafkaConnector{}).
+		WithOptions(priorityControllerOptions(&v1alpha1.KafkaConnector{})).
 		Complete(r)
 }
 
@@ -49,6 +56,10 @@ func (h KafkaConnectorHandler) createOrUpdate(avn *aiven.Client, o client.Object
 		return err
 	}
 
+	if err := h.checkPluginAvailable(avn, conn); err != nil {
+		return err
+	}
+
 	exists, err := h.exists(avn, conn)
 	if err != nil {
 		return fmt.Errorf("unable to check if kafka connector exists: %w", err)
@@ -89,6 +100,71 @@ func (h KafkaConnectorHandler) createOrUpdate(avn *aiven.Client, o client.Object
 	return nil
 }
 
+// checkPluginAvailable fails with the PluginAvailable condition naming the available plugins
+// when the connector class is not installed on the service, instead of the bad request of the connector API
+func (h KafkaConnectorHandler) checkPluginAvailable(avn *aiven.Client, conn *v1alpha1.KafkaConnector) error {
+	plugins, err := aivenAPIFor(avn).listAvailableConnectors(conn.Spec.Project, conn.Spec.ServiceName)
+	if err != nil {
+		return fmt.Errorf("unable to list available connectors: %w", err)
+	}
+
+	c := getPluginAvailableCondition(conn.Spec.ConnectorClass, conn.Spec.ServiceName, plugins)
+	meta.SetStatusCondition(&conn.Status.Conditions, c)
+	if c.Status == metav1.ConditionTrue {
+		return nil
+	}
+
+	// The status is not updated when the creation fails, so the condition is saved here
+	if err := h.k8s.Status().Update(context.Background(), conn); err != nil {
+		return err
+	}
+	return errors.New(c.Message)
+}
+
+// getPluginAvailableCondition tells whether the connector class is one of the plugins of the service
+func getPluginAvailableCondition(class, service string, plugins []aiven.KafkaConnectorPlugin) metav1.Condition {
+	classes := make([]string, 0, len(plugins))
+	for _, p := range plugins {
+		if p.Class == class {
+			return metav1.Condition{
+				Type:    conditionTypePluginAvailable,
+				Status:  metav1.ConditionTrue,
+				Reason:  "PluginFound",
+				Message: fmt.Sprintf("Connector class %q is %s %s", class, p.Title, p.Version),
+			}
+		}
+		classes = append(classes, p.Class)
+	}
+
+	sort.Strings(classes)
+	message := fmt.Sprintf("Connector class %q is not available on service %q.", class, service)
+	if suggestion := similarConnectorClass(class, classes); suggestion != "" {
+		message += fmt.Sprintf(" Did you mean %q?", suggestion)
+	}
+	if len(classes) > 0 {
+		message += fmt.Sprintf(" Available connector classes: %s", strings.Join(classes, ", "))
+	} else {
+		message += " The service has no connector plugins"
+	}
+	return metav1.Condition{
+		Type:    conditionTypePluginAvailable,
+		Status:  metav1.ConditionFalse,
+		Reason:  "PluginNotFound",
+		Message: message,
+	}
+}
+
+// similarConnectorClass returns the class with the same name in another package, or the same name in another case
+func similarConnectorClass(class string, classes []string) string {
+	name := class[strings.LastIndex(class, ".")+1:]
+	for _, c := range classes {
+		if strings.EqualFold(c, class) || c[strings.LastIndex(c, ".")+1:] == name {
+			return c
+		}
+	}
+	return ""
+}
+
 // buildConnectorConfig joins mandatory fields with additional conncetor specific config
 func (h KafkaConnectorHandler) buildConnectorConfig(conn *v1alpha1.KafkaConnector) (aiven.KafkaConnectorConfig, error) {
 	const (
@@ -0,0 +1,34 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"testing"
+
+	"github.com/aiven/aiven-go-client"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestGetPluginAvailableCondition(t *testing.T) {
+	plugins := []aiven.KafkaConnectorPlugin{
+		{Class: "io.aiven.connect.jdbc.JdbcSinkConnector", Title: "JDBC Sink", Version: "6.8.0"},
+		{Class: "io.debezium.connector.postgresql.PostgresConnector", Title: "Debezium - PostgreSQL", Version: "1.9.7"},
+	}
+
+	c := getPluginAvailableCondition("io.aiven.connect.jdbc.JdbcSinkConnector", "connect", plugins)
+	assert.Equal(t, metav1.ConditionTrue, c.Status)
+	assert.Equal(t, "PluginFound", c.Reason)
+	assert.Equal(t, `Connector class "io.aiven.connect.jdbc.JdbcSinkConnector" is JDBC Sink 6.8.0`, c.Message)
+
+	// The class of another distribution of the connector
+	c = getPluginAvailableCondition("io.confluent.connect.jdbc.JdbcSinkConnector", "connect", plugins)
+	assert.Equal(t, metav1.ConditionFalse, c.Status)
+	assert.Equal(t, "PluginNotFound", c.Reason)
+	assert.Equal(t, `Connector class "io.confluent.connect.jdbc.JdbcSinkConnector" is not available on service "connect". `+
+		`Did you mean "io.aiven.connect.jdbc.JdbcSinkConnector"? `+
+		`Available connector classes: io.aiven.connect.jdbc.JdbcSinkConnector, io.debezium.connector.postgresql.PostgresConnector`, c.Message)
+
+	c = getPluginAvailableCondition("com.example.Connector", "connect", nil)
+	assert.Equal(t, `Connector class "com.example.Connector" is not available on service "connect". The service has no connector plugins`, c.Message)
+}
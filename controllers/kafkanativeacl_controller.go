@@ -0,0 +1,169 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/aiven/aiven-go-client"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+// KafkaNativeACLReconciler reconciles a KafkaNativeACL object
+type KafkaNativeACLReconciler struct {
+	Controller
+}
+
+type KafkaNativeACLHandler struct{}
+
+// +kubebuilder:rbac:groups=aiven.io,resources=kafkanativeacls,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=aiven.io,resources=kafkanativeacls/status,verbs=get;update;patch
+
+func (r *KafkaNativeACLReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	return r.reconcileInstance(ctx, req, KafkaNativeACLHandler{}, &v1alpha1.KafkaNativeACL{})
+}
+
+func (r *KafkaNativeACLReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&v1alpha1.KafkaNativeACL{}).
+		WithOptions(priorityControllerOptions(&v1alpha1.KafkaNativeACL{})).
+		Complete(r)
+}
+
+func (h KafkaNativeACLHandler) createOrUpdate(avn *aiven.Client, i client.Object, refs []client.Object) error {
+	acl, err := h.convert(i)
+	if err != nil {
+		return err
+	}
+
+	api := aivenAPIFor(avn)
+	list, err := api.listKafkaNativeACLs(acl.Spec.Project, acl.Spec.ServiceName)
+	if err != nil {
+		return err
+	}
+
+	// Adopts the entry of the spec if it exists, like KafkaACL does
+	want := newAivenKafkaNativeACL(acl)
+	id := findKafkaNativeACLID(list, want)
+
+	// The entries can't be modified, deletes the entry of the previous spec instead
+	if acl.Status.ID != "" && acl.Status.ID != id {
+		err = api.deleteKafkaNativeACL(acl.Spec.Project, acl.Spec.ServiceName, acl.Status.ID)
+		if err != nil {
+			return fmt.Errorf("unable to delete Kafka native ACL: %w", err)
+		}
+	}
+
+	if id == "" {
+		r, err := api.createKafkaNativeACL(acl.Spec.Project, acl.Spec.ServiceName, want)
+		if err != nil {
+			return err
+		}
+		id = r.ID
+	}
+
+	acl.Status.ID = id
+	meta.SetStatusCondition(&acl.Status.Conditions,
+		getInitializedCondition("CreatedOrUpdate",
+			"Instance was created or update on Aiven side"))
+
+	meta.SetStatusCondition(&acl.Status.Conditions,
+		getRunningCondition(metav1.ConditionUnknown, "CreatedOrUpdate",
+			"Instance was created or update on Aiven side, status remains unknown"))
+
+	metav1.SetMetaDataAnnotation(&acl.ObjectMeta,
+		processedGenerationAnnotation, strconv.FormatInt(acl.GetGeneration(), formatIntBaseDecimal))
+
+	return nil
+}
+
+func (h KafkaNativeACLHandler) delete(avn *aiven.Client, i client.Object) (bool, error) {
+	acl, err := h.convert(i)
+	if err != nil {
+		return false, err
+	}
+
+	if acl.Status.ID == "" {
+		return true, nil
+	}
+
+	err = aivenAPIFor(avn).deleteKafkaNativeACL(acl.Spec.Project, acl.Spec.ServiceName, acl.Status.ID)
+	if err != nil {
+		return false, fmt.Errorf("unable to delete Kafka native ACL: %w", err)
+	}
+	return true, nil
+}
+
+// newAivenKafkaNativeACL returns the entry of the spec
+func newAivenKafkaNativeACL(acl *v1alpha1.KafkaNativeACL) *aivenKafkaNativeACL {
+	return &aivenKafkaNativeACL{
+		ResourceType:   acl.Spec.ResourceType,
+		Name:           acl.Spec.ResourceName,
+		PatternType:    acl.Spec.PatternType,
+		Operation:      acl.Spec.Operation,
+		PermissionType: acl.Spec.PermissionType,
+		Principal:      acl.Spec.Principal,
+		Host:           acl.Spec.Host,
+	}
+}
+
+// findKafkaNativeACLID returns the ID of the entry with the same fields, empty if there is none
+func findKafkaNativeACLID(list []*aivenKafkaNativeACL, want *aivenKafkaNativeACL) string {
+	for _, a := range list {
+		entry := *a
+		entry.ID = ""
+		if entry == *want {
+			return a.ID
+		}
+	}
+	return ""
+}
+
+func (h KafkaNativeACLHandler) get(avn *aiven.Client, i client.Object) (*corev1.Secret, error) {
+	acl, err := h.convert(i)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = aivenAPIFor(avn).getKafkaNativeACL(acl.Spec.Project, acl.Spec.ServiceName, acl.Status.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	meta.SetStatusCondition(&acl.Status.Conditions,
+		getRunningCondition(metav1.ConditionTrue, "CheckRunning",
+			"Instance is running on Aiven side"))
+
+	metav1.SetMetaDataAnnotation(&acl.ObjectMeta, instanceIsRunningAnnotation, "true")
+
+	return nil, nil
+}
+
+func (h KafkaNativeACLHandler) checkPreconditions(avn *aiven.Client, i client.Object) (bool, error) {
+	acl, err := h.convert(i)
+	if err != nil {
+		return false, err
+	}
+
+	meta.SetStatusCondition(&acl.Status.Conditions,
+		getInitializedCondition("Preconditions", "Checking preconditions"))
+
+	return checkServiceIsRunning(avn, acl.Spec.Project, acl.Spec.ServiceName)
+}
+
+func (h KafkaNativeACLHandler) convert(i client.Object) (*v1alpha1.KafkaNativeACL, error) {
+	acl, ok := i.(*v1alpha1.KafkaNativeACL)
+	if !ok {
+		return nil, fmt.Errorf("cannot convert object to KafkaNativeACL")
+	}
+
+	return acl, nil
+}
@@ -0,0 +1,139 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"os"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+var _ = Describe("Kafka Native ACL Controller", func() {
+	// Define utility constants for object names and testing timeouts/durations and intervals.
+	const (
+		namespace = "default"
+
+		timeout  = time.Minute * 20
+		interval = time.Second * 10
+	)
+
+	var (
+		kafka       *v1alpha1.Kafka
+		acl         *v1alpha1.KafkaNativeACL
+		serviceName string
+		aclName     string
+		projectName string
+		ctx         context.Context
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		projectName = os.Getenv("AIVEN_PROJECT_NAME")
+		serviceName = "k8s-test-kafka-native-acl-acc-" + generateRandomID()
+		aclName = "k8s-native-acl-" + generateRandomID()
+		kafka = kafkaSpec(serviceName, namespace)
+		acl = kafkaNativeACLSpec(projectName, serviceName, aclName, namespace)
+
+		By("Creating a new Kafka instance")
+		Expect(k8sClient.Create(ctx, kafka)).Should(Succeed())
+
+		By("Creating a new KafkaNativeACL instance")
+		Expect(k8sClient.Create(ctx, acl)).Should(Succeed())
+
+		Eventually(func() bool {
+			createdACL := &v1alpha1.KafkaNativeACL{}
+			lookupKey := types.NamespacedName{Name: aclName, Namespace: namespace}
+			err := k8sClient.Get(ctx, lookupKey, createdACL)
+			if err == nil {
+				return meta.IsStatusConditionTrue(createdACL.Status.Conditions, conditionTypeRunning)
+			}
+			return false
+		}, timeout, interval).Should(BeTrue())
+	})
+
+	Context("Validating Kafka Native ACL reconciler behaviour", func() {
+		It("should replace the entry when the spec changes", func() {
+			createdACL := &v1alpha1.KafkaNativeACL{}
+			lookupKey := types.NamespacedName{Name: aclName, Namespace: namespace}
+			Expect(k8sClient.Get(ctx, lookupKey, createdACL)).Should(Succeed())
+
+			By("checking that the entry exists at Aiven")
+			api := newAivenAPI(os.Getenv("AIVEN_TOKEN"))
+			a, err := api.getKafkaNativeACL(projectName, serviceName, createdACL.Status.ID)
+			Expect(err).To(BeNil())
+			Expect(a.Operation).Should(Equal("Read"))
+			Expect(a.PermissionType).Should(Equal("ALLOW"))
+			Expect(a.Host).Should(Equal("*"))
+
+			By(`updating operation from "Read" to "Write" and making sure it has a new ID`)
+			createdACL.Spec.Operation = "Write"
+			Expect(k8sClient.Update(ctx, createdACL)).Should(Succeed())
+
+			updatedACL := &v1alpha1.KafkaNativeACL{}
+			Eventually(func() bool {
+				err := k8sClient.Get(ctx, lookupKey, updatedACL)
+				return err == nil && updatedACL.Status.ID != "" && updatedACL.Status.ID != createdACL.Status.ID
+			}, timeout, interval).Should(BeTrue())
+
+			a, err = api.getKafkaNativeACL(projectName, serviceName, updatedACL.Status.ID)
+			Expect(err).To(BeNil())
+			Expect(a.Operation).Should(Equal("Write"))
+
+			By("checking that the old entry is removed at Aiven")
+			_, err = api.getKafkaNativeACL(projectName, serviceName, createdACL.Status.ID)
+			Expect(isAivenAPINotFound(err)).To(BeTrue())
+		})
+	})
+
+	AfterEach(func() {
+		createdACL := &v1alpha1.KafkaNativeACL{}
+		lookupKey := types.NamespacedName{Name: aclName, Namespace: namespace}
+		Expect(k8sClient.Get(ctx, lookupKey, createdACL)).Should(Succeed())
+
+		By("Ensures that Kafka Native ACL instance was deleted")
+		ensureDelete(ctx, acl)
+
+		By("Ensures that Kafka Native ACL is deleted on Aiven side")
+		_, err := newAivenAPI(os.Getenv("AIVEN_TOKEN")).getKafkaNativeACL(projectName, serviceName, createdACL.Status.ID)
+		Expect(isAivenAPINotFound(err)).To(BeTrue())
+
+		By("Ensures that Kafka instance was deleted")
+		ensureDelete(ctx, kafka)
+	})
+})
+
+func kafkaNativeACLSpec(project, service, name, namespace string) *v1alpha1.KafkaNativeACL {
+	return &v1alpha1.KafkaNativeACL{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "aiven.io/v1alpha1",
+			Kind:       "KafkaNativeACL",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: v1alpha1.KafkaNativeACLSpec{
+			Project:        project,
+			ServiceName:    service,
+			ResourceType:   "Topic",
+			ResourceName:   "orders-",
+			PatternType:    "PREFIXED",
+			Operation:      "Read",
+			PermissionType: "ALLOW",
+			Principal:      "User:k8s-native-acl",
+			Host:           "*",
+			AuthSecretRef: v1alpha1.AuthSecretReference{
+				Name: secretRefName,
+				Key:  secretRefKey,
+			},
+		},
+	}
+}
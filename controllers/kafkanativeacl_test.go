@@ -0,0 +1,45 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+func TestFindKafkaNativeACLID(t *testing.T) {
+	entry := func(id, operation string) *aivenKafkaNativeACL {
+		return &aivenKafkaNativeACL{
+			ID:             id,
+			ResourceType:   "Topic",
+			Name:           "orders",
+			PatternType:    "LITERAL",
+			Operation:      operation,
+			PermissionType: "ALLOW",
+			Principal:      "User:app",
+			Host:           "*",
+		}
+	}
+	list := []*aivenKafkaNativeACL{entry("acl1", "Read"), entry("acl2", "Write")}
+
+	acl := &v1alpha1.KafkaNativeACL{Spec: v1alpha1.KafkaNativeACLSpec{
+		ResourceType:   "Topic",
+		ResourceName:   "orders",
+		PatternType:    "LITERAL",
+		Operation:      "Write",
+		PermissionType: "ALLOW",
+		Principal:      "User:app",
+		Host:           "*",
+	}}
+	assert.Equal(t, "acl2", findKafkaNativeACLID(list, newAivenKafkaNativeACL(acl)))
+
+	// The list is not modified
+	assert.Equal(t, "acl1", list[0].ID)
+
+	acl.Spec.PermissionType = "DENY"
+	assert.Equal(t, "", findKafkaNativeACLID(list, newAivenKafkaNativeACL(acl)))
+	assert.Equal(t, "", findKafkaNativeACLID(nil, newAivenKafkaNativeACL(acl)))
+}
@@ -24,6 +24,14 @@ type KafkaSchemaReconciler struct {
 
 type KafkaSchemaHandler struct{}
 
+const (
+	// kafkaSchemaDeletionPolicyHard deletes the subject with its schema history
+	kafkaSchemaDeletionPolicyHard = "hard"
+
+	// kafkaSchemaDeletionPolicyNone leaves the subject in the schema registry
+	kafkaSchemaDeletionPolicyNone = "none"
+)
+
 // +kubebuilder:rbac:groups=aiven.io,resources=kafkaschemas,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=aiven.io,resources=kafkaschemas/status,verbs=get;update;patch
 
@@ -34,6 +42,7 @@ func (r *KafkaSchemaReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 func (r *KafkaSchemaReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&v1alpha1.KafkaSchema{}).
+		WithOptions(priorityControllerOptions(&v1alpha1.KafkaSchema{})).
 		Complete(r)
 }
 
@@ -44,14 +53,26 @@ func (h KafkaSchemaHandler) createOrUpdate(avn *aiven.Client, i client.Object, r
 	}
 
 	// createOrUpdate Kafka Schema Subject
-	_, err = avn.KafkaSubjectSchemas.Add(
-		schema.Spec.Project,
-		schema.Spec.ServiceName,
-		schema.Spec.SubjectName,
-		aiven.KafkaSchemaSubject{
-			Schema: schema.Spec.Schema,
-		},
-	)
+	if len(schema.Spec.References) > 0 {
+		err = aivenAPIFor(avn).addKafkaSubjectSchema(
+			schema.Spec.Project,
+			schema.Spec.ServiceName,
+			schema.Spec.SubjectName,
+			schema.Spec.Schema,
+			schema.Spec.SchemaType,
+			schema.Spec.References,
+		)
+	} else {
+		_, err = avn.KafkaSubjectSchemas.Add(
+			schema.Spec.Project,
+			schema.Spec.ServiceName,
+			schema.Spec.SubjectName,
+			aiven.KafkaSchemaSubject{
+				Schema:     schema.Spec.Schema,
+				SchemaType: schema.Spec.SchemaType,
+			},
+		)
+	}
 	if err != nil {
 		return fmt.Errorf("cannot add Kafka Schema Subject: %w", err)
 	}
@@ -97,11 +118,23 @@ func (h KafkaSchemaHandler) delete(avn *aiven.Client, i client.Object) (bool, er
 		return false, err
 	}
 
+	if schema.Spec.DeletionPolicy == kafkaSchemaDeletionPolicyNone {
+		return true, nil
+	}
+
+	// The schema registry hard deletes only the soft deleted subjects
 	err = avn.KafkaSubjectSchemas.Delete(schema.Spec.Project, schema.Spec.ServiceName, schema.Spec.SubjectName)
 	if err != nil && !aiven.IsNotFound(err) {
 		return false, fmt.Errorf("aiven client delete Kafka Schema error: %w", err)
 	}
 
+	if schema.Spec.DeletionPolicy == kafkaSchemaDeletionPolicyHard {
+		err = aivenAPIFor(avn).deleteKafkaSubjectPermanently(schema.Spec.Project, schema.Spec.ServiceName, schema.Spec.SubjectName)
+		if err != nil {
+			return false, fmt.Errorf("cannot hard delete Kafka Schema subject: %w", err)
+		}
+	}
+
 	return true, nil
 }
 
@@ -126,7 +159,25 @@ func (h KafkaSchemaHandler) checkPreconditions(avn *aiven.Client, i client.Objec
 		return false, err
 	}
 
-	return checkServiceIsRunning(avn, schema.Spec.Project, schema.Spec.ServiceName)
+	check, err := checkServiceIsRunning(avn, schema.Spec.Project, schema.Spec.ServiceName)
+	if !check || err != nil {
+		return check, err
+	}
+
+	// The referenced schemas are usually applied together with the schema, so it waits for them to be registered
+	for _, ref := range schema.Spec.References {
+		_, err := avn.KafkaSubjectSchemas.Get(schema.Spec.Project, schema.Spec.ServiceName, ref.Subject, ref.Version)
+		if aiven.IsNotFound(err) {
+			meta.SetStatusCondition(&schema.Status.Conditions,
+				getInitializedCondition("Preconditions",
+					fmt.Sprintf("Waiting for the referenced subject %q version %d", ref.Subject, ref.Version)))
+			return false, nil
+		}
+		if err != nil {
+			return false, fmt.Errorf("cannot get the referenced subject %q version %d: %w", ref.Subject, ref.Version, err)
+		}
+	}
+	return true, nil
 }
 
 func (h KafkaSchemaHandler) convert(i client.Object) (*v1alpha1.KafkaSchema, error) {
@@ -68,6 +68,55 @@ var _ = Describe("Kafka Schema Controller", func() {
 			By("by checking that after creation KafkaSchema status fields were properly populated")
 			Expect(createdSchema.Status.Version).Should(Equal(1))
 		})
+
+		It("should leave the subject with the none deletion policy", func() {
+			lookupKey := types.NamespacedName{Name: schemaSubject, Namespace: namespace}
+			Expect(k8sClient.Get(ctx, lookupKey, schema)).Should(Succeed())
+
+			By("by deleting the KafkaSchema with the none deletion policy")
+			schema.Spec.DeletionPolicy = "none"
+			Expect(k8sClient.Update(ctx, schema)).Should(Succeed())
+			ensureDelete(ctx, schema)
+
+			By("by checking the subject is still in the schema registry")
+			versions, err := aivenClient.KafkaSubjectSchemas.GetVersions(os.Getenv("AIVEN_PROJECT_NAME"), serviceName, schemaSubject)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(versions.Versions).ShouldNot(BeEmpty())
+
+			// Restores the resource for the cleanup
+			schema = kafkaSchemaSpec(serviceName, schemaSubject, namespace)
+			Expect(k8sClient.Create(ctx, schema)).Should(Succeed())
+		})
+	})
+
+	Context("Validating Kafka Schema references", func() {
+		It("should register a schema that refers to another subject", func() {
+			referring := kafkaSchemaSpec(serviceName, "k8s-subj2", namespace)
+			referring.Spec.Schema = `{
+					"fields": [{"name": "example", "type": "example.example"}],
+					"name": "referring",
+					"namespace": "example",
+					"type": "record"
+				}`
+			referring.Spec.References = []v1alpha1.KafkaSchemaReference{
+				{Name: "example.example", Subject: schemaSubject, Version: 1},
+			}
+			Expect(k8sClient.Create(ctx, referring)).Should(Succeed())
+
+			By("by waiting for the referring schema to be registered")
+			lookupKey := types.NamespacedName{Name: referring.Name, Namespace: namespace}
+			created := &v1alpha1.KafkaSchema{}
+			Eventually(func() bool {
+				err := k8sClient.Get(ctx, lookupKey, created)
+				if err == nil {
+					return meta.IsStatusConditionTrue(created.Status.Conditions, conditionTypeRunning)
+				}
+				return false
+			}, timeout, interval).Should(BeTrue())
+			Expect(created.Status.Version).Should(Equal(1))
+
+			ensureDelete(ctx, referring)
+		})
 	})
 
 	AfterEach(func() {
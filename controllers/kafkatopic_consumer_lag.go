@@ -0,0 +1,100 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/aiven/aiven-go-client"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+const (
+	// kafkaTopicConsumerLagInterval is how often the lag of the consumer groups is refreshed
+	kafkaTopicConsumerLagInterval = 5 * time.Minute
+
+	// kafkaTopicConsumerGroupsMax limits the consumer groups in the status, the metrics have all of them
+	kafkaTopicConsumerGroupsMax = 20
+)
+
+// consumerGroupLagSeries keeps the consumer groups of the topics that have metrics, to delete the ones that are gone
+var consumerGroupLagSeries sync.Map
+
+// consumerGroupLags returns the lag of the consumer groups summed over the partitions
+func consumerGroupLags(t *aiven.KafkaTopic) map[string]int64 {
+	lags := make(map[string]int64)
+	for _, p := range t.Partitions {
+		if p == nil {
+			continue
+		}
+
+		for _, g := range p.ConsumerGroups {
+			// The group has no committed offset in the partition
+			if g == nil || g.Offset < 0 {
+				continue
+			}
+
+			lag := p.LatestOffset - g.Offset
+			if lag < 0 {
+				lag = 0
+			}
+			lags[g.GroupName] += lag
+		}
+	}
+	return lags
+}
+
+// newKafkaTopicConsumerGroups returns the most lagging groups first
+func newKafkaTopicConsumerGroups(lags map[string]int64) []v1alpha1.KafkaTopicConsumerGroup {
+	groups := make([]v1alpha1.KafkaTopicConsumerGroup, 0, len(lags))
+	for name, lag := range lags {
+		groups = append(groups, v1alpha1.KafkaTopicConsumerGroup{Name: name, Lag: lag})
+	}
+
+	sort.Slice(groups, func(i, j int) bool {
+		if groups[i].Lag != groups[j].Lag {
+			return groups[i].Lag > groups[j].Lag
+		}
+		return groups[i].Name < groups[j].Name
+	})
+
+	if len(groups) > kafkaTopicConsumerGroupsMax {
+		groups = groups[:kafkaTopicConsumerGroupsMax]
+	}
+	return groups
+}
+
+// setConsumerGroupLagMetrics exposes the lag of the groups, and removes the groups that are gone
+func setConsumerGroupLagMetrics(topic *v1alpha1.KafkaTopic, lags map[string]int64) {
+	deleteConsumerGroupLagMetrics(topic)
+
+	groups := make([]string, 0, len(lags))
+	for name, lag := range lags {
+		kafkaTopicConsumerGroupLag.With(consumerGroupLagLabels(topic, name)).Set(float64(lag))
+		groups = append(groups, name)
+	}
+	consumerGroupLagSeries.Store(topic.Namespace+"/"+topic.Name, groups)
+}
+
+func deleteConsumerGroupLagMetrics(topic *v1alpha1.KafkaTopic) {
+	groups, ok := consumerGroupLagSeries.LoadAndDelete(topic.Namespace + "/" + topic.Name)
+	if !ok {
+		return
+	}
+
+	for _, name := range groups.([]string) {
+		kafkaTopicConsumerGroupLag.Delete(consumerGroupLagLabels(topic, name))
+	}
+}
+
+func consumerGroupLagLabels(topic *v1alpha1.KafkaTopic, group string) prometheus.Labels {
+	return prometheus.Labels{
+		"namespace":      topic.Namespace,
+		"name":           topic.Name,
+		"consumer_group": group,
+	}
+}
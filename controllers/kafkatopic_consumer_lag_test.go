@@ -0,0 +1,58 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aiven/aiven-go-client"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+func TestConsumerGroupLags(t *testing.T) {
+	topic := &aiven.KafkaTopic{
+		Partitions: []*aiven.Partition{
+			{
+				Partition:    0,
+				LatestOffset: 100,
+				ConsumerGroups: []*aiven.ConsumerGroup{
+					{GroupName: "billing", Offset: 90},
+					{GroupName: "audit", Offset: 100},
+				},
+			},
+			{
+				Partition:    1,
+				LatestOffset: 50,
+				ConsumerGroups: []*aiven.ConsumerGroup{
+					{GroupName: "billing", Offset: 45},
+					{GroupName: "audit", Offset: -1},
+					nil,
+				},
+			},
+			nil,
+		},
+	}
+
+	assert.Equal(t, map[string]int64{"billing": 15, "audit": 0}, consumerGroupLags(topic))
+	assert.Empty(t, consumerGroupLags(&aiven.KafkaTopic{}))
+}
+
+func TestNewKafkaTopicConsumerGroups(t *testing.T) {
+	groups := newKafkaTopicConsumerGroups(map[string]int64{"b": 5, "a": 5, "c": 10})
+	assert.Equal(t, []v1alpha1.KafkaTopicConsumerGroup{
+		{Name: "c", Lag: 10},
+		{Name: "a", Lag: 5},
+		{Name: "b", Lag: 5},
+	}, groups)
+
+	lags := make(map[string]int64)
+	for i := 0; i < kafkaTopicConsumerGroupsMax+5; i++ {
+		lags[fmt.Sprintf("group-%d", i)] = int64(i)
+	}
+	groups = newKafkaTopicConsumerGroups(lags)
+	assert.Len(t, groups, kafkaTopicConsumerGroupsMax)
+	assert.Equal(t, fmt.Sprintf("group-%d", kafkaTopicConsumerGroupsMax+4), groups[0].Name)
+}
@@ -6,13 +6,18 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"time"
 
 	"github.com/aiven/aiven-go-client"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	"github.com/aiven/aiven-operator/api/v1alpha1"
 )
@@ -34,6 +39,12 @@ func (r *KafkaTopicReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 func (r *KafkaTopicReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&v1alpha1.KafkaTopic{}).
+		Watches(
+			&source.Kind{Type: &v1alpha1.Kafka{}},
+			handler.EnqueueRequestsFromMapFunc(r.kafkaTopicsOf),
+			builder.WithPredicates(predicate.GenerationChangedPredicate{}),
+		).
+		WithOptions(priorityControllerOptions(&v1alpha1.KafkaTopic{})).
 		Complete(r)
 }
 
@@ -43,6 +54,10 @@ func (h KafkaTopicHandler) createOrUpdate(avn *aiven.Client, i client.Object, re
 		return err
 	}
 
+	// The topic inherits the topicDefaults of the referenced Kafka it doesn't override
+	topic.Status.InheritedConfig = inheritedKafkaTopicConfig(topic, refs)
+	config := convertKafkaTopicConfig(mergeKafkaTopicConfig(topic.Spec.Config, topic.Status.InheritedConfig))
+
 	var tags []aiven.KafkaTopicTag
 	for _, t := range topic.Spec.Tags {
 		tags = append(tags, aiven.KafkaTopicTag{
@@ -63,7 +78,7 @@ func (h KafkaTopicHandler) createOrUpdate(avn *aiven.Client, i client.Object, re
 			Replication: &topic.Spec.Replication,
 			TopicName:   topic.Name,
 			Tags:        tags,
-			Config:      convertKafkaTopicConfig(topic),
+			Config:      config,
 		})
 		if err != nil && !aiven.IsAlreadyExists(err) {
 			return err
@@ -76,7 +91,7 @@ func (h KafkaTopicHandler) createOrUpdate(avn *aiven.Client, i client.Object, re
 				Partitions:  &topic.Spec.Partitions,
 				Replication: &topic.Spec.Replication,
 				Tags:        tags,
-				Config:      convertKafkaTopicConfig(topic),
+				Config:      config,
 			})
 		if err != nil {
 			return fmt.Errorf("cannot update Kafka Topic: %w", err)
@@ -111,9 +126,19 @@ func (h KafkaTopicHandler) delete(avn *aiven.Client, i client.Object) (bool, err
 		return false, err
 	}
 
+	deleteConsumerGroupLagMetrics(topic)
 	return true, nil
 }
 
+// resyncAfter refreshes the lag of the consumer groups periodically
+func (h KafkaTopicHandler) resyncAfter(i client.Object) time.Duration {
+	topic, err := h.convert(i)
+	if err != nil || !topic.Spec.ConsumerGroupLag {
+		return 0
+	}
+	return kafkaTopicConsumerLagInterval
+}
+
 func (h KafkaTopicHandler) exists(avn *aiven.Client, topic *v1alpha1.KafkaTopic) (bool, error) {
 	t, err := avn.KafkaTopics.Get(topic.Spec.Project, topic.Spec.ServiceName, topic.Name)
 	if err != nil && !aiven.IsNotFound(err) {
@@ -137,22 +162,41 @@ func (h KafkaTopicHandler) get(avn *aiven.Client, i client.Object) (*corev1.Secr
 		return nil, err
 	}
 
-	state, err := h.getState(avn, topic)
-	if err != nil {
+	t, err := h.getTopic(avn, topic)
+	if err != nil || t == nil {
 		return nil, err
 	}
 
-	topic.Status.State = state
+	topic.Status.State = t.State
+	topic.Status.Partitions, topic.Status.UnderReplicatedPartitions = kafkaTopicPartitionsProgress(t)
 
-	if state == "ACTIVE" {
-		meta.SetStatusCondition(&topic.Status.Conditions,
-			getRunningCondition(metav1.ConditionTrue, "CheckRunning",
-				"Instance is running on Aiven side"))
+	if topic.Spec.ConsumerGroupLag {
+		lags := consumerGroupLags(t)
+		topic.Status.ConsumerGroups = newKafkaTopicConsumerGroups(lags)
+		setConsumerGroupLagMetrics(topic, lags)
+	} else {
+		topic.Status.ConsumerGroups = nil
+		deleteConsumerGroupLagMetrics(topic)
+	}
+
+	if t.State != "ACTIVE" {
+		return nil, nil
+	}
 
-		metav1.SetMetaDataAnnotation(&topic.ObjectMeta, instanceIsRunningAnnotation, "true")
+	// The added partitions are not live right away, the topic is running once they are
+	if topic.Status.Partitions < topic.Spec.Partitions {
+		meta.SetStatusCondition(&topic.Status.Conditions,
+			getRunningCondition(metav1.ConditionUnknown, "CheckPartitions",
+				fmt.Sprintf("Waiting for the partitions to be live: %d of %d", topic.Status.Partitions, topic.Spec.Partitions)))
+		return nil, nil
 	}
 
-	return nil, err
+	meta.SetStatusCondition(&topic.Status.Conditions,
+		getRunningCondition(metav1.ConditionTrue, "CheckRunning",
+			"Instance is running on Aiven side"))
+
+	metav1.SetMetaDataAnnotation(&topic.ObjectMeta, instanceIsRunningAnnotation, "true")
+	return nil, nil
 }
 
 func (h KafkaTopicHandler) checkPreconditions(avn *aiven.Client, i client.Object) (bool, error) {
@@ -167,19 +211,35 @@ func (h KafkaTopicHandler) checkPreconditions(avn *aiven.Client, i client.Object
 	return checkServiceIsRunning(avn, topic.Spec.Project, topic.Spec.ServiceName)
 }
 
-func (h KafkaTopicHandler) getState(avn *aiven.Client, topic *v1alpha1.KafkaTopic) (string, error) {
+// getTopic returns the topic, or nil if Aiven fails to get it temporarily
+func (h KafkaTopicHandler) getTopic(avn *aiven.Client, topic *v1alpha1.KafkaTopic) (*aiven.KafkaTopic, error) {
 	t, err := avn.KafkaTopics.Get(topic.Spec.Project, topic.Spec.ServiceName, topic.Name)
 	if err != nil {
 		if aivenError, ok := err.(aiven.Error); ok {
 			// Getting topic info can sometimes temporarily fail with 501 and 502. Don't
 			// treat that as fatal error but keep on retrying instead.
 			if aivenError.Status == 501 || aivenError.Status == 502 {
-				return "", nil
+				return nil, nil
 			}
 		}
-		return "", err
+		return nil, err
+	}
+	return t, nil
+}
+
+// kafkaTopicPartitionsProgress returns the number of the live partitions,
+// and the number of the partitions that have fewer in-sync replicas than the replication factor
+func kafkaTopicPartitionsProgress(t *aiven.KafkaTopic) (live, underReplicated int) {
+	for _, p := range t.Partitions {
+		if p == nil {
+			continue
+		}
+		live++
+		if p.ISR < t.Replication {
+			underReplicated++
+		}
 	}
-	return t.State, nil
+	return live, underReplicated
 }
 
 func (h KafkaTopicHandler) convert(i client.Object) (*v1alpha1.KafkaTopic, error) {
@@ -191,30 +251,30 @@ func (h KafkaTopicHandler) convert(i client.Object) (*v1alpha1.KafkaTopic, error
 	return topic, nil
 }
 
-func convertKafkaTopicConfig(topic *v1alpha1.KafkaTopic) aiven.KafkaTopicConfig {
+func convertKafkaTopicConfig(config v1alpha1.KafkaTopicConfig) aiven.KafkaTopicConfig {
 	return aiven.KafkaTopicConfig{
-		CleanupPolicy:                   topic.Spec.Config.CleanupPolicy,
-		CompressionType:                 topic.Spec.Config.CompressionType,
-		DeleteRetentionMs:               topic.Spec.Config.DeleteRetentionMs,
-		FileDeleteDelayMs:               topic.Spec.Config.FileDeleteDelayMs,
-		FlushMessages:                   topic.Spec.Config.FlushMessages,
-		FlushMs:                         topic.Spec.Config.FlushMs,
-		IndexIntervalBytes:              topic.Spec.Config.IndexIntervalBytes,
-		MaxCompactionLagMs:              topic.Spec.Config.MaxCompactionLagMs,
-		MaxMessageBytes:                 topic.Spec.Config.MaxMessageBytes,
-		MessageDownconversionEnable:     topic.Spec.Config.MessageDownconversionEnable,
-		MessageFormatVersion:            topic.Spec.Config.MessageFormatVersion,
-		MessageTimestampDifferenceMaxMs: topic.Spec.Config.MessageTimestampDifferenceMaxMs,
-		MessageTimestampType:            topic.Spec.Config.MessageTimestampType,
-		MinCompactionLagMs:              topic.Spec.Config.MinCompactionLagMs,
-		MinInsyncReplicas:               topic.Spec.Config.MinInsyncReplicas,
-		Preallocate:                     topic.Spec.Config.Preallocate,
-		RetentionBytes:                  topic.Spec.Config.RetentionBytes,
-		RetentionMs:                     topic.Spec.Config.RetentionMs,
-		SegmentBytes:                    topic.Spec.Config.SegmentBytes,
-		SegmentIndexBytes:               topic.Spec.Config.SegmentIndexBytes,
-		SegmentJitterMs:                 topic.Spec.Config.SegmentJitterMs,
-		SegmentMs:                       topic.Spec.Config.SegmentMs,
-		UncleanLeaderElectionEnable:     topic.Spec.Config.UncleanLeaderElectionEnable,
+		CleanupPolicy:                   config.CleanupPolicy,
+		CompressionType:                 config.CompressionType,
+		DeleteRetentionMs:               config.DeleteRetentionMs,
+		FileDeleteDelayMs:               config.FileDeleteDelayMs,
+		FlushMessages:                   config.FlushMessages,
+		FlushMs:                         config.FlushMs,
+		IndexIntervalBytes:              config.IndexIntervalBytes,
+		MaxCompactionLagMs:              config.MaxCompactionLagMs,
+		MaxMessageBytes:                 config.MaxMessageBytes,
+		MessageDownconversionEnable:     config.MessageDownconversionEnable,
+		MessageFormatVersion:            config.MessageFormatVersion,
+		MessageTimestampDifferenceMaxMs: config.MessageTimestampDifferenceMaxMs,
+		MessageTimestampType:            config.MessageTimestampType,
+		MinCompactionLagMs:              config.MinCompactionLagMs,
+		MinInsyncReplicas:               config.MinInsyncReplicas,
+		Preallocate:                     config.Preallocate,
+		RetentionBytes:                  config.RetentionBytes,
+		RetentionMs:                     config.RetentionMs,
+		SegmentBytes:                    config.SegmentBytes,
+		SegmentIndexBytes:               config.SegmentIndexBytes,
+		SegmentJitterMs:                 config.SegmentJitterMs,
+		SegmentMs:                       config.SegmentMs,
+		UncleanLeaderElectionEnable:     config.UncleanLeaderElectionEnable,
 	}
 }
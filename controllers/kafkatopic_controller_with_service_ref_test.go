@@ -0,0 +1,123 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+var _ = Describe("KafkaTopic Controller with a service in another namespace", func() {
+	// Define utility constants for object names and testing timeouts/durations and intervals.
+	const (
+		serviceNamespace = "default"
+
+		timeout  = time.Minute * 20
+		interval = time.Second * 10
+	)
+
+	var (
+		kafka       *v1alpha1.Kafka
+		topic       *v1alpha1.KafkaTopic
+		grant       *v1alpha1.ReferenceGrant
+		namespace   *corev1.Namespace
+		serviceName string
+		topicName   string
+		ctx         = context.Background()
+	)
+
+	BeforeEach(func() {
+		serviceName = "k8s-test-kafka-topic-ref-acc-" + generateRandomID()
+		topicName = "k8s-test-topic-ref-acc-" + generateRandomID()
+		namespace = &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "k8s-test-app-" + generateRandomID()}}
+		kafka = kafkaSpec(serviceName, serviceNamespace)
+		grant = kafkaTopicReferenceGrantSpec(serviceName, serviceNamespace, namespace.Name)
+
+		// The topic has no token of its own, it uses the token of the service
+		topic = kafkaTopicSpec(serviceName, topicName, namespace.Name)
+		topic.Spec.AuthSecretRef = v1alpha1.AuthSecretReference{}
+		topic.Spec.ServiceRef = &v1alpha1.ServiceReference{
+			Kind:      "Kafka",
+			Name:      serviceName,
+			Namespace: serviceNamespace,
+		}
+
+		By("Creating the namespace of the topic")
+		Expect(k8sClient.Create(ctx, namespace)).Should(Succeed())
+
+		By("Creating a new Kafka instance")
+		Expect(k8sClient.Create(ctx, kafka)).Should(Succeed())
+
+		By("Creating a new KafkaTopic instance")
+		Expect(k8sClient.Create(ctx, topic)).Should(Succeed())
+	})
+
+	Context("Validating the reference grant", func() {
+		It("should create the topic once the reference is granted", func() {
+			lookupKey := types.NamespacedName{Name: topicName, Namespace: namespace.Name}
+			createdTopic := &v1alpha1.KafkaTopic{}
+
+			By("by checking the topic is not processed without a grant")
+			Consistently(func() bool {
+				err := k8sClient.Get(ctx, lookupKey, createdTopic)
+				return err == nil && !isAlreadyProcessed(createdTopic)
+			}, time.Minute, interval).Should(BeTrue())
+
+			By("Creating a new ReferenceGrant instance")
+			Expect(k8sClient.Create(ctx, grant)).Should(Succeed())
+
+			By("by waiting Kafka Topic to become ACTIVE")
+			Eventually(func() bool {
+				err := k8sClient.Get(ctx, lookupKey, createdTopic)
+				if err == nil {
+					return meta.IsStatusConditionTrue(createdTopic.Status.Conditions, conditionTypeRunning)
+				}
+				return false
+			}, timeout, interval).Should(BeTrue())
+
+			Expect(createdTopic.Status.State).Should(Equal("ACTIVE"))
+		})
+	})
+
+	AfterEach(func() {
+		By("Ensures that Kafka Topic instance was deleted")
+		ensureDelete(ctx, topic)
+		By("Ensures that ReferenceGrant instance was deleted")
+		ensureDelete(ctx, grant)
+		By("Ensures that Kafka instance was deleted")
+		ensureDelete(ctx, kafka)
+		// Namespaces are not removed in the test environment, it doesn't wait for that
+		By("Deleting the namespace of the topic")
+		Expect(k8sClient.Delete(ctx, namespace)).Should(Succeed())
+	})
+})
+
+func kafkaTopicReferenceGrantSpec(serviceName, namespace, fromNamespace string) *v1alpha1.ReferenceGrant {
+	return &v1alpha1.ReferenceGrant{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "aiven.io/v1alpha1",
+			Kind:       "ReferenceGrant",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      serviceName + "-topics",
+			Namespace: namespace,
+		},
+		Spec: v1alpha1.ReferenceGrantSpec{
+			From: []v1alpha1.ReferenceGrantFrom{
+				{Kind: "KafkaTopic", Namespace: fromNamespace},
+			},
+			To: []v1alpha1.ReferenceGrantTo{
+				{Kind: "Kafka", Name: serviceName},
+			},
+		},
+	}
+}
@@ -0,0 +1,88 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"reflect"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+// inheritedKafkaTopicConfig returns the fields of the topicDefaults of the referenced Kafka the topic doesn't set,
+// and nil if it inherits none
+func inheritedKafkaTopicConfig(topic *v1alpha1.KafkaTopic, refs []client.Object) *v1alpha1.KafkaTopicConfig {
+	var defaults *v1alpha1.KafkaTopicConfig
+	for _, r := range refs {
+		if k, ok := r.(*v1alpha1.Kafka); ok {
+			defaults = k.Spec.TopicDefaults
+		}
+	}
+	if defaults == nil {
+		return nil
+	}
+
+	inherited := &v1alpha1.KafkaTopicConfig{}
+	own := reflect.ValueOf(topic.Spec.Config)
+	def := reflect.ValueOf(*defaults)
+	out := reflect.ValueOf(inherited).Elem()
+	found := false
+	for i := 0; i < own.NumField(); i++ {
+		if own.Field(i).IsZero() && !def.Field(i).IsZero() {
+			out.Field(i).Set(def.Field(i))
+			found = true
+		}
+	}
+	if !found {
+		return nil
+	}
+	return inherited
+}
+
+// mergeKafkaTopicConfig returns the config with the inherited fields set
+func mergeKafkaTopicConfig(config v1alpha1.KafkaTopicConfig, inherited *v1alpha1.KafkaTopicConfig) v1alpha1.KafkaTopicConfig {
+	if inherited == nil {
+		return config
+	}
+
+	out := reflect.ValueOf(&config).Elem()
+	in := reflect.ValueOf(*inherited)
+	for i := 0; i < in.NumField(); i++ {
+		if !in.Field(i).IsZero() {
+			out.Field(i).Set(in.Field(i))
+		}
+	}
+	return config
+}
+
+// refsChanged tells if the topicDefaults of the referenced Kafka changed since the topic was updated
+func (h KafkaTopicHandler) refsChanged(i client.Object, refs []client.Object) bool {
+	topic, err := h.convert(i)
+	if err != nil {
+		return false
+	}
+	return !reflect.DeepEqual(inheritedKafkaTopicConfig(topic, refs), topic.Status.InheritedConfig)
+}
+
+// kafkaTopicsOf returns the topics that refer to the Kafka with serviceRef, which inherit its topicDefaults
+func (r *KafkaTopicReconciler) kafkaTopicsOf(o client.Object) []reconcile.Request {
+	topics := &v1alpha1.KafkaTopicList{}
+	if err := r.List(context.Background(), topics); err != nil {
+		r.Log.Error(err, "unable to list the topics of the Kafka", "kafka", client.ObjectKeyFromObject(o))
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for i := range topics.Items {
+		t := &topics.Items[i]
+		ref := t.Spec.ServiceRef
+		if ref == nil || ref.Kind != "Kafka" || ref.Service(t.Namespace).NamespacedName != client.ObjectKeyFromObject(o) {
+			continue
+		}
+		requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(t)})
+	}
+	return requests
+}
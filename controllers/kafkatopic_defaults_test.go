@@ -0,0 +1,68 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+func TestInheritedKafkaTopicConfig(t *testing.T) {
+	kafka := &v1alpha1.Kafka{Spec: v1alpha1.KafkaSpec{TopicDefaults: &v1alpha1.KafkaTopicConfig{
+		CleanupPolicy:     "compact",
+		RetentionMs:       anyPointer(int64(86400000)),
+		MinInsyncReplicas: anyPointer(int64(2)),
+	}}}
+	topic := &v1alpha1.KafkaTopic{Spec: v1alpha1.KafkaTopicSpec{Config: v1alpha1.KafkaTopicConfig{
+		CleanupPolicy:   "delete",
+		CompressionType: "zstd",
+	}}}
+	refs := []client.Object{kafka}
+
+	// The fields the topic sets are not inherited
+	inherited := inheritedKafkaTopicConfig(topic, refs)
+	assert.Equal(t, &v1alpha1.KafkaTopicConfig{
+		RetentionMs:       anyPointer(int64(86400000)),
+		MinInsyncReplicas: anyPointer(int64(2)),
+	}, inherited)
+	assert.Equal(t, v1alpha1.KafkaTopicConfig{
+		CleanupPolicy:     "delete",
+		CompressionType:   "zstd",
+		RetentionMs:       anyPointer(int64(86400000)),
+		MinInsyncReplicas: anyPointer(int64(2)),
+	}, mergeKafkaTopicConfig(topic.Spec.Config, inherited))
+
+	// Nothing is inherited without the defaults, or when the topic overrides all of them
+	assert.Nil(t, inheritedKafkaTopicConfig(topic, nil))
+	assert.Nil(t, inheritedKafkaTopicConfig(topic, []client.Object{&v1alpha1.Kafka{}}))
+	topic.Spec.Config.RetentionMs = anyPointer(int64(1000))
+	topic.Spec.Config.MinInsyncReplicas = anyPointer(int64(1))
+	assert.Nil(t, inheritedKafkaTopicConfig(topic, refs))
+	assert.Equal(t, topic.Spec.Config, mergeKafkaTopicConfig(topic.Spec.Config, nil))
+}
+
+func TestKafkaTopicRefsChanged(t *testing.T) {
+	kafka := &v1alpha1.Kafka{Spec: v1alpha1.KafkaSpec{TopicDefaults: &v1alpha1.KafkaTopicConfig{
+		RetentionMs: anyPointer(int64(86400000)),
+	}}}
+	topic := &v1alpha1.KafkaTopic{}
+	refs := []client.Object{kafka}
+
+	h := KafkaTopicHandler{}
+	assert.True(t, h.refsChanged(topic, refs))
+
+	topic.Status.InheritedConfig = inheritedKafkaTopicConfig(topic, refs)
+	assert.False(t, h.refsChanged(topic, refs))
+
+	kafka.Spec.TopicDefaults.RetentionMs = anyPointer(int64(3600000))
+	assert.True(t, h.refsChanged(topic, refs))
+
+	kafka.Spec.TopicDefaults = nil
+	assert.True(t, h.refsChanged(topic, refs))
+	topic.Status.InheritedConfig = nil
+	assert.False(t, h.refsChanged(topic, refs))
+}
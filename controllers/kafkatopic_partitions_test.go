@@ -0,0 +1,30 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"testing"
+
+	"github.com/aiven/aiven-go-client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKafkaTopicPartitionsProgress(t *testing.T) {
+	topic := &aiven.KafkaTopic{
+		Replication: 3,
+		Partitions: []*aiven.Partition{
+			{Partition: 0, ISR: 3},
+			{Partition: 1, ISR: 3},
+			{Partition: 2, ISR: 1},
+			nil,
+		},
+	}
+
+	live, underReplicated := kafkaTopicPartitionsProgress(topic)
+	assert.Equal(t, 3, live)
+	assert.Equal(t, 1, underReplicated)
+
+	live, underReplicated = kafkaTopicPartitionsProgress(&aiven.KafkaTopic{Replication: 2})
+	assert.Equal(t, 0, live)
+	assert.Equal(t, 0, underReplicated)
+}
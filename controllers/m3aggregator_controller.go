@@ -0,0 +1,106 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aiven/aiven-go-client"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+// M3AggregatorReconciler reconciles a M3Aggregator object
+type M3AggregatorReconciler struct {
+	Controller
+}
+
+// +kubebuilder:rbac:groups=aiven.io,resources=m3aggregators,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=aiven.io,resources=m3aggregators/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=aiven.io,resources=m3aggregators/finalizers,verbs=update
+
+func (r *M3AggregatorReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	return r.reconcileInstance(ctx, req, newGenericServiceHandler(newM3AggregatorAdapter, r.Recorder), &v1alpha1.M3Aggregator{})
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *M3AggregatorReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&v1alpha1.M3Aggregator{}).
+		WithOptions(priorityControllerOptions(&v1alpha1.M3Aggregator{})).
+		Owns(&corev1.Secret{}).
+		Complete(r)
+}
+
+func newM3AggregatorAdapter(_ *aiven.Client, object client.Object) (serviceAdapter, error) {
+	m3aggregator, ok := object.(*v1alpha1.M3Aggregator)
+	if !ok {
+		return nil, fmt.Errorf("object is not of type v1alpha1.M3Aggregator")
+	}
+	return &m3aggregatorAdapter{m3aggregator}, nil
+}
+
+// m3aggregatorAdapter handles an Aiven M3Aggregator service
+type m3aggregatorAdapter struct {
+	*v1alpha1.M3Aggregator
+}
+
+func (a *m3aggregatorAdapter) getObjectMeta() *metav1.ObjectMeta {
+	return &a.ObjectMeta
+}
+
+func (a *m3aggregatorAdapter) getServiceStatus() *v1alpha1.ServiceStatus {
+	return &a.Status
+}
+
+func (a *m3aggregatorAdapter) getServiceCommonSpec() *v1alpha1.ServiceCommonSpec {
+	return &a.Spec.ServiceCommonSpec
+}
+
+func (a *m3aggregatorAdapter) getUserConfig() any {
+	return &a.Spec.UserConfig
+}
+
+func (a *m3aggregatorAdapter) newSecret(s *aiven.Service) (*corev1.Secret, error) {
+	name := a.Spec.ConnInfoSecretTarget.Name
+	if name == "" {
+		name = a.Name
+	}
+
+	stringData := map[string]string{
+		"M3AGGREGATOR_HOST":     s.URIParams["host"],
+		"M3AGGREGATOR_PORT":     s.URIParams["port"],
+		"M3AGGREGATOR_USER":     s.URIParams["user"],
+		"M3AGGREGATOR_PASSWORD": s.URIParams["password"],
+		"M3AGGREGATOR_URI":      s.URI,
+	}
+
+	// Removes empties
+	for k, v := range stringData {
+		if v == "" {
+			delete(stringData, k)
+		}
+	}
+
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: a.Namespace},
+		StringData: stringData,
+	}, nil
+}
+
+func (a *m3aggregatorAdapter) getServiceType() string {
+	return "m3aggregator"
+}
+
+func (a *m3aggregatorAdapter) getDiskSpace() string {
+	return a.Spec.DiskSpace
+}
+
+func (a *m3aggregatorAdapter) getAdditionalDiskSpace() string {
+	return a.Spec.AdditionalDiskSpace
+}
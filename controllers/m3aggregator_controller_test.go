@@ -0,0 +1,147 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"os"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+	m3aggregatoruserconfig "github.com/aiven/aiven-operator/api/v1alpha1/userconfigs/m3aggregator"
+)
+
+var _ = Describe("M3Aggregator Controller", func() {
+	// Define utility constants for object names and testing timeouts/durations and intervals.
+	const (
+		namespace = "default"
+
+		timeout  = time.Minute * 20
+		interval = time.Second * 10
+	)
+
+	var (
+		m3aggregator *v1alpha1.M3Aggregator
+		serviceName  string
+		ctx          context.Context
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		serviceName = "k8s-test-m3aggregator-acc-" + generateRandomID()
+		m3aggregator = m3aggregatorSpec(serviceName, namespace)
+
+		By("Creating a new M3Aggregator CR instance")
+		Expect(k8sClient.Create(ctx, m3aggregator)).Should(Succeed())
+
+		m3aggregatorLookupKey := types.NamespacedName{Name: serviceName, Namespace: namespace}
+		createdM3Aggregator := &v1alpha1.M3Aggregator{}
+		// We'll need to retry getting this newly created M3Aggregator,
+		// given that creation may not immediately happen.
+		By("by retrieving M3Aggregator instance from k8s")
+		Eventually(func() bool {
+			err := k8sClient.Get(ctx, m3aggregatorLookupKey, createdM3Aggregator)
+
+			return err == nil
+		}, timeout, interval).Should(BeTrue())
+
+		By("by waiting M3Aggregator service status to become RUNNING")
+		Eventually(func() bool {
+			err := k8sClient.Get(ctx, m3aggregatorLookupKey, createdM3Aggregator)
+			if err == nil {
+				return meta.IsStatusConditionTrue(createdM3Aggregator.Status.Conditions, conditionTypeRunning)
+			}
+			return false
+		}, timeout, interval).Should(BeTrue())
+
+		By("by checking finalizers")
+		Expect(createdM3Aggregator.GetFinalizers()).ToNot(BeEmpty())
+	})
+
+	Context("Validating M3Aggregator reconciler behaviour", func() {
+		It("should createOrUpdate a new M3Aggregator service", func() {
+			createdM3Aggregator := &v1alpha1.M3Aggregator{}
+			m3aggregatorLookupKey := types.NamespacedName{Name: serviceName, Namespace: namespace}
+
+			Expect(k8sClient.Get(ctx, m3aggregatorLookupKey, createdM3Aggregator)).Should(Succeed())
+
+			By("by checking that after creation of a M3Aggregator service secret is created")
+			createdSecret := &corev1.Secret{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: serviceName, Namespace: namespace}, createdSecret)).Should(Succeed())
+
+			// It is running
+			Expect(createdM3Aggregator.Status.State).Should(Equal("RUNNING"))
+
+			// Secretes test
+			Expect(createdSecret.Data["M3AGGREGATOR_HOST"]).NotTo(BeEmpty())
+			Expect(createdSecret.Data["M3AGGREGATOR_PORT"]).NotTo(BeEmpty())
+			Expect(createdSecret.Data["M3AGGREGATOR_USER"]).NotTo(BeEmpty())
+			Expect(createdSecret.Data["M3AGGREGATOR_PASSWORD"]).NotTo(BeEmpty())
+			Expect(createdSecret.Data["M3AGGREGATOR_URI"]).NotTo(BeEmpty())
+
+			// User config test
+			Expect(*createdM3Aggregator.Spec.UserConfig.M3aggregatorVersion).Should(Equal("1.5"))
+
+			// Ip filters test
+			expectedIPFilter := []*m3aggregatoruserconfig.IpFilter{
+				{
+					Network: "10.20.0.0/16",
+				},
+				{
+					Network:     "0.0.0.0",
+					Description: anyPointer("whatever"),
+				},
+			}
+			Expect(createdM3Aggregator.Spec.UserConfig.IpFilter).Should(Equal(expectedIPFilter))
+		})
+	})
+
+	AfterEach(func() {
+		By("Ensures that M3Aggregator instance was deleted")
+		ensureDelete(ctx, m3aggregator)
+	})
+})
+
+func m3aggregatorSpec(serviceName, namespace string) *v1alpha1.M3Aggregator {
+	return &v1alpha1.M3Aggregator{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "aiven.io/v1alpha1",
+			Kind:       "M3Aggregator",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      serviceName,
+			Namespace: namespace,
+		},
+		Spec: v1alpha1.M3AggregatorSpec{
+			ServiceCommonSpec: v1alpha1.ServiceCommonSpec{
+				Project:   os.Getenv("AIVEN_PROJECT_NAME"),
+				Plan:      "business-8",
+				CloudName: "google-europe-west1",
+				Tags:      map[string]string{"key1": "value1"},
+			},
+			UserConfig: &m3aggregatoruserconfig.M3aggregatorUserConfig{
+				M3aggregatorVersion: anyPointer("1.5"),
+				IpFilter: []*m3aggregatoruserconfig.IpFilter{
+					{
+						Network: "10.20.0.0/16",
+					},
+					{
+						Network:     "0.0.0.0",
+						Description: anyPointer("whatever"),
+					},
+				},
+			},
+			AuthSecretRef: v1alpha1.AuthSecretReference{
+				Name: secretRefName,
+				Key:  secretRefKey,
+			},
+		},
+	}
+}
@@ -0,0 +1,106 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aiven/aiven-go-client"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+// M3DBReconciler reconciles a M3DB object
+type M3DBReconciler struct {
+	Controller
+}
+
+// +kubebuilder:rbac:groups=aiven.io,resources=m3dbs,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=aiven.io,resources=m3dbs/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=aiven.io,resources=m3dbs/finalizers,verbs=update
+
+func (r *M3DBReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	return r.reconcileInstance(ctx, req, newGenericServiceHandler(newM3DBAdapter, r.Recorder), &v1alpha1.M3DB{})
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *M3DBReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&v1alpha1.M3DB{}).
+		WithOptions(priorityControllerOptions(&v1alpha1.M3DB{})).
+		Owns(&corev1.Secret{}).
+		Complete(r)
+}
+
+func newM3DBAdapter(_ *aiven.Client, object client.Object) (serviceAdapter, error) {
+	m3db, ok := object.(*v1alpha1.M3DB)
+	if !ok {
+		return nil, fmt.Errorf("object is not of type v1alpha1.M3DB")
+	}
+	return &m3dbAdapter{m3db}, nil
+}
+
+// m3dbAdapter handles an Aiven M3DB service
+type m3dbAdapter struct {
+	*v1alpha1.M3DB
+}
+
+func (a *m3dbAdapter) getObjectMeta() *metav1.ObjectMeta {
+	return &a.ObjectMeta
+}
+
+func (a *m3dbAdapter) getServiceStatus() *v1alpha1.ServiceStatus {
+	return &a.Status
+}
+
+func (a *m3dbAdapter) getServiceCommonSpec() *v1alpha1.ServiceCommonSpec {
+	return &a.Spec.ServiceCommonSpec
+}
+
+func (a *m3dbAdapter) getUserConfig() any {
+	return &a.Spec.UserConfig
+}
+
+func (a *m3dbAdapter) newSecret(s *aiven.Service) (*corev1.Secret, error) {
+	name := a.Spec.ConnInfoSecretTarget.Name
+	if name == "" {
+		name = a.Name
+	}
+
+	stringData := map[string]string{
+		"M3DB_HOST":     s.URIParams["host"],
+		"M3DB_PORT":     s.URIParams["port"],
+		"M3DB_USER":     s.URIParams["user"],
+		"M3DB_PASSWORD": s.URIParams["password"],
+		"M3DB_URI":      s.URI,
+	}
+
+	// Removes empties
+	for k, v := range stringData {
+		if v == "" {
+			delete(stringData, k)
+		}
+	}
+
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: a.Namespace},
+		StringData: stringData,
+	}, nil
+}
+
+func (a *m3dbAdapter) getServiceType() string {
+	return "m3db"
+}
+
+func (a *m3dbAdapter) getDiskSpace() string {
+	return a.Spec.DiskSpace
+}
+
+func (a *m3dbAdapter) getAdditionalDiskSpace() string {
+	return a.Spec.AdditionalDiskSpace
+}
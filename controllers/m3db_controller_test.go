@@ -0,0 +1,159 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"os"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+	m3dbuserconfig "github.com/aiven/aiven-operator/api/v1alpha1/userconfigs/m3db"
+)
+
+var _ = Describe("M3DB Controller", func() {
+	// Define utility constants for object names and testing timeouts/durations and intervals.
+	const (
+		namespace = "default"
+
+		timeout  = time.Minute * 20
+		interval = time.Second * 10
+	)
+
+	var (
+		m3db        *v1alpha1.M3DB
+		serviceName string
+		ctx         context.Context
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		serviceName = "k8s-test-m3db-acc-" + generateRandomID()
+		m3db = m3dbSpec(serviceName, namespace)
+
+		By("Creating a new M3DB CR instance")
+		Expect(k8sClient.Create(ctx, m3db)).Should(Succeed())
+
+		m3dbLookupKey := types.NamespacedName{Name: serviceName, Namespace: namespace}
+		createdM3DB := &v1alpha1.M3DB{}
+		// We'll need to retry getting this newly created M3DB,
+		// given that creation may not immediately happen.
+		By("by retrieving M3DB instance from k8s")
+		Eventually(func() bool {
+			err := k8sClient.Get(ctx, m3dbLookupKey, createdM3DB)
+
+			return err == nil
+		}, timeout, interval).Should(BeTrue())
+
+		By("by waiting M3DB service status to become RUNNING")
+		Eventually(func() bool {
+			err := k8sClient.Get(ctx, m3dbLookupKey, createdM3DB)
+			if err == nil {
+				return meta.IsStatusConditionTrue(createdM3DB.Status.Conditions, conditionTypeRunning)
+			}
+			return false
+		}, timeout, interval).Should(BeTrue())
+
+		By("by checking finalizers")
+		Expect(createdM3DB.GetFinalizers()).ToNot(BeEmpty())
+	})
+
+	Context("Validating M3DB reconciler behaviour", func() {
+		It("should createOrUpdate a new M3DB service", func() {
+			createdM3DB := &v1alpha1.M3DB{}
+			m3dbLookupKey := types.NamespacedName{Name: serviceName, Namespace: namespace}
+
+			Expect(k8sClient.Get(ctx, m3dbLookupKey, createdM3DB)).Should(Succeed())
+
+			By("by checking that after creation of a M3DB service secret is created")
+			createdSecret := &corev1.Secret{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: serviceName, Namespace: namespace}, createdSecret)).Should(Succeed())
+
+			// It is running
+			Expect(createdM3DB.Status.State).Should(Equal("RUNNING"))
+
+			// Secretes test
+			Expect(createdSecret.Data["M3DB_HOST"]).NotTo(BeEmpty())
+			Expect(createdSecret.Data["M3DB_PORT"]).NotTo(BeEmpty())
+			Expect(createdSecret.Data["M3DB_USER"]).NotTo(BeEmpty())
+			Expect(createdSecret.Data["M3DB_PASSWORD"]).NotTo(BeEmpty())
+			Expect(createdSecret.Data["M3DB_URI"]).NotTo(BeEmpty())
+
+			// User config test
+			Expect(createdM3DB.Spec.UserConfig.Namespaces).Should(HaveLen(1))
+			Expect(createdM3DB.Spec.UserConfig.Namespaces[0].Name).Should(Equal("default"))
+			Expect(*createdM3DB.Spec.UserConfig.Namespaces[0].Options.RetentionOptions.RetentionPeriodDuration).Should(Equal("48h"))
+
+			// Ip filters test
+			expectedIPFilter := []*m3dbuserconfig.IpFilter{
+				{
+					Network: "10.20.0.0/16",
+				},
+				{
+					Network:     "0.0.0.0",
+					Description: anyPointer("whatever"),
+				},
+			}
+			Expect(createdM3DB.Spec.UserConfig.IpFilter).Should(Equal(expectedIPFilter))
+		})
+	})
+
+	AfterEach(func() {
+		By("Ensures that M3DB instance was deleted")
+		ensureDelete(ctx, m3db)
+	})
+})
+
+func m3dbSpec(serviceName, namespace string) *v1alpha1.M3DB {
+	return &v1alpha1.M3DB{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "aiven.io/v1alpha1",
+			Kind:       "M3DB",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      serviceName,
+			Namespace: namespace,
+		},
+		Spec: v1alpha1.M3DBSpec{
+			ServiceCommonSpec: v1alpha1.ServiceCommonSpec{
+				Project:   os.Getenv("AIVEN_PROJECT_NAME"),
+				Plan:      "startup-8",
+				CloudName: "google-europe-west1",
+				Tags:      map[string]string{"key1": "value1"},
+			},
+			UserConfig: &m3dbuserconfig.M3dbUserConfig{
+				Namespaces: []*m3dbuserconfig.Namespaces{
+					{
+						Name: "default",
+						Type: "unaggregated",
+						Options: &m3dbuserconfig.Options{
+							RetentionOptions: m3dbuserconfig.RetentionOptions{
+								RetentionPeriodDuration: anyPointer("48h"),
+							},
+						},
+					},
+				},
+				IpFilter: []*m3dbuserconfig.IpFilter{
+					{
+						Network: "10.20.0.0/16",
+					},
+					{
+						Network:     "0.0.0.0",
+						Description: anyPointer("whatever"),
+					},
+				},
+			},
+			AuthSecretRef: v1alpha1.AuthSecretReference{
+				Name: secretRefName,
+				Key:  secretRefKey,
+			},
+		},
+	}
+}
@@ -0,0 +1,70 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aiven/aiven-go-client"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+const conditionTypeChangesFrozen = "ChangesFrozen"
+
+// freezeServiceChanges leaves the plan and the user config changes out of the update during a maintenance freeze.
+// Returns the postponed changes, and the freeze that postponed them
+func freezeServiceChanges(spec *v1alpha1.ServiceCommonSpec, current *aiven.Service, req *aiven.UpdateServiceRequest, now time.Time) ([]string, *v1alpha1.MaintenanceFreeze, error) {
+	freeze := spec.ActiveMaintenanceFreeze(now)
+	if freeze == nil {
+		return nil, nil, nil
+	}
+
+	changes := make([]string, 0)
+	if req.Plan != "" && req.Plan != current.Plan {
+		changes = append(changes, "plan")
+		req.Plan = current.Plan
+	}
+
+	fields, err := changedUserConfigFields(req.UserConfig, current.UserConfig)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(fields) > 0 {
+		for _, f := range fields {
+			changes = append(changes, "user_config."+f)
+		}
+		req.UserConfig = nil
+	}
+
+	if len(changes) == 0 {
+		return nil, nil, nil
+	}
+	return changes, freeze, nil
+}
+
+// getChangesFrozenCondition tells whether the changes are postponed because of a maintenance freeze
+func getChangesFrozenCondition(changes []string, freeze *v1alpha1.MaintenanceFreeze) metav1.Condition {
+	if freeze == nil {
+		return metav1.Condition{
+			Type:    conditionTypeChangesFrozen,
+			Status:  metav1.ConditionFalse,
+			Reason:  "NotFrozen",
+			Message: "All the changes are applied",
+		}
+	}
+
+	message := fmt.Sprintf("The changes of %s are postponed until %s", strings.Join(changes, ", "), freeze.End.UTC().Format(time.RFC3339))
+	if freeze.Reason != "" {
+		message += ": " + freeze.Reason
+	}
+	return metav1.Condition{
+		Type:    conditionTypeChangesFrozen,
+		Status:  metav1.ConditionTrue,
+		Reason:  "MaintenanceFreeze",
+		Message: message,
+	}
+}
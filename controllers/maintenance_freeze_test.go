@@ -0,0 +1,65 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aiven/aiven-go-client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+func TestFreezeServiceChanges(t *testing.T) {
+	now := time.Date(2022, 12, 20, 0, 0, 0, 0, time.UTC)
+	spec := &v1alpha1.ServiceCommonSpec{
+		MaintenanceFreeze: []v1alpha1.MaintenanceFreeze{{
+			Start:  metav1.NewTime(time.Date(2022, 12, 15, 0, 0, 0, 0, time.UTC)),
+			End:    metav1.NewTime(time.Date(2023, 1, 5, 0, 0, 0, 0, time.UTC)),
+			Reason: "end of the year",
+		}},
+	}
+	current := &aiven.Service{
+		Plan:       "business-4",
+		UserConfig: map[string]interface{}{"pg_version": "13"},
+	}
+	newRequest := func() *aiven.UpdateServiceRequest {
+		return &aiven.UpdateServiceRequest{
+			Plan:                  "business-8",
+			TerminationProtection: true,
+			UserConfig:            map[string]interface{}{"pg_version": "14"},
+		}
+	}
+
+	req := newRequest()
+	changes, freeze, err := freezeServiceChanges(spec, current, req, now)
+	require.NoError(t, err)
+	require.NotNil(t, freeze)
+	assert.Equal(t, []string{"plan", "user_config.pg_version"}, changes)
+	assert.Equal(t, "business-4", req.Plan)
+	assert.Nil(t, req.UserConfig)
+	assert.True(t, req.TerminationProtection, "the other changes are applied")
+
+	c := getChangesFrozenCondition(changes, freeze)
+	assert.Equal(t, metav1.ConditionTrue, c.Status)
+	assert.Equal(t, "The changes of plan, user_config.pg_version are postponed until 2023-01-05T00:00:00Z: end of the year", c.Message)
+
+	// After the freeze
+	req = newRequest()
+	changes, freeze, err = freezeServiceChanges(spec, current, req, now.AddDate(0, 1, 0))
+	require.NoError(t, err)
+	assert.Nil(t, freeze)
+	assert.Empty(t, changes)
+	assert.Equal(t, "business-8", req.Plan)
+	assert.Equal(t, metav1.ConditionFalse, getChangesFrozenCondition(changes, freeze).Status)
+
+	// Nothing to postpone
+	req = &aiven.UpdateServiceRequest{Plan: "business-4", UserConfig: map[string]interface{}{"pg_version": "13"}}
+	_, freeze, err = freezeServiceChanges(spec, current, req, now)
+	require.NoError(t, err)
+	assert.Nil(t, freeze)
+}
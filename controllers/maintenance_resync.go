@@ -0,0 +1,56 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"strings"
+	"time"
+
+	"github.com/aiven/aiven-go-client"
+)
+
+const (
+	// maintenanceWindowDuration is how long the maintenance of the service may take after the window starts
+	maintenanceWindowDuration = 4 * time.Hour
+
+	// maintenanceResyncDelay gives Aiven some time to update the service after the maintenance window ends
+	maintenanceResyncDelay = 5 * time.Minute
+)
+
+var weekdays = map[string]time.Weekday{
+	"sunday":    time.Sunday,
+	"monday":    time.Monday,
+	"tuesday":   time.Tuesday,
+	"wednesday": time.Wednesday,
+	"thursday":  time.Thursday,
+	"friday":    time.Friday,
+	"saturday":  time.Saturday,
+}
+
+// maintenanceWindowEnd returns the end of the current or the next maintenance window, which is in UTC.
+// Returns false if the service has no valid maintenance window
+func maintenanceWindowEnd(w aiven.MaintenanceWindow, now time.Time) (time.Time, bool) {
+	dow, ok := weekdays[strings.ToLower(w.DayOfWeek)]
+	if !ok {
+		return time.Time{}, false
+	}
+
+	tod, err := time.Parse("15:04:05", w.TimeOfDay)
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	// The latest start that is not in the future
+	now = now.UTC()
+	days := (int(now.Weekday()) - int(dow) + 7) % 7
+	start := time.Date(now.Year(), now.Month(), now.Day()-days, tod.Hour(), tod.Minute(), tod.Second(), 0, time.UTC)
+	if start.After(now) {
+		start = start.AddDate(0, 0, -7)
+	}
+
+	end := start.Add(maintenanceWindowDuration)
+	if !end.After(now) {
+		end = end.AddDate(0, 0, 7)
+	}
+	return end, true
+}
@@ -0,0 +1,69 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aiven/aiven-go-client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMaintenanceWindowEnd(t *testing.T) {
+	// Friday
+	now := time.Date(2023, 1, 20, 12, 0, 0, 0, time.UTC)
+	window := func(dow, tod string) aiven.MaintenanceWindow {
+		return aiven.MaintenanceWindow{DayOfWeek: dow, TimeOfDay: tod}
+	}
+
+	cases := []struct {
+		name     string
+		window   aiven.MaintenanceWindow
+		expected time.Time
+	}{
+		{
+			name:     "later this week",
+			window:   window("saturday", "23:00:00"),
+			expected: time.Date(2023, 1, 22, 3, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "later today",
+			window:   window("friday", "20:00:00"),
+			expected: time.Date(2023, 1, 21, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "in progress",
+			window:   window("friday", "10:00:00"),
+			expected: time.Date(2023, 1, 20, 14, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "ended today",
+			window:   window("friday", "06:00:00"),
+			expected: time.Date(2023, 1, 27, 10, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "next week",
+			window:   window("monday", "01:30:00"),
+			expected: time.Date(2023, 1, 23, 5, 30, 0, 0, time.UTC),
+		},
+		{
+			name:     "started yesterday",
+			window:   window("thursday", "22:00:00"),
+			expected: time.Date(2023, 1, 27, 2, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			end, ok := maintenanceWindowEnd(c.window, now)
+			assert.True(t, ok)
+			assert.Equal(t, c.expected, end)
+		})
+	}
+
+	_, ok := maintenanceWindowEnd(window("", ""), now)
+	assert.False(t, ok)
+	_, ok = maintenanceWindowEnd(window("friday", "noon"), now)
+	assert.False(t, ok)
+}
@@ -0,0 +1,53 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+var serviceMigrationProgress = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Name: "aiven_operator_service_migration_progress_percent",
+		Help: "Progress of the service data migration or rebalance, e.g. during a version or plan change",
+	},
+	[]string{"service_type", "namespace", "name"},
+)
+
+var serviceDiskUsage = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Name: "aiven_operator_service_disk_usage_percent",
+		Help: "Disk usage of the most used service node",
+	},
+	[]string{"service_type", "namespace", "name"},
+)
+
+var kafkaTopicConsumerGroupLag = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Name: "aiven_operator_kafka_topic_consumer_group_lag",
+		Help: "Number of the messages of the topic the consumer group has not consumed yet, for the topics with spec.consumerGroupLag",
+	},
+	[]string{"namespace", "name", "consumer_group"},
+)
+
+var chaosInjectedErrors = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "aiven_operator_chaos_injected_errors_total",
+		Help: "Aiven API errors injected by the chaos mode",
+	},
+	[]string{"method"},
+)
+
+var statusSchemaRejections = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "aiven_operator_status_schema_rejections_total",
+		Help: "Status updates the CRD schema rejected, e.g. because of a state Aiven added after the types were generated",
+	},
+	[]string{"kind", "field"},
+)
+
+func init() {
+	// Served by the manager's metrics endpoint
+	metrics.Registry.MustRegister(serviceMigrationProgress, serviceDiskUsage, kafkaTopicConsumerGroupLag, chaosInjectedErrors, statusSchemaRejections)
+}
@@ -0,0 +1,143 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aiven/aiven-go-client"
+	"github.com/go-sql-driver/mysql"
+)
+
+// mysqlClientTimeout limits the connection and each statement, so a service that doesn't answer doesn't block the reconciler
+const mysqlClientTimeout = 30 * time.Second
+
+// mysqlClient runs statements on a MySQL service as its admin user,
+// for the database and grant settings Aiven API doesn't manage
+type mysqlClient struct {
+	db *sql.DB
+}
+
+// newMySQLClient connects to the service, the client must be closed by the caller.
+// The operator must reach the service: the default address is tried first,
+// which is the private one for the services in a VPC, then the public one if public access is enabled
+func newMySQLClient(avn *aiven.Client, project, service string) (*mysqlClient, error) {
+	s, err := avn.Services.Get(project, service)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.Type != "mysql" {
+		return nil, fmt.Errorf("service %q is not MySQL, got %q service type", service, s.Type)
+	}
+
+	ca, err := avn.CA.Get(project)
+	if err != nil {
+		return nil, fmt.Errorf("aiven client error %w", err)
+	}
+
+	roots := x509.NewCertPool()
+	if !roots.AppendCertsFromPEM([]byte(ca)) {
+		return nil, fmt.Errorf("invalid CA certificate of project %q", project)
+	}
+
+	errs := make([]string, 0)
+	for _, addr := range mysqlAddresses(s) {
+		c, err := connectMySQL(addr, s.URIParams["user"], s.URIParams["password"], roots)
+		if err == nil {
+			return c, nil
+		}
+		errs = append(errs, fmt.Sprintf("%s: %s", addr, err))
+	}
+	return nil, fmt.Errorf("cannot connect to MySQL, the operator must run in a network peered with the service "+
+		"or the service must have public access enabled: %s", strings.Join(errs, "; "))
+}
+
+func connectMySQL(addr, user, password string, roots *x509.CertPool) (*mysqlClient, error) {
+	config := mysql.NewConfig()
+	config.Net = "tcp"
+	config.Addr = addr
+	config.User = user
+	config.Passwd = password
+	// The server name is taken from the address. Aiven services accept TLS connections only
+	config.TLS = &tls.Config{RootCAs: roots, MinVersion: tls.VersionTLS12}
+	config.Timeout = mysqlClientTimeout
+	config.ReadTimeout = mysqlClientTimeout
+	config.WriteTimeout = mysqlClientTimeout
+
+	connector, err := mysql.NewConnector(config)
+	if err != nil {
+		return nil, err
+	}
+
+	db := sql.OpenDB(connector)
+	ctx, cancel := context.WithTimeout(context.Background(), mysqlClientTimeout)
+	defer cancel()
+
+	err = db.PingContext(ctx)
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+	return &mysqlClient{db: db}, nil
+}
+
+// mysqlAddresses returns the default address of the service, then the public one if it differs
+func mysqlAddresses(s *aiven.Service) []string {
+	addrs := []string{net.JoinHostPort(s.URIParams["host"], s.URIParams["port"])}
+	for _, c := range s.Components {
+		if c.Component != "mysql" || c.Route != "public" || c.Usage != "primary" {
+			continue
+		}
+
+		addr := net.JoinHostPort(c.Host, strconv.Itoa(c.Port))
+		if addr != addrs[0] {
+			addrs = append(addrs, addr)
+		}
+	}
+	return addrs
+}
+
+// exec runs statements that don't return rows
+func (c *mysqlClient) exec(query string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), mysqlClientTimeout)
+	defer cancel()
+
+	_, err := c.db.ExecContext(ctx, query)
+	return err
+}
+
+func (c *mysqlClient) Close() error {
+	return c.db.Close()
+}
+
+func isMySQLNoSuchGrant(err error) bool {
+	var e *mysql.MySQLError
+	// ER_NONEXISTING_GRANT and ER_NONEXISTING_TABLE_GRANT
+	return errors.As(err, &e) && (e.Number == 1141 || e.Number == 1147)
+}
+
+// quoteMySQLIdentifier quotes database, table and column names
+func quoteMySQLIdentifier(name string) string {
+	return "`" + strings.ReplaceAll(name, "`", "``") + "`"
+}
+
+// quoteMySQLString quotes string literals, like user names.
+// The quotes are doubled, which works with NO_BACKSLASH_ESCAPES too.
+// The backslashes are left as they are, the user names are Kubernetes names, which can't have them
+func quoteMySQLString(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
+
+// mysqlNameFormat matches the names that can't be quoted in the statements, like the charsets and the collations
+var mysqlNameFormat = regexp.MustCompile(`^[a-zA-Z0-9_]+$`)
@@ -25,13 +25,14 @@ type MySQLReconciler struct {
 //+kubebuilder:rbac:groups=aiven.io,resources=mysqls/finalizers,verbs=update
 
 func (r *MySQLReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
-	return r.reconcileInstance(ctx, req, newGenericServiceHandler(newMySQLAdapter), &v1alpha1.MySQL{})
+	return r.reconcileInstance(ctx, req, newGenericServiceHandler(newMySQLAdapter, r.Recorder), &v1alpha1.MySQL{})
 }
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *MySQLReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&v1alpha1.MySQL{}).
+		WithOptions(priorityControllerOptions(&v1alpha1.MySQL{})).
 		Owns(&corev1.Secret{}).
 		Complete(r)
 }
@@ -102,3 +103,7 @@ func (a *mySQLAdapter) getServiceType() string {
 func (a *mySQLAdapter) getDiskSpace() string {
 	return a.Spec.DiskSpace
 }
+
+func (a *mySQLAdapter) getAdditionalDiskSpace() string {
+	return a.Spec.AdditionalDiskSpace
+}
@@ -0,0 +1,171 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/aiven/aiven-go-client"
+)
+
+// opensearchAPI calls the OpenSearch REST API of the service as its admin user,
+// for the snapshot features Aiven API doesn't manage
+type opensearchAPI struct {
+	baseURL  string
+	user     string
+	password string
+	http     *http.Client
+}
+
+func newOpenSearchAPI(avn *aiven.Client, project, service string) (*opensearchAPI, error) {
+	s, err := avn.Services.Get(project, service)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.Type != "opensearch" {
+		return nil, fmt.Errorf("service %q is not OpenSearch, got %q service type", service, s.Type)
+	}
+
+	return &opensearchAPI{
+		baseURL:  fmt.Sprintf("https://%s:%s", s.URIParams["host"], s.URIParams["port"]),
+		user:     s.URIParams["user"],
+		password: s.URIParams["password"],
+		http:     &http.Client{Timeout: time.Minute},
+	}, nil
+}
+
+// putSnapshotRepository registers or updates the repository
+func (c *opensearchAPI) putSnapshotRepository(name, repoType string, settings map[string]interface{}) error {
+	in := map[string]interface{}{"type": repoType, "settings": settings}
+	return c.do(http.MethodPut, "/_snapshot/"+url.PathEscape(name), in, nil)
+}
+
+// getSnapshotRepository fails with the not found error if the repository is not registered
+func (c *opensearchAPI) getSnapshotRepository(name string) error {
+	return c.do(http.MethodGet, "/_snapshot/"+url.PathEscape(name), nil, nil)
+}
+
+// deleteSnapshotRepository unregisters the repository, the snapshots in the storage are kept
+func (c *opensearchAPI) deleteSnapshotRepository(name string) error {
+	err := c.do(http.MethodDelete, "/_snapshot/"+url.PathEscape(name), nil, nil)
+	if isOpenSearchAPINotFound(err) {
+		return nil
+	}
+	return err
+}
+
+type opensearchSnapshot struct {
+	State   string   `json:"state"`
+	Indices []string `json:"indices"`
+}
+
+// getSnapshot returns the snapshot in the repository
+func (c *opensearchAPI) getSnapshot(repository, snapshot string) (*opensearchSnapshot, error) {
+	var out struct {
+		Snapshots []opensearchSnapshot `json:"snapshots"`
+	}
+	err := c.do(http.MethodGet, fmt.Sprintf("/_snapshot/%s/%s", url.PathEscape(repository), url.PathEscape(snapshot)), nil, &out)
+	if err != nil {
+		return nil, err
+	}
+	if len(out.Snapshots) == 0 {
+		return nil, &opensearchAPIError{Status: http.StatusNotFound, Message: fmt.Sprintf("snapshot %q not found", snapshot)}
+	}
+	return &out.Snapshots[0], nil
+}
+
+type opensearchRestoreRequest struct {
+	Indices           string `json:"indices,omitempty"`
+	RenamePattern     string `json:"rename_pattern,omitempty"`
+	RenameReplacement string `json:"rename_replacement,omitempty"`
+	// The cluster state is managed by Aiven
+	IncludeGlobalState bool `json:"include_global_state"`
+}
+
+// restoreSnapshot starts the restore without waiting for the completion
+func (c *opensearchAPI) restoreSnapshot(repository, snapshot string, req opensearchRestoreRequest) error {
+	path := fmt.Sprintf("/_snapshot/%s/%s/_restore", url.PathEscape(repository), url.PathEscape(snapshot))
+	return c.do(http.MethodPost, path, req, nil)
+}
+
+// opensearchRecovery is the recovery progress of the shards of an index
+type opensearchRecovery struct {
+	Shards []struct {
+		Type  string `json:"type"`
+		Stage string `json:"stage"`
+	} `json:"shards"`
+}
+
+// getRecovery returns the recovery progress of the indices
+func (c *opensearchAPI) getRecovery(indices []string) (map[string]opensearchRecovery, error) {
+	escaped := make([]string, len(indices))
+	for i, index := range indices {
+		escaped[i] = url.PathEscape(index)
+	}
+
+	out := make(map[string]opensearchRecovery)
+	err := c.do(http.MethodGet, "/"+strings.Join(escaped, ",")+"/_recovery", nil, &out)
+	return out, err
+}
+
+// opensearchAPIError is a non-2xx response of the API
+type opensearchAPIError struct {
+	Status  int
+	Message string
+}
+
+func (e *opensearchAPIError) Error() string {
+	return fmt.Sprintf("opensearch api error %d: %s", e.Status, e.Message)
+}
+
+func isOpenSearchAPINotFound(err error) bool {
+	e, ok := err.(*opensearchAPIError)
+	return ok && e.Status == http.StatusNotFound
+}
+
+func (c *opensearchAPI) do(method, path string, in, out interface{}) error {
+	var body io.Reader
+	if in != nil {
+		b, err := json.Marshal(in)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequest(method, c.baseURL+path, body)
+	if err != nil {
+		return err
+	}
+	req.SetBasicAuth(c.user, c.password)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", operatorUserAgent)
+
+	rsp, err := c.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer rsp.Body.Close()
+
+	b, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return err
+	}
+
+	if rsp.StatusCode < 200 || rsp.StatusCode > 299 {
+		return &opensearchAPIError{Status: rsp.StatusCode, Message: string(b)}
+	}
+
+	if out == nil {
+		return nil
+	}
+	return json.Unmarshal(b, out)
+}
@@ -5,6 +5,7 @@ package controllers
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"github.com/aiven/aiven-go-client"
 	corev1 "k8s.io/api/core/v1"
@@ -26,13 +27,14 @@ type OpenSearchHandler struct{}
 //+kubebuilder:rbac:groups=aiven.io,resources=opensearches/status,verbs=get;update;patch
 
 func (r *OpenSearchReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
-	return r.reconcileInstance(ctx, req, newGenericServiceHandler(newOpenSearchAdapter), &v1alpha1.OpenSearch{})
+	return r.reconcileInstance(ctx, req, newGenericServiceHandler(newOpenSearchAdapter, r.Recorder), &v1alpha1.OpenSearch{})
 }
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *OpenSearchReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&v1alpha1.OpenSearch{}).
+		WithOptions(priorityControllerOptions(&v1alpha1.OpenSearch{})).
 		Owns(&corev1.Secret{}).
 		Complete(r)
 }
@@ -72,11 +74,17 @@ func (a *opensearchAdapter) newSecret(s *aiven.Service) (*corev1.Secret, error)
 		name = a.Name
 	}
 
+	// OpenSearch Dashboards uses the same credentials as the API
+	dashboardsHost, dashboardsPort := openSearchDashboardsAddress(s)
 	stringData := map[string]string{
-		"HOST":     s.URIParams["host"],
-		"PASSWORD": s.URIParams["password"],
-		"PORT":     s.URIParams["port"],
-		"USER":     s.URIParams["user"],
+		"HOST":            s.URIParams["host"],
+		"PASSWORD":        s.URIParams["password"],
+		"PORT":            s.URIParams["port"],
+		"USER":            s.URIParams["user"],
+		"URI":             s.URI,
+		"DASHBOARDS_HOST": dashboardsHost,
+		"DASHBOARDS_PORT": dashboardsPort,
+		"DASHBOARDS_URI":  s.ConnectionInfo.OpensearchDashboardsURI,
 	}
 
 	// Removes empties
@@ -99,3 +107,22 @@ func (a *opensearchAdapter) getServiceType() string {
 func (a *opensearchAdapter) getDiskSpace() string {
 	return a.Spec.DiskSpace
 }
+
+func (a *opensearchAdapter) getAdditionalDiskSpace() string {
+	return a.Spec.AdditionalDiskSpace
+}
+
+// openSearchDashboardsAddress returns the OpenSearch Dashboards host and port, empty if the dashboards are disabled
+func openSearchDashboardsAddress(s *aiven.Service) (host, port string) {
+	for _, c := range s.Components {
+		if c.Component != "opensearch_dashboards" {
+			continue
+		}
+
+		// Prefers the public route over the private and privatelink ones
+		if host == "" || c.Route == "dynamic" || c.Route == "public" {
+			host, port = c.Host, strconv.Itoa(c.Port)
+		}
+	}
+	return host, port
+}
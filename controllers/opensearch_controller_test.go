@@ -80,6 +80,12 @@ var _ = Describe("OpenSearch Controller", func() {
 			Expect(createdSecret.Data["PORT"]).NotTo(BeEmpty())
 			Expect(createdSecret.Data["USER"]).NotTo(BeEmpty())
 			Expect(createdSecret.Data["PASSWORD"]).NotTo(BeEmpty())
+			Expect(createdSecret.Data["URI"]).NotTo(BeEmpty())
+
+			By("by checking the OpenSearch Dashboards connection, which is enabled by default")
+			Expect(createdSecret.Data["DASHBOARDS_HOST"]).NotTo(BeEmpty())
+			Expect(createdSecret.Data["DASHBOARDS_PORT"]).NotTo(BeEmpty())
+			Expect(createdSecret.Data["DASHBOARDS_URI"]).NotTo(BeEmpty())
 
 			Expect(createdOs.Status.State).Should(Equal("RUNNING"))
 
@@ -0,0 +1,29 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"testing"
+
+	"github.com/aiven/aiven-go-client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOpenSearchDashboardsAddress(t *testing.T) {
+	s := &aiven.Service{
+		Components: []*aiven.ServiceComponents{
+			{Component: "opensearch", Host: "os.aivencloud.com", Port: 13041, Route: "dynamic"},
+			{Component: "opensearch_dashboards", Host: "private-os.aivencloud.com", Port: 443, Route: "private"},
+			{Component: "opensearch_dashboards", Host: "os.aivencloud.com", Port: 443, Route: "dynamic"},
+		},
+	}
+
+	host, port := openSearchDashboardsAddress(s)
+	assert.Equal(t, "os.aivencloud.com", host)
+	assert.Equal(t, "443", port)
+
+	// The dashboards are disabled
+	host, port = openSearchDashboardsAddress(&aiven.Service{Components: s.Components[:1]})
+	assert.Empty(t, host)
+	assert.Empty(t, port)
+}
@@ -0,0 +1,265 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/aiven/aiven-go-client"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+// OpenSearchACLReconciler reconciles a OpenSearchACL object
+type OpenSearchACLReconciler struct {
+	Controller
+}
+
+type OpenSearchACLHandler struct {
+	k8s client.Client
+}
+
+// +kubebuilder:rbac:groups=aiven.io,resources=opensearchacls,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=aiven.io,resources=opensearchacls/status,verbs=get;update;patch
+
+func (r *OpenSearchACLReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	return r.reconcileInstance(ctx, req, OpenSearchACLHandler{k8s: r.Client}, &v1alpha1.OpenSearchACL{})
+}
+
+func (r *OpenSearchACLReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&v1alpha1.OpenSearchACL{}).
+		WithOptions(priorityControllerOptions(&v1alpha1.OpenSearchACL{})).
+		Complete(r)
+}
+
+func (h OpenSearchACLHandler) createOrUpdate(avn *aiven.Client, i client.Object, refs []client.Object) error {
+	acl, err := h.convert(i)
+	if err != nil {
+		return err
+	}
+
+	s, err := avn.Services.Get(acl.Spec.Project, acl.Spec.ServiceName)
+	if err != nil {
+		return err
+	}
+	if s.Type != "opensearch" {
+		return fmt.Errorf("OpenSearchACL can be used with OpenSearch services only, got %q service type", s.Type)
+	}
+
+	err = h.syncACLConfig(avn, acl)
+	if err != nil {
+		return err
+	}
+
+	meta.SetStatusCondition(&acl.Status.Conditions,
+		getInitializedCondition("Updated",
+			"Instance was created or update on Aiven side"))
+
+	meta.SetStatusCondition(&acl.Status.Conditions,
+		getRunningCondition(metav1.ConditionUnknown, "Updated",
+			"Instance was created or update on Aiven side, status remains unknown"))
+
+	metav1.SetMetaDataAnnotation(&acl.ObjectMeta,
+		processedGenerationAnnotation, strconv.FormatInt(acl.GetGeneration(), formatIntBaseDecimal))
+
+	return nil
+}
+
+// newOpenSearchACLConfig returns the ACL config of the spec, the ACLs are enabled.
+// The ACLs of the current config that belong to the users in kept are added as they are
+func newOpenSearchACLConfig(spec *v1alpha1.OpenSearchACLSpec, current []aiven.ElasticSearchACL, kept map[string]bool) aiven.ElasticSearchACLConfig {
+	config := aiven.ElasticSearchACLConfig{
+		ACLs:        make([]aiven.ElasticSearchACL, 0, len(spec.ACLs)),
+		Enabled:     true,
+		ExtendedAcl: spec.ExtendedACL,
+	}
+	for _, a := range spec.ACLs {
+		acl := aiven.ElasticSearchACL{Username: a.Username}
+		for _, r := range a.Rules {
+			acl.Rules = append(acl.Rules, aiven.ElasticsearchACLRule{Index: r.Index, Permission: r.Permission})
+		}
+		config.ACLs = append(config.ACLs, acl)
+	}
+	config.ACLs = append(config.ACLs, keptOpenSearchACLs(current, kept)...)
+	return config
+}
+
+// keptOpenSearchACLs returns the ACLs of the users in kept
+func keptOpenSearchACLs(acls []aiven.ElasticSearchACL, kept map[string]bool) []aiven.ElasticSearchACL {
+	result := make([]aiven.ElasticSearchACL, 0)
+	for _, acl := range acls {
+		if kept[acl.Username] {
+			result = append(result, acl)
+		}
+	}
+	return result
+}
+
+// serviceUserACLs returns the names of the ServiceUsers of the service that manage their own ACLs with openSearchAclRules.
+// Their ACLs are left as they are, and they can't be listed in the spec
+func (h OpenSearchACLHandler) serviceUserACLs(acl *v1alpha1.OpenSearchACL) (map[string]bool, error) {
+	list := &v1alpha1.ServiceUserList{}
+	err := h.k8s.List(context.Background(), list)
+	if err != nil {
+		return nil, fmt.Errorf("cannot list ServiceUsers: %w", err)
+	}
+
+	users := make(map[string]bool)
+	for _, u := range list.Items {
+		if u.Spec.Project != acl.Spec.Project || u.Spec.ServiceName != acl.Spec.ServiceName {
+			continue
+		}
+		if len(u.Spec.OpenSearchACLRules) > 0 || len(u.Status.OpenSearchACLRules) > 0 {
+			users[u.Name] = true
+		}
+	}
+
+	for _, a := range acl.Spec.ACLs {
+		if users[a.Username] {
+			return nil, fmt.Errorf("the ACLs of user %q are managed with the openSearchAclRules of its ServiceUser", a.Username)
+		}
+	}
+	return users, nil
+}
+
+// syncACLConfig updates the ACL config of the service to match the spec, if it differs.
+// The rules added outside the spec are removed, except the ones of the ServiceUsers
+func (h OpenSearchACLHandler) syncACLConfig(avn *aiven.Client, acl *v1alpha1.OpenSearchACL) error {
+	users, err := h.serviceUserACLs(acl)
+	if err != nil {
+		return err
+	}
+
+	// The client names the ACL endpoints after Elasticsearch, Aiven serves them for OpenSearch services too
+	r, err := avn.ElasticsearchACLs.Get(acl.Spec.Project, acl.Spec.ServiceName)
+	if err != nil {
+		return fmt.Errorf("cannot get OpenSearch ACLs: %w", err)
+	}
+
+	want := newOpenSearchACLConfig(&acl.Spec, r.ElasticSearchACLConfig.ACLs, users)
+	if equalOpenSearchACLConfigs(r.ElasticSearchACLConfig, want) {
+		return nil
+	}
+	return updateOpenSearchACLConfig(avn, acl.Spec.Project, acl.Spec.ServiceName, want)
+}
+
+func updateOpenSearchACLConfig(avn *aiven.Client, project, serviceName string, config aiven.ElasticSearchACLConfig) error {
+	_, err := avn.ElasticsearchACLs.Update(project, serviceName, aiven.ElasticsearchACLRequest{
+		ElasticSearchACLConfig: config,
+	})
+	if err != nil {
+		return fmt.Errorf("cannot update OpenSearch ACLs: %w", err)
+	}
+	return nil
+}
+
+// equalOpenSearchACLConfigs returns true if the configs have the same flags and the same rules per user.
+// The order of the users doesn't matter, the order of the rules of a user does
+func equalOpenSearchACLConfigs(a, b aiven.ElasticSearchACLConfig) bool {
+	if a.Enabled != b.Enabled || a.ExtendedAcl != b.ExtendedAcl || len(a.ACLs) != len(b.ACLs) {
+		return false
+	}
+
+	rules := make(map[string][]aiven.ElasticsearchACLRule, len(a.ACLs))
+	for _, acl := range a.ACLs {
+		rules[acl.Username] = acl.Rules
+	}
+	for _, acl := range b.ACLs {
+		want, ok := rules[acl.Username]
+		if !ok || len(want) != len(acl.Rules) {
+			return false
+		}
+		for i := range want {
+			if want[i] != acl.Rules[i] {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func (h OpenSearchACLHandler) delete(avn *aiven.Client, i client.Object) (bool, error) {
+	acl, err := h.convert(i)
+	if err != nil {
+		return false, err
+	}
+
+	users, err := h.serviceUserACLs(acl)
+	if err != nil {
+		return false, err
+	}
+
+	r, err := avn.ElasticsearchACLs.Get(acl.Spec.Project, acl.Spec.ServiceName)
+	if aiven.IsNotFound(err) {
+		return true, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("cannot get OpenSearch ACLs: %w", err)
+	}
+
+	// Keeps the ACLs of the ServiceUsers enabled, otherwise disables the ACLs,
+	// and the users get back the access they had before
+	config := aiven.ElasticSearchACLConfig{ACLs: keptOpenSearchACLs(r.ElasticSearchACLConfig.ACLs, users)}
+	if len(config.ACLs) > 0 {
+		config.Enabled = true
+		config.ExtendedAcl = r.ElasticSearchACLConfig.ExtendedAcl
+	}
+
+	_, err = avn.ElasticsearchACLs.Update(acl.Spec.Project, acl.Spec.ServiceName, aiven.ElasticsearchACLRequest{
+		ElasticSearchACLConfig: config,
+	})
+	if err != nil && !aiven.IsNotFound(err) {
+		return false, fmt.Errorf("cannot disable OpenSearch ACLs: %w", err)
+	}
+	return true, nil
+}
+
+func (h OpenSearchACLHandler) get(avn *aiven.Client, i client.Object) (*corev1.Secret, error) {
+	acl, err := h.convert(i)
+	if err != nil {
+		return nil, err
+	}
+
+	// The resource manages the config, the changes made in the Aiven Console are reverted
+	err = h.syncACLConfig(avn, acl)
+	if err != nil {
+		return nil, err
+	}
+
+	meta.SetStatusCondition(&acl.Status.Conditions,
+		getRunningCondition(metav1.ConditionTrue, "CheckRunning",
+			"Instance is running on Aiven side"))
+
+	metav1.SetMetaDataAnnotation(&acl.ObjectMeta, instanceIsRunningAnnotation, "true")
+
+	return nil, nil
+}
+
+func (h OpenSearchACLHandler) checkPreconditions(avn *aiven.Client, i client.Object) (bool, error) {
+	acl, err := h.convert(i)
+	if err != nil {
+		return false, err
+	}
+
+	meta.SetStatusCondition(&acl.Status.Conditions,
+		getInitializedCondition("Preconditions", "Checking preconditions"))
+
+	return checkServiceIsRunning(avn, acl.Spec.Project, acl.Spec.ServiceName)
+}
+
+func (h OpenSearchACLHandler) convert(i client.Object) (*v1alpha1.OpenSearchACL, error) {
+	acl, ok := i.(*v1alpha1.OpenSearchACL)
+	if !ok {
+		return nil, fmt.Errorf("cannot convert object to OpenSearchACL")
+	}
+
+	return acl, nil
+}
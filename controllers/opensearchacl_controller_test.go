@@ -0,0 +1,129 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"os"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+var _ = Describe("OpenSearchACL Controller", func() {
+	// Define utility constants for object names and testing timeouts/durations and intervals.
+	const (
+		namespace = "default"
+
+		timeout  = time.Minute * 20
+		interval = time.Second * 10
+	)
+
+	var (
+		service     *v1alpha1.OpenSearch
+		acl         *v1alpha1.OpenSearchACL
+		serviceName string
+		aclName     string
+		ctx         context.Context
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		serviceName = "k8s-test-os-acl-acc-" + generateRandomID()
+		aclName = "k8s-test-os-acl-" + generateRandomID()
+
+		By("Creating a new OpenSearch CR instance")
+		service = osSpec(serviceName, namespace)
+		Expect(k8sClient.Create(ctx, service)).Should(Succeed())
+
+		By("Creating a new OpenSearchACL CR instance")
+		acl = opensearchACLSpec(serviceName, aclName, namespace)
+		Expect(k8sClient.Create(ctx, acl)).Should(Succeed())
+
+		By("by waiting OpenSearchACL to become RUNNING")
+		Eventually(func() bool {
+			lookupKey := types.NamespacedName{Name: aclName, Namespace: namespace}
+			created := &v1alpha1.OpenSearchACL{}
+			err := k8sClient.Get(ctx, lookupKey, created)
+			if err == nil {
+				return meta.IsStatusConditionTrue(created.Status.Conditions, conditionTypeRunning)
+			}
+			return false
+		}, timeout, interval).Should(BeTrue())
+	})
+
+	Context("Validating OpenSearchACL reconciler behaviour", func() {
+		It("should manage the ACL config of the service", func() {
+			created := &v1alpha1.OpenSearchACL{}
+			lookupKey := types.NamespacedName{Name: aclName, Namespace: namespace}
+			Expect(k8sClient.Get(ctx, lookupKey, created)).Should(Succeed())
+
+			By("by checking the ACL config on Aiven side")
+			r, err := aivenClient.ElasticsearchACLs.Get(created.Spec.Project, serviceName)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(r.ElasticSearchACLConfig.Enabled).To(BeTrue())
+			Expect(r.ElasticSearchACLConfig.ExtendedAcl).To(BeTrue())
+			Expect(equalOpenSearchACLConfigs(r.ElasticSearchACLConfig, newOpenSearchACLConfig(&created.Spec, nil, nil))).To(BeTrue())
+
+			By("by updating the rules of the user")
+			created.Spec.ACLs[0].Rules[0].Permission = "read"
+			Expect(k8sClient.Update(ctx, created)).Should(Succeed())
+			Eventually(func() string {
+				r, err := aivenClient.ElasticsearchACLs.Get(created.Spec.Project, serviceName)
+				if err != nil || len(r.ElasticSearchACLConfig.ACLs) == 0 || len(r.ElasticSearchACLConfig.ACLs[0].Rules) == 0 {
+					return ""
+				}
+				return r.ElasticSearchACLConfig.ACLs[0].Rules[0].Permission
+			}, timeout, interval).Should(Equal("read"))
+
+			By("by checking the ACLs are disabled on deletion")
+			ensureDelete(ctx, acl)
+			r, err = aivenClient.ElasticsearchACLs.Get(created.Spec.Project, serviceName)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(r.ElasticSearchACLConfig.Enabled).To(BeFalse())
+			Expect(r.ElasticSearchACLConfig.ACLs).To(BeEmpty())
+			acl = nil
+		})
+	})
+
+	AfterEach(func() {
+		if acl != nil {
+			By("Ensures that OpenSearchACL instance was deleted")
+			ensureDelete(ctx, acl)
+		}
+
+		By("Ensures that OpenSearch instance was deleted")
+		ensureDelete(ctx, service)
+	})
+})
+
+func opensearchACLSpec(serviceName, name, namespace string) *v1alpha1.OpenSearchACL {
+	return &v1alpha1.OpenSearchACL{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "aiven.io/v1alpha1",
+			Kind:       "OpenSearchACL",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: v1alpha1.OpenSearchACLSpec{
+			Project:     os.Getenv("AIVEN_PROJECT_NAME"),
+			ServiceName: serviceName,
+			ExtendedACL: true,
+			ACLs: []v1alpha1.OpenSearchUserACL{
+				{Username: "k8s-test-writer", Rules: []v1alpha1.OpenSearchACLRule{{Index: "logs-*", Permission: "write"}}},
+			},
+			AuthSecretRef: v1alpha1.AuthSecretReference{
+				Name: secretRefName,
+				Key:  secretRefKey,
+			},
+		},
+	}
+}
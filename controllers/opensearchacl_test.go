@@ -0,0 +1,137 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/aiven/aiven-go-client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+// fakeOpenSearchACLAPI serves the ACL config of an OpenSearch service, PUT replaces it
+type fakeOpenSearchACLAPI struct {
+	config  aiven.ElasticSearchACLConfig
+	updated int
+}
+
+func (f *fakeOpenSearchACLAPI) RoundTrip(r *http.Request) (*http.Response, error) {
+	rsp := &http.Response{StatusCode: http.StatusOK, Header: make(http.Header), Request: r}
+	var out interface{}
+	switch r.Method + " " + r.URL.Path {
+	case "GET /v1/project/foo/service/bar":
+		out = map[string]interface{}{"service": aiven.Service{Name: "bar", Type: "opensearch", State: "RUNNING"}}
+	case "GET /v1/project/foo/service/bar/elasticsearch/acl":
+		out = &aiven.ElasticSearchACLResponse{ElasticSearchACLConfig: f.config}
+	case "PUT /v1/project/foo/service/bar/elasticsearch/acl":
+		var in aiven.ElasticsearchACLRequest
+		if err := json.NewDecoder(r.Body).Decode(&in); err != nil {
+			return nil, err
+		}
+		f.config = in.ElasticSearchACLConfig
+		f.updated++
+		out = &aiven.ElasticSearchACLResponse{ElasticSearchACLConfig: f.config}
+	default:
+		rsp.StatusCode = http.StatusNotFound
+		out = map[string]string{"message": "Not found"}
+	}
+	b, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	rsp.Body = io.NopCloser(bytes.NewReader(b))
+	return rsp, nil
+}
+
+func TestOpenSearchACL(t *testing.T) {
+	// The ServiceUser manages its own rules, they are kept
+	carol := aiven.ElasticSearchACL{Username: "carol", Rules: []aiven.ElasticsearchACLRule{{Index: "metrics-*", Permission: "read"}}}
+	api := &fakeOpenSearchACLAPI{config: aiven.ElasticSearchACLConfig{Enabled: true, ACLs: []aiven.ElasticSearchACL{carol}}}
+	avn := newFakeAivenClient("token", api)
+	acl := &v1alpha1.OpenSearchACL{Spec: v1alpha1.OpenSearchACLSpec{
+		Project:     "foo",
+		ServiceName: "bar",
+		ExtendedACL: true,
+		ACLs: []v1alpha1.OpenSearchUserACL{
+			{Username: "alice", Rules: []v1alpha1.OpenSearchACLRule{{Index: "logs-*", Permission: "read"}}},
+			{Username: "bob", Rules: []v1alpha1.OpenSearchACLRule{{Index: "*", Permission: "admin"}}},
+		},
+	}}
+
+	user := &v1alpha1.ServiceUser{ObjectMeta: metav1.ObjectMeta{Name: "carol", Namespace: "default"}}
+	user.Spec.Project = "foo"
+	user.Spec.ServiceName = "bar"
+	user.Status.OpenSearchACLRules = []v1alpha1.OpenSearchACLRule{{Index: "metrics-*", Permission: "read"}}
+	otherService := &v1alpha1.ServiceUser{ObjectMeta: metav1.ObjectMeta{Name: "alice", Namespace: "default"}}
+	otherService.Spec.Project = "foo"
+	otherService.Spec.ServiceName = "baz"
+	otherService.Spec.OpenSearchACLRules = []v1alpha1.OpenSearchACLRule{{Index: "*", Permission: "read"}}
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1alpha1.AddToScheme(scheme))
+	k8s := fake.NewClientBuilder().WithScheme(scheme).WithObjects(user, otherService).Build()
+
+	h := OpenSearchACLHandler{k8s: k8s}
+	require.NoError(t, h.createOrUpdate(avn, acl, nil))
+	assert.True(t, api.config.Enabled)
+	assert.True(t, api.config.ExtendedAcl)
+	assert.Len(t, api.config.ACLs, 3)
+	assert.Contains(t, api.config.ACLs, carol)
+
+	// Nothing to update while the config matches the spec, whatever the order of the users
+	api.config.ACLs[0], api.config.ACLs[1] = api.config.ACLs[1], api.config.ACLs[0]
+	_, err := h.get(avn, acl)
+	require.NoError(t, err)
+	assert.Equal(t, 1, api.updated)
+	assert.True(t, isAlreadyRunning(acl))
+
+	// The changes made outside the spec are reverted
+	api.config.ACLs = append(api.config.ACLs, aiven.ElasticSearchACL{Username: "eve", Rules: []aiven.ElasticsearchACLRule{{Index: "*", Permission: "admin"}}})
+	_, err = h.get(avn, acl)
+	require.NoError(t, err)
+	assert.Equal(t, 2, api.updated)
+	assert.Len(t, api.config.ACLs, 3)
+	assert.Contains(t, api.config.ACLs, carol)
+
+	// The users of the ServiceUsers can't be listed in the spec
+	invalid := acl.DeepCopy()
+	invalid.Spec.ACLs = append(invalid.Spec.ACLs, v1alpha1.OpenSearchUserACL{Username: "carol"})
+	assert.ErrorContains(t, h.createOrUpdate(avn, invalid, nil), `"carol"`)
+	assert.Equal(t, 2, api.updated)
+
+	// The deletion keeps the ACLs of the ServiceUser
+	deleted, err := h.delete(avn, acl)
+	require.NoError(t, err)
+	assert.True(t, deleted)
+	assert.True(t, api.config.Enabled)
+	assert.Equal(t, []aiven.ElasticSearchACL{carol}, api.config.ACLs)
+
+	// The deletion disables the ACLs when there are no ServiceUsers with rules
+	h = OpenSearchACLHandler{k8s: fake.NewClientBuilder().WithScheme(scheme).Build()}
+	deleted, err = h.delete(avn, acl)
+	require.NoError(t, err)
+	assert.True(t, deleted)
+	assert.False(t, api.config.Enabled)
+	assert.Empty(t, api.config.ACLs)
+}
+
+func TestEqualOpenSearchACLConfigs(t *testing.T) {
+	read := aiven.ElasticsearchACLRule{Index: "logs-*", Permission: "read"}
+	deny := aiven.ElasticsearchACLRule{Index: "logs-secret-*", Permission: "deny"}
+	a := aiven.ElasticSearchACLConfig{Enabled: true, ACLs: []aiven.ElasticSearchACL{{Username: "alice", Rules: []aiven.ElasticsearchACLRule{read, deny}}}}
+
+	assert.True(t, equalOpenSearchACLConfigs(a, a))
+	assert.False(t, equalOpenSearchACLConfigs(a, aiven.ElasticSearchACLConfig{Enabled: true, ExtendedAcl: true, ACLs: a.ACLs}))
+	assert.False(t, equalOpenSearchACLConfigs(a, aiven.ElasticSearchACLConfig{Enabled: true, ACLs: []aiven.ElasticSearchACL{{Username: "alice", Rules: []aiven.ElasticsearchACLRule{deny, read}}}}))
+	assert.False(t, equalOpenSearchACLConfigs(a, aiven.ElasticSearchACLConfig{Enabled: true, ACLs: []aiven.ElasticSearchACL{{Username: "bob", Rules: []aiven.ElasticsearchACLRule{read, deny}}}}))
+}
@@ -0,0 +1,203 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/aiven/aiven-go-client"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+// OpenSearchSnapshotRepositoryReconciler reconciles a OpenSearchSnapshotRepository object
+type OpenSearchSnapshotRepositoryReconciler struct {
+	Controller
+}
+
+type OpenSearchSnapshotRepositoryHandler struct {
+	k8s client.Client
+}
+
+// +kubebuilder:rbac:groups=aiven.io,resources=opensearchsnapshotrepositories,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=aiven.io,resources=opensearchsnapshotrepositories/status,verbs=get;update;patch
+
+func (r *OpenSearchSnapshotRepositoryReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	return r.reconcileInstance(ctx, req, OpenSearchSnapshotRepositoryHandler{k8s: r.Client}, &v1alpha1.OpenSearchSnapshotRepository{})
+}
+
+func (r *OpenSearchSnapshotRepositoryReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&v1alpha1.OpenSearchSnapshotRepository{}).
+		WithOptions(priorityControllerOptions(&v1alpha1.OpenSearchSnapshotRepository{})).
+		Complete(r)
+}
+
+func (h OpenSearchSnapshotRepositoryHandler) createOrUpdate(avn *aiven.Client, i client.Object, refs []client.Object) error {
+	repo, err := h.convert(i)
+	if err != nil {
+		return err
+	}
+
+	settings, err := h.getSettings(repo)
+	if err != nil {
+		return err
+	}
+
+	api, err := newOpenSearchAPI(avn, repo.Spec.Project, repo.Spec.ServiceName)
+	if err != nil {
+		return err
+	}
+
+	err = api.putSnapshotRepository(repo.Name, repo.Spec.Type, settings)
+	if err != nil {
+		return fmt.Errorf("cannot register snapshot repository: %w", err)
+	}
+
+	meta.SetStatusCondition(&repo.Status.Conditions,
+		getInitializedCondition("Registered",
+			"Instance was created or update on Aiven side"))
+
+	meta.SetStatusCondition(&repo.Status.Conditions,
+		getRunningCondition(metav1.ConditionUnknown, "Registered",
+			"Instance was created or update on Aiven side, status remains unknown"))
+
+	metav1.SetMetaDataAnnotation(&repo.ObjectMeta,
+		processedGenerationAnnotation, strconv.FormatInt(repo.GetGeneration(), formatIntBaseDecimal))
+
+	return nil
+}
+
+// getSettings returns the repository settings with the credentials read from the secrets.
+// The credentials are read on every update and never written to the resource
+func (h OpenSearchSnapshotRepositoryHandler) getSettings(repo *v1alpha1.OpenSearchSnapshotRepository) (map[string]interface{}, error) {
+	settings := map[string]interface{}{"readonly": repo.Spec.ReadOnly}
+	switch {
+	case repo.Spec.S3 != nil:
+		s3 := repo.Spec.S3
+		accessKey, err := h.getSecretValue(repo.Namespace, s3.AccessKeyRef)
+		if err != nil {
+			return nil, err
+		}
+		secretKey, err := h.getSecretValue(repo.Namespace, s3.SecretKeyRef)
+		if err != nil {
+			return nil, err
+		}
+		settings["bucket"] = s3.Bucket
+		settings["access_key"] = accessKey
+		settings["secret_key"] = secretKey
+		if s3.Region != "" {
+			settings["region"] = s3.Region
+		}
+		if s3.Endpoint != "" {
+			settings["endpoint"] = s3.Endpoint
+		}
+		if s3.BasePath != "" {
+			settings["base_path"] = s3.BasePath
+		}
+	case repo.Spec.GCS != nil:
+		gcs := repo.Spec.GCS
+		credentials, err := h.getSecretValue(repo.Namespace, gcs.CredentialsRef)
+		if err != nil {
+			return nil, err
+		}
+		settings["bucket"] = gcs.Bucket
+		settings["credentials"] = credentials
+		if gcs.BasePath != "" {
+			settings["base_path"] = gcs.BasePath
+		}
+	default:
+		return nil, fmt.Errorf("no settings for repository type %q", repo.Spec.Type)
+	}
+	return settings, nil
+}
+
+func (h OpenSearchSnapshotRepositoryHandler) getSecretValue(namespace string, ref v1alpha1.SecretKeyReference) (string, error) {
+	secret := &corev1.Secret{}
+	err := h.k8s.Get(context.Background(), types.NamespacedName{Namespace: namespace, Name: ref.Name}, secret)
+	if err != nil {
+		return "", fmt.Errorf("unable to get secret %q: %w", ref.Name, err)
+	}
+
+	v, ok := secret.Data[ref.Key]
+	if !ok {
+		return "", fmt.Errorf("no key %q in secret %q", ref.Key, ref.Name)
+	}
+	return string(v), nil
+}
+
+func (h OpenSearchSnapshotRepositoryHandler) delete(avn *aiven.Client, i client.Object) (bool, error) {
+	repo, err := h.convert(i)
+	if err != nil {
+		return false, err
+	}
+
+	api, err := newOpenSearchAPI(avn, repo.Spec.Project, repo.Spec.ServiceName)
+	if aiven.IsNotFound(err) {
+		return true, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	err = api.deleteSnapshotRepository(repo.Name)
+	if err != nil {
+		return false, fmt.Errorf("cannot unregister snapshot repository: %w", err)
+	}
+
+	return true, nil
+}
+
+func (h OpenSearchSnapshotRepositoryHandler) get(avn *aiven.Client, i client.Object) (*corev1.Secret, error) {
+	repo, err := h.convert(i)
+	if err != nil {
+		return nil, err
+	}
+
+	api, err := newOpenSearchAPI(avn, repo.Spec.Project, repo.Spec.ServiceName)
+	if err != nil {
+		return nil, err
+	}
+
+	err = api.getSnapshotRepository(repo.Name)
+	if err != nil {
+		return nil, fmt.Errorf("cannot get snapshot repository: %w", err)
+	}
+
+	meta.SetStatusCondition(&repo.Status.Conditions,
+		getRunningCondition(metav1.ConditionTrue, "CheckRunning",
+			"Instance is running on Aiven side"))
+
+	metav1.SetMetaDataAnnotation(&repo.ObjectMeta, instanceIsRunningAnnotation, "true")
+
+	return nil, nil
+}
+
+func (h OpenSearchSnapshotRepositoryHandler) checkPreconditions(avn *aiven.Client, i client.Object) (bool, error) {
+	repo, err := h.convert(i)
+	if err != nil {
+		return false, err
+	}
+
+	meta.SetStatusCondition(&repo.Status.Conditions,
+		getInitializedCondition("Preconditions", "Checking preconditions"))
+
+	return checkServiceIsRunning(avn, repo.Spec.Project, repo.Spec.ServiceName)
+}
+
+func (h OpenSearchSnapshotRepositoryHandler) convert(i client.Object) (*v1alpha1.OpenSearchSnapshotRepository, error) {
+	repo, ok := i.(*v1alpha1.OpenSearchSnapshotRepository)
+	if !ok {
+		return nil, fmt.Errorf("cannot convert object to OpenSearchSnapshotRepository")
+	}
+
+	return repo, nil
+}
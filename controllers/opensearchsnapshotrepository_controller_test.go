@@ -0,0 +1,151 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"os"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+var _ = Describe("OpenSearchSnapshotRepository Controller", func() {
+	// Define utility constants for object names and testing timeouts/durations and intervals.
+	const (
+		namespace = "default"
+
+		timeout  = time.Minute * 20
+		interval = time.Second * 10
+	)
+
+	var (
+		service     *v1alpha1.OpenSearch
+		repo        *v1alpha1.OpenSearchSnapshotRepository
+		secret      *corev1.Secret
+		serviceName string
+		repoName    string
+		ctx         context.Context
+	)
+
+	BeforeEach(func() {
+		service = nil
+		if os.Getenv("OPENSEARCH_SNAPSHOT_S3_BUCKET") == "" {
+			Skip("OPENSEARCH_SNAPSHOT_S3_BUCKET, OPENSEARCH_SNAPSHOT_S3_REGION, AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY are required")
+		}
+
+		ctx = context.Background()
+		serviceName = "k8s-test-os-repo-acc-" + generateRandomID()
+		repoName = "k8s-test-repo-" + generateRandomID()
+
+		By("Creating a new OpenSearch CR instance")
+		service = osSpec(serviceName, namespace)
+		Expect(k8sClient.Create(ctx, service)).Should(Succeed())
+
+		By("Creating the Secret with the S3 credentials")
+		secret = s3CredentialsSecret(repoName, namespace)
+		Expect(k8sClient.Create(ctx, secret)).Should(Succeed())
+
+		By("Creating a new OpenSearchSnapshotRepository CR instance")
+		repo = opensearchSnapshotRepositorySpec(serviceName, repoName, namespace)
+		Expect(k8sClient.Create(ctx, repo)).Should(Succeed())
+
+		By("by waiting OpenSearchSnapshotRepository to become RUNNING")
+		Eventually(func() bool {
+			lookupKey := types.NamespacedName{Name: repoName, Namespace: namespace}
+			created := &v1alpha1.OpenSearchSnapshotRepository{}
+			err := k8sClient.Get(ctx, lookupKey, created)
+			if err == nil {
+				return meta.IsStatusConditionTrue(created.Status.Conditions, conditionTypeRunning)
+			}
+			return false
+		}, timeout, interval).Should(BeTrue())
+	})
+
+	Context("Validating OpenSearchSnapshotRepository reconciler behaviour", func() {
+		It("should register the repository on the service", func() {
+			created := &v1alpha1.OpenSearchSnapshotRepository{}
+			lookupKey := types.NamespacedName{Name: repoName, Namespace: namespace}
+			Expect(k8sClient.Get(ctx, lookupKey, created)).Should(Succeed())
+
+			By("by checking the repository on the service")
+			api, err := newOpenSearchAPI(aivenClient, created.Spec.Project, serviceName)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(api.getSnapshotRepository(repoName)).To(Succeed())
+
+			By("by checking finalizers")
+			Expect(created.GetFinalizers()).ToNot(BeEmpty())
+
+			By("by checking the repository is unregistered on deletion")
+			ensureDelete(ctx, repo)
+			Expect(isOpenSearchAPINotFound(api.getSnapshotRepository(repoName))).To(BeTrue())
+			repo = nil
+		})
+	})
+
+	AfterEach(func() {
+		if service == nil {
+			return
+		}
+
+		if repo != nil {
+			By("Ensures that OpenSearchSnapshotRepository instance was deleted")
+			ensureDelete(ctx, repo)
+		}
+
+		By("Ensures that the Secret was deleted")
+		Expect(k8sClient.Delete(ctx, secret)).Should(Succeed())
+
+		By("Ensures that OpenSearch instance was deleted")
+		ensureDelete(ctx, service)
+	})
+})
+
+func s3CredentialsSecret(name, namespace string) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		StringData: map[string]string{
+			"access-key": os.Getenv("AWS_ACCESS_KEY_ID"),
+			"secret-key": os.Getenv("AWS_SECRET_ACCESS_KEY"),
+		},
+	}
+}
+
+func opensearchSnapshotRepositorySpec(serviceName, name, namespace string) *v1alpha1.OpenSearchSnapshotRepository {
+	return &v1alpha1.OpenSearchSnapshotRepository{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "aiven.io/v1alpha1",
+			Kind:       "OpenSearchSnapshotRepository",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: v1alpha1.OpenSearchSnapshotRepositorySpec{
+			Project:     os.Getenv("AIVEN_PROJECT_NAME"),
+			ServiceName: serviceName,
+			Type:        "s3",
+			S3: &v1alpha1.OpenSearchS3RepositorySettings{
+				Bucket:       os.Getenv("OPENSEARCH_SNAPSHOT_S3_BUCKET"),
+				Region:       os.Getenv("OPENSEARCH_SNAPSHOT_S3_REGION"),
+				BasePath:     name,
+				AccessKeyRef: v1alpha1.SecretKeyReference{Name: name, Key: "access-key"},
+				SecretKeyRef: v1alpha1.SecretKeyReference{Name: name, Key: "secret-key"},
+			},
+			AuthSecretRef: v1alpha1.AuthSecretReference{
+				Name: secretRefName,
+				Key:  secretRefKey,
+			},
+		},
+	}
+}
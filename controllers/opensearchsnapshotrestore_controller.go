@@ -0,0 +1,297 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/aiven/aiven-go-client"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+const (
+	restoreStepCheckSnapshot  = "CheckSnapshot"
+	restoreStepStartRestore   = "StartRestore"
+	restoreStepRecoverIndices = "RecoverIndices"
+
+	restoreStateInProgress = "InProgress"
+	restoreStateDone       = "Done"
+	restoreStateFailed     = "Failed"
+)
+
+// OpenSearchSnapshotRestoreReconciler reconciles a OpenSearchSnapshotRestore object
+type OpenSearchSnapshotRestoreReconciler struct {
+	Controller
+}
+
+type OpenSearchSnapshotRestoreHandler struct{}
+
+// +kubebuilder:rbac:groups=aiven.io,resources=opensearchsnapshotrestores,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=aiven.io,resources=opensearchsnapshotrestores/status,verbs=get;update;patch
+
+func (r *OpenSearchSnapshotRestoreReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	return r.reconcileInstance(ctx, req, OpenSearchSnapshotRestoreHandler{}, &v1alpha1.OpenSearchSnapshotRestore{})
+}
+
+func (r *OpenSearchSnapshotRestoreReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&v1alpha1.OpenSearchSnapshotRestore{}).
+		WithOptions(priorityControllerOptions(&v1alpha1.OpenSearchSnapshotRestore{})).
+		Complete(r)
+}
+
+// createOrUpdate starts the restore, the spec is immutable, so it runs once
+func (h OpenSearchSnapshotRestoreHandler) createOrUpdate(avn *aiven.Client, i client.Object, refs []client.Object) error {
+	restore, err := h.convert(i)
+	if err != nil {
+		return err
+	}
+
+	api, err := newOpenSearchAPI(avn, restore.Spec.Project, restore.Spec.ServiceName)
+	if err != nil {
+		return err
+	}
+
+	status := &restore.Status
+	setRestoreStep(status, restoreStepCheckSnapshot, restoreStateInProgress, "")
+	snapshot, err := api.getSnapshot(restore.Spec.RepositoryName, restore.Spec.SnapshotName)
+	if err != nil {
+		return fmt.Errorf("cannot get snapshot: %w", err)
+	}
+
+	// A partial snapshot restores the indices that were fully copied
+	if snapshot.State != "SUCCESS" && snapshot.State != "PARTIAL" {
+		setRestoreStep(status, restoreStepCheckSnapshot, restoreStateFailed, fmt.Sprintf("snapshot state is %s", snapshot.State))
+		return markRestoreProcessed(restore)
+	}
+
+	indices, err := restoredIndices(snapshot.Indices, restore.Spec.Indices, restore.Spec.RenamePattern, restore.Spec.RenameReplacement)
+	if err != nil {
+		setRestoreStep(status, restoreStepCheckSnapshot, restoreStateFailed, err.Error())
+		return markRestoreProcessed(restore)
+	}
+	if len(indices) == 0 {
+		setRestoreStep(status, restoreStepCheckSnapshot, restoreStateFailed, "no indices of the snapshot match")
+		return markRestoreProcessed(restore)
+	}
+	setRestoreStep(status, restoreStepCheckSnapshot, restoreStateDone, fmt.Sprintf("%d indices to restore", len(indices)))
+
+	err = api.restoreSnapshot(restore.Spec.RepositoryName, restore.Spec.SnapshotName, opensearchRestoreRequest{
+		Indices:           strings.Join(restore.Spec.Indices, ","),
+		RenamePattern:     restore.Spec.RenamePattern,
+		RenameReplacement: restore.Spec.RenameReplacement,
+	})
+	if err != nil {
+		return fmt.Errorf("cannot start restore: %w", err)
+	}
+
+	status.Indices = indices
+	setRestoreStep(status, restoreStepStartRestore, restoreStateDone, "")
+	setRestoreStep(status, restoreStepRecoverIndices, restoreStateInProgress, "")
+	return markRestoreProcessed(restore)
+}
+
+func markRestoreProcessed(restore *v1alpha1.OpenSearchSnapshotRestore) error {
+	meta.SetStatusCondition(&restore.Status.Conditions,
+		getInitializedCondition("Started",
+			"Instance was created or update on Aiven side"))
+
+	meta.SetStatusCondition(&restore.Status.Conditions,
+		getRunningCondition(metav1.ConditionUnknown, "Started",
+			"Instance was created or update on Aiven side, status remains unknown"))
+
+	metav1.SetMetaDataAnnotation(&restore.ObjectMeta,
+		processedGenerationAnnotation, strconv.FormatInt(restore.GetGeneration(), formatIntBaseDecimal))
+
+	return nil
+}
+
+// delete keeps the restored indices, they belong to the service now
+func (h OpenSearchSnapshotRestoreHandler) delete(_ *aiven.Client, _ client.Object) (bool, error) {
+	return true, nil
+}
+
+// get tracks the recovery of the restored indices, the restore is running once all shards are recovered
+func (h OpenSearchSnapshotRestoreHandler) get(avn *aiven.Client, i client.Object) (*corev1.Secret, error) {
+	restore, err := h.convert(i)
+	if err != nil {
+		return nil, err
+	}
+
+	status := &restore.Status
+	if len(status.Steps) == 0 {
+		return nil, nil
+	}
+
+	last := status.Steps[len(status.Steps)-1]
+	if last.State == restoreStateFailed {
+		meta.SetStatusCondition(&status.Conditions,
+			getRunningCondition(metav1.ConditionFalse, last.Name, last.Message))
+		return nil, nil
+	}
+
+	if last.Name != restoreStepRecoverIndices || last.State != restoreStateDone {
+		api, err := newOpenSearchAPI(avn, restore.Spec.Project, restore.Spec.ServiceName)
+		if err != nil {
+			return nil, err
+		}
+
+		recovery, err := api.getRecovery(status.Indices)
+		if err != nil {
+			return nil, fmt.Errorf("cannot get recovery progress: %w", err)
+		}
+
+		done, total := recoveryProgress(recovery, status.Indices)
+		if total == 0 {
+			setRestoreStep(status, restoreStepRecoverIndices, restoreStateInProgress, "waiting for the recovery to start")
+			return nil, nil
+		}
+
+		message := fmt.Sprintf("%d of %d shards are recovered", done, total)
+		if done < total {
+			setRestoreStep(status, restoreStepRecoverIndices, restoreStateInProgress, message)
+			return nil, nil
+		}
+		setRestoreStep(status, restoreStepRecoverIndices, restoreStateDone, message)
+	}
+
+	meta.SetStatusCondition(&status.Conditions,
+		getRunningCondition(metav1.ConditionTrue, "CheckRunning",
+			"Instance is running on Aiven side"))
+
+	metav1.SetMetaDataAnnotation(&restore.ObjectMeta, instanceIsRunningAnnotation, "true")
+
+	return nil, nil
+}
+
+func (h OpenSearchSnapshotRestoreHandler) checkPreconditions(avn *aiven.Client, i client.Object) (bool, error) {
+	restore, err := h.convert(i)
+	if err != nil {
+		return false, err
+	}
+
+	meta.SetStatusCondition(&restore.Status.Conditions,
+		getInitializedCondition("Preconditions", "Checking preconditions"))
+
+	running, err := checkServiceIsRunning(avn, restore.Spec.Project, restore.Spec.ServiceName)
+	if !running || err != nil {
+		return running, err
+	}
+
+	// Waits for the repository, which could be registered by an OpenSearchSnapshotRepository just now
+	api, err := newOpenSearchAPI(avn, restore.Spec.Project, restore.Spec.ServiceName)
+	if err != nil {
+		return false, err
+	}
+
+	err = api.getSnapshotRepository(restore.Spec.RepositoryName)
+	if isOpenSearchAPINotFound(err) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+func (h OpenSearchSnapshotRestoreHandler) convert(i client.Object) (*v1alpha1.OpenSearchSnapshotRestore, error) {
+	restore, ok := i.(*v1alpha1.OpenSearchSnapshotRestore)
+	if !ok {
+		return nil, fmt.Errorf("cannot convert object to OpenSearchSnapshotRestore")
+	}
+
+	return restore, nil
+}
+
+// setRestoreStep sets the state of the step, adds the step if it is new
+func setRestoreStep(status *v1alpha1.OpenSearchSnapshotRestoreStatus, name, state, message string) {
+	for i := range status.Steps {
+		step := &status.Steps[i]
+		if step.Name != name {
+			continue
+		}
+		if step.State != state {
+			step.LastTransitionTime = metav1.Now()
+		}
+		step.State = state
+		step.Message = message
+		return
+	}
+
+	status.Steps = append(status.Steps, v1alpha1.OpenSearchSnapshotRestoreStep{
+		Name:               name,
+		State:              state,
+		Message:            message,
+		LastTransitionTime: metav1.Now(),
+	})
+}
+
+// restoredIndices returns the names the snapshot indices matching the patterns are restored to.
+// Like OpenSearch, skips the system indices unless a pattern names them, and applies the rename to every index
+func restoredIndices(snapshotIndices, patterns []string, renamePattern, renameReplacement string) ([]string, error) {
+	var rename *regexp.Regexp
+	if renamePattern != "" {
+		var err error
+		rename, err = regexp.Compile(renamePattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid renamePattern: %w", err)
+		}
+	}
+
+	// OpenSearch uses $1 in replacements, Go needs ${1} if the group is followed by a name character
+	replacement := regexp.MustCompile(`\$(\d+)`).ReplaceAllString(renameReplacement, "$${$1}")
+
+	result := make([]string, 0, len(snapshotIndices))
+	for _, index := range snapshotIndices {
+		if !matchIndex(index, patterns) {
+			continue
+		}
+		if rename != nil {
+			index = rename.ReplaceAllString(index, replacement)
+		}
+		result = append(result, index)
+	}
+	return result, nil
+}
+
+func matchIndex(index string, patterns []string) bool {
+	if len(patterns) == 0 {
+		return !strings.HasPrefix(index, ".")
+	}
+	for _, p := range patterns {
+		if ok, _ := path.Match(p, index); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// recoveryProgress returns the recovered and total shards the indices restore from the snapshot.
+// An index missing in the response is not being recovered yet, so the total is unknown until all are there
+func recoveryProgress(recovery map[string]opensearchRecovery, indices []string) (int, int) {
+	done, total := 0, 0
+	for _, index := range indices {
+		r, ok := recovery[index]
+		if !ok {
+			return done, 0
+		}
+		for _, s := range r.Shards {
+			if s.Type != "SNAPSHOT" {
+				continue
+			}
+			total++
+			if s.Stage == "DONE" {
+				done++
+			}
+		}
+	}
+	return done, total
+}
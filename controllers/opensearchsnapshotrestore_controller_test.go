@@ -0,0 +1,153 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+var _ = Describe("OpenSearchSnapshotRestore Controller", func() {
+	// Define utility constants for object names and testing timeouts/durations and intervals.
+	const (
+		namespace = "default"
+
+		timeout  = time.Minute * 20
+		interval = time.Second * 10
+	)
+
+	var (
+		service     *v1alpha1.OpenSearch
+		repo        *v1alpha1.OpenSearchSnapshotRepository
+		restore     *v1alpha1.OpenSearchSnapshotRestore
+		secret      *corev1.Secret
+		serviceName string
+		repoName    string
+		restoreName string
+		ctx         context.Context
+	)
+
+	BeforeEach(func() {
+		service = nil
+		if os.Getenv("OPENSEARCH_SNAPSHOT_S3_BUCKET") == "" {
+			Skip("OPENSEARCH_SNAPSHOT_S3_BUCKET, OPENSEARCH_SNAPSHOT_S3_REGION, AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY are required")
+		}
+
+		ctx = context.Background()
+		serviceName = "k8s-test-os-restore-acc-" + generateRandomID()
+		repoName = "k8s-test-repo-" + generateRandomID()
+		restoreName = "k8s-test-restore-" + generateRandomID()
+
+		By("Creating a new OpenSearch CR instance")
+		service = osSpec(serviceName, namespace)
+		Expect(k8sClient.Create(ctx, service)).Should(Succeed())
+
+		By("Creating a new OpenSearchSnapshotRepository CR instance")
+		secret = s3CredentialsSecret(repoName, namespace)
+		Expect(k8sClient.Create(ctx, secret)).Should(Succeed())
+		repo = opensearchSnapshotRepositorySpec(serviceName, repoName, namespace)
+		Expect(k8sClient.Create(ctx, repo)).Should(Succeed())
+
+		By("by waiting OpenSearchSnapshotRepository to become RUNNING")
+		Eventually(func() bool {
+			created := &v1alpha1.OpenSearchSnapshotRepository{}
+			err := k8sClient.Get(ctx, types.NamespacedName{Name: repoName, Namespace: namespace}, created)
+			if err == nil {
+				return meta.IsStatusConditionTrue(created.Status.Conditions, conditionTypeRunning)
+			}
+			return false
+		}, timeout, interval).Should(BeTrue())
+
+		By("Creating a snapshot of an index")
+		api, err := newOpenSearchAPI(aivenClient, os.Getenv("AIVEN_PROJECT_NAME"), serviceName)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(api.do(http.MethodPut, "/orders/_doc/1?refresh=true", map[string]string{"item": "crab"}, nil)).To(Succeed())
+		Expect(api.do(http.MethodPut, "/_snapshot/"+repoName+"/orders?wait_for_completion=true", map[string]string{"indices": "orders"}, nil)).To(Succeed())
+
+		By("Creating a new OpenSearchSnapshotRestore CR instance")
+		restore = opensearchSnapshotRestoreSpec(serviceName, repoName, restoreName, namespace)
+		Expect(k8sClient.Create(ctx, restore)).Should(Succeed())
+
+		By("by waiting OpenSearchSnapshotRestore to become RUNNING")
+		Eventually(func() bool {
+			created := &v1alpha1.OpenSearchSnapshotRestore{}
+			err := k8sClient.Get(ctx, types.NamespacedName{Name: restoreName, Namespace: namespace}, created)
+			if err == nil {
+				return meta.IsStatusConditionTrue(created.Status.Conditions, conditionTypeRunning)
+			}
+			return false
+		}, timeout, interval).Should(BeTrue())
+	})
+
+	Context("Validating OpenSearchSnapshotRestore reconciler behaviour", func() {
+		It("should restore the snapshot step by step", func() {
+			created := &v1alpha1.OpenSearchSnapshotRestore{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: restoreName, Namespace: namespace}, created)).Should(Succeed())
+
+			By("by checking the steps in the status")
+			Expect(created.Status.Indices).Should(Equal([]string{"restored-orders"}))
+			Expect(created.Status.Steps).Should(HaveLen(3))
+			for _, step := range created.Status.Steps {
+				Expect(step.State).Should(Equal(restoreStateDone))
+			}
+
+			By("by checking the restored index on the service")
+			api, err := newOpenSearchAPI(aivenClient, created.Spec.Project, serviceName)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(api.do(http.MethodGet, "/restored-orders/_doc/1", nil, nil)).To(Succeed())
+		})
+	})
+
+	AfterEach(func() {
+		if service == nil {
+			return
+		}
+
+		By("Ensures that OpenSearchSnapshotRestore instance was deleted")
+		ensureDelete(ctx, restore)
+
+		By("Ensures that OpenSearchSnapshotRepository instance was deleted")
+		ensureDelete(ctx, repo)
+		Expect(k8sClient.Delete(ctx, secret)).Should(Succeed())
+
+		By("Ensures that OpenSearch instance was deleted")
+		ensureDelete(ctx, service)
+	})
+})
+
+func opensearchSnapshotRestoreSpec(serviceName, repoName, name, namespace string) *v1alpha1.OpenSearchSnapshotRestore {
+	return &v1alpha1.OpenSearchSnapshotRestore{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "aiven.io/v1alpha1",
+			Kind:       "OpenSearchSnapshotRestore",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: v1alpha1.OpenSearchSnapshotRestoreSpec{
+			Project:           os.Getenv("AIVEN_PROJECT_NAME"),
+			ServiceName:       serviceName,
+			RepositoryName:    repoName,
+			SnapshotName:      "orders",
+			Indices:           []string{"orders"},
+			RenamePattern:     "(.+)",
+			RenameReplacement: "restored-$1",
+			AuthSecretRef: v1alpha1.AuthSecretReference{
+				Name: secretRefName,
+				Key:  secretRefKey,
+			},
+		},
+	}
+}
@@ -0,0 +1,56 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRestoredIndices(t *testing.T) {
+	snapshot := []string{".kibana_1", "logs-2023.01", "logs-2023.02", "orders"}
+
+	indices, err := restoredIndices(snapshot, nil, "", "")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"logs-2023.01", "logs-2023.02", "orders"}, indices)
+
+	indices, err = restoredIndices(snapshot, []string{"logs-*", ".kibana_1"}, "(.+)", "restored-$1")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"restored-.kibana_1", "restored-logs-2023.01", "restored-logs-2023.02"}, indices)
+
+	// The group is followed by a name character
+	indices, err = restoredIndices(snapshot, []string{"orders"}, "(.+)", "$1_old")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"orders_old"}, indices)
+
+	_, err = restoredIndices(snapshot, nil, "(", "x")
+	assert.ErrorContains(t, err, "invalid renamePattern")
+}
+
+func TestRecoveryProgress(t *testing.T) {
+	shards := func(stages ...string) opensearchRecovery {
+		r := opensearchRecovery{}
+		for _, s := range stages {
+			r.Shards = append(r.Shards, struct {
+				Type  string `json:"type"`
+				Stage string `json:"stage"`
+			}{Type: "SNAPSHOT", Stage: s})
+		}
+		return r
+	}
+
+	recovery := map[string]opensearchRecovery{
+		"logs":   shards("DONE", "INDEX"),
+		"orders": shards("DONE"),
+	}
+
+	done, total := recoveryProgress(recovery, []string{"logs", "orders"})
+	assert.Equal(t, 2, done)
+	assert.Equal(t, 3, total)
+
+	// The recovery of an index hasn't started yet
+	_, total = recoveryProgress(recovery, []string{"logs", "orders", "events"})
+	assert.Equal(t, 0, total)
+}
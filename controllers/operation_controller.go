@@ -0,0 +1,193 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+const (
+	operationActionResyncProject     = "ResyncProject"
+	operationActionPauseProject      = "PauseProject"
+	operationActionResumeProject     = "ResumeProject"
+	operationActionRotateCredentials = "RotateCredentials"
+
+	operationStateSucceeded = "Succeeded"
+	operationStateFailed    = "Failed"
+
+	eventOperationApplied = "OperationApplied"
+)
+
+// OperationReconciler runs the action of an Operation once, by annotating the resources it applies to.
+// The controllers of the resources do the rest, so an incident doesn't need kubectl annotate loops
+type OperationReconciler struct {
+	client.Client
+
+	Log      logr.Logger
+	Recorder record.EventRecorder
+}
+
+// +kubebuilder:rbac:groups=aiven.io,resources=operations,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=aiven.io,resources=operations/status,verbs=get;update;patch
+
+func (r *OperationReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	op := &v1alpha1.Operation{}
+	if err := r.Get(ctx, req.NamespacedName, op); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	// The action runs once
+	if op.Status.CompletionTime != nil {
+		return ctrl.Result{}, nil
+	}
+
+	resources, err := r.apply(ctx, op)
+	if err != nil {
+		// Lists the resources annotated so far, the retry annotates them with the same values
+		op.Status.State = operationStateFailed
+		op.Status.Resources = resources
+		meta.SetStatusCondition(&op.Status.Conditions,
+			getRunningCondition(metav1.ConditionFalse, "Apply", err.Error()))
+		if statusErr := r.Status().Update(ctx, op); statusErr != nil {
+			r.Log.Error(statusErr, "unable to update operation status")
+		}
+		return ctrl.Result{}, err
+	}
+
+	now := metav1.Now()
+	op.Status.State = operationStateSucceeded
+	op.Status.Resources = resources
+	op.Status.CompletionTime = &now
+	meta.SetStatusCondition(&op.Status.Conditions,
+		getRunningCondition(metav1.ConditionTrue, "Applied", fmt.Sprintf("%s is applied to %d resources", op.Spec.Action, len(resources))))
+	r.Recorder.Eventf(op, corev1.EventTypeNormal, eventOperationApplied, "%s is applied to %d resources", op.Spec.Action, len(resources))
+	r.Log.Info("operation applied", "name", op.Name, "action", op.Spec.Action, "resources", len(resources))
+	return ctrl.Result{}, r.Status().Update(ctx, op)
+}
+
+// apply annotates the resources of the operation, returns the ones it has annotated, sorted
+func (r *OperationReconciler) apply(ctx context.Context, op *v1alpha1.Operation) ([]string, error) {
+	// The creation time is the same on every retry, so the resources handled already are not handled again
+	requestedAt := op.CreationTimestamp.UTC().Format(time.RFC3339)
+
+	var annotate func(a map[string]string)
+	switch op.Spec.Action {
+	case operationActionResyncProject:
+		annotate = func(a map[string]string) {
+			a[reconcileNowAnnotation] = requestedAt
+		}
+	case operationActionPauseProject:
+		annotate = func(a map[string]string) {
+			a[pausedAnnotation] = "true"
+		}
+	case operationActionResumeProject:
+		annotate = func(a map[string]string) {
+			delete(a, pausedAnnotation)
+		}
+	case operationActionRotateCredentials:
+		annotate = func(a map[string]string) {
+			a[rotateCredentialsAnnotation] = requestedAt
+			a[reconcileNowAnnotation] = requestedAt
+		}
+	default:
+		return nil, fmt.Errorf("unknown action %q", op.Spec.Action)
+	}
+
+	objects, err := r.listResources(ctx, op)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]string, 0, len(objects))
+	for _, o := range objects {
+		orig := o.DeepCopyObject().(client.Object)
+		a := o.GetAnnotations()
+		if a == nil {
+			a = make(map[string]string)
+		}
+		annotate(a)
+		o.SetAnnotations(a)
+		if !reflect.DeepEqual(orig.GetAnnotations(), o.GetAnnotations()) {
+			if err := r.Patch(ctx, o, client.MergeFrom(orig)); client.IgnoreNotFound(err) != nil {
+				sort.Strings(resources)
+				return resources, fmt.Errorf("unable to annotate %s %s/%s: %w", operationResourceKind(o), o.GetNamespace(), o.GetName(), err)
+			}
+		}
+		resources = append(resources, fmt.Sprintf("%s %s/%s", operationResourceKind(o), o.GetNamespace(), o.GetName()))
+	}
+	sort.Strings(resources)
+	return resources, nil
+}
+
+// listResources returns the ServiceUsers of the namespace for RotateCredentials,
+// the project and its resources in all the namespaces for the other actions
+func (r *OperationReconciler) listResources(ctx context.Context, op *v1alpha1.Operation) ([]client.Object, error) {
+	if op.Spec.Action == operationActionRotateCredentials {
+		list := &v1alpha1.ServiceUserList{}
+		if err := r.List(ctx, list, client.InNamespace(op.Spec.Namespace)); err != nil {
+			return nil, err
+		}
+		objects := make([]client.Object, 0, len(list.Items))
+		for i := range list.Items {
+			objects = append(objects, &list.Items[i])
+		}
+		return objects, nil
+	}
+
+	objects := make([]client.Object, 0)
+	for _, t := range r.Scheme().KnownTypes(v1alpha1.GroupVersion) {
+		list, ok := reflect.New(t).Interface().(client.ObjectList)
+		if !ok {
+			continue
+		}
+
+		if err := r.List(ctx, list); err != nil {
+			return nil, err
+		}
+
+		items, err := meta.ExtractList(list)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range items {
+			o, ok := item.(aivenManagedObject)
+			if !ok {
+				continue
+			}
+
+			// The Project is named after the project it manages
+			_, isProject := o.(*v1alpha1.Project)
+			if objectProject(o) == op.Spec.Project || isProject && o.GetName() == op.Spec.Project {
+				objects = append(objects, o)
+			}
+		}
+	}
+	return objects, nil
+}
+
+func operationResourceKind(o client.Object) string {
+	return reflect.Indirect(reflect.ValueOf(o)).Type().Name()
+}
+
+func (r *OperationReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&v1alpha1.Operation{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Complete(r)
+}
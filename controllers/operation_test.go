@@ -0,0 +1,77 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+func TestOperation(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, v1alpha1.AddToScheme(scheme))
+
+	created := metav1.NewTime(time.Date(2023, 2, 1, 12, 0, 0, 0, time.UTC))
+	objects := []client.Object{
+		&v1alpha1.Project{ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "a"}},
+		&v1alpha1.KafkaTopic{ObjectMeta: metav1.ObjectMeta{Name: "orders", Namespace: "a"}, Spec: v1alpha1.KafkaTopicSpec{Project: "foo"}},
+		&v1alpha1.ServiceUser{ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "b"}, Spec: v1alpha1.ServiceUserSpec{Project: "foo"}},
+		&v1alpha1.ServiceUser{ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "a"}, Spec: v1alpha1.ServiceUserSpec{Project: "bar"}},
+		&v1alpha1.Operation{ObjectMeta: metav1.ObjectMeta{Name: "pause", CreationTimestamp: created}, Spec: v1alpha1.OperationSpec{Action: operationActionPauseProject, Project: "foo"}},
+		&v1alpha1.Operation{ObjectMeta: metav1.ObjectMeta{Name: "resume", CreationTimestamp: created}, Spec: v1alpha1.OperationSpec{Action: operationActionResumeProject, Project: "foo"}},
+		&v1alpha1.Operation{ObjectMeta: metav1.ObjectMeta{Name: "rotate", CreationTimestamp: created}, Spec: v1alpha1.OperationSpec{Action: operationActionRotateCredentials, Namespace: "a"}},
+	}
+	k8s := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build()
+	r := &OperationReconciler{Client: k8s, Log: logr.Discard(), Recorder: record.NewFakeRecorder(100)}
+
+	run := func(name string) *v1alpha1.Operation {
+		_, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Name: name}})
+		require.NoError(t, err)
+		op := new(v1alpha1.Operation)
+		require.NoError(t, k8s.Get(context.Background(), types.NamespacedName{Name: name}, op))
+		return op
+	}
+	annotations := func(o client.Object, namespace, name string) map[string]string {
+		require.NoError(t, k8s.Get(context.Background(), types.NamespacedName{Name: name, Namespace: namespace}, o))
+		return o.GetAnnotations()
+	}
+
+	// Pauses the project and its resources in all the namespaces
+	op := run("pause")
+	assert.Equal(t, operationStateSucceeded, op.Status.State)
+	assert.NotNil(t, op.Status.CompletionTime)
+	assert.Equal(t, []string{"KafkaTopic a/orders", "Project a/foo", "ServiceUser b/app"}, op.Status.Resources)
+	assert.True(t, isPaused(&v1alpha1.ServiceUser{ObjectMeta: metav1.ObjectMeta{Annotations: annotations(&v1alpha1.ServiceUser{}, "b", "app")}}))
+	assert.NotContains(t, annotations(&v1alpha1.ServiceUser{}, "a", "other"), pausedAnnotation)
+
+	// The action runs once
+	require.NoError(t, k8s.Delete(context.Background(), &v1alpha1.KafkaTopic{ObjectMeta: metav1.ObjectMeta{Name: "orders", Namespace: "a"}}))
+	assert.Len(t, run("pause").Status.Resources, 3)
+
+	op = run("resume")
+	assert.Equal(t, []string{"Project a/foo", "ServiceUser b/app"}, op.Status.Resources)
+	assert.NotContains(t, annotations(&v1alpha1.ServiceUser{}, "b", "app"), pausedAnnotation)
+
+	// Rotates the credentials of the users of the namespace, whatever their project
+	op = run("rotate")
+	assert.Equal(t, []string{"ServiceUser a/other"}, op.Status.Resources)
+	a := annotations(&v1alpha1.ServiceUser{}, "a", "other")
+	assert.Equal(t, "2023-02-01T12:00:00Z", a[rotateCredentialsAnnotation])
+	assert.Equal(t, "2023-02-01T12:00:00Z", a[reconcileNowAnnotation])
+}
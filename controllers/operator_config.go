@@ -0,0 +1,125 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"sync"
+	"time"
+
+	"github.com/aiven/aiven-go-client"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+const (
+	// featureDiskPressure checks the disk usage of the services
+	featureDiskPressure = "DiskPressure"
+
+	// featureServiceDiff shows the changes made outside the operator in the service status
+	featureServiceDiff = "ServiceDiff"
+
+	// featureServiceNotifications shows the notifications of the services in their status
+	featureServiceNotifications = "ServiceNotifications"
+)
+
+// operatorConfig is the part of the OperatorConfig the controllers read on every reconciliation
+type operatorConfig struct {
+	resyncInterval time.Duration
+	defaultTags    map[string]string
+	featureGates   map[string]bool
+}
+
+var (
+	operatorConfigMu      sync.RWMutex
+	currentOperatorConfig operatorConfig
+)
+
+func getOperatorConfig() operatorConfig {
+	operatorConfigMu.RLock()
+	defer operatorConfigMu.RUnlock()
+	return currentOperatorConfig
+}
+
+func setOperatorConfig(c operatorConfig) {
+	operatorConfigMu.Lock()
+	defer operatorConfigMu.Unlock()
+	currentOperatorConfig = c
+}
+
+// newOperatorConfig returns the config of the spec, the defaults if the spec is nil
+func newOperatorConfig(spec *v1alpha1.OperatorConfigSpec) operatorConfig {
+	c := operatorConfig{}
+	if spec == nil {
+		return c
+	}
+
+	if spec.ResyncInterval != nil {
+		c.resyncInterval = spec.ResyncInterval.Duration
+	}
+	c.defaultTags = spec.DefaultTags
+	c.featureGates = spec.FeatureGates
+	return c
+}
+
+// featureEnabled returns true unless the feature gate is disabled
+func featureEnabled(name string) bool {
+	enabled, ok := getOperatorConfig().featureGates[name]
+	return !ok || enabled
+}
+
+// serviceTags returns the default tags with the tags of the service on top, then the tags of the owner
+func serviceTags(spec *v1alpha1.ServiceCommonSpec) map[string]string {
+	defaults := getOperatorConfig().defaultTags
+	tags := make(map[string]string, len(defaults)+len(spec.Tags))
+	for k, v := range defaults {
+		tags[k] = v
+	}
+	for k, v := range spec.Tags {
+		tags[k] = v
+	}
+	if spec.Owner != nil {
+		for k, v := range spec.Owner.Tags() {
+			tags[k] = v
+		}
+	}
+	return tags
+}
+
+// updateServiceTags adds the tags to the service, or updates their values.
+// The other tags of the service, like the ones added in the console, are kept.
+// Writes the tags only when they differ, so the reconciliations of the unchanged services don't update them
+func updateServiceTags(avn *aiven.Client, project, service string, tags map[string]string) error {
+	if len(tags) == 0 {
+		return nil
+	}
+
+	current, err := avn.ServiceTags.Get(project, service)
+	if err != nil {
+		return err
+	}
+
+	merged, changed := mergeServiceTags(current.Tags, tags)
+	if !changed {
+		return nil
+	}
+
+	_, err = avn.ServiceTags.Set(project, service, aiven.ServiceTagsRequest{Tags: merged})
+	return err
+}
+
+// mergeServiceTags returns the current tags with the given ones set, and whether any of them has changed
+func mergeServiceTags(current, tags map[string]string) (map[string]string, bool) {
+	merged := make(map[string]string, len(current)+len(tags))
+	for k, v := range current {
+		merged[k] = v
+	}
+
+	changed := false
+	for k, v := range tags {
+		if cur, ok := merged[k]; !ok || cur != v {
+			merged[k] = v
+			changed = true
+		}
+	}
+	return merged, changed
+}
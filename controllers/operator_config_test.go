@@ -0,0 +1,83 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/aiven/aiven-go-client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+func TestOperatorConfig(t *testing.T) {
+	defer setOperatorConfig(operatorConfig{})
+
+	// The defaults
+	setOperatorConfig(newOperatorConfig(nil))
+	assert.True(t, featureEnabled(featureDiskPressure))
+	assert.True(t, featureEnabled(featureServiceDiff))
+	assert.Zero(t, getOperatorConfig().resyncInterval)
+	assert.Equal(t, map[string]string{"env": "prod"}, serviceTags(&v1alpha1.ServiceCommonSpec{Tags: map[string]string{"env": "prod"}}))
+
+	setOperatorConfig(newOperatorConfig(&v1alpha1.OperatorConfigSpec{
+		ResyncInterval: &metav1.Duration{Duration: time.Hour},
+		DefaultTags:    map[string]string{"team": "platform", "env": "dev"},
+		FeatureGates:   map[string]bool{featureDiskPressure: false, featureServiceDiff: true},
+	}))
+	assert.False(t, featureEnabled(featureDiskPressure))
+	assert.True(t, featureEnabled(featureServiceDiff))
+	assert.Equal(t, time.Hour, getOperatorConfig().resyncInterval)
+
+	// The tags of the service take precedence
+	spec := &v1alpha1.ServiceCommonSpec{Tags: map[string]string{"env": "prod"}}
+	assert.Equal(t, map[string]string{"team": "platform", "env": "prod"}, serviceTags(spec))
+	assert.Equal(t, map[string]string{"env": "prod"}, spec.Tags)
+}
+
+// serviceTagsAPI serves the tags endpoint of a service
+type serviceTagsAPI struct {
+	tags map[string]string
+	puts int
+}
+
+func (f *serviceTagsAPI) RoundTrip(r *http.Request) (*http.Response, error) {
+	if r.Method == http.MethodPut {
+		req := new(aiven.ServiceTagsRequest)
+		if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+			return nil, err
+		}
+		f.tags = req.Tags
+		f.puts++
+	}
+
+	b, err := json.Marshal(map[string]interface{}{"tags": f.tags})
+	if err != nil {
+		return nil, err
+	}
+	return &http.Response{StatusCode: http.StatusOK, Header: make(http.Header), Request: r, Body: io.NopCloser(bytes.NewReader(b))}, nil
+}
+
+func TestUpdateServiceTags(t *testing.T) {
+	api := &serviceTagsAPI{tags: map[string]string{"cost-center": "42", "env": "dev"}}
+	avn := &aiven.Client{APIKey: "token", Client: &http.Client{Transport: api}}
+	avn.Init()
+
+	// The tags added outside the operator are kept
+	require.NoError(t, updateServiceTags(avn, "foo", "pg", map[string]string{"env": "prod", "team": "platform"}))
+	assert.Equal(t, map[string]string{"cost-center": "42", "env": "prod", "team": "platform"}, api.tags)
+	assert.Equal(t, 1, api.puts)
+
+	// Nothing is written when the tags are there already
+	require.NoError(t, updateServiceTags(avn, "foo", "pg", map[string]string{"env": "prod"}))
+	require.NoError(t, updateServiceTags(avn, "foo", "pg", nil))
+	assert.Equal(t, 1, api.puts)
+}
@@ -0,0 +1,81 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+// OperatorConfigReconciler applies the OperatorConfig to the running operator,
+// so tuning it doesn't need a restart
+type OperatorConfigReconciler struct {
+	client.Client
+
+	Log logr.Logger
+
+	// DefaultAPIRateLimit is the --aiven-api-rate-limit flag, used when the OperatorConfig doesn't set it
+	DefaultAPIRateLimit int
+}
+
+// +kubebuilder:rbac:groups=aiven.io,resources=operatorconfigs,verbs=get;list;watch
+// +kubebuilder:rbac:groups=aiven.io,resources=operatorconfigs/status,verbs=get;update;patch
+
+func (r *OperatorConfigReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	config := &v1alpha1.OperatorConfig{}
+	err := r.Get(ctx, types.NamespacedName{Name: v1alpha1.OperatorConfigName}, config)
+	if apierrors.IsNotFound(err) {
+		r.Log.Info("OperatorConfig is not found, using the defaults")
+		r.apply(nil)
+		return ctrl.Result{}, nil
+	}
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	r.apply(&config.Spec)
+	r.Log.Info("OperatorConfig applied", "generation", config.Generation)
+
+	if config.Status.ObservedGeneration == config.Generation {
+		return ctrl.Result{}, nil
+	}
+	config.Status.ObservedGeneration = config.Generation
+	meta.SetStatusCondition(&config.Status.Conditions, metav1.Condition{
+		Type:    conditionTypeRunning,
+		Status:  metav1.ConditionTrue,
+		Reason:  "Applied",
+		Message: "The operator uses the config",
+	})
+	return ctrl.Result{}, r.Status().Update(ctx, config)
+}
+
+// apply sets the config of the controllers, the defaults if the spec is nil
+func (r *OperatorConfigReconciler) apply(spec *v1alpha1.OperatorConfigSpec) {
+	setOperatorConfig(newOperatorConfig(spec))
+
+	rateLimit := r.DefaultAPIRateLimit
+	if spec != nil && spec.AivenAPIRateLimit != nil {
+		rateLimit = *spec.AivenAPIRateLimit
+	}
+	aivenAPIBudget.setRate(rateLimit)
+}
+
+func (r *OperatorConfigReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	isDefault := predicate.NewPredicateFuncs(func(o client.Object) bool {
+		return o.GetName() == v1alpha1.OperatorConfigName
+	})
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&v1alpha1.OperatorConfig{}, builder.WithPredicates(isDefault, predicate.GenerationChangedPredicate{})).
+		Complete(r)
+}
@@ -0,0 +1,78 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+var _ = Describe("OperatorConfig Controller", func() {
+	const (
+		timeout  = time.Minute
+		interval = time.Second
+	)
+
+	var (
+		config *v1alpha1.OperatorConfig
+		ctx    context.Context
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		rateLimit := 120
+		config = &v1alpha1.OperatorConfig{
+			ObjectMeta: metav1.ObjectMeta{Name: v1alpha1.OperatorConfigName},
+			Spec: v1alpha1.OperatorConfigSpec{
+				ResyncInterval:    &metav1.Duration{Duration: 30 * time.Minute},
+				AivenAPIRateLimit: &rateLimit,
+				DefaultTags:       map[string]string{"team": "platform"},
+				FeatureGates:      map[string]bool{featureServiceDiff: false},
+			},
+		}
+
+		By("Creating a new OperatorConfig")
+		Expect(k8sClient.Create(ctx, config)).Should(Succeed())
+	})
+
+	Context("Validating OperatorConfig reconciler behaviour", func() {
+		It("should apply the config without a restart", func() {
+			By("by waiting for the config to be applied")
+			created := &v1alpha1.OperatorConfig{}
+			Eventually(func() bool {
+				err := k8sClient.Get(ctx, types.NamespacedName{Name: v1alpha1.OperatorConfigName}, created)
+				return err == nil && created.Status.ObservedGeneration == created.Generation
+			}, timeout, interval).Should(BeTrue())
+
+			Expect(getOperatorConfig().resyncInterval).Should(Equal(30 * time.Minute))
+			Expect(featureEnabled(featureServiceDiff)).Should(BeFalse())
+			Expect(featureEnabled(featureDiskPressure)).Should(BeTrue())
+			Expect(serviceTags(&v1alpha1.ServiceCommonSpec{})).Should(Equal(map[string]string{"team": "platform"}))
+
+			By("by updating the config")
+			created.Spec.FeatureGates = nil
+			Expect(k8sClient.Update(ctx, created)).Should(Succeed())
+			Eventually(func() bool {
+				return featureEnabled(featureServiceDiff)
+			}, timeout, interval).Should(BeTrue())
+
+		})
+	})
+
+	AfterEach(func() {
+		By("Ensures that OperatorConfig was deleted")
+		ensureDelete(ctx, config)
+
+		By("by checking the defaults are restored")
+		Eventually(func() time.Duration {
+			return getOperatorConfig().resyncInterval
+		}, timeout, interval).Should(BeZero())
+	})
+})
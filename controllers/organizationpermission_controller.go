@@ -0,0 +1,195 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/aiven/aiven-go-client"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+const (
+	conditionTypePermissionsDrift = "PermissionsDrift"
+
+	// organizationPermissionResourceType is the type of the resources the permissions are granted on
+	organizationPermissionResourceType = "project"
+)
+
+// OrganizationPermissionReconciler reconciles a OrganizationPermission object
+type OrganizationPermissionReconciler struct {
+	Controller
+}
+
+type OrganizationPermissionHandler struct{}
+
+// +kubebuilder:rbac:groups=aiven.io,resources=organizationpermissions,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=aiven.io,resources=organizationpermissions/status,verbs=get;update;patch
+
+func (r *OrganizationPermissionReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	return r.reconcileInstance(ctx, req, OrganizationPermissionHandler{}, &v1alpha1.OrganizationPermission{})
+}
+
+func (r *OrganizationPermissionReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&v1alpha1.OrganizationPermission{}).
+		WithOptions(priorityControllerOptions(&v1alpha1.OrganizationPermission{})).
+		Complete(r)
+}
+
+func (h OrganizationPermissionHandler) createOrUpdate(avn *aiven.Client, i client.Object, refs []client.Object) error {
+	perm, err := h.convert(i)
+	if err != nil {
+		return err
+	}
+
+	// Replaces all the permissions of the project, so the roles granted outside the spec are revoked
+	err = aivenAPIFor(avn).setOrganizationPermissions(perm.Spec.OrganizationID, organizationPermissionResourceType,
+		perm.Spec.Project, newAivenOrganizationPermissions(perm.Spec.Permissions))
+	if err != nil {
+		return err
+	}
+
+	meta.SetStatusCondition(&perm.Status.Conditions,
+		getInitializedCondition("Updated",
+			"Instance was created or update on Aiven side"))
+
+	meta.SetStatusCondition(&perm.Status.Conditions,
+		getRunningCondition(metav1.ConditionUnknown, "Updated",
+			"Instance was created or update on Aiven side, status remains unknown"))
+
+	metav1.SetMetaDataAnnotation(&perm.ObjectMeta,
+		processedGenerationAnnotation, strconv.FormatInt(perm.GetGeneration(), formatIntBaseDecimal))
+
+	return nil
+}
+
+func newAivenOrganizationPermissions(entries []v1alpha1.OrganizationPermissionEntry) []aivenOrganizationPermission {
+	permissions := make([]aivenOrganizationPermission, 0, len(entries))
+	for _, e := range entries {
+		permissions = append(permissions, aivenOrganizationPermission{
+			PrincipalType: e.PrincipalType,
+			PrincipalID:   e.PrincipalID,
+			Permissions:   e.Permissions,
+		})
+	}
+	return permissions
+}
+
+func (h OrganizationPermissionHandler) delete(avn *aiven.Client, i client.Object) (bool, error) {
+	perm, err := h.convert(i)
+	if err != nil {
+		return false, err
+	}
+
+	// Revokes the roles the resource has granted
+	err = aivenAPIFor(avn).setOrganizationPermissions(perm.Spec.OrganizationID, organizationPermissionResourceType,
+		perm.Spec.Project, []aivenOrganizationPermission{})
+	if err != nil && !isAivenAPINotFound(err) {
+		return false, fmt.Errorf("unable to revoke the permissions: %w", err)
+	}
+	return true, nil
+}
+
+func (h OrganizationPermissionHandler) get(avn *aiven.Client, i client.Object) (*corev1.Secret, error) {
+	perm, err := h.convert(i)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := aivenAPIFor(avn).getOrganizationPermissions(perm.Spec.OrganizationID, organizationPermissionResourceType, perm.Spec.Project)
+	if err != nil {
+		return nil, err
+	}
+
+	perm.Status.Drift = findOrganizationPermissionsDrift(perm.Spec.Permissions, current)
+	meta.SetStatusCondition(&perm.Status.Conditions, getPermissionsDriftCondition(perm.Status.Drift))
+
+	meta.SetStatusCondition(&perm.Status.Conditions,
+		getRunningCondition(metav1.ConditionTrue, "CheckRunning",
+			"Instance is running on Aiven side"))
+
+	metav1.SetMetaDataAnnotation(&perm.ObjectMeta, instanceIsRunningAnnotation, "true")
+
+	return nil, nil
+}
+
+// findOrganizationPermissionsDrift returns the principals whose roles on Aiven side differ from the spec, sorted
+func findOrganizationPermissionsDrift(spec []v1alpha1.OrganizationPermissionEntry, current []aivenOrganizationPermission) []string {
+	declared := make(map[string][]string, len(spec))
+	for _, e := range spec {
+		declared[e.PrincipalType+" "+e.PrincipalID] = e.Permissions
+	}
+	actual := make(map[string][]string, len(current))
+	for _, p := range current {
+		actual[p.PrincipalType+" "+p.PrincipalID] = p.Permissions
+	}
+
+	drift := make([]string, 0)
+	for principal, want := range declared {
+		got, ok := actual[principal]
+		switch {
+		case !ok || len(got) == 0:
+			drift = append(drift, fmt.Sprintf("%s has no roles, %s is declared", principal, joinRoles(want)))
+		case !equalStringSets(got, want):
+			drift = append(drift, fmt.Sprintf("%s has %s, %s is declared", principal, joinRoles(got), joinRoles(want)))
+		}
+	}
+	for principal, got := range actual {
+		if _, ok := declared[principal]; !ok && len(got) > 0 {
+			drift = append(drift, fmt.Sprintf("%s has %s, it is not declared", principal, joinRoles(got)))
+		}
+	}
+	if len(drift) == 0 {
+		return nil
+	}
+	sort.Strings(drift)
+	return drift
+}
+
+func joinRoles(roles []string) string {
+	roles = append([]string(nil), roles...)
+	sort.Strings(roles)
+	return strings.Join(roles, ", ")
+}
+
+func getPermissionsDriftCondition(drift []string) metav1.Condition {
+	if len(drift) == 0 {
+		return metav1.Condition{
+			Type:    conditionTypePermissionsDrift,
+			Status:  metav1.ConditionFalse,
+			Reason:  "PermissionsMatch",
+			Message: "The permissions on Aiven side match the declared ones",
+		}
+	}
+
+	return metav1.Condition{
+		Type:    conditionTypePermissionsDrift,
+		Status:  metav1.ConditionTrue,
+		Reason:  "PermissionsChanged",
+		Message: strings.Join(drift, "; ") + ". Set the aiven.io/reconcile-now annotation to apply the declared ones",
+	}
+}
+
+func (h OrganizationPermissionHandler) checkPreconditions(_ *aiven.Client, _ client.Object) (bool, error) {
+	return true, nil
+}
+
+func (h OrganizationPermissionHandler) convert(i client.Object) (*v1alpha1.OrganizationPermission, error) {
+	perm, ok := i.(*v1alpha1.OrganizationPermission)
+	if !ok {
+		return nil, fmt.Errorf("cannot convert object to OrganizationPermission")
+	}
+
+	return perm, nil
+}
@@ -0,0 +1,96 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"os"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+var _ = Describe("OrganizationPermission Controller", func() {
+	const (
+		namespace = "default"
+		timeout   = time.Minute * 5
+		interval  = time.Second * 10
+	)
+
+	It("grants the roles on the project, updates them, and revokes them on deletion", func() {
+		organizationID := os.Getenv("AIVEN_ORGANIZATION_ID")
+		groupID := os.Getenv("AIVEN_ORGANIZATION_USER_GROUP_ID")
+		if organizationID == "" || groupID == "" {
+			Skip("AIVEN_ORGANIZATION_ID and AIVEN_ORGANIZATION_USER_GROUP_ID are required")
+		}
+
+		ctx := context.Background()
+		projectName := os.Getenv("AIVEN_PROJECT_NAME")
+		permName := "k8s-test-org-permission-acc-" + generateRandomID()
+		permObj := organizationPermissionSpec(permName, namespace, organizationID, projectName, groupID)
+		permLookupKey := types.NamespacedName{Name: permName, Namespace: namespace}
+		api := newAivenAPI(os.Getenv("AIVEN_TOKEN"))
+
+		By("Creating a new OrganizationPermission")
+		Expect(k8sClient.Create(ctx, permObj)).Should(Succeed())
+
+		By("by waiting the permissions to match the spec")
+		createdPerm := &v1alpha1.OrganizationPermission{}
+		Eventually(func() bool {
+			err := k8sClient.Get(ctx, permLookupKey, createdPerm)
+			return err == nil && meta.IsStatusConditionTrue(createdPerm.Status.Conditions, conditionTypeRunning) &&
+				meta.IsStatusConditionFalse(createdPerm.Status.Conditions, conditionTypePermissionsDrift)
+		}, timeout, interval).Should(BeTrue())
+
+		permissions, err := api.getOrganizationPermissions(organizationID, organizationPermissionResourceType, projectName)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(findOrganizationPermissionsDrift(permObj.Spec.Permissions, permissions)).To(BeEmpty())
+
+		By("Updating the roles of the group")
+		createdPerm.Spec.Permissions[0].Permissions = []string{"read_only"}
+		Expect(k8sClient.Update(ctx, createdPerm)).Should(Succeed())
+		Eventually(func() []string {
+			permissions, err := api.getOrganizationPermissions(organizationID, organizationPermissionResourceType, projectName)
+			if err != nil || len(permissions) == 0 {
+				return nil
+			}
+			return permissions[0].Permissions
+		}, timeout, interval).Should(ConsistOf("read_only"))
+
+		By("Deletes the OrganizationPermission, the roles are revoked")
+		ensureDelete(ctx, permObj)
+		permissions, err = api.getOrganizationPermissions(organizationID, organizationPermissionResourceType, projectName)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(permissions).To(BeEmpty())
+	})
+})
+
+func organizationPermissionSpec(name, namespace, organizationID, project, groupID string) *v1alpha1.OrganizationPermission {
+	return &v1alpha1.OrganizationPermission{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "aiven.io/v1alpha1",
+			Kind:       "OrganizationPermission",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: v1alpha1.OrganizationPermissionSpec{
+			OrganizationID: organizationID,
+			Project:        project,
+			Permissions: []v1alpha1.OrganizationPermissionEntry{
+				{PrincipalType: "user_group", PrincipalID: groupID, Permissions: []string{"developer"}},
+			},
+			AuthSecretRef: v1alpha1.AuthSecretReference{
+				Name: secretRefName,
+				Key:  secretRefKey,
+			},
+		},
+	}
+}
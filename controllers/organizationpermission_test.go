@@ -0,0 +1,88 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+// fakeOrganizationPermissionsAPI serves the permissions of a project, PUT replaces them
+type fakeOrganizationPermissionsAPI struct {
+	permissions []aivenOrganizationPermission
+}
+
+func (f *fakeOrganizationPermissionsAPI) RoundTrip(r *http.Request) (*http.Response, error) {
+	rsp := &http.Response{StatusCode: http.StatusOK, Header: make(http.Header), Request: r}
+	var out interface{} = map[string]string{}
+	switch r.Method + " " + r.URL.Path {
+	case "GET /v1/organization/org1/permissions/project/foo":
+		out = map[string]interface{}{"permissions": f.permissions}
+	case "PUT /v1/organization/org1/permissions/project/foo":
+		var in struct {
+			Permissions []aivenOrganizationPermission `json:"permissions"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&in); err != nil {
+			return nil, err
+		}
+		f.permissions = in.Permissions
+	default:
+		rsp.StatusCode = http.StatusNotFound
+		out = map[string]string{"message": "Not found"}
+	}
+	b, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	rsp.Body = io.NopCloser(bytes.NewReader(b))
+	return rsp, nil
+}
+
+func TestOrganizationPermission(t *testing.T) {
+	api := &fakeOrganizationPermissionsAPI{}
+	avn := newFakeAivenClient("token", api)
+	perm := &v1alpha1.OrganizationPermission{Spec: v1alpha1.OrganizationPermissionSpec{
+		OrganizationID: "org1",
+		Project:        "foo",
+		Permissions: []v1alpha1.OrganizationPermissionEntry{
+			{PrincipalType: "user_group", PrincipalID: "ug1", Permissions: []string{"developer"}},
+			{PrincipalType: "user", PrincipalID: "u1", Permissions: []string{"read_only", "admin"}},
+		},
+	}}
+
+	h := OrganizationPermissionHandler{}
+	require.NoError(t, h.createOrUpdate(avn, perm, nil))
+	assert.Len(t, api.permissions, 2)
+
+	_, err := h.get(avn, perm)
+	require.NoError(t, err)
+	assert.Nil(t, perm.Status.Drift)
+	assert.True(t, meta.IsStatusConditionFalse(perm.Status.Conditions, conditionTypePermissionsDrift))
+	assert.True(t, isAlreadyRunning(perm))
+
+	// Roles granted in the Aiven Console are reported
+	api.permissions[1].Permissions = []string{"admin"}
+	api.permissions = append(api.permissions, aivenOrganizationPermission{PrincipalType: "user", PrincipalID: "u2", Permissions: []string{"operator"}})
+	_, err = h.get(avn, perm)
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"user u1 has admin, admin, read_only is declared",
+		"user u2 has operator, it is not declared",
+	}, perm.Status.Drift)
+	assert.True(t, meta.IsStatusConditionTrue(perm.Status.Conditions, conditionTypePermissionsDrift))
+
+	// The deletion revokes all the roles
+	deleted, err := h.delete(avn, perm)
+	require.NoError(t, err)
+	assert.True(t, deleted)
+	assert.Empty(t, api.permissions)
+}
@@ -0,0 +1,262 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/aiven/aiven-go-client"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+// OrganizationVPCReconciler reconciles a OrganizationVPC object
+type OrganizationVPCReconciler struct {
+	Controller
+}
+
+type OrganizationVPCHandler struct{}
+
+// +kubebuilder:rbac:groups=aiven.io,resources=organizationvpcs,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=aiven.io,resources=organizationvpcs/status,verbs=get;update;patch
+
+func (r *OrganizationVPCReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	return r.reconcileInstance(ctx, req, OrganizationVPCHandler{}, &v1alpha1.OrganizationVPC{})
+}
+
+func (r *OrganizationVPCReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&v1alpha1.OrganizationVPC{}).
+		WithOptions(priorityControllerOptions(&v1alpha1.OrganizationVPC{})).
+		Complete(r)
+}
+
+func (h OrganizationVPCHandler) createOrUpdate(avn *aiven.Client, i client.Object, refs []client.Object) error {
+	vpc, err := h.convert(i)
+	if err != nil {
+		return err
+	}
+
+	// The VPC itself is immutable, the peering connections are synced once it is active
+	reason := "Updated"
+	if vpc.Status.ID == "" {
+		created, err := aivenAPIFor(avn).createOrganizationVPC(vpc.Spec.OrganizationID, vpc.Spec.CloudName, vpc.Spec.NetworkCidr)
+		if err != nil {
+			return err
+		}
+
+		vpc.Status.ID = created.OrganizationVPCID
+		vpc.Status.State = created.State
+		reason = "Created"
+	}
+
+	meta.SetStatusCondition(&vpc.Status.Conditions,
+		getInitializedCondition(reason,
+			"Instance was created or update on Aiven side"))
+
+	meta.SetStatusCondition(&vpc.Status.Conditions,
+		getRunningCondition(metav1.ConditionUnknown, reason,
+			"Instance was created or update on Aiven side, status remains unknown"))
+
+	metav1.SetMetaDataAnnotation(&vpc.ObjectMeta,
+		processedGenerationAnnotation, strconv.FormatInt(vpc.GetGeneration(), formatIntBaseDecimal))
+
+	return nil
+}
+
+func (h OrganizationVPCHandler) delete(avn *aiven.Client, i client.Object) (bool, error) {
+	vpc, err := h.convert(i)
+	if err != nil {
+		return false, err
+	}
+
+	if vpc.Status.ID == "" {
+		return true, nil
+	}
+
+	api := aivenAPIFor(avn)
+	current, err := api.getOrganizationVPC(vpc.Spec.OrganizationID, vpc.Status.ID)
+	if isAivenAPINotFound(err) {
+		return true, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	switch current.State {
+	case "DELETED":
+		return true, nil
+	case "DELETING":
+		return false, nil
+	}
+
+	err = api.deleteOrganizationVPC(vpc.Spec.OrganizationID, vpc.Status.ID)
+	if isDependencyError(err) {
+		return false, fmt.Errorf("%w: %s", v1alpha1.ErrDeleteDependencies, err)
+	}
+	return false, err
+}
+
+func (h OrganizationVPCHandler) get(avn *aiven.Client, i client.Object) (*corev1.Secret, error) {
+	vpc, err := h.convert(i)
+	if err != nil {
+		return nil, err
+	}
+
+	if vpc.Status.ID == "" {
+		return nil, nil
+	}
+
+	api := aivenAPIFor(avn)
+	current, err := api.getOrganizationVPC(vpc.Spec.OrganizationID, vpc.Status.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	vpc.Status.State = current.State
+	if current.State != "ACTIVE" {
+		return nil, nil
+	}
+
+	create, remove := diffOrganizationVPCPeeringConnections(vpc.Spec.PeeringConnections, current.PeeringConnections)
+	for _, id := range remove {
+		err = api.deleteOrganizationVPCPeeringConnection(vpc.Spec.OrganizationID, vpc.Status.ID, id)
+		if err != nil {
+			return nil, fmt.Errorf("cannot delete peering connection %q: %w", id, err)
+		}
+	}
+	for _, pc := range create {
+		err = api.createOrganizationVPCPeeringConnection(vpc.Spec.OrganizationID, vpc.Status.ID, newOrganizationVPCPeeringConnectionRequest(pc))
+		if err != nil {
+			return nil, fmt.Errorf("cannot create peering connection to VPC %q of cloud account %q: %w", pc.PeerVPC, pc.PeerCloudAccount, err)
+		}
+	}
+
+	if len(create)+len(remove) > 0 {
+		current, err = api.getOrganizationVPC(vpc.Spec.OrganizationID, vpc.Status.ID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// The messages are the same as of the project VPC peering connections
+	network := &aiven.VPC{}
+	if len(current.Clouds) > 0 {
+		network.NetworkCIDR = current.Clouds[0].NetworkCIDR
+	}
+
+	vpc.Status.PeeringConnections = nil
+	for _, pc := range current.PeeringConnections {
+		vpc.Status.PeeringConnections = append(vpc.Status.PeeringConnections, newProjectVPCPeeringConnection(network, &pc.VPCPeeringConnection))
+	}
+
+	meta.SetStatusCondition(&vpc.Status.Conditions,
+		getRunningCondition(metav1.ConditionTrue, "CheckRunning",
+			"Instance is running on Aiven side"))
+
+	metav1.SetMetaDataAnnotation(&vpc.ObjectMeta, instanceIsRunningAnnotation, "true")
+
+	return nil, nil
+}
+
+// diffOrganizationVPCPeeringConnections returns the peering connections of the spec to create,
+// and the IDs of the existing ones that are not in the spec to delete
+func diffOrganizationVPCPeeringConnections(spec []v1alpha1.OrganizationVPCPeeringConnectionSpec, current []*aivenOrganizationVPCPeeringConnection) ([]v1alpha1.OrganizationVPCPeeringConnectionSpec, []string) {
+	matches := func(s v1alpha1.OrganizationVPCPeeringConnectionSpec, pc *aivenOrganizationVPCPeeringConnection) bool {
+		return s.PeerCloudAccount == pc.PeerCloudAccount &&
+			s.PeerVPC == pc.PeerVPC &&
+			s.PeerRegion == stringValue(pc.PeerRegion) &&
+			s.PeerResourceGroup == stringValue(pc.PeerResourceGroup)
+	}
+
+	create := make([]v1alpha1.OrganizationVPCPeeringConnectionSpec, 0)
+	for _, s := range spec {
+		found := false
+		for _, pc := range current {
+			if matches(s, pc) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			create = append(create, s)
+		}
+	}
+
+	remove := make([]string, 0)
+	for _, pc := range current {
+		if pc.State == "DELETING" || pc.State == "DELETED" {
+			continue
+		}
+
+		found := false
+		for _, s := range spec {
+			if matches(s, pc) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			remove = append(remove, pc.PeeringConnectionID)
+		}
+	}
+	return create, remove
+}
+
+func newOrganizationVPCPeeringConnectionRequest(s v1alpha1.OrganizationVPCPeeringConnectionSpec) aiven.CreateVPCPeeringConnectionRequest {
+	req := aiven.CreateVPCPeeringConnectionRequest{
+		PeerCloudAccount:     s.PeerCloudAccount,
+		PeerVPC:              s.PeerVPC,
+		PeerAzureAppId:       s.PeerAzureAppID,
+		PeerAzureTenantId:    s.PeerAzureTenantID,
+		PeerResourceGroup:    s.PeerResourceGroup,
+		UserPeerNetworkCIDRs: s.UserPeerNetworkCIDRs,
+	}
+	if s.PeerRegion != "" {
+		req.PeerRegion = &s.PeerRegion
+	}
+	return req
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// resyncAfter refreshes the peering connections, which are accepted or deleted outside the resource
+func (h OrganizationVPCHandler) resyncAfter(o client.Object) time.Duration {
+	vpc, err := h.convert(o)
+	if err != nil || !isAlreadyRunning(vpc) {
+		return 0
+	}
+
+	for _, pc := range vpc.Status.PeeringConnections {
+		if pc.State != "ACTIVE" {
+			return jitter(projectVPCPendingPeeringRefreshInterval)
+		}
+	}
+	return jitter(projectVPCPeeringRefreshInterval)
+}
+
+func (h OrganizationVPCHandler) checkPreconditions(_ *aiven.Client, _ client.Object) (bool, error) {
+	return true, nil
+}
+
+func (h OrganizationVPCHandler) convert(i client.Object) (*v1alpha1.OrganizationVPC, error) {
+	vpc, ok := i.(*v1alpha1.OrganizationVPC)
+	if !ok {
+		return nil, fmt.Errorf("cannot convert object to OrganizationVPC")
+	}
+
+	return vpc, nil
+}
@@ -0,0 +1,97 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"os"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+var _ = Describe("OrganizationVPC Controller", func() {
+	const (
+		namespace = "default"
+		timeout   = time.Minute * 20
+		interval  = time.Second * 10
+	)
+
+	It("creates a Kafka in the organization VPC, and deletes the VPC after it", func() {
+		organizationID := os.Getenv("AIVEN_ORGANIZATION_ID")
+		if organizationID == "" {
+			Skip("AIVEN_ORGANIZATION_ID is required")
+		}
+
+		ctx := context.Background()
+		projectName := os.Getenv("AIVEN_PROJECT_NAME")
+		vpcName := "k8s-test-org-vpc-acc-" + generateRandomID()
+		serviceName := "k8s-test-kafka-acc-" + generateRandomID()
+		vpcObj := organizationVPCSpec(vpcName, namespace, organizationID)
+		kafkaObj := kafkaForProjectVPC(serviceName, namespace, projectName, "")
+		kafkaObj.Spec.ProjectVPCRef = nil
+		kafkaObj.Spec.OrganizationVPCRef = &v1alpha1.ResourceReference{Name: vpcName, Namespace: namespace}
+		vpcLookupKey := types.NamespacedName{Name: vpcName, Namespace: namespace}
+
+		By("Creating a new OrganizationVPC and a Kafka in it")
+		Expect(k8sClient.Create(ctx, vpcObj)).Should(Succeed())
+		Expect(k8sClient.Create(ctx, kafkaObj)).Should(Succeed())
+
+		By("by waiting the VPC to become ACTIVE")
+		createdVPC := &v1alpha1.OrganizationVPC{}
+		Eventually(func() bool {
+			err := k8sClient.Get(ctx, vpcLookupKey, createdVPC)
+			return err == nil && meta.IsStatusConditionTrue(createdVPC.Status.Conditions, conditionTypeRunning)
+		}, timeout, interval).Should(BeTrue())
+		Expect(createdVPC.Status.State).Should(Equal("ACTIVE"))
+		Expect(createdVPC.Status.ID).NotTo(BeEmpty())
+
+		By("by waiting Kafka service status to become RUNNING")
+		createdKafka := &v1alpha1.Kafka{}
+		Eventually(func() bool {
+			err := k8sClient.Get(ctx, types.NamespacedName{Name: serviceName, Namespace: namespace}, createdKafka)
+			return err == nil && meta.IsStatusConditionTrue(createdKafka.Status.Conditions, conditionTypeRunning)
+		}, timeout, interval).Should(BeTrue())
+
+		avnKafka, err := aivenClient.Services.Get(projectName, serviceName)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(avnKafka.ProjectVPCID).Should(Equal(createdVPC.Status.ID))
+
+		By("Deletes the Kafka, the VPC is deleted after it")
+		Expect(k8sClient.Delete(ctx, vpcObj)).Should(Succeed())
+		ensureDelete(ctx, kafkaObj)
+		Eventually(func() bool {
+			err := k8sClient.Get(ctx, vpcLookupKey, &v1alpha1.OrganizationVPC{})
+			return apierrors.IsNotFound(err)
+		}, timeout, interval).Should(BeTrue())
+	})
+})
+
+func organizationVPCSpec(name, namespace, organizationID string) *v1alpha1.OrganizationVPC {
+	return &v1alpha1.OrganizationVPC{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "aiven.io/v1alpha1",
+			Kind:       "OrganizationVPC",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: v1alpha1.OrganizationVPCSpec{
+			OrganizationID: organizationID,
+			CloudName:      "google-europe-west2",
+			NetworkCidr:    "10.1.0.0/24",
+			AuthSecretRef: v1alpha1.AuthSecretReference{
+				Name: secretRefName,
+				Key:  secretRefKey,
+			},
+		},
+	}
+}
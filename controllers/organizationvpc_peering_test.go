@@ -0,0 +1,46 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"testing"
+
+	"github.com/aiven/aiven-go-client"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+func TestDiffOrganizationVPCPeeringConnections(t *testing.T) {
+	region := "eu-west-1"
+	current := func(id, account, vpc, state string, region *string) *aivenOrganizationVPCPeeringConnection {
+		return &aivenOrganizationVPCPeeringConnection{
+			VPCPeeringConnection: aiven.VPCPeeringConnection{PeerCloudAccount: account, PeerVPC: vpc, PeerRegion: region, State: state},
+			PeeringConnectionID:  id,
+		}
+	}
+
+	spec := []v1alpha1.OrganizationVPCPeeringConnectionSpec{
+		{PeerCloudAccount: "123", PeerVPC: "vpc-a"},
+		{PeerCloudAccount: "123", PeerVPC: "vpc-b", PeerRegion: region},
+		{PeerCloudAccount: "456", PeerVPC: "vpc-c"},
+	}
+
+	create, remove := diffOrganizationVPCPeeringConnections(spec, []*aivenOrganizationVPCPeeringConnection{
+		current("1", "123", "vpc-a", "ACTIVE", nil),
+		// Another region is another peering connection
+		current("2", "123", "vpc-b", "ACTIVE", nil),
+		current("3", "123", "vpc-b", "PENDING_PEER", &region),
+		// Not in the spec
+		current("4", "789", "vpc-d", "ACTIVE", nil),
+		current("5", "789", "vpc-e", "DELETING", nil),
+	})
+
+	assert.Equal(t, []v1alpha1.OrganizationVPCPeeringConnectionSpec{{PeerCloudAccount: "456", PeerVPC: "vpc-c"}}, create)
+	assert.Equal(t, []string{"2", "4"}, remove)
+
+	// No peering connections in the spec deletes all of them
+	create, remove = diffOrganizationVPCPeeringConnections(nil, []*aivenOrganizationVPCPeeringConnection{current("1", "123", "vpc-a", "ACTIVE", nil)})
+	assert.Empty(t, create)
+	assert.Equal(t, []string{"1"}, remove)
+}
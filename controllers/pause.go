@@ -0,0 +1,19 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// pausedAnnotation stops the reconciliation of the instance while it is "true", e.g. during an incident
+	pausedAnnotation = "aiven.io/paused"
+
+	eventReconciliationPaused = "ReconciliationPaused"
+)
+
+// isPaused returns true if the instance must be left as it is, on Aiven side too
+func isPaused(o client.Object) bool {
+	return o.GetAnnotations()[pausedAnnotation] == "true"
+}
@@ -5,6 +5,9 @@ package controllers
 import (
 	"context"
 	"fmt"
+	"net"
+	"net/url"
+	"strconv"
 
 	"github.com/aiven/aiven-go-client"
 	corev1 "k8s.io/api/core/v1"
@@ -24,12 +27,13 @@ type PostgreSQLReconciler struct {
 // +kubebuilder:rbac:groups=aiven.io,resources=postgresqls/status,verbs=get;update;patch
 
 func (r *PostgreSQLReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
-	return r.reconcileInstance(ctx, req, newGenericServiceHandler(newPostgresSQLAdapter), &v1alpha1.PostgreSQL{})
+	return r.reconcileInstance(ctx, req, newGenericServiceHandler(newPostgresSQLAdapter, r.Recorder), &v1alpha1.PostgreSQL{})
 }
 
 func (r *PostgreSQLReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&v1alpha1.PostgreSQL{}).
+		WithOptions(priorityControllerOptions(&v1alpha1.PostgreSQL{})).
 		Owns(&corev1.Secret{}).
 		Complete(r)
 }
@@ -52,7 +56,7 @@ func (a *postgresSQLAdapter) getObjectMeta() *metav1.ObjectMeta {
 }
 
 func (a *postgresSQLAdapter) getServiceStatus() *v1alpha1.ServiceStatus {
-	return &a.Status
+	return &a.Status.ServiceStatus
 }
 
 func (a *postgresSQLAdapter) getServiceCommonSpec() *v1alpha1.ServiceCommonSpec {
@@ -77,6 +81,14 @@ func (a *postgresSQLAdapter) newSecret(s *aiven.Service) (*corev1.Secret, error)
 		"PGPASSWORD":   s.URIParams["password"],
 		"PGSSLMODE":    s.URIParams["sslmode"],
 		"DATABASE_URI": s.URI,
+		// Clearly named keys, so the direct connections are not made by accident
+		"PGPORT_DIRECT":       s.URIParams["port"],
+		"DATABASE_URI_DIRECT": s.URI,
+	}
+
+	// PgBouncer connections need a ConnectionPool, its Secret has the pooled URI
+	if port := pgBouncerPort(s); port != "" {
+		stringData["PGPORT_POOLED"] = port
 	}
 
 	// Removes empties
@@ -99,3 +111,86 @@ func (a *postgresSQLAdapter) getServiceType() string {
 func (a *postgresSQLAdapter) getDiskSpace() string {
 	return a.Spec.DiskSpace
 }
+
+func (a *postgresSQLAdapter) getAdditionalDiskSpace() string {
+	return a.Spec.AdditionalDiskSpace
+}
+
+// updateStatus sets the limits of the service, the node memory is fetched when the plan or the cloud changes only
+func (a *postgresSQLAdapter) updateStatus(avn *aiven.Client, s *aiven.Service) error {
+	nodeMemoryMB := 0
+	if l := a.Status.Limits; l != nil && l.Plan == s.Plan && l.CloudName == s.CloudName {
+		nodeMemoryMB = l.NodeMemoryMB
+	} else {
+		var err error
+		nodeMemoryMB, err = aivenAPIFor(avn).getServicePlanNodeMemoryMB(a.Spec.Project, s.Type, s.Plan, s.CloudName)
+		if err != nil {
+			return fmt.Errorf("unable to get the plan %q of the service: %w", s.Plan, err)
+		}
+	}
+
+	var workMem *int
+	if a.Spec.UserConfig != nil {
+		workMem = a.Spec.UserConfig.WorkMem
+	}
+	a.Status.Limits = newPostgreSQLLimits(s, nodeMemoryMB, workMem)
+	return nil
+}
+
+// newPostgreSQLLimits returns the limits of the service,
+// the max_connections comes from the service metadata, which Aiven computes from the plan
+func newPostgreSQLLimits(s *aiven.Service, nodeMemoryMB int, workMem *int) *v1alpha1.PostgreSQLLimits {
+	limits := &v1alpha1.PostgreSQLLimits{
+		Plan:         s.Plan,
+		CloudName:    s.CloudName,
+		NodeMemoryMB: nodeMemoryMB,
+	}
+
+	if m, ok := s.Metadata.(map[string]any); ok {
+		if v, ok := m["max_connections"].(float64); ok {
+			limits.MaxConnections = int(v)
+		}
+	}
+
+	switch {
+	case workMem != nil:
+		limits.WorkMemMB = *workMem
+	case nodeMemoryMB > 0:
+		// The Aiven default is 1MB + 0.075% of the memory, up to 32MB
+		limits.WorkMemMB = 1 + nodeMemoryMB*75/100000
+		if limits.WorkMemMB > 32 {
+			limits.WorkMemMB = 32
+		}
+	}
+	return limits
+}
+
+// pgBouncerPort returns the PgBouncer port of the service, which serves the connection pools
+func pgBouncerPort(s *aiven.Service) string {
+	port := ""
+	for _, c := range s.Components {
+		if c.Component != "pgbouncer" {
+			continue
+		}
+
+		// Prefers the one on the same host as the service URI
+		if port == "" || c.Host == s.URIParams["host"] {
+			port = strconv.Itoa(c.Port)
+		}
+	}
+	return port
+}
+
+// pgURI builds a PostgreSQL connection URI
+func pgURI(user, password, host, port, database, sslMode string) string {
+	u := url.URL{
+		Scheme: "postgres",
+		User:   url.UserPassword(user, password),
+		Host:   net.JoinHostPort(host, port),
+		Path:   "/" + database,
+	}
+	if sslMode != "" {
+		u.RawQuery = "sslmode=" + url.QueryEscape(sslMode)
+	}
+	return u.String()
+}
@@ -83,6 +83,8 @@ var _ = Describe("PostgreSQL Controller", func() {
 			Expect(createdSecret.Data["PGPASSWORD"]).NotTo(BeEmpty())
 			Expect(createdSecret.Data["PGSSLMODE"]).NotTo(BeEmpty())
 			Expect(createdSecret.Data["DATABASE_URI"]).NotTo(BeEmpty())
+			Expect(createdSecret.Data["DATABASE_URI_DIRECT"]).To(Equal(createdSecret.Data["DATABASE_URI"]))
+			Expect(createdSecret.Data["PGPORT_DIRECT"]).To(Equal(createdSecret.Data["PGPORT"]))
 
 			Expect(createdPostgreSQL.Status.State).Should(Equal("RUNNING"))
 		})
@@ -0,0 +1,38 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"testing"
+
+	"github.com/aiven/aiven-go-client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewPostgreSQLLimits(t *testing.T) {
+	s := &aiven.Service{
+		Plan:      "business-4",
+		CloudName: "google-europe-west1",
+		Metadata:  map[string]any{"max_connections": float64(200), "pg_version": "14.6"},
+	}
+
+	// 1MB + 0.075% of 4GB
+	limits := newPostgreSQLLimits(s, 4096, nil)
+	assert.Equal(t, "business-4", limits.Plan)
+	assert.Equal(t, "google-europe-west1", limits.CloudName)
+	assert.Equal(t, 200, limits.MaxConnections)
+	assert.Equal(t, 4096, limits.NodeMemoryMB)
+	assert.Equal(t, 4, limits.WorkMemMB)
+
+	// Up to 32MB
+	assert.Equal(t, 32, newPostgreSQLLimits(s, 262144, nil).WorkMemMB)
+
+	// The user config wins
+	workMem := 64
+	assert.Equal(t, 64, newPostgreSQLLimits(s, 4096, &workMem).WorkMemMB)
+
+	// Unknown memory and metadata
+	limits = newPostgreSQLLimits(&aiven.Service{Plan: "hobbyist"}, 0, nil)
+	assert.Zero(t, limits.MaxConnections)
+	assert.Zero(t, limits.WorkMemMB)
+}
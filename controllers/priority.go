@@ -0,0 +1,122 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	// priorityAnnotation is the reconciliation priority of the instance: high, normal or low
+	priorityAnnotation = "aiven.io/priority"
+
+	priorityHigh   = "high"
+	priorityNormal = "normal"
+	priorityLow    = "low"
+
+	// highPriorityMaxRetryDelay caps the error backoff of the high priority instances,
+	// so they recover soon after an Aiven API outage
+	highPriorityMaxRetryDelay = 30 * time.Second
+
+	// lowPriorityMinRetryDelay is the least error backoff of the low priority instances
+	lowPriorityMinRetryDelay = 30 * time.Second
+
+	eventInvalidPriority = "InvalidPriority"
+)
+
+// priorities keeps the priorities of the reconciled instances for the rate limiters, which get the requests only
+var priorities sync.Map
+
+// getPriority returns the priority of the instance, normal if the annotation is missing or invalid
+func getPriority(o client.Object) string {
+	switch p := o.GetAnnotations()[priorityAnnotation]; p {
+	case priorityHigh, priorityLow:
+		return p
+	default:
+		return priorityNormal
+	}
+}
+
+// isValidPriority returns false for the annotation values that are not known
+func isValidPriority(o client.Object) bool {
+	p, ok := o.GetAnnotations()[priorityAnnotation]
+	return !ok || p == priorityHigh || p == priorityNormal || p == priorityLow
+}
+
+func priorityKey(o client.Object, req reconcile.Request) string {
+	return fmt.Sprintf("%T/%s", o, req.NamespacedName)
+}
+
+// rememberPriority keeps the priority of the instance for the rate limiter of its controller
+func rememberPriority(o client.Object) {
+	key := priorityKey(o, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(o)})
+	if p := getPriority(o); p != priorityNormal {
+		priorities.Store(key, p)
+	} else {
+		priorities.Delete(key)
+	}
+}
+
+// forgetPriority removes the priority of the deleted instance
+func forgetPriority(o client.Object, req reconcile.Request) {
+	priorities.Delete(priorityKey(o, req))
+}
+
+// priorityRateLimiter shortens the error backoff of the high priority instances and lengthens the one of the low priority
+type priorityRateLimiter struct {
+	workqueue.RateLimiter
+
+	// kind is an instance of the controller kind
+	kind client.Object
+}
+
+func (l *priorityRateLimiter) When(item interface{}) time.Duration {
+	d := l.RateLimiter.When(item)
+	req, ok := item.(reconcile.Request)
+	if !ok {
+		return d
+	}
+
+	p, _ := priorities.Load(priorityKey(l.kind, req))
+	switch p {
+	case priorityHigh:
+		if d > highPriorityMaxRetryDelay {
+			d = highPriorityMaxRetryDelay
+		}
+	case priorityLow:
+		if d < lowPriorityMinRetryDelay {
+			d = lowPriorityMinRetryDelay
+		}
+	}
+	return d
+}
+
+// priorityControllerOptions makes the controller of the kind retry the failed instances by their priority
+func priorityControllerOptions(kind client.Object) controller.Options {
+	return controller.Options{
+		RateLimiter: &priorityRateLimiter{
+			RateLimiter: workqueue.DefaultControllerRateLimiter(),
+			kind:        kind,
+		},
+	}
+}
+
+// prioritySpread scales the startup resync spread by the priority:
+// the high priority instances are reconciled first, the low priority ones after the others
+func prioritySpread(o client.Object, spread time.Duration, random func(time.Duration) time.Duration) time.Duration {
+	switch getPriority(o) {
+	case priorityHigh:
+		return 0
+	case priorityLow:
+		return spread + random(spread)
+	default:
+		return random(spread)
+	}
+}
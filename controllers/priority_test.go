@@ -0,0 +1,81 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+func newPriorityTopic(name, priority string) *v1alpha1.KafkaTopic {
+	topic := &v1alpha1.KafkaTopic{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"}}
+	if priority != "" {
+		topic.Annotations = map[string]string{priorityAnnotation: priority}
+	}
+	return topic
+}
+
+func TestGetPriority(t *testing.T) {
+	assert.Equal(t, priorityNormal, getPriority(newPriorityTopic("a", "")))
+	assert.Equal(t, priorityHigh, getPriority(newPriorityTopic("a", "high")))
+	assert.Equal(t, priorityLow, getPriority(newPriorityTopic("a", "low")))
+	assert.Equal(t, priorityNormal, getPriority(newPriorityTopic("a", "urgent")))
+
+	assert.True(t, isValidPriority(newPriorityTopic("a", "")))
+	assert.True(t, isValidPriority(newPriorityTopic("a", "normal")))
+	assert.False(t, isValidPriority(newPriorityTopic("a", "urgent")))
+}
+
+func TestPrioritySpread(t *testing.T) {
+	half := func(d time.Duration) time.Duration { return d / 2 }
+	spread := 10 * time.Minute
+
+	assert.Equal(t, time.Duration(0), prioritySpread(newPriorityTopic("a", "high"), spread, half))
+	assert.Equal(t, 5*time.Minute, prioritySpread(newPriorityTopic("a", ""), spread, half))
+	assert.Equal(t, 15*time.Minute, prioritySpread(newPriorityTopic("a", "low"), spread, half))
+}
+
+// constantRateLimiter always returns the same delay
+type constantRateLimiter time.Duration
+
+func (c constantRateLimiter) When(interface{}) time.Duration { return time.Duration(c) }
+func (c constantRateLimiter) Forget(interface{})             {}
+func (c constantRateLimiter) NumRequeues(interface{}) int    { return 0 }
+
+func TestPriorityRateLimiter(t *testing.T) {
+	high := newPriorityTopic("high", "high")
+	low := newPriorityTopic("low", "low")
+	normal := newPriorityTopic("normal", "")
+	for _, o := range []*v1alpha1.KafkaTopic{high, low, normal} {
+		rememberPriority(o)
+	}
+
+	request := func(name string) reconcile.Request {
+		return reconcile.Request{NamespacedName: types.NamespacedName{Name: name, Namespace: "default"}}
+	}
+
+	slow := &priorityRateLimiter{RateLimiter: constantRateLimiter(10 * time.Minute), kind: &v1alpha1.KafkaTopic{}}
+	assert.Equal(t, highPriorityMaxRetryDelay, slow.When(request("high")))
+	assert.Equal(t, 10*time.Minute, slow.When(request("low")))
+	assert.Equal(t, 10*time.Minute, slow.When(request("normal")))
+
+	fast := &priorityRateLimiter{RateLimiter: constantRateLimiter(time.Second), kind: &v1alpha1.KafkaTopic{}}
+	assert.Equal(t, time.Second, fast.When(request("high")))
+	assert.Equal(t, lowPriorityMinRetryDelay, fast.When(request("low")))
+	assert.Equal(t, time.Second, fast.When(request("normal")))
+
+	// Another kind with the same name
+	kafka := &priorityRateLimiter{RateLimiter: constantRateLimiter(10 * time.Minute), kind: &v1alpha1.Kafka{}}
+	assert.Equal(t, 10*time.Minute, kafka.When(request("high")))
+
+	// The deleted instances are forgotten
+	forgetPriority(&v1alpha1.KafkaTopic{}, request("high"))
+	assert.Equal(t, 10*time.Minute, slow.When(request("high")))
+}
@@ -0,0 +1,132 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/aiven/aiven-go-client"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+// staticAivenAPI serves the GET responses by their paths
+type staticAivenAPI struct {
+	responses map[string]interface{}
+	requests  []string
+}
+
+func (f *staticAivenAPI) RoundTrip(r *http.Request) (*http.Response, error) {
+	path := strings.TrimPrefix(r.URL.Path, "/v1")
+	f.requests = append(f.requests, r.Method+" "+path)
+
+	rsp := &http.Response{StatusCode: http.StatusOK, Header: make(http.Header), Request: r}
+	out, ok := f.responses[path]
+	if !ok || r.Method != http.MethodGet {
+		rsp.StatusCode = http.StatusNotFound
+		out = map[string]string{"message": "Not found"}
+	}
+	b, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	rsp.Body = io.NopCloser(bytes.NewReader(b))
+	return rsp, nil
+}
+
+func TestProjectAggregateStatusAivenSide(t *testing.T) {
+	pending := aiven.MaintenanceWindow{Updates: []*aiven.MaintenanceUpdate{{Description: "Upgrade"}}}
+	api := &staticAivenAPI{responses: map[string]interface{}{
+		"/project/foo": map[string]interface{}{"project": aiven.Project{Name: "foo", EstimatedBalance: "12.50", AvailableCredits: "100.00"}},
+		"/project/foo/service": map[string]interface{}{"services": []aiven.Service{
+			{Name: "pg1", Type: "pg", Plan: "startup-4", CloudName: "google-europe-west1", Powered: true, DiskSpaceMB: 81920 + 10240, MaintenanceWindow: pending},
+			{Name: "pg2", Type: "pg", Plan: "startup-4", CloudName: "google-europe-west1", Powered: true},
+			{Name: "kafka", Type: "kafka", Plan: "business-4", CloudName: "google-europe-west1", Powered: true, MaintenanceWindow: pending},
+			{Name: "off", Type: "kafka", Plan: "business-4", CloudName: "google-europe-west1", Powered: false},
+		}},
+		"/project/foo/pricing/service-types/pg/plans/startup-4/clouds/google-europe-west1":     map[string]string{"base_price_usd": "0.1000", "extra_disk_price_per_gb_usd": "0.0010"},
+		"/project/foo/pricing/service-types/kafka/plans/business-4/clouds/google-europe-west1": map[string]string{"base_price_usd": "1.5000"},
+		"/project/foo/service-types/pg/plans/startup-4":                                        map[string]int{"disk_space_mb": 81920},
+	}}
+	avn := &aiven.Client{APIKey: "token", Client: &http.Client{Transport: api}}
+	avn.Init()
+
+	project := &v1alpha1.Project{ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "default"}}
+	require.NoError(t, ProjectHandler{}.updateAggregateStatus(avn, project))
+	assert.Equal(t, "12.50", project.Status.EstimatedBalance)
+	assert.Equal(t, []string{"kafka", "pg1"}, project.Status.Aggregate.PendingMaintenance)
+
+	// Two pg plans, one with 10 GB added, and one powered on kafka
+	assert.Equal(t, "1.7100", project.Status.Aggregate.EstimatedHourlyCostUSD)
+
+	// The prices are fetched once per plan
+	assert.Equal(t, 1, countRequests(api.requests, "GET /project/foo/pricing/service-types/pg/plans/startup-4/clouds/google-europe-west1"))
+	assert.Equal(t, 1, countRequests(api.requests, "GET /project/foo/service-types/pg/plans/startup-4"))
+}
+
+func TestProjectAggregateStatusResources(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, v1alpha1.AddToScheme(scheme))
+
+	project := &v1alpha1.Project{ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "default"}}
+	project.Spec.AggregateStatus = true
+	project.Status.Aggregate = &v1alpha1.ProjectAggregateStatus{}
+
+	ready := &v1alpha1.Kafka{ObjectMeta: metav1.ObjectMeta{Name: "ready", Namespace: "default", Generation: 1, Annotations: map[string]string{
+		processedGenerationAnnotation: "1",
+		instanceIsRunningAnnotation:   "true",
+	}}}
+	ready.Spec.Project = "foo"
+
+	creating := &v1alpha1.PostgreSQL{ObjectMeta: metav1.ObjectMeta{Name: "creating", Namespace: "default"}}
+	creating.Spec.Project = "foo"
+
+	failed := &v1alpha1.PostgreSQL{ObjectMeta: metav1.ObjectMeta{Name: "failed", Namespace: "default"}}
+	failed.Spec.Project = "foo"
+	meta.SetStatusCondition(&failed.Status.Conditions, getRunningCondition(metav1.ConditionFalse, "Failed", "boom"))
+
+	otherProject := &v1alpha1.Kafka{ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "default"}}
+	otherProject.Spec.Project = "bar"
+
+	otherNamespace := &v1alpha1.Kafka{ObjectMeta: metav1.ObjectMeta{Name: "ready", Namespace: "other"}}
+	otherNamespace.Spec.Project = "foo"
+
+	objects := []client.Object{project, ready, creating, failed, otherProject, otherNamespace}
+	k8s := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build()
+	r := &ProjectReconciler{Controller: Controller{Client: k8s, Log: logr.Discard(), Scheme: scheme}}
+
+	require.NoError(t, r.updateAggregateStatus(context.Background(), project))
+	stored := &v1alpha1.Project{}
+	require.NoError(t, k8s.Get(context.Background(), types.NamespacedName{Name: "foo", Namespace: "default"}, stored))
+	assert.Equal(t, 1, stored.Status.Aggregate.Ready)
+	assert.Equal(t, 1, stored.Status.Aggregate.NotReady)
+	assert.Equal(t, 1, stored.Status.Aggregate.Error)
+}
+
+// countRequests returns the number of the requests made to the method and path
+func countRequests(list []string, s string) int {
+	n := 0
+	for _, v := range list {
+		if v == s {
+			n++
+		}
+	}
+	return n
+}
@@ -0,0 +1,91 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/aiven/aiven-go-client"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+func TestProjectCACertConfigMap(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, v1alpha1.AddToScheme(scheme))
+
+	project := &v1alpha1.Project{ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "default", UID: "uid"}}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "default"},
+		Data:       map[string][]byte{"CA_CERT": []byte("first"), "ca.crt": []byte("first")},
+	}
+	k8s := fake.NewClientBuilder().WithScheme(scheme).WithObjects(project, secret).Build()
+	r := &ProjectReconciler{Controller: Controller{Client: k8s, Log: logr.Discard(), Scheme: scheme}}
+	ctx := context.Background()
+	key := types.NamespacedName{Name: "foo-ca", Namespace: "default"}
+
+	// No target, no ConfigMap
+	require.NoError(t, r.updateCACertConfigMap(ctx, project))
+	err := k8s.Get(ctx, key, &corev1.ConfigMap{})
+	assert.True(t, apierrors.IsNotFound(err))
+
+	// The ConfigMap is owned by the project
+	project.Spec.CACertConfigMapTarget = &v1alpha1.ConfigMapTarget{Name: "foo-ca"}
+	require.NoError(t, r.updateCACertConfigMap(ctx, project))
+	configMap := &corev1.ConfigMap{}
+	require.NoError(t, k8s.Get(ctx, key, configMap))
+	assert.Equal(t, map[string]string{"ca.crt": "first"}, configMap.Data)
+	require.Len(t, configMap.OwnerReferences, 1)
+	assert.Equal(t, "Project", configMap.OwnerReferences[0].Kind)
+	assert.Equal(t, "foo", configMap.OwnerReferences[0].Name)
+
+	// The rotated CA is copied on the next reconciliation
+	secret.Data = map[string][]byte{"CA_CERT": []byte("second"), "ca.crt": []byte("second")}
+	require.NoError(t, k8s.Update(ctx, secret))
+	require.NoError(t, r.updateCACertConfigMap(ctx, project))
+	require.NoError(t, k8s.Get(ctx, key, configMap))
+	assert.Equal(t, map[string]string{"ca.crt": "second"}, configMap.Data)
+}
+
+// projectCAAPI serves the CA certificate of project foo
+type projectCAAPI struct {
+	cert string
+}
+
+func (f *projectCAAPI) RoundTrip(r *http.Request) (*http.Response, error) {
+	rsp := &http.Response{StatusCode: http.StatusOK, Header: make(http.Header), Request: r}
+	body := fmt.Sprintf(`{"certificate": %q}`, f.cert)
+	if r.URL.Path != "/v1/project/foo/kms/ca" {
+		rsp.StatusCode = http.StatusNotFound
+		body = `{"message": "Not found"}`
+	}
+	rsp.Body = io.NopCloser(strings.NewReader(body))
+	return rsp, nil
+}
+
+func TestProjectCACertSecret(t *testing.T) {
+	avn := &aiven.Client{APIKey: "token", Client: &http.Client{Transport: &projectCAAPI{cert: "cert"}}}
+	avn.Init()
+
+	project := &v1alpha1.Project{ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "default"}}
+	secret, err := ProjectHandler{}.get(avn, project)
+	require.NoError(t, err)
+	assert.Equal(t, "foo", secret.Name)
+	assert.Equal(t, map[string]string{"CA_CERT": "cert", "ca.crt": "cert"}, secret.StringData)
+}
@@ -5,19 +5,29 @@ package controllers
 import (
 	"context"
 	"fmt"
+	"reflect"
 	"regexp"
+	"sort"
 	"strconv"
+	"time"
 
 	"github.com/aiven/aiven-go-client"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
 	"github.com/aiven/aiven-operator/api/v1alpha1"
 )
 
+// caCertRefreshInterval how often the project CA bundle is refreshed to pick up the CA rotation
+const caCertRefreshInterval = time.Hour
+
 // ProjectReconciler reconciles a Project object
 type ProjectReconciler struct {
 	Controller
@@ -28,18 +38,144 @@ type ProjectHandler struct{}
 
 // +kubebuilder:rbac:groups=aiven.io,resources=projects,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=aiven.io,resources=projects/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch;create;update;patch;delete
 
 func (r *ProjectReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
-	return r.reconcileInstance(ctx, req, ProjectHandler{}, &v1alpha1.Project{})
+	result, err := r.reconcileInstance(ctx, req, ProjectHandler{}, &v1alpha1.Project{})
+	if err != nil || !result.IsZero() {
+		return result, err
+	}
+
+	project := &v1alpha1.Project{}
+	if err := r.Get(ctx, req.NamespacedName, project); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	if isMarkedForDeletion(project) || !isAlreadyRunning(project) {
+		return ctrl.Result{}, nil
+	}
+
+	if err := r.updateCACertConfigMap(ctx, project); err != nil {
+		return ctrl.Result{}, fmt.Errorf("unable to update CA certificate ConfigMap: %w", err)
+	}
+
+	if err := r.updateAggregateStatus(ctx, project); err != nil {
+		return ctrl.Result{}, fmt.Errorf("unable to update aggregate status: %w", err)
+	}
+
+	// The secret and the ConfigMap are refreshed on every reconciliation
+	return ctrl.Result{RequeueAfter: jitter(caCertRefreshInterval)}, nil
 }
 
 func (r *ProjectReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&v1alpha1.Project{}).
+		WithOptions(priorityControllerOptions(&v1alpha1.Project{})).
 		Owns(&corev1.Secret{}).
+		Owns(&corev1.ConfigMap{}).
 		Complete(r)
 }
 
+// updateCACertConfigMap copies the project CA certificate from the project secret to the ConfigMap
+func (r *ProjectReconciler) updateCACertConfigMap(ctx context.Context, project *v1alpha1.Project) error {
+	if project.Spec.CACertConfigMapTarget == nil {
+		return nil
+	}
+
+	secret := &corev1.Secret{}
+	name := types.NamespacedName{Name: ProjectHandler{}.getSecretName(project), Namespace: project.Namespace}
+	if err := r.Get(ctx, name, secret); err != nil {
+		return fmt.Errorf("cannot get project secret: %w", err)
+	}
+
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      project.Spec.CACertConfigMapTarget.Name,
+			Namespace: project.Namespace,
+		},
+	}
+	_, err := controllerutil.CreateOrUpdate(ctx, r.Client, configMap, func() error {
+		configMap.Data = map[string]string{"ca.crt": string(secret.Data["CA_CERT"])}
+		return ctrl.SetControllerReference(project, configMap, r.Scheme)
+	})
+	return err
+}
+
+// updateAggregateStatus counts the ready, not ready and failed resources linked to the project in the namespace
+func (r *ProjectReconciler) updateAggregateStatus(ctx context.Context, project *v1alpha1.Project) error {
+	if !project.Spec.AggregateStatus || project.Status.Aggregate == nil {
+		return nil
+	}
+
+	var ready, notReady, failed int
+	for _, t := range r.Scheme.KnownTypes(v1alpha1.GroupVersion) {
+		list, ok := reflect.New(t).Interface().(client.ObjectList)
+		if !ok {
+			continue
+		}
+
+		if _, ok = list.(*v1alpha1.ProjectList); ok {
+			continue
+		}
+
+		if err := r.List(ctx, list, client.InNamespace(project.Namespace)); err != nil {
+			return err
+		}
+
+		items, err := meta.ExtractList(list)
+		if err != nil {
+			return err
+		}
+
+		for _, item := range items {
+			o, ok := item.(client.Object)
+			if !ok {
+				continue
+			}
+
+			u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(o)
+			if err != nil {
+				return err
+			}
+
+			if p, _, _ := unstructured.NestedString(u, "spec", "project"); p != project.Name {
+				continue
+			}
+
+			switch {
+			case isAlreadyProcessed(o) && isAlreadyRunning(o):
+				ready++
+			case isRunningConditionFalse(u):
+				failed++
+			default:
+				notReady++
+			}
+		}
+	}
+
+	aggregate := project.Status.Aggregate
+	if aggregate.Ready == ready && aggregate.NotReady == notReady && aggregate.Error == failed {
+		return nil
+	}
+
+	aggregate.Ready = ready
+	aggregate.NotReady = notReady
+	aggregate.Error = failed
+	return r.Status().Update(ctx, project)
+}
+
+// isRunningConditionFalse returns true if the unstructured object reports a failure in its Running condition
+func isRunningConditionFalse(u map[string]interface{}) bool {
+	conditions, _, _ := unstructured.NestedSlice(u, "status", "conditions")
+	for _, c := range conditions {
+		m, ok := c.(map[string]interface{})
+		if ok && m["type"] == conditionTypeRunning && m["status"] == string(metav1.ConditionFalse) {
+			return true
+		}
+	}
+	return false
+}
+
 func (h ProjectHandler) getLongCardID(client *aiven.Client, cardID string) (*string, error) {
 	if cardID == "" {
 		return nil, nil
@@ -160,6 +296,15 @@ func (h ProjectHandler) get(avn *aiven.Client, i client.Object) (*corev1.Secret,
 		return nil, fmt.Errorf("aiven client error %w", err)
 	}
 
+	if project.Spec.AggregateStatus {
+		err = h.updateAggregateStatus(avn, project)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		project.Status.Aggregate = nil
+	}
+
 	meta.SetStatusCondition(&project.Status.Conditions,
 		getRunningCondition(metav1.ConditionTrue, "CheckRunning",
 			"Instance is running on Aiven side"))
@@ -173,10 +318,104 @@ func (h ProjectHandler) get(avn *aiven.Client, i client.Object) (*corev1.Secret,
 		},
 		StringData: map[string]string{
 			"CA_CERT": cert,
+			"ca.crt":  cert,
 		},
 	}, nil
 }
 
+// updateAggregateStatus refreshes the project balance, the services with pending maintenance updates
+// and the estimated cost of the services. The resources are counted by the reconciler.
+func (h ProjectHandler) updateAggregateStatus(avn *aiven.Client, project *v1alpha1.Project) error {
+	p, err := avn.Projects.Get(project.Name)
+	if err != nil {
+		return err
+	}
+
+	project.Status.EstimatedBalance = p.EstimatedBalance
+	project.Status.AvailableCredits = p.AvailableCredits
+
+	services, err := avn.Services.List(project.Name)
+	if err != nil {
+		return err
+	}
+
+	pending := make([]string, 0)
+	for _, s := range services {
+		if len(s.MaintenanceWindow.Updates) > 0 {
+			pending = append(pending, s.Name)
+		}
+	}
+	sort.Strings(pending)
+
+	cost, err := h.estimateHourlyCost(avn, project.Name, services)
+	if err != nil {
+		return err
+	}
+
+	if project.Status.Aggregate == nil {
+		project.Status.Aggregate = new(v1alpha1.ProjectAggregateStatus)
+	}
+	project.Status.Aggregate.PendingMaintenance = pending
+	project.Status.Aggregate.EstimatedHourlyCostUSD = strconv.FormatFloat(cost, 'f', 4, 64)
+	return nil
+}
+
+// estimateHourlyCost sums up the hourly prices of the powered on services in USD:
+// the plan price and the price of the disk space added on top of the plan.
+// The plans are fetched once per service type, plan and cloud
+func (h ProjectHandler) estimateHourlyCost(avn *aiven.Client, project string, services []*aiven.Service) (float64, error) {
+	type planKey struct{ serviceType, plan, cloud string }
+	plans := make(map[planKey]*aiven.GetServicePlanResponse)
+	prices := make(map[planKey]*aiven.GetServicePlanPricingResponse)
+
+	var cost float64
+	for _, s := range services {
+		if !s.Powered {
+			continue
+		}
+
+		key := planKey{serviceType: s.Type, plan: s.Plan, cloud: s.CloudName}
+		price, ok := prices[key]
+		if !ok {
+			var err error
+			price, err = avn.ServiceTypes.GetPlanPricing(project, s.Type, s.Plan, s.CloudName)
+			if err != nil {
+				return 0, fmt.Errorf("cannot get %s plan %q pricing: %w", s.Type, s.Plan, err)
+			}
+			prices[key] = price
+		}
+
+		base, err := strconv.ParseFloat(price.BasePriceUSD, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid %s plan %q price %q: %w", s.Type, s.Plan, price.BasePriceUSD, err)
+		}
+		cost += base
+
+		if s.DiskSpaceMB == 0 || price.ExtraDiskPricePerGBUSD == "" {
+			continue
+		}
+
+		key.cloud = ""
+		plan, ok := plans[key]
+		if !ok {
+			plan, err = avn.ServiceTypes.GetPlan(project, s.Type, s.Plan)
+			if err != nil {
+				return 0, fmt.Errorf("cannot get %s plan %q: %w", s.Type, s.Plan, err)
+			}
+			plans[key] = plan
+		}
+
+		if extra := s.DiskSpaceMB - plan.DiskSpaceMB; extra > 0 {
+			perGB, err := strconv.ParseFloat(price.ExtraDiskPricePerGBUSD, 64)
+			if err != nil {
+				return 0, fmt.Errorf("invalid %s plan %q disk price %q: %w", s.Type, s.Plan, price.ExtraDiskPricePerGBUSD, err)
+			}
+			cost += perGB * float64(extra) / 1024
+		}
+	}
+	return cost, nil
+}
+
 // exists checks if project already exists on Aiven side
 func (h ProjectHandler) exists(avn *aiven.Client, project *v1alpha1.Project) (bool, error) {
 	pr, err := avn.Projects.Get(project.Name)
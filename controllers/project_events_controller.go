@@ -0,0 +1,219 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aiven/aiven-go-client"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+const (
+	// projectEventsPollInterval how often the project event log is polled
+	projectEventsPollInterval = 5 * time.Minute
+
+	// lastProjectEventAnnotation keeps the time of the last emitted project event
+	lastProjectEventAnnotation = "controllers.aiven.io/last-project-event-time"
+)
+
+// ProjectEventsReconciler polls the Aiven project event log and emits its entries
+// as Kubernetes Events on the Project and on the corresponding service resources
+type ProjectEventsReconciler struct {
+	Controller
+}
+
+func (r *ProjectEventsReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	project := &v1alpha1.Project{}
+	if err := r.Get(ctx, req.NamespacedName, project); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	if isMarkedForDeletion(project) {
+		return ctrl.Result{}, nil
+	}
+
+	if !isAlreadyRunning(project) {
+		return ctrl.Result{RequeueAfter: jitter(projectEventsPollInterval)}, nil
+	}
+
+	avn, err := r.newAivenClient(ctx, project)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	events, err := avn.Projects.GetEventLog(project.Name)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("cannot get project event log: %w", err)
+	}
+
+	if err = r.emitEvents(ctx, project, events); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{RequeueAfter: jitter(projectEventsPollInterval)}, nil
+}
+
+func (r *ProjectEventsReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	// The annotation updates must not trigger polling
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("project-events").
+		For(&v1alpha1.Project{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Complete(r)
+}
+
+// emitEvents records the events that happened after the last emitted one.
+// On the first run it only remembers the time of the latest event, so the history is not replayed.
+func (r *ProjectEventsReconciler) emitEvents(ctx context.Context, project *v1alpha1.Project, events []*aiven.ProjectEvent) error {
+	type projectEvent struct {
+		*aiven.ProjectEvent
+		time time.Time
+	}
+
+	list := make([]projectEvent, 0, len(events))
+	for _, e := range events {
+		t, err := time.Parse(time.RFC3339, e.Time)
+		if err != nil {
+			r.Log.Info("cannot parse project event time", "time", e.Time, "error", err)
+			continue
+		}
+		list = append(list, projectEvent{ProjectEvent: e, time: t})
+	}
+
+	if len(list) == 0 {
+		return nil
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].time.Before(list[j].time)
+	})
+
+	latest := list[len(list)-1].time
+	lastEmitted, ok := project.GetAnnotations()[lastProjectEventAnnotation]
+	if ok {
+		since, err := time.Parse(time.RFC3339, lastEmitted)
+		if err != nil {
+			return fmt.Errorf("invalid %s annotation: %w", lastProjectEventAnnotation, err)
+		}
+
+		if !latest.After(since) {
+			return nil
+		}
+
+		for _, e := range list {
+			if !e.time.After(since) {
+				continue
+			}
+
+			o, err := r.getEventObject(ctx, project, e.ServiceName)
+			if err != nil {
+				return err
+			}
+
+			message := e.EventDesc
+			if e.Actor != "" {
+				message = fmt.Sprintf("%s (by %s)", message, e.Actor)
+			}
+			r.Recorder.Event(o, corev1.EventTypeNormal, projectEventReason(e.EventType), message)
+		}
+	}
+
+	patch := client.MergeFrom(project.DeepCopy())
+	metav1.SetMetaDataAnnotation(&project.ObjectMeta, lastProjectEventAnnotation, latest.Format(time.RFC3339Nano))
+	return r.Patch(ctx, project, patch)
+}
+
+// getEventObject returns the service resource the event belongs to, or the project when there is no such
+func (r *ProjectEventsReconciler) getEventObject(ctx context.Context, project *v1alpha1.Project, serviceName string) (client.Object, error) {
+	if serviceName == "" {
+		return project, nil
+	}
+
+	services := []client.Object{
+		&v1alpha1.Cassandra{},
+		&v1alpha1.Clickhouse{},
+		&v1alpha1.Dragonfly{},
+		&v1alpha1.Grafana{},
+		&v1alpha1.Kafka{},
+		&v1alpha1.KafkaConnect{},
+		&v1alpha1.M3Aggregator{},
+		&v1alpha1.M3DB{},
+		&v1alpha1.MySQL{},
+		&v1alpha1.OpenSearch{},
+		&v1alpha1.PostgreSQL{},
+		&v1alpha1.Redis{},
+		&v1alpha1.Thanos{},
+		&v1alpha1.Valkey{},
+	}
+
+	for _, o := range services {
+		err := r.Get(ctx, types.NamespacedName{Name: serviceName, Namespace: project.Namespace}, o)
+		if apierrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(o)
+		if err != nil {
+			return nil, err
+		}
+
+		p, _, _ := unstructured.NestedString(u, "spec", "project")
+		if p == project.Name {
+			return o, nil
+		}
+	}
+
+	return project, nil
+}
+
+// newAivenClient creates a client with the default token or with the token from the object auth secret
+func (r *ProjectEventsReconciler) newAivenClient(ctx context.Context, o aivenManagedObject) (*aiven.Client, error) {
+	token := r.DefaultToken
+	if len(token) == 0 {
+		secret := &corev1.Secret{}
+		if err := r.Get(ctx, types.NamespacedName{Name: o.AuthSecretRef().Name, Namespace: o.GetNamespace()}, secret); err != nil {
+			return nil, fmt.Errorf("cannot get secret %q: %w", o.AuthSecretRef().Name, err)
+		}
+		token = string(secret.Data[o.AuthSecretRef().Key])
+	}
+
+	avn, err := r.Controller.newAivenClient(token)
+	if err != nil {
+		return nil, fmt.Errorf("cannot initialize aiven client: %w", err)
+	}
+	return avn, nil
+}
+
+// projectEventReason converts event type to event reason, e.g. service_maintenance_start -> ServiceMaintenanceStart
+func projectEventReason(eventType string) string {
+	var reason strings.Builder
+	for _, s := range strings.Split(eventType, "_") {
+		if s == "" {
+			continue
+		}
+		reason.WriteString(strings.ToUpper(s[:1]) + s[1:])
+	}
+
+	if reason.Len() == 0 {
+		return "ProjectEvent"
+	}
+	return reason.String()
+}
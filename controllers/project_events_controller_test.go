@@ -0,0 +1,126 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aiven/aiven-go-client"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+// recordedEvents returns the events recorded so far
+func recordedEvents(recorder *record.FakeRecorder) []string {
+	events := make([]string, 0)
+	for {
+		select {
+		case e := <-recorder.Events:
+			events = append(events, e)
+		default:
+			return events
+		}
+	}
+}
+
+func TestProjectEventsEmit(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, v1alpha1.AddToScheme(scheme))
+
+	project := &v1alpha1.Project{ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "default"}}
+	kafka := &v1alpha1.Kafka{ObjectMeta: metav1.ObjectMeta{Name: "kafka", Namespace: "default"}}
+	kafka.Spec.Project = "foo"
+	other := &v1alpha1.PostgreSQL{ObjectMeta: metav1.ObjectMeta{Name: "pg", Namespace: "default"}}
+	other.Spec.Project = "bar"
+
+	k8s := fake.NewClientBuilder().WithScheme(scheme).WithObjects(project, kafka, other).Build()
+	recorder := record.NewFakeRecorder(100)
+	r := &ProjectEventsReconciler{Controller: Controller{Client: k8s, Log: logr.Discard(), Scheme: scheme, Recorder: recorder}}
+	ctx := context.Background()
+
+	events := []*aiven.ProjectEvent{
+		{EventType: "service_create", EventDesc: "Created service kafka", ServiceName: "kafka", Time: "2023-01-30T10:00:00Z"},
+		{EventType: "project_update", EventDesc: "Updated project", Actor: "admin@example.com", Time: "2023-01-30T09:00:00Z"},
+	}
+
+	// The first run doesn't replay the history, only remembers the latest event
+	require.NoError(t, r.emitEvents(ctx, project, events))
+	assert.Empty(t, recordedEvents(recorder))
+	assert.Equal(t, "2023-01-30T10:00:00Z", project.Annotations[lastProjectEventAnnotation])
+
+	stored := &v1alpha1.Project{}
+	require.NoError(t, k8s.Get(ctx, types.NamespacedName{Name: "foo", Namespace: "default"}, stored))
+	assert.Equal(t, "2023-01-30T10:00:00Z", stored.Annotations[lastProjectEventAnnotation])
+
+	// Only the newer events are emitted, in order, on the resources they belong to
+	events = append(events,
+		&aiven.ProjectEvent{EventType: "service_maintenance_start", EventDesc: "Maintenance started", ServiceName: "kafka", Time: "2023-01-30T12:00:00Z"},
+		&aiven.ProjectEvent{EventType: "service_delete", EventDesc: "Deleted service pg", ServiceName: "pg", Actor: "admin@example.com", Time: "2023-01-30T11:00:00Z"},
+		&aiven.ProjectEvent{EventType: "service_update", EventDesc: "Invalid time", ServiceName: "kafka", Time: "yesterday"},
+	)
+	require.NoError(t, r.emitEvents(ctx, project, events))
+	assert.Equal(t, []string{
+		"Normal ServiceDelete Deleted service pg (by admin@example.com)",
+		"Normal ServiceMaintenanceStart Maintenance started",
+	}, recordedEvents(recorder))
+	assert.Equal(t, "2023-01-30T12:00:00Z", project.Annotations[lastProjectEventAnnotation])
+
+	// The events already emitted are not emitted again
+	require.NoError(t, r.emitEvents(ctx, project, events))
+	assert.Empty(t, recordedEvents(recorder))
+}
+
+func TestProjectEventsObject(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, v1alpha1.AddToScheme(scheme))
+
+	project := &v1alpha1.Project{ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "default"}}
+	kafka := &v1alpha1.Kafka{ObjectMeta: metav1.ObjectMeta{Name: "kafka", Namespace: "default"}}
+	kafka.Spec.Project = "foo"
+	other := &v1alpha1.PostgreSQL{ObjectMeta: metav1.ObjectMeta{Name: "pg", Namespace: "default"}}
+	other.Spec.Project = "bar"
+
+	k8s := fake.NewClientBuilder().WithScheme(scheme).WithObjects(project, kafka, other).Build()
+	r := &ProjectEventsReconciler{Controller: Controller{Client: k8s, Log: logr.Discard(), Scheme: scheme}}
+	ctx := context.Background()
+
+	cases := map[string]string{
+		"":        "Project/foo",
+		"kafka":   "Kafka/kafka",
+		"pg":      "Project/foo", // of another project
+		"missing": "Project/foo",
+	}
+	for serviceName, expected := range cases {
+		o, err := r.getEventObject(ctx, project, serviceName)
+		require.NoError(t, err)
+		kind := "Project"
+		if _, ok := o.(*v1alpha1.Kafka); ok {
+			kind = "Kafka"
+		}
+		assert.Equal(t, expected, kind+"/"+o.GetName(), serviceName)
+	}
+}
+
+func TestProjectEventReason(t *testing.T) {
+	cases := map[string]string{
+		"service_maintenance_start": "ServiceMaintenanceStart",
+		"service_create":            "ServiceCreate",
+		"_leading__underscores_":    "LeadingUnderscores",
+		"":                          "ProjectEvent",
+	}
+	for eventType, reason := range cases {
+		assert.Equal(t, reason, projectEventReason(eventType), eventType)
+	}
+}
@@ -0,0 +1,168 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/aiven/aiven-go-client"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	conditionTypeProjectUnavailable = "ProjectUnavailable"
+	eventProjectUnavailable         = "ProjectUnavailable"
+
+	// projectUnavailableRetry is how often the resources of an unavailable project are retried
+	projectUnavailableRetry = 30 * time.Minute
+)
+
+// unavailableProjects are the projects that are deleted or not accessible with the token of the resources
+var unavailableProjects = newProjectAvailability()
+
+// projectAvailability remembers the unavailable projects,
+// so their resources are suspended until the next retry instead of failing on every reconciliation
+type projectAvailability struct {
+	mu    sync.Mutex
+	until map[string]time.Time
+	now   func() time.Time
+}
+
+func newProjectAvailability() *projectAvailability {
+	return &projectAvailability{until: make(map[string]time.Time), now: time.Now}
+}
+
+// markUnavailable suspends the resources of the project for the projectUnavailableRetry
+func (p *projectAvailability) markUnavailable(project string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.until[project] = p.now().Add(projectUnavailableRetry)
+}
+
+// unavailableFor returns how long the resources of the project are suspended, zero if the project is available
+func (p *projectAvailability) unavailableFor(project string) time.Duration {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	until, ok := p.until[project]
+	if !ok {
+		return 0
+	}
+
+	left := until.Sub(p.now())
+	if left <= 0 {
+		// The next reconciliation tells if the project is back
+		delete(p.until, project)
+		return 0
+	}
+	return left
+}
+
+// isProjectAccessError returns true for the errors a missing project or a revoked access results in
+func isProjectAccessError(err error) bool {
+	status := 0
+	var e aiven.Error
+	var apiErr *aivenAPIError
+	switch {
+	case errors.As(err, &e):
+		status = e.Status
+	case errors.As(err, &apiErr):
+		status = apiErr.Status
+	}
+	return status == http.StatusNotFound || status == http.StatusForbidden
+}
+
+// checkProjectAvailable returns an error if the project is deleted or not accessible with the client
+func checkProjectAvailable(avn *aiven.Client, project string) error {
+	_, err := avn.Projects.Get(project)
+	if isProjectAccessError(err) {
+		return fmt.Errorf("project %q is deleted or not accessible: %w", project, err)
+	}
+	// Any other error doesn't tell about the project
+	return nil
+}
+
+// objectProject returns the Aiven project of the object from its spec.project, empty for the Project kind
+func objectProject(o client.Object) string {
+	v := reflect.Indirect(reflect.ValueOf(o))
+	if v.Kind() != reflect.Struct {
+		return ""
+	}
+
+	spec := v.FieldByName("Spec")
+	if !spec.IsValid() || spec.Kind() != reflect.Struct {
+		return ""
+	}
+
+	project := spec.FieldByName("Project")
+	if !project.IsValid() || project.Kind() != reflect.String {
+		return ""
+	}
+	return project.String()
+}
+
+// objectConditions returns the status conditions of the object, nil if it has none
+func objectConditions(o client.Object) *[]metav1.Condition {
+	v := reflect.Indirect(reflect.ValueOf(o))
+	if v.Kind() != reflect.Struct {
+		return nil
+	}
+
+	status := v.FieldByName("Status")
+	if !status.IsValid() || status.Kind() != reflect.Struct {
+		return nil
+	}
+
+	conditions := status.FieldByName("Conditions")
+	if !conditions.IsValid() || !conditions.CanAddr() {
+		return nil
+	}
+
+	c, ok := conditions.Addr().Interface().(*[]metav1.Condition)
+	if !ok {
+		return nil
+	}
+	return c
+}
+
+// suspendForUnavailableProject sets the ProjectUnavailable condition and requeues the instance after the retry interval
+func (c *Controller) suspendForUnavailableProject(ctx context.Context, o client.Object, project string, after time.Duration, reason error) (ctrl.Result, error) {
+	result := ctrl.Result{RequeueAfter: jitter(after)}
+	conditions := objectConditions(o)
+	if conditions == nil || meta.IsStatusConditionTrue(*conditions, conditionTypeProjectUnavailable) {
+		return result, nil
+	}
+
+	message := fmt.Sprintf("Project %q is deleted or not accessible, retrying every %s", project, projectUnavailableRetry)
+	if reason != nil {
+		message = fmt.Sprintf("%s: %s", message, reason)
+	}
+	newOwnerEventRecorder(c.Recorder).Event(o, corev1.EventTypeWarning, eventProjectUnavailable, message)
+	meta.SetStatusCondition(conditions, metav1.Condition{
+		Type:    conditionTypeProjectUnavailable,
+		Status:  metav1.ConditionTrue,
+		Reason:  "ProjectNotAccessible",
+		Message: message,
+	})
+	return result, c.Status().Update(ctx, o)
+}
+
+// clearProjectUnavailable removes the ProjectUnavailable condition once the project is back
+func (c *Controller) clearProjectUnavailable(ctx context.Context, o client.Object) error {
+	conditions := objectConditions(o)
+	if conditions == nil || meta.FindStatusCondition(*conditions, conditionTypeProjectUnavailable) == nil {
+		return nil
+	}
+
+	meta.RemoveStatusCondition(conditions, conditionTypeProjectUnavailable)
+	return c.Status().Update(ctx, o)
+}
@@ -0,0 +1,68 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/aiven/aiven-go-client"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+func TestProjectAvailability(t *testing.T) {
+	now := time.Now()
+	p := newProjectAvailability()
+	p.now = func() time.Time { return now }
+
+	assert.Zero(t, p.unavailableFor("foo"))
+
+	p.markUnavailable("foo")
+	assert.Equal(t, projectUnavailableRetry, p.unavailableFor("foo"))
+	assert.Zero(t, p.unavailableFor("bar"))
+
+	now = now.Add(projectUnavailableRetry - time.Minute)
+	assert.Equal(t, time.Minute, p.unavailableFor("foo"))
+
+	// Retried after the interval
+	now = now.Add(time.Minute)
+	assert.Zero(t, p.unavailableFor("foo"))
+	assert.NotContains(t, p.until, "foo")
+}
+
+func TestIsProjectAccessError(t *testing.T) {
+	assert.True(t, isProjectAccessError(aiven.Error{Status: http.StatusNotFound}))
+	assert.True(t, isProjectAccessError(fmt.Errorf("unable to create or update instance at aiven: %w", aiven.Error{Status: http.StatusForbidden})))
+	assert.True(t, isProjectAccessError(&aivenAPIError{Status: http.StatusForbidden}))
+	assert.False(t, isProjectAccessError(aiven.Error{Status: http.StatusInternalServerError}))
+	assert.False(t, isProjectAccessError(fmt.Errorf("foo")))
+	assert.False(t, isProjectAccessError(nil))
+}
+
+func TestObjectProject(t *testing.T) {
+	pg := &v1alpha1.PostgreSQL{}
+	pg.Spec.Project = "foo"
+	assert.Equal(t, "foo", objectProject(pg))
+
+	topic := &v1alpha1.KafkaTopic{}
+	topic.Spec.Project = "bar"
+	assert.Equal(t, "bar", objectProject(topic))
+
+	// The project is the resource itself
+	assert.Equal(t, "", objectProject(&v1alpha1.Project{}))
+}
+
+func TestObjectConditions(t *testing.T) {
+	pg := &v1alpha1.PostgreSQL{}
+	conditions := objectConditions(pg)
+	if assert.NotNil(t, conditions) {
+		meta.SetStatusCondition(conditions, metav1.Condition{Type: conditionTypeProjectUnavailable, Status: metav1.ConditionTrue, Reason: "ProjectNotAccessible"})
+		assert.True(t, meta.IsStatusConditionTrue(pg.Status.Conditions, conditionTypeProjectUnavailable))
+	}
+}
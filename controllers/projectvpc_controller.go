@@ -6,6 +6,7 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"time"
 
 	"github.com/aiven/aiven-go-client"
 	corev1 "k8s.io/api/core/v1"
@@ -22,6 +23,14 @@ var isDependencyError = v1alpha1.ErrorSubstrChecker(
 	"VPC cannot be deleted while there are services migrating from it",
 )
 
+const (
+	// projectVPCPeeringRefreshInterval how often the peering connections are refreshed
+	projectVPCPeeringRefreshInterval = time.Hour
+
+	// projectVPCPendingPeeringRefreshInterval how often the peering connections are refreshed while some of them are not active
+	projectVPCPendingPeeringRefreshInterval = time.Minute * 5
+)
+
 // ProjectVPCReconciler reconciles a ProjectVPC object
 type ProjectVPCReconciler struct {
 	Controller
@@ -33,12 +42,33 @@ type ProjectVPCHandler struct{}
 // +kubebuilder:rbac:groups=aiven.io,resources=projectvpcs/status,verbs=get;update;patch
 
 func (r *ProjectVPCReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
-	return r.reconcileInstance(ctx, req, ProjectVPCHandler{}, &v1alpha1.ProjectVPC{})
+	result, err := r.reconcileInstance(ctx, req, ProjectVPCHandler{}, &v1alpha1.ProjectVPC{})
+	if err != nil || !result.IsZero() {
+		return result, err
+	}
+
+	projectVPC := &v1alpha1.ProjectVPC{}
+	if err := r.Get(ctx, req.NamespacedName, projectVPC); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	if isMarkedForDeletion(projectVPC) || !isAlreadyRunning(projectVPC) {
+		return ctrl.Result{}, nil
+	}
+
+	// The peering connections are managed outside the resource, they are refreshed periodically
+	for _, pc := range projectVPC.Status.PeeringConnections {
+		if pc.State != "ACTIVE" {
+			return ctrl.Result{RequeueAfter: jitter(projectVPCPendingPeeringRefreshInterval)}, nil
+		}
+	}
+	return ctrl.Result{RequeueAfter: jitter(projectVPCPeeringRefreshInterval)}, nil
 }
 
 func (r *ProjectVPCReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&v1alpha1.ProjectVPC{}).
+		WithOptions(priorityControllerOptions(&v1alpha1.ProjectVPC{})).
 		Complete(r)
 }
 
@@ -138,8 +168,23 @@ func (h ProjectVPCHandler) get(avn *aiven.Client, i client.Object) (*corev1.Secr
 		return nil, err
 	}
 
+	if vpc == nil {
+		return nil, nil
+	}
+
 	projectVPC.Status.State = vpc.State
 	if vpc.State == "ACTIVE" {
+		// The list of the VPCs doesn't have the peering connections
+		vpc, err = avn.VPCs.Get(projectVPC.Spec.Project, vpc.ProjectVPCID)
+		if err != nil {
+			return nil, err
+		}
+
+		projectVPC.Status.PeeringConnections = nil
+		for _, pc := range vpc.PeeringConnections {
+			projectVPC.Status.PeeringConnections = append(projectVPC.Status.PeeringConnections, newProjectVPCPeeringConnection(vpc, pc))
+		}
+
 		meta.SetStatusCondition(&projectVPC.Status.Conditions,
 			getRunningCondition(metav1.ConditionTrue, "CheckRunning",
 				"Instance is running on Aiven side"))
@@ -150,6 +195,56 @@ func (h ProjectVPCHandler) get(avn *aiven.Client, i client.Object) (*corev1.Secr
 	return nil, nil
 }
 
+// newProjectVPCPeeringConnection returns the status of the peering connection,
+// with a message of what to do if it is not active
+func newProjectVPCPeeringConnection(vpc *aiven.VPC, pc *aiven.VPCPeeringConnection) v1alpha1.ProjectVPCPeeringConnection {
+	status := v1alpha1.ProjectVPCPeeringConnection{
+		PeerCloudAccount:     pc.PeerCloudAccount,
+		PeerVPC:              pc.PeerVPC,
+		State:                pc.State,
+		UserPeerNetworkCIDRs: pc.UserPeerNetworkCIDRs,
+	}
+	if pc.PeerRegion != nil {
+		status.PeerRegion = *pc.PeerRegion
+	}
+
+	// Aiven tells the details of the state, e.g. the AWS peering connection ID or the validation error
+	info := make(map[string]interface{})
+	if pc.StateInfo != nil {
+		info = *pc.StateInfo
+	}
+	details, _ := info["message"].(string)
+
+	switch pc.State {
+	case "ACTIVE":
+	case "APPROVED":
+		status.Message = "Aiven is creating the peering connection"
+	case "PENDING_PEER":
+		status.Message = fmt.Sprintf("Accept the peering connection in the cloud account %q of the peer VPC", pc.PeerCloudAccount)
+		if id, ok := info["aws_vpc_peering_connection_id"].(string); ok {
+			status.Message += fmt.Sprintf(", its AWS peering connection ID is %q", id)
+		}
+	case "INVALID_SPECIFICATION":
+		status.Message = fmt.Sprintf("Aiven can't create the peering connection. "+
+			"Check that the peer VPC exists, the cloud account is right, and its network doesn't overlap with the project VPC network %s", vpc.NetworkCIDR)
+	case "REJECTED_BY_PEER":
+		status.Message = "The peer rejected the peering connection. Delete the peering connection and create it again to retry"
+	case "DELETED_BY_PEER":
+		status.Message = "The peer deleted the peering connection. Delete the peering connection and create it again to restore it"
+	case "PENDING_VALIDATION":
+		status.Message = "Aiven is validating the peering connection"
+	}
+
+	if details != "" {
+		if status.Message == "" {
+			status.Message = details
+		} else {
+			status.Message += ". " + details
+		}
+	}
+	return status
+}
+
 func (h ProjectVPCHandler) checkPreconditions(_ *aiven.Client, _ client.Object) (bool, error) {
 	return true, nil
 }
@@ -0,0 +1,65 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"testing"
+
+	"github.com/aiven/aiven-go-client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewProjectVPCPeeringConnection(t *testing.T) {
+	vpc := &aiven.VPC{NetworkCIDR: "10.0.0.0/24"}
+	region := "eu-west-1"
+
+	cases := []struct {
+		name    string
+		pc      *aiven.VPCPeeringConnection
+		message string
+	}{
+		{
+			name:    "active",
+			pc:      &aiven.VPCPeeringConnection{State: "ACTIVE"},
+			message: "",
+		},
+		{
+			name: "pending peer",
+			pc: &aiven.VPCPeeringConnection{
+				PeerCloudAccount: "123456789012",
+				State:            "PENDING_PEER",
+				StateInfo:        &map[string]interface{}{"aws_vpc_peering_connection_id": "pcx-1"},
+			},
+			message: `Accept the peering connection in the cloud account "123456789012" of the peer VPC, its AWS peering connection ID is "pcx-1"`,
+		},
+		{
+			name: "overlapping network",
+			pc: &aiven.VPCPeeringConnection{
+				State:     "INVALID_SPECIFICATION",
+				StateInfo: &map[string]interface{}{"message": "Peer VPC CIDR overlaps with the project VPC"},
+			},
+			message: "Aiven can't create the peering connection. Check that the peer VPC exists, the cloud account is right, " +
+				"and its network doesn't overlap with the project VPC network 10.0.0.0/24. Peer VPC CIDR overlaps with the project VPC",
+		},
+		{
+			name: "unknown state",
+			pc: &aiven.VPCPeeringConnection{
+				State:     "SOMETHING_NEW",
+				StateInfo: &map[string]interface{}{"message": "details"},
+			},
+			message: "details",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			c.pc.PeerVPC = "vpc-1"
+			c.pc.PeerRegion = &region
+			status := newProjectVPCPeeringConnection(vpc, c.pc)
+			assert.Equal(t, c.message, status.Message)
+			assert.Equal(t, c.pc.State, status.State)
+			assert.Equal(t, "vpc-1", status.PeerVPC)
+			assert.Equal(t, region, status.PeerRegion)
+		})
+	}
+}
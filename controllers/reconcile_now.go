@@ -0,0 +1,47 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// reconcileNowAnnotation is an RFC3339 time, a new value forces a full reconciliation of the instance
+	reconcileNowAnnotation = "aiven.io/reconcile-now"
+
+	// reconcileNowHandledAnnotation is the last reconcileNowAnnotation value handled
+	reconcileNowHandledAnnotation = "controllers.aiven.io/reconcile-now-handled"
+
+	eventInvalidReconcileNow   = "InvalidReconcileNow"
+	eventReconcileNowRequested = "ReconcileNowRequested"
+)
+
+// checkReconcileNow handles a new reconcile-now annotation value:
+// the processed generation is reset, so the instance is created or updated on Aiven side again
+// right away, without waiting for the resync and the Aiven API budget.
+// The events are emitted with the recorder of the instance, so they reach its owner too.
+// Returns true if the reconciliation is forced.
+func (c *Controller) checkReconcileNow(o client.Object, rec record.EventRecorder) bool {
+	a := o.GetAnnotations()
+	value, ok := a[reconcileNowAnnotation]
+	if !ok || value == a[reconcileNowHandledAnnotation] || isMarkedForDeletion(o) {
+		return false
+	}
+
+	if _, err := time.Parse(time.RFC3339, value); err != nil {
+		rec.Eventf(o, corev1.EventTypeWarning, eventInvalidReconcileNow, "invalid %s annotation: %s", reconcileNowAnnotation, err)
+		return false
+	}
+
+	// The annotations are saved with the instance status, a failed reconciliation is forced again
+	a[reconcileNowHandledAnnotation] = value
+	delete(a, processedGenerationAnnotation)
+	o.SetAnnotations(a)
+	rec.Eventf(o, corev1.EventTypeNormal, eventReconcileNowRequested, "reconciliation requested at %s", value)
+	return true
+}
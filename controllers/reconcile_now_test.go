@@ -0,0 +1,50 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+func TestCheckReconcileNow(t *testing.T) {
+	recorder := record.NewFakeRecorder(10)
+	c := &Controller{Recorder: recorder}
+	rec := newOwnerEventRecorder(c.Recorder)
+	topic := &v1alpha1.KafkaTopic{ObjectMeta: metav1.ObjectMeta{
+		Name:       "a",
+		Namespace:  "default",
+		Generation: 2,
+		Annotations: map[string]string{
+			processedGenerationAnnotation: "2",
+			instanceIsRunningAnnotation:   "true",
+		},
+	}}
+
+	// No annotation
+	assert.False(t, c.checkReconcileNow(topic, rec))
+	assert.True(t, isAlreadyProcessed(topic))
+
+	// A new value resets the processed generation
+	topic.Annotations[reconcileNowAnnotation] = "2023-02-01T12:00:00Z"
+	assert.True(t, c.checkReconcileNow(topic, rec))
+	assert.False(t, isAlreadyProcessed(topic))
+	assert.Equal(t, "2023-02-01T12:00:00Z", topic.Annotations[reconcileNowHandledAnnotation])
+
+	// The same value is handled once
+	topic.Annotations[processedGenerationAnnotation] = "2"
+	assert.False(t, c.checkReconcileNow(topic, rec))
+	assert.True(t, isAlreadyProcessed(topic))
+
+	// Not a time
+	topic.Annotations[reconcileNowAnnotation] = "now"
+	assert.False(t, c.checkReconcileNow(topic, rec))
+	assert.True(t, isAlreadyProcessed(topic))
+	assert.Contains(t, <-recorder.Events, "ReconcileNowRequested")
+	assert.Contains(t, <-recorder.Events, "InvalidReconcileNow")
+}
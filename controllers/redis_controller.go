@@ -5,6 +5,8 @@ package controllers
 import (
 	"context"
 	"fmt"
+	"net"
+	"net/url"
 
 	"github.com/aiven/aiven-go-client"
 	corev1 "k8s.io/api/core/v1"
@@ -15,6 +17,9 @@ import (
 	"github.com/aiven/aiven-operator/api/v1alpha1"
 )
 
+// redisDefaultDB is the database index clients connect to by default
+const redisDefaultDB = "0"
+
 // RedisReconciler reconciles a Redis object
 type RedisReconciler struct {
 	Controller
@@ -26,13 +31,14 @@ type RedisHandler struct{}
 //+kubebuilder:rbac:groups=aiven.io,resources=redis/status,verbs=get;update;patch
 
 func (r *RedisReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
-	return r.reconcileInstance(ctx, req, newGenericServiceHandler(newRedisAdapter), &v1alpha1.Redis{})
+	return r.reconcileInstance(ctx, req, newGenericServiceHandler(newRedisAdapter, r.Recorder), &v1alpha1.Redis{})
 }
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *RedisReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&v1alpha1.Redis{}).
+		WithOptions(priorityControllerOptions(&v1alpha1.Redis{})).
 		Owns(&corev1.Secret{}).
 		Complete(r)
 }
@@ -78,6 +84,25 @@ func (a *redisAdapter) newSecret(s *aiven.Service) (*corev1.Secret, error) {
 		"PORT":     s.URIParams["port"],
 		"SSL":      s.URIParams["ssl"],
 		"USER":     s.URIParams["user"],
+		"DB":       redisDefaultDB,
+	}
+
+	if s.URIParams["host"] != "" {
+		scheme := "rediss"
+		if !a.sslEnforced() {
+			scheme = "redis"
+		}
+
+		u := url.URL{
+			Scheme: scheme,
+			User:   url.UserPassword(s.URIParams["user"], s.URIParams["password"]),
+			Host:   net.JoinHostPort(s.URIParams["host"], s.URIParams["port"]),
+			Path:   "/" + redisDefaultDB,
+		}
+		stringData["URI"] = u.String()
+
+		// The name the Redis clients and buildpacks look for
+		stringData["REDIS_URI"] = u.String()
 	}
 
 	// Removes empties
@@ -93,6 +118,14 @@ func (a *redisAdapter) newSecret(s *aiven.Service) (*corev1.Secret, error) {
 	}, nil
 }
 
+// sslEnforced returns false if SSL is disabled in the user config, Aiven enforces it by default
+func (a *redisAdapter) sslEnforced() bool {
+	if a.Spec.UserConfig == nil || a.Spec.UserConfig.RedisSsl == nil {
+		return true
+	}
+	return *a.Spec.UserConfig.RedisSsl
+}
+
 func (a *redisAdapter) getServiceType() string {
 	return "redis"
 }
@@ -100,3 +133,7 @@ func (a *redisAdapter) getServiceType() string {
 func (a *redisAdapter) getDiskSpace() string {
 	return a.Spec.DiskSpace
 }
+
+func (a *redisAdapter) getAdditionalDiskSpace() string {
+	return a.Spec.AdditionalDiskSpace
+}
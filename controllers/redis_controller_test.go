@@ -80,8 +80,16 @@ var _ = Describe("Redis Controller using secret", func() {
 			Expect(createdSecret.Data["PORT"]).NotTo(BeEmpty())
 			Expect(createdSecret.Data["USER"]).NotTo(BeEmpty())
 			Expect(createdSecret.Data["PASSWORD"]).NotTo(BeEmpty())
+			Expect(createdSecret.Data["DB"]).To(Equal([]byte("0")))
+			Expect(string(createdSecret.Data["URI"])).To(HavePrefix("rediss://"))
+			Expect(createdSecret.Data["REDIS_URI"]).To(Equal(createdSecret.Data["URI"]))
 
 			Expect(createdRedis.Status.State).Should(Equal("RUNNING"))
+
+			By("by checking the connection info has no credentials")
+			Expect(createdRedis.Status.ConnectionInfo).NotTo(BeNil())
+			Expect(createdRedis.Status.ConnectionInfo.Scheme).Should(Equal("rediss"))
+			Expect(createdRedis.Status.ConnectionInfo.Endpoint).Should(Equal(string(createdSecret.Data["HOST"]) + ":" + string(createdSecret.Data["PORT"])))
 		})
 	})
 
@@ -0,0 +1,91 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+// +kubebuilder:rbac:groups=aiven.io,resources=referencegrants,verbs=get;list;watch
+
+// errReferenceNotGranted is returned for a service in another namespace that is not shared with the resource
+var errReferenceNotGranted = errors.New("reference is not granted")
+
+// getReferencedService returns the service the object refers to, and nil if it doesn't refer to one.
+// A service in another namespace is returned only if a ReferenceGrant of that namespace allows the reference
+func getReferencedService(ctx context.Context, k8s client.Client, o serviceRefObject) (aivenManagedObject, error) {
+	ref := o.GetServiceRef()
+	if ref == nil {
+		return nil, nil
+	}
+
+	gvk, err := apiutil.GVKForObject(o, k8s.Scheme())
+	if err != nil {
+		return nil, err
+	}
+
+	key := ref.Service(o.GetNamespace())
+	kind := gvk.Kind
+	if key.NamespacedName.Namespace != o.GetNamespace() {
+		grants := &v1alpha1.ReferenceGrantList{}
+		err = k8s.List(ctx, grants, client.InNamespace(key.NamespacedName.Namespace))
+		if err != nil {
+			return nil, err
+		}
+		if !referenceGranted(grants.Items, kind, o.GetNamespace(), ref.Kind, ref.Name) {
+			return nil, fmt.Errorf("%w: no ReferenceGrant in namespace %q allows %s of namespace %q to refer to %s %q",
+				errReferenceNotGranted, key.NamespacedName.Namespace, kind, o.GetNamespace(), ref.Kind, ref.Name)
+		}
+	}
+
+	fabric, ok := serviceKindAdapters[ref.Kind]
+	if !ok {
+		return nil, fmt.Errorf("unknown service kind %q", ref.Kind)
+	}
+
+	obj, err := k8s.Scheme().New(key.GroupVersionKind)
+	if err != nil {
+		return nil, err
+	}
+
+	service := obj.(aivenManagedObject)
+	err = k8s.Get(ctx, key.NamespacedName, service)
+	if err != nil {
+		return nil, err
+	}
+
+	// The reference must not lend the token to another service
+	adapter, err := fabric(nil, service)
+	if err != nil {
+		return nil, err
+	}
+	project, serviceName := o.GetProjectAndServiceName()
+	if adapter.getServiceCommonSpec().Project != project || service.GetName() != serviceName {
+		return nil, fmt.Errorf("serviceRef %s %q is service %q of project %q, but the resource is for service %q of project %q",
+			ref.Kind, key.NamespacedName, service.GetName(), adapter.getServiceCommonSpec().Project, serviceName, project)
+	}
+	return service, nil
+}
+
+// referenceGranted returns true if one of the grants allows the resource of the kind in the namespace to refer to the service
+func referenceGranted(grants []v1alpha1.ReferenceGrant, fromKind, fromNamespace, toKind, toName string) bool {
+	for i := range grants {
+		if grants[i].Allows(fromKind, fromNamespace, toKind, toName) {
+			return true
+		}
+	}
+	return false
+}
+
+// hasServiceRef returns true if the object refers to a service, which token it uses if it doesn't have one
+func hasServiceRef(o client.Object) bool {
+	ref, ok := o.(serviceRefObject)
+	return ok && ref.GetServiceRef() != nil
+}
@@ -0,0 +1,83 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+const (
+	conditionTypeResourceMissing = "ResourceMissing"
+
+	// recreateMissingAnnotation set to "true" recreates the service that is missing on Aiven side, once
+	recreateMissingAnnotation = "aiven.io/recreate-missing"
+
+	eventResourceMissing     = "ResourceMissing"
+	eventRecreatingMissing   = "RecreatingMissingResource"
+	recreateMissingConfirmed = "true"
+)
+
+// serviceWasRunning returns true if the service has been running, so it's not created again silently
+func serviceWasRunning(object client.Object, status *v1alpha1.ServiceStatus) bool {
+	return isAlreadyRunning(object) || meta.IsStatusConditionTrue(status.Conditions, conditionTypeResourceMissing)
+}
+
+// checkResourceMissing handles the service that has been running and is missing on Aiven side, e.g. deleted in the Aiven Console.
+// A recreated service is empty, so it's recreated only when confirmed with the annotation.
+// Returns true if the service must not be recreated
+func (h *genericServiceHandler) checkResourceMissing(object client.Object, status *v1alpha1.ServiceStatus) bool {
+	a := object.GetAnnotations()
+	if a[recreateMissingAnnotation] == recreateMissingConfirmed {
+		// The confirmation is used once, the service that goes missing again is not recreated
+		delete(a, recreateMissingAnnotation)
+		delete(a, instanceIsRunningAnnotation)
+		delete(a, processedGenerationAnnotation)
+		object.SetAnnotations(a)
+		meta.SetStatusCondition(&status.Conditions, metav1.Condition{
+			Type:    conditionTypeResourceMissing,
+			Status:  metav1.ConditionFalse,
+			Reason:  "Recreating",
+			Message: "The service is recreated on Aiven side, the data of the missing service is not restored",
+		})
+		if h.rec != nil {
+			h.rec.Event(object, corev1.EventTypeNormal, eventRecreatingMissing, "recreating the service that is missing on Aiven side")
+		}
+		return false
+	}
+
+	if !meta.IsStatusConditionTrue(status.Conditions, conditionTypeResourceMissing) && h.rec != nil {
+		h.rec.Eventf(object, corev1.EventTypeWarning, eventResourceMissing,
+			"the service is missing on Aiven side and is not recreated, set the %s annotation to %q to recreate it",
+			recreateMissingAnnotation, recreateMissingConfirmed)
+	}
+	meta.SetStatusCondition(&status.Conditions, metav1.Condition{
+		Type:   conditionTypeResourceMissing,
+		Status: metav1.ConditionTrue,
+		Reason: "NotFound",
+		Message: fmt.Sprintf("The service is missing on Aiven side, e.g. deleted in the Aiven Console. "+
+			"A recreated service is empty, set the %s annotation to %q to recreate it", recreateMissingAnnotation, recreateMissingConfirmed),
+	})
+	meta.SetStatusCondition(&status.Conditions,
+		getRunningCondition(metav1.ConditionFalse, "ResourceMissing", "The service is missing on Aiven side"))
+	return true
+}
+
+// clearResourceMissing tells the service is found on Aiven side again, e.g. restored by the support
+func clearResourceMissing(status *v1alpha1.ServiceStatus) {
+	if !meta.IsStatusConditionTrue(status.Conditions, conditionTypeResourceMissing) {
+		return
+	}
+	meta.SetStatusCondition(&status.Conditions, metav1.Condition{
+		Type:    conditionTypeResourceMissing,
+		Status:  metav1.ConditionFalse,
+		Reason:  "Found",
+		Message: "The service is found on Aiven side",
+	})
+}
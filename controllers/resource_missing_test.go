@@ -0,0 +1,59 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+func TestServiceResourceMissing(t *testing.T) {
+	api := &fakeAivenAPI{}
+	avn := newFakeAivenClient("token", api)
+	h := newGenericServiceHandler(newPostgresSQLAdapter, record.NewFakeRecorder(10))
+
+	pg := &v1alpha1.PostgreSQL{}
+	pg.Name = "pg"
+	pg.Spec.Project = "foo"
+	pg.Spec.Plan = "startup-4"
+	pg.Annotations = map[string]string{
+		instanceIsRunningAnnotation:   "true",
+		processedGenerationAnnotation: "0",
+	}
+
+	// The service that has been running is not found, it's reported missing
+	secret, err := h.get(avn, pg)
+	require.NoError(t, err)
+	assert.Nil(t, secret)
+	assert.True(t, meta.IsStatusConditionTrue(pg.Status.Conditions, conditionTypeResourceMissing))
+	assert.True(t, meta.IsStatusConditionFalse(pg.Status.Conditions, conditionTypeRunning))
+
+	// An update of the spec doesn't recreate it
+	api.requests = nil
+	delete(pg.Annotations, instanceIsRunningAnnotation)
+	require.NoError(t, h.createOrUpdate(avn, pg, nil))
+	assert.Equal(t, []string{"GET /project/foo/service/pg"}, api.requests)
+	assert.Equal(t, "0", pg.Annotations[processedGenerationAnnotation])
+
+	// The confirmation is used once, the next reconciliation creates the service
+	pg.Annotations[recreateMissingAnnotation] = "true"
+	_, err = h.get(avn, pg)
+	require.NoError(t, err)
+	assert.NotContains(t, pg.Annotations, recreateMissingAnnotation)
+	assert.NotContains(t, pg.Annotations, processedGenerationAnnotation)
+	assert.True(t, meta.IsStatusConditionFalse(pg.Status.Conditions, conditionTypeResourceMissing))
+	assert.False(t, serviceWasRunning(pg, &pg.Status.ServiceStatus))
+
+	// The service that has never been running is an error until it's created
+	_, err = h.get(avn, pg)
+	assert.Error(t, err)
+
+	clearResourceMissing(&pg.Status.ServiceStatus)
+	assert.Equal(t, "Recreating", meta.FindStatusCondition(pg.Status.Conditions, conditionTypeResourceMissing).Reason)
+}
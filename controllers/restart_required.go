@@ -0,0 +1,125 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const conditionTypeRestartRequired = "RestartRequired"
+
+// userConfigRestartGroup is the user config fields group that restarts the service on change
+const userConfigRestartGroup = "restart"
+
+// getRestartRequiredCondition tells whether the update changes fields that restart the service
+func getRestartRequiredCondition(fields []string) metav1.Condition {
+	if len(fields) == 0 {
+		return metav1.Condition{
+			Type:    conditionTypeRestartRequired,
+			Status:  metav1.ConditionFalse,
+			Reason:  "OnlineUpdate",
+			Message: "The update is applied without a service restart",
+		}
+	}
+	return metav1.Condition{
+		Type:    conditionTypeRestartRequired,
+		Status:  metav1.ConditionTrue,
+		Reason:  "RestartFieldsChanged",
+		Message: fmt.Sprintf("The service is restarted to apply the changes of: %s", strings.Join(fields, ", ")),
+	}
+}
+
+// restartRequiredFields returns the dotted names of the fields in the restart group
+// that differ from the current user config of the service
+func restartRequiredFields(restartConfig, current map[string]interface{}) ([]string, error) {
+	return changedUserConfigFields(restartConfig, current)
+}
+
+// changedUserConfigFields returns the dotted names of the fields that differ from the current user config of the service
+func changedUserConfigFields(userConfig, current map[string]interface{}) ([]string, error) {
+	// Normalizes both to json types, so numbers are compared as float64
+	want, err := normalizeJSON(userConfig)
+	if err != nil {
+		return nil, err
+	}
+	got, err := normalizeJSON(current)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := make([]string, 0)
+	diffUserConfigFields("", want, got, func(name string, _, _ interface{}) {
+		fields = append(fields, name)
+	})
+	sort.Strings(fields)
+	return fields, nil
+}
+
+// diffUserConfigFields calls changed with the dotted name and the values of each declared field that differs
+func diffUserConfigFields(prefix string, want, got map[string]interface{}, changed func(name string, want, got interface{})) {
+	for k, v := range want {
+		name := prefix + k
+		w, wok := v.(map[string]interface{})
+		g, gok := got[k].(map[string]interface{})
+		if wok && gok {
+			diffUserConfigFields(name+".", w, g, changed)
+			continue
+		}
+		if !reflect.DeepEqual(v, got[k]) {
+			changed(name, v, got[k])
+		}
+	}
+}
+
+func normalizeJSON(m map[string]interface{}) (map[string]interface{}, error) {
+	result := make(map[string]interface{})
+	if m == nil {
+		return result, nil
+	}
+
+	b, err := json.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(b, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	if v, ok := result["ip_filter"]; ok {
+		result["ip_filter"] = normalizeIPFilter(v)
+	}
+	return result, nil
+}
+
+// normalizeIPFilter turns the ip_filter entries into the object form the CRDs use.
+// The API returns plain CIDRs for the entries set without a description, and an empty description is no description
+func normalizeIPFilter(v interface{}) interface{} {
+	list, ok := v.([]interface{})
+	if !ok {
+		return v
+	}
+
+	result := make([]interface{}, len(list))
+	for i, item := range list {
+		switch e := item.(type) {
+		case string:
+			result[i] = map[string]interface{}{"network": e}
+		case map[string]interface{}:
+			entry := map[string]interface{}{"network": e["network"]}
+			if d, ok := e["description"]; ok && d != nil && d != "" {
+				entry["description"] = d
+			}
+			result[i] = entry
+		default:
+			result[i] = item
+		}
+	}
+	return result
+}
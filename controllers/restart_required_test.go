@@ -0,0 +1,79 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestRestartRequiredFields(t *testing.T) {
+	current := map[string]interface{}{
+		"pg_stat_monitor_enable": false,
+		"pg": map[string]interface{}{
+			"autovacuum_freeze_max_age": float64(200000000),
+			"max_connections":           float64(100),
+		},
+	}
+
+	// Same values, numbers of different types
+	fields, err := restartRequiredFields(map[string]interface{}{
+		"pg_stat_monitor_enable": false,
+		"pg": map[string]interface{}{
+			"autovacuum_freeze_max_age": 200000000,
+		},
+	}, current)
+	require.NoError(t, err)
+	assert.Empty(t, fields)
+	assert.Equal(t, metav1.ConditionFalse, getRestartRequiredCondition(fields).Status)
+
+	fields, err = restartRequiredFields(map[string]interface{}{
+		"pg_stat_monitor_enable": true,
+		"pg": map[string]interface{}{
+			"autovacuum_freeze_max_age": 300000000,
+		},
+	}, current)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"pg.autovacuum_freeze_max_age", "pg_stat_monitor_enable"}, fields)
+
+	condition := getRestartRequiredCondition(fields)
+	assert.Equal(t, metav1.ConditionTrue, condition.Status)
+	assert.Contains(t, condition.Message, "pg.autovacuum_freeze_max_age, pg_stat_monitor_enable")
+
+	// A field that is not set yet
+	fields, err = restartRequiredFields(map[string]interface{}{"redis_number_of_databases": 32}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"redis_number_of_databases"}, fields)
+}
+
+func TestRestartRequiredFieldsIPFilter(t *testing.T) {
+	current := map[string]interface{}{
+		"ip_filter": []interface{}{
+			"10.0.0.0/8",
+			map[string]interface{}{"network": "192.168.0.0/24", "description": "office"},
+		},
+	}
+
+	// The object form without a description is the same as a plain CIDR
+	fields, err := restartRequiredFields(map[string]interface{}{
+		"ip_filter": []interface{}{
+			map[string]interface{}{"network": "10.0.0.0/8", "description": ""},
+			map[string]interface{}{"network": "192.168.0.0/24", "description": "office"},
+		},
+	}, current)
+	require.NoError(t, err)
+	assert.Empty(t, fields)
+
+	// A changed description is a change
+	fields, err = restartRequiredFields(map[string]interface{}{
+		"ip_filter": []interface{}{
+			map[string]interface{}{"network": "10.0.0.0/8", "description": "vpn"},
+			map[string]interface{}{"network": "192.168.0.0/24", "description": "office"},
+		},
+	}, current)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ip_filter"}, fields)
+}
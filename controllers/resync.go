@@ -0,0 +1,96 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"fmt"
+	"hash/fnv"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// resyncJitterFactor is the max share of the periodic requeue interval added as a jitter
+	resyncJitterFactor = 0.1
+
+	// runningCheckpointTTL is how long the running confirmation is trusted after the operator restart
+	runningCheckpointTTL = time.Hour
+
+	// runningCheckpointMaxDelay caps the postponement of the checkpointed instances after the operator restart,
+	// the changes made to them on Aiven side while the operator was down are picked up by then
+	runningCheckpointMaxDelay = 15 * time.Minute
+)
+
+var (
+	// startupResyncSpread spreads the first reconciliation of the ready instances after the operator start
+	startupResyncSpread time.Duration
+
+	// operatorStartTime the first reconciliations are spread from
+	operatorStartTime = time.Now()
+)
+
+// SetStartupResyncSpread sets the interval the first reconciliations of the ready instances are spread across.
+// Otherwise, all of them hit the Aiven API at once after the operator restart.
+func SetStartupResyncSpread(d time.Duration) {
+	startupResyncSpread = d
+}
+
+// startupResyncDelay returns the delay for the reconciliation of the ready instance after the operator start.
+// If the instance was confirmed running before the start, the checkpoint is trusted until it expires,
+// but no longer than runningCheckpointMaxDelay.
+// Every instance gets its own slot by its priority, derived from its kind and name,
+// so nothing is kept per instance. Returns zero once the slot has passed.
+func startupResyncDelay(o client.Object, now time.Time) time.Duration {
+	start := operatorStartTime
+	confirmed, err := time.Parse(time.RFC3339, o.GetAnnotations()[runningConfirmedAnnotation])
+	if err == nil && confirmed.Before(operatorStartTime) {
+		expires := confirmed.Add(runningCheckpointTTL)
+		if latest := operatorStartTime.Add(runningCheckpointMaxDelay); expires.After(latest) {
+			expires = latest
+		}
+		if expires.After(start) {
+			start = expires
+		}
+	}
+
+	// The high priority instances go first, the low priority ones last
+	slot := start.Add(prioritySpread(o, startupResyncSpread, func(d time.Duration) time.Duration {
+		if d <= 0 {
+			return 0
+		}
+		h := fnv.New64a()
+		_, _ = fmt.Fprintf(h, "%T/%s/%s", o, o.GetNamespace(), o.GetName())
+		return time.Duration(h.Sum64() % uint64(d))
+	}))
+
+	if delay := slot.Sub(now); delay > 0 {
+		return delay
+	}
+	return 0
+}
+
+// updateRunningCheckpoint remembers the time the instance was confirmed running,
+// so it is not checked again right after the operator restart.
+// Refreshes it only when a half of the TTL has passed, otherwise every update of the annotation triggers a new reconciliation.
+func updateRunningCheckpoint(o client.Object) {
+	a := o.GetAnnotations()
+	if a == nil {
+		a = make(map[string]string)
+	}
+
+	confirmed, err := time.Parse(time.RFC3339, a[runningConfirmedAnnotation])
+	if err == nil && time.Since(confirmed) < runningCheckpointTTL/2 {
+		return
+	}
+
+	a[runningConfirmedAnnotation] = time.Now().UTC().Format(time.RFC3339)
+	o.SetAnnotations(a)
+}
+
+// jitter adds a random jitter to the periodic requeue interval,
+// so the instances created at once don't get requeued at once
+func jitter(d time.Duration) time.Duration {
+	return wait.Jitter(d, resyncJitterFactor)
+}
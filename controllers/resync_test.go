@@ -0,0 +1,122 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+func TestStartupResyncDelay(t *testing.T) {
+	defer func(spread time.Duration, start time.Time) {
+		startupResyncSpread, operatorStartTime = spread, start
+	}(startupResyncSpread, operatorStartTime)
+
+	start := time.Date(2023, 1, 30, 12, 0, 0, 0, time.UTC)
+	operatorStartTime = start
+	kafka := &v1alpha1.Kafka{ObjectMeta: metav1.ObjectMeta{Name: "kafka", Namespace: "default"}}
+
+	// Disabled
+	startupResyncSpread = 0
+	assert.Zero(t, startupResyncDelay(kafka, start))
+
+	startupResyncSpread = 10 * time.Minute
+	delay := startupResyncDelay(kafka, start)
+	assert.Less(t, delay, startupResyncSpread)
+
+	// The instance keeps its slot, the delay shrinks as the time goes
+	assert.Equal(t, delay, startupResyncDelay(kafka, start))
+	assert.Equal(t, delay/2, startupResyncDelay(kafka, start.Add(delay/2)))
+	assert.Zero(t, startupResyncDelay(kafka, start.Add(delay)))
+
+	// The instances are spread across the interval, the same name of another kind gets its own slot
+	minutes := make(map[time.Duration]bool)
+	for i := 0; i < 100; i++ {
+		pg := &v1alpha1.PostgreSQL{ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("pg%d", i), Namespace: "default"}}
+		minutes[startupResyncDelay(pg, start).Truncate(time.Minute)] = true
+	}
+	assert.Len(t, minutes, 10)
+	assert.NotEqual(t, delay, startupResyncDelay(&v1alpha1.PostgreSQL{ObjectMeta: kafka.ObjectMeta}, start))
+
+	// Nothing is postponed once the spread is over
+	for i := 0; i < 100; i++ {
+		pg := &v1alpha1.PostgreSQL{ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("pg%d", i), Namespace: "default"}}
+		assert.Zero(t, startupResyncDelay(pg, start.Add(startupResyncSpread)))
+	}
+}
+
+func TestStartupResyncDelayCheckpoint(t *testing.T) {
+	defer func(spread time.Duration, start time.Time) {
+		startupResyncSpread, operatorStartTime = spread, start
+	}(startupResyncSpread, operatorStartTime)
+
+	start := time.Date(2023, 1, 30, 12, 0, 0, 0, time.UTC)
+	operatorStartTime = start
+	startupResyncSpread = 0
+	kafka := &v1alpha1.Kafka{ObjectMeta: metav1.ObjectMeta{Name: "kafka", Namespace: "default"}}
+	confirmedAt := func(t time.Time) {
+		kafka.Annotations = map[string]string{runningConfirmedAnnotation: t.Format(time.RFC3339)}
+	}
+
+	// No checkpoint
+	assert.Zero(t, startupResyncDelay(kafka, start))
+
+	// The checkpoint is trusted until it expires
+	confirmedAt(start.Add(-55 * time.Minute))
+	assert.Equal(t, 5*time.Minute, startupResyncDelay(kafka, start))
+	assert.Zero(t, startupResyncDelay(kafka, start.Add(5*time.Minute)))
+
+	// The expired checkpoint
+	confirmedAt(start.Add(-2 * time.Hour))
+	assert.Zero(t, startupResyncDelay(kafka, start))
+
+	// The fresh checkpoint doesn't postpone the reconciliation for the whole TTL
+	confirmedAt(start.Add(-time.Minute))
+	assert.Equal(t, runningCheckpointMaxDelay, startupResyncDelay(kafka, start))
+
+	// The checkpoint made after the start doesn't postpone anything
+	confirmedAt(start.Add(time.Minute))
+	assert.Zero(t, startupResyncDelay(kafka, start.Add(2*time.Minute)))
+
+	// The spread is added on top of the checkpoint
+	startupResyncSpread = 10 * time.Minute
+	confirmedAt(start.Add(-55 * time.Minute))
+	delay := startupResyncDelay(kafka, start)
+	assert.GreaterOrEqual(t, delay, 5*time.Minute)
+	assert.Less(t, delay, 15*time.Minute)
+}
+
+func TestUpdateRunningCheckpoint(t *testing.T) {
+	kafka := &v1alpha1.Kafka{}
+	updateRunningCheckpoint(kafka)
+	confirmed, err := time.Parse(time.RFC3339, kafka.Annotations[runningConfirmedAnnotation])
+	assert.NoError(t, err)
+	assert.WithinDuration(t, time.Now(), confirmed, time.Minute)
+
+	// The recent checkpoint is not refreshed
+	recent := time.Now().Add(-runningCheckpointTTL / 4).UTC().Format(time.RFC3339)
+	kafka.Annotations[runningConfirmedAnnotation] = recent
+	updateRunningCheckpoint(kafka)
+	assert.Equal(t, recent, kafka.Annotations[runningConfirmedAnnotation])
+
+	// The old one is
+	kafka.Annotations[runningConfirmedAnnotation] = time.Now().Add(-runningCheckpointTTL).UTC().Format(time.RFC3339)
+	updateRunningCheckpoint(kafka)
+	confirmed, err = time.Parse(time.RFC3339, kafka.Annotations[runningConfirmedAnnotation])
+	assert.NoError(t, err)
+	assert.WithinDuration(t, time.Now(), confirmed, time.Minute)
+}
+
+func TestJitter(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		d := jitter(time.Hour)
+		assert.GreaterOrEqual(t, d, time.Hour)
+		assert.LessOrEqual(t, d, time.Hour+time.Duration(resyncJitterFactor*float64(time.Hour)))
+	}
+}
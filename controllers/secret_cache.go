@@ -0,0 +1,78 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/cluster"
+)
+
+const (
+	// secretCacheLabel marks the secrets the operator caches:
+	// the connection secrets it creates and the auth secrets the resources refer to
+	secretCacheLabel           = "aiven.io/operator-secret"
+	secretCacheLabelConnection = "connection"
+	secretCacheLabelAuth       = "auth"
+)
+
+// SecretCacheSelectors restricts the Secret informer to the labeled secrets.
+// Otherwise, the operator caches every Secret of the cluster.
+func SecretCacheSelectors() cache.SelectorsByObject {
+	r, err := labels.NewRequirement(secretCacheLabel, selection.Exists, nil)
+	if err != nil {
+		// The requirement is static, it can't fail
+		panic(err)
+	}
+
+	return cache.SelectorsByObject{
+		&corev1.Secret{}: {Label: labels.NewSelector().Add(*r)},
+	}
+}
+
+// NewSecretFallbackClient creates the manager client, which reads the secrets that are not labeled yet
+// from the API server, e.g. the auth secrets before their first use and the secrets created by older versions.
+// The operator labels them, so they are cached from then on.
+func NewSecretFallbackClient(c cache.Cache, config *rest.Config, options client.Options, uncachedObjects ...client.Object) (client.Client, error) {
+	cached, err := cluster.DefaultNewClient(c, config, options, uncachedObjects...)
+	if err != nil {
+		return nil, err
+	}
+
+	reader, err := client.New(config, options)
+	if err != nil {
+		return nil, err
+	}
+	return &secretFallbackClient{Client: cached, apiReader: reader}, nil
+}
+
+type secretFallbackClient struct {
+	client.Client
+	apiReader client.Reader
+}
+
+func (c *secretFallbackClient) Get(ctx context.Context, key client.ObjectKey, obj client.Object) error {
+	err := c.Client.Get(ctx, key, obj)
+	if _, ok := obj.(*corev1.Secret); ok && apierrors.IsNotFound(err) {
+		return c.apiReader.Get(ctx, key, obj)
+	}
+	return err
+}
+
+// hasSecretCacheLabel tells whether the secret is cached
+func hasSecretCacheLabel(s *corev1.Secret) bool {
+	_, ok := s.GetLabels()[secretCacheLabel]
+	return ok
+}
+
+func setSecretCacheLabel(s *corev1.Secret, value string) {
+	metav1.SetMetaDataLabel(&s.ObjectMeta, secretCacheLabel, value)
+}
@@ -67,7 +67,7 @@ func (c *SecretFinalizerGCController) SetupWithManager(mgr ctrl.Manager, hasDefa
 							},
 						},
 					}
-				} else if !hasDefaultToken {
+				} else if !hasDefaultToken && !hasServiceRef(a) {
 					gvk := ao.GetObjectKind().GroupVersionKind().String()
 					namespacedName := types.NamespacedName{
 						Name:      ao.GetName(),
@@ -0,0 +1,65 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/aiven/aiven-go-client"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+// serviceCloudCandidates returns cloudName and the fallback clouds in the order of preference
+func serviceCloudCandidates(spec *v1alpha1.ServiceCommonSpec) []string {
+	clouds := make([]string, 0, len(spec.CloudFallbacks)+1)
+	for _, c := range append([]string{spec.CloudName}, spec.CloudFallbacks...) {
+		if c != "" {
+			clouds = append(clouds, c)
+		}
+	}
+	return clouds
+}
+
+// selectServiceCloud returns the first cloud of cloudName and cloudFallbacks the plan is available in
+func selectServiceCloud(avn *aiven.Client, o serviceAdapter) (string, error) {
+	spec := o.getServiceCommonSpec()
+	clouds := serviceCloudCandidates(spec)
+	if len(spec.CloudFallbacks) == 0 || spec.Plan == "" {
+		return spec.CloudName, nil
+	}
+
+	for _, cloud := range clouds {
+		_, err := avn.ServiceTypes.GetPlanPricing(spec.Project, o.getServiceType(), spec.Plan, cloud)
+		if err == nil {
+			return cloud, nil
+		}
+		if !isPlanNotAvailableError(err) {
+			return "", fmt.Errorf("unable to check plan %q in cloud %q: %w", spec.Plan, cloud, err)
+		}
+	}
+	return "", fmt.Errorf("plan %q is not available in any of the clouds: %s", spec.Plan, strings.Join(clouds, ", "))
+}
+
+// isPlanNotAvailableError returns true if Aiven has no pricing for the plan in the cloud
+func isPlanNotAvailableError(err error) bool {
+	e, ok := err.(aiven.Error)
+	return ok && (e.Status == http.StatusNotFound || e.Status == http.StatusBadRequest)
+}
+
+// serviceUpdateCloud returns the cloud of the update request.
+// A service created in a fallback cloud stays there, it is moved only if the cloud is not in the spec anymore
+func serviceUpdateCloud(spec *v1alpha1.ServiceCommonSpec, currentCloud string) string {
+	if len(spec.CloudFallbacks) == 0 {
+		return spec.CloudName
+	}
+
+	for _, c := range serviceCloudCandidates(spec) {
+		if c == currentCloud {
+			return currentCloud
+		}
+	}
+	return spec.CloudName
+}
@@ -0,0 +1,88 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"golang.org/x/exp/slices"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+// +kubebuilder:rbac:groups=aiven.io,resources=cloudpolicies,verbs=get;list;watch
+
+// ServiceCloudPolicyPath validates the clouds of the service kinds against the CloudPolicies of their namespace
+const ServiceCloudPolicyPath = "/validate-aiven-io-v1alpha1-service-cloudpolicy"
+
+//+kubebuilder:webhook:verbs=create;update,path=/validate-aiven-io-v1alpha1-service-cloudpolicy,mutating=false,failurePolicy=fail,groups=aiven.io,resources=cassandras;clickhouses;dragonflies;grafanas;kafkas;kafkaconnects;m3aggregators;m3dbs;mysqls;opensearches;postgresqls;redis;thanos;valkeys,versions=v1alpha1,name=vservicecloudpolicy.kb.io,sideEffects=none,admissionReviewVersions=v1
+
+// ServiceCloudPolicyValidator rejects services in a cloud the CloudPolicies of their namespace don't allow
+type ServiceCloudPolicyValidator struct {
+	Client  client.Client
+	Decoder *admission.Decoder
+}
+
+func (v *ServiceCloudPolicyValidator) Handle(ctx context.Context, req admission.Request) admission.Response {
+	fabric, ok := serviceKindAdapters[req.Kind.Kind]
+	if !ok {
+		return admission.Allowed("")
+	}
+
+	spec, obj, err := decodeServiceSpec(v.Client, v.Decoder, req.Object, req.Kind.Kind, fabric)
+	if err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	// Validates the changes only, so a policy created afterwards doesn't block the updates and the deletion of the service
+	if req.OldObject.Raw != nil {
+		old, _, err := decodeServiceSpec(v.Client, v.Decoder, req.OldObject, req.Kind.Kind, fabric)
+		if err != nil {
+			return admission.Errored(http.StatusBadRequest, err)
+		}
+		if old.CloudName == spec.CloudName && slices.Equal(old.CloudFallbacks, spec.CloudFallbacks) {
+			return admission.Allowed("")
+		}
+	}
+
+	list := &v1alpha1.CloudPolicyList{}
+	err = v.Client.List(ctx, list, client.InNamespace(obj.GetNamespace()))
+	if err != nil {
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+
+	err = checkServiceCloudPolicies(spec, list.Items)
+	if err != nil {
+		return admission.Denied(err.Error())
+	}
+	return admission.Allowed("")
+}
+
+// checkServiceCloudPolicies returns an error if a policy doesn't allow the cloud or one of the fallback clouds of the service
+func checkServiceCloudPolicies(spec *v1alpha1.ServiceCommonSpec, policies []v1alpha1.CloudPolicy) error {
+	if len(policies) == 0 {
+		return nil
+	}
+
+	if spec.CloudName == "" {
+		// Aiven picks the cloud, which may be any
+		return fmt.Errorf("cloudName must be set, the CloudPolicy %q of the namespace limits the clouds", policies[0].Name)
+	}
+
+	clouds := append([]string{spec.CloudName}, spec.CloudFallbacks...)
+	for i := range policies {
+		p := &policies[i]
+		for _, cloud := range clouds {
+			if !p.Allows(cloud) {
+				return fmt.Errorf("cloud %q is not allowed by the CloudPolicy %q of the namespace, the allowed clouds are: %s",
+					cloud, p.Name, strings.Join(p.Spec.AllowedClouds, ", "))
+			}
+		}
+	}
+	return nil
+}
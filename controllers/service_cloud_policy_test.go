@@ -0,0 +1,76 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+func TestCheckServiceCloudPolicies(t *testing.T) {
+	policies := []v1alpha1.CloudPolicy{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "eu"},
+			Spec:       v1alpha1.CloudPolicySpec{AllowedClouds: []string{"google-europe-*", "aws-eu-*"}},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "no-aws"},
+			Spec:       v1alpha1.CloudPolicySpec{AllowedClouds: []string{"google-*", "azure-*"}},
+		},
+	}
+
+	cases := []struct {
+		name     string
+		spec     v1alpha1.ServiceCommonSpec
+		policies []v1alpha1.CloudPolicy
+		err      string
+	}{
+		{
+			name: "no policies",
+			spec: v1alpha1.ServiceCommonSpec{CloudName: "aws-us-east-1"},
+		},
+		{
+			name:     "allowed by all",
+			spec:     v1alpha1.ServiceCommonSpec{CloudName: "google-europe-west1"},
+			policies: policies,
+		},
+		{
+			name:     "not allowed by the first",
+			spec:     v1alpha1.ServiceCommonSpec{CloudName: "google-us-east1"},
+			policies: policies,
+			err:      `cloud "google-us-east1" is not allowed by the CloudPolicy "eu" of the namespace, the allowed clouds are: google-europe-*, aws-eu-*`,
+		},
+		{
+			name:     "not allowed by the second",
+			spec:     v1alpha1.ServiceCommonSpec{CloudName: "aws-eu-west-1"},
+			policies: policies,
+			err:      `cloud "aws-eu-west-1" is not allowed by the CloudPolicy "no-aws" of the namespace, the allowed clouds are: google-*, azure-*`,
+		},
+		{
+			name:     "fallback cloud not allowed",
+			spec:     v1alpha1.ServiceCommonSpec{CloudName: "google-europe-west1", CloudFallbacks: []string{"google-europe-west4", "google-us-east1"}},
+			policies: policies,
+			err:      `cloud "google-us-east1" is not allowed by the CloudPolicy "eu" of the namespace, the allowed clouds are: google-europe-*, aws-eu-*`,
+		},
+		{
+			name:     "cloud not set",
+			policies: policies,
+			err:      `cloudName must be set, the CloudPolicy "eu" of the namespace limits the clouds`,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := checkServiceCloudPolicies(&c.spec, c.policies)
+			if c.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, c.err)
+			}
+		})
+	}
+}
@@ -0,0 +1,36 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"testing"
+
+	"github.com/aiven/aiven-go-client"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+func TestServiceUpdateCloud(t *testing.T) {
+	spec := &v1alpha1.ServiceCommonSpec{
+		CloudName:      "aws-eu-west-1",
+		CloudFallbacks: []string{"aws-eu-west-2", "google-europe-west1"},
+	}
+	assert.Equal(t, []string{"aws-eu-west-1", "aws-eu-west-2", "google-europe-west1"}, serviceCloudCandidates(spec))
+
+	assert.Equal(t, "aws-eu-west-2", serviceUpdateCloud(spec, "aws-eu-west-2"), "stays in the fallback cloud")
+	assert.Equal(t, "aws-eu-west-1", serviceUpdateCloud(spec, "aws-eu-west-1"))
+	assert.Equal(t, "aws-eu-west-1", serviceUpdateCloud(spec, "azure-westeurope"), "moved to cloudName")
+
+	noFallbacks := &v1alpha1.ServiceCommonSpec{CloudName: "aws-eu-west-1"}
+	assert.Equal(t, "aws-eu-west-1", serviceUpdateCloud(noFallbacks, "aws-eu-west-2"))
+
+	assert.Equal(t, []string{"aws-eu-west-2"}, serviceCloudCandidates(&v1alpha1.ServiceCommonSpec{CloudFallbacks: []string{"aws-eu-west-2"}}))
+}
+
+func TestIsPlanNotAvailableError(t *testing.T) {
+	assert.True(t, isPlanNotAvailableError(aiven.Error{Status: 404}))
+	assert.True(t, isPlanNotAvailableError(aiven.Error{Status: 400}))
+	assert.False(t, isPlanNotAvailableError(aiven.Error{Status: 500}))
+	assert.False(t, isPlanNotAvailableError(nil))
+}
@@ -0,0 +1,157 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/aiven/aiven-go-client"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+// customCloudPrefix starts the names of the custom clouds (BYOC)
+const customCloudPrefix = "custom-"
+
+// ServiceCustomCloudPath validates the custom clouds of the service kinds against the clouds of the project
+const ServiceCustomCloudPath = "/validate-aiven-io-v1alpha1-service-customcloud"
+
+//+kubebuilder:webhook:verbs=create;update,path=/validate-aiven-io-v1alpha1-service-customcloud,mutating=false,failurePolicy=fail,groups=aiven.io,resources=cassandras;clickhouses;dragonflies;grafanas;kafkas;kafkaconnects;m3aggregators;m3dbs;mysqls;opensearches;postgresqls;redis;thanos;valkeys,versions=v1alpha1,name=vservicecustomcloud.kb.io,sideEffects=none,admissionReviewVersions=v1
+
+// ServiceCustomCloudValidator rejects services in a custom cloud (BYOC) the project doesn't have.
+// The clouds are listed with the token of the service, a service that can't be checked is allowed with a warning
+type ServiceCustomCloudValidator struct {
+	Client  client.Client
+	Decoder *admission.Decoder
+
+	// DefaultToken is used for the services without authSecretRef
+	DefaultToken string
+}
+
+func (v *ServiceCustomCloudValidator) Handle(ctx context.Context, req admission.Request) admission.Response {
+	fabric, ok := serviceKindAdapters[req.Kind.Kind]
+	if !ok {
+		return admission.Allowed("")
+	}
+
+	spec, obj, err := decodeServiceSpec(v.Client, v.Decoder, req.Object, req.Kind.Kind, fabric)
+	if err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	if !isCustomCloud(spec.CloudName) {
+		return admission.Allowed("")
+	}
+
+	// Validates the changes only, so a custom cloud that is removed afterwards doesn't block the updates
+	if req.OldObject.Raw != nil {
+		old, _, err := decodeServiceSpec(v.Client, v.Decoder, req.OldObject, req.Kind.Kind, fabric)
+		if err != nil {
+			return admission.Errored(http.StatusBadRequest, err)
+		}
+		if old.CloudName == spec.CloudName && old.Project == spec.Project {
+			return admission.Allowed("")
+		}
+	}
+
+	token, err := getAdmissionToken(ctx, v.Client, v.DefaultToken, obj.(aivenManagedObject))
+	if err != nil {
+		return admission.Allowed("").WithWarnings(fmt.Sprintf("custom cloud %q is not validated: %s", spec.CloudName, err))
+	}
+
+	clouds, err := newAivenAPI(token).listProjectClouds(spec.Project)
+	if err != nil {
+		return admission.Allowed("").WithWarnings(fmt.Sprintf("custom cloud %q is not validated: unable to list the clouds of project %q: %s", spec.CloudName, spec.Project, err))
+	}
+
+	_, err = findCustomCloud(spec.Project, spec.CloudName, clouds)
+	if err != nil {
+		return admission.Denied(err.Error())
+	}
+	return admission.Allowed("")
+}
+
+// getAdmissionToken returns the token of the resource for the admission webhooks that call the Aiven API
+func getAdmissionToken(ctx context.Context, c client.Client, defaultToken string, o aivenManagedObject) (string, error) {
+	ref := o.AuthSecretRef()
+	if ref.Name == "" {
+		if defaultToken == "" {
+			return "", fmt.Errorf("authSecretRef is not set")
+		}
+		return defaultToken, nil
+	}
+
+	secret := &corev1.Secret{}
+	err := c.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: o.GetNamespace()}, secret)
+	if err != nil {
+		return "", fmt.Errorf("cannot get secret %q: %w", ref.Name, err)
+	}
+	return string(secret.Data[ref.Key]), nil
+}
+
+// isCustomCloud returns true for the custom clouds (BYOC)
+func isCustomCloud(cloudName string) bool {
+	return strings.HasPrefix(cloudName, customCloudPrefix)
+}
+
+// findCustomCloud returns the custom cloud of the project, or an error that lists the custom clouds the project has
+func findCustomCloud(project, cloudName string, clouds []aivenCloud) (*aivenCloud, error) {
+	custom := make([]string, 0)
+	for i, c := range clouds {
+		if c.CloudName == cloudName {
+			return &clouds[i], nil
+		}
+		if isCustomCloud(c.CloudName) {
+			custom = append(custom, c.CloudName)
+		}
+	}
+
+	if len(custom) == 0 {
+		return nil, fmt.Errorf("project %q has no custom clouds, cloudName %q is not available", project, cloudName)
+	}
+	sort.Strings(custom)
+	return nil, fmt.Errorf("custom cloud %q is not available in project %q, the custom clouds are: %s", cloudName, project, strings.Join(custom, ", "))
+}
+
+// newServiceCustomCloud returns the status of the custom cloud
+func newServiceCustomCloud(c *aivenCloud) *v1alpha1.ServiceCustomCloud {
+	return &v1alpha1.ServiceCustomCloud{
+		CloudName:   c.CloudName,
+		Description: c.CloudDescription,
+		Provider:    c.Provider,
+		GeoRegion:   c.GeoRegion,
+	}
+}
+
+// updateServiceCustomCloud sets the custom cloud the service runs in to the status.
+// The clouds are listed only when the service moves to another custom cloud
+func updateServiceCustomCloud(avn *aiven.Client, status *v1alpha1.ServiceStatus, project, cloudName string) error {
+	if !isCustomCloud(cloudName) {
+		status.CustomCloud = nil
+		return nil
+	}
+
+	if status.CustomCloud != nil && status.CustomCloud.CloudName == cloudName {
+		return nil
+	}
+
+	clouds, err := aivenAPIFor(avn).listProjectClouds(project)
+	if err != nil {
+		return fmt.Errorf("unable to list the clouds of project %q: %w", project, err)
+	}
+
+	c, err := findCustomCloud(project, cloudName, clouds)
+	if err != nil {
+		return err
+	}
+	status.CustomCloud = newServiceCustomCloud(c)
+	return nil
+}
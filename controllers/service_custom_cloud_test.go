@@ -0,0 +1,35 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindCustomCloud(t *testing.T) {
+	clouds := []aivenCloud{
+		{CloudName: "aws-eu-west-1", Provider: "aws"},
+		{CloudName: "custom-aws-eu-west-1-b", CloudDescription: "Europe, Ireland - Amazon Web Services (BYOC)", Provider: "aws", GeoRegion: "europe"},
+		{CloudName: "custom-aws-eu-west-1-a", Provider: "aws"},
+	}
+
+	c, err := findCustomCloud("dev", "custom-aws-eu-west-1-b", clouds)
+	require.NoError(t, err)
+	assert.Equal(t, "Europe, Ireland - Amazon Web Services (BYOC)", newServiceCustomCloud(c).Description)
+	assert.Equal(t, "europe", newServiceCustomCloud(c).GeoRegion)
+
+	_, err = findCustomCloud("dev", "custom-google-europe-west1", clouds)
+	assert.EqualError(t, err, `custom cloud "custom-google-europe-west1" is not available in project "dev", the custom clouds are: custom-aws-eu-west-1-a, custom-aws-eu-west-1-b`)
+
+	_, err = findCustomCloud("dev", "custom-google-europe-west1", clouds[:1])
+	assert.EqualError(t, err, `project "dev" has no custom clouds, cloudName "custom-google-europe-west1" is not available`)
+}
+
+func TestIsCustomCloud(t *testing.T) {
+	assert.True(t, isCustomCloud("custom-aws-eu-west-1"))
+	assert.False(t, isCustomCloud("aws-eu-west-1"))
+	assert.False(t, isCustomCloud(""))
+}
@@ -0,0 +1,122 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"encoding/json"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/aiven/aiven-go-client"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+const (
+	// serviceDiffMaxFields limits the number of the fields in the status, so the object doesn't grow unbounded
+	serviceDiffMaxFields = 20
+
+	// serviceDiffMaxValueLength limits the length of the values in the status
+	serviceDiffMaxValueLength = 64
+
+	serviceDiffRedacted = "<redacted>"
+)
+
+// newServiceDiff returns the fields the update request would change on Aiven side, nil if there are none.
+// The versions that are newer on Aiven side are not listed, the update leaves them as they are
+func newServiceDiff(o serviceAdapter, current *aiven.Service) (*v1alpha1.ServiceDiff, error) {
+	req, _, err := newUpdateServiceRequest(o, "", current)
+	if err != nil {
+		return nil, err
+	}
+	req.DiskSpaceMB = declaredDiskSpaceMB(o)
+
+	fields := make([]v1alpha1.ServiceFieldDiff, 0)
+	add := func(name string, declared, actual interface{}) {
+		fields = append(fields, newServiceFieldDiff(name, declared, actual))
+	}
+
+	if req.Plan != "" && req.Plan != current.Plan {
+		add("plan", req.Plan, current.Plan)
+	}
+	if req.Cloud != "" && req.Cloud != current.CloudName {
+		add("cloud_name", req.Cloud, current.CloudName)
+	}
+	if req.DiskSpaceMB > 0 && req.DiskSpaceMB != current.DiskSpaceMB {
+		add("disk_space_mb", req.DiskSpaceMB, current.DiskSpaceMB)
+	}
+	if w := req.MaintenanceWindow; w != nil {
+		if w.DayOfWeek != "" && w.DayOfWeek != current.MaintenanceWindow.DayOfWeek {
+			add("maintenance.dow", w.DayOfWeek, current.MaintenanceWindow.DayOfWeek)
+		}
+		if w.TimeOfDay != "" && w.TimeOfDay != current.MaintenanceWindow.TimeOfDay {
+			add("maintenance.time", w.TimeOfDay, current.MaintenanceWindow.TimeOfDay)
+		}
+	}
+	if req.TerminationProtection != current.TerminationProtection {
+		add("termination_protection", req.TerminationProtection, current.TerminationProtection)
+	}
+
+	want, err := normalizeJSON(req.UserConfig)
+	if err != nil {
+		return nil, err
+	}
+	got, err := normalizeJSON(current.UserConfig)
+	if err != nil {
+		return nil, err
+	}
+	diffUserConfigFields("user_config.", want, got, add)
+
+	if len(fields) == 0 {
+		return nil, nil
+	}
+
+	sort.Slice(fields, func(i, j int) bool {
+		return fields[i].Field < fields[j].Field
+	})
+
+	diff := &v1alpha1.ServiceDiff{Fields: fields, Total: len(fields)}
+	if len(fields) > serviceDiffMaxFields {
+		diff.Fields = fields[:serviceDiffMaxFields]
+	}
+	return diff, nil
+}
+
+func newServiceFieldDiff(name string, declared, actual interface{}) v1alpha1.ServiceFieldDiff {
+	if isSecretField(name) {
+		return v1alpha1.ServiceFieldDiff{Field: name, Declared: serviceDiffRedacted, Actual: serviceDiffRedacted}
+	}
+	return v1alpha1.ServiceFieldDiff{Field: name, Declared: formatDiffValue(declared), Actual: formatDiffValue(actual)}
+}
+
+// isSecretField tells whether the value of the dotted field name is a credential, like migration.password
+func isSecretField(name string) bool {
+	last := strings.ToLower(name[strings.LastIndex(name, ".")+1:])
+	for _, s := range []string{"password", "secret", "token", "credentials"} {
+		if strings.Contains(last, s) {
+			return true
+		}
+	}
+	return last == "key" || strings.HasSuffix(last, "_key")
+}
+
+// formatDiffValue returns the value as JSON, truncated to serviceDiffMaxValueLength
+func formatDiffValue(v interface{}) string {
+	var s string
+	switch t := v.(type) {
+	case string:
+		s = strconv.Quote(t)
+	default:
+		b, err := json.Marshal(v)
+		if err != nil {
+			return "?"
+		}
+		s = string(b)
+	}
+
+	if r := []rune(s); len(r) > serviceDiffMaxValueLength {
+		return string(r[:serviceDiffMaxValueLength-3]) + "..."
+	}
+	return s
+}
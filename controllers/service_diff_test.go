@@ -0,0 +1,91 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aiven/aiven-go-client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+	pguserconfig "github.com/aiven/aiven-operator/api/v1alpha1/userconfigs/pg"
+)
+
+func TestNewServiceDiff(t *testing.T) {
+	pg := &v1alpha1.PostgreSQL{
+		ObjectMeta: metav1.ObjectMeta{Name: "pg", Namespace: "default"},
+		Spec: v1alpha1.PostgreSQLSpec{
+			ServiceCommonSpec: v1alpha1.ServiceCommonSpec{
+				Project:   "dev",
+				Plan:      "business-4",
+				CloudName: "google-europe-west1",
+			},
+			UserConfig: &pguserconfig.PgUserConfig{
+				PgVersion: anyPointer("14"),
+				Pg:        &pguserconfig.Pg{AutovacuumMaxWorkers: anyPointer(8)},
+				Migration: &pguserconfig.Migration{Host: "old-pg", Port: 5432, Password: anyPointer("secret")},
+			},
+		},
+	}
+	a, err := newPostgresSQLAdapter(nil, pg)
+	require.NoError(t, err)
+
+	current := &aiven.Service{
+		Plan:      "business-8",
+		CloudName: "google-europe-west1",
+		UserConfig: map[string]interface{}{
+			// Upgraded on Aiven side, the update doesn't downgrade it
+			"pg_version": "15",
+			"pg":         map[string]interface{}{"autovacuum_max_workers": 16},
+			"migration":  map[string]interface{}{"host": "old-pg", "port": 5432, "password": "changed"},
+		},
+	}
+
+	diff, err := newServiceDiff(a, current)
+	require.NoError(t, err)
+	assert.Equal(t, &v1alpha1.ServiceDiff{
+		Fields: []v1alpha1.ServiceFieldDiff{
+			{Field: "plan", Declared: `"business-4"`, Actual: `"business-8"`},
+			{Field: "user_config.migration.password", Declared: "<redacted>", Actual: "<redacted>"},
+			{Field: "user_config.pg.autovacuum_max_workers", Declared: "8", Actual: "16"},
+		},
+		Total: 3,
+	}, diff)
+
+	// No drift
+	current.Plan = "business-4"
+	current.UserConfig = map[string]interface{}{
+		"pg_version": "14",
+		"pg":         map[string]interface{}{"autovacuum_max_workers": 8},
+		"migration":  map[string]interface{}{"host": "old-pg", "port": 5432, "password": "secret"},
+	}
+	diff, err = newServiceDiff(a, current)
+	require.NoError(t, err)
+	assert.Nil(t, diff)
+
+	// The values are truncated
+	ipFilter := make([]*pguserconfig.IpFilter, 0)
+	for i := 0; i < 30; i++ {
+		ipFilter = append(ipFilter, &pguserconfig.IpFilter{Network: fmt.Sprintf("10.0.%d.0/24", i)})
+	}
+	pg.Spec.UserConfig.IpFilter = ipFilter
+	diff, err = newServiceDiff(a, current)
+	require.NoError(t, err)
+	require.Len(t, diff.Fields, 1)
+	assert.Equal(t, "user_config.ip_filter", diff.Fields[0].Field)
+	assert.True(t, strings.HasSuffix(diff.Fields[0].Declared, "..."))
+	assert.Len(t, diff.Fields[0].Declared, serviceDiffMaxValueLength)
+}
+
+func TestIsSecretField(t *testing.T) {
+	assert.True(t, isSecretField("user_config.migration.password"))
+	assert.True(t, isSecretField("user_config.kafka_connect_secret_providers.aws.secret_key"))
+	assert.True(t, isSecretField("user_config.key"))
+	assert.False(t, isSecretField("user_config.redis_notify_keyspace_events"))
+	assert.False(t, isSecretField("plan"))
+}
@@ -0,0 +1,88 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"fmt"
+
+	"github.com/aiven/aiven-go-client"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+// serviceDiskSpaceMB returns the disk space to request for the service, zero for the disk space of the plan.
+// The additional disk space is added to the disk space of the plan
+func serviceDiskSpaceMB(avn *aiven.Client, o serviceAdapter) (int, error) {
+	additionalMB := v1alpha1.ConvertDiscSpace(o.getAdditionalDiskSpace())
+	if additionalMB == 0 {
+		return v1alpha1.ConvertDiscSpace(o.getDiskSpace()), nil
+	}
+
+	spec := o.getServiceCommonSpec()
+	plan, err := aivenAPIFor(avn).getServicePlan(spec.Project, o.getServiceType(), spec.Plan)
+	if err != nil {
+		return 0, fmt.Errorf("unable to get the disk space of plan %q: %w", spec.Plan, err)
+	}
+	if err = checkAdditionalDiskSpace(spec.Plan, plan, additionalMB); err != nil {
+		return 0, err
+	}
+	return plan.DiskSpaceMB + additionalMB, nil
+}
+
+// checkAdditionalDiskSpace returns an error if the plan doesn't allow the additional disk space
+func checkAdditionalDiskSpace(planName string, plan *aivenServicePlan, additionalMB int) error {
+	maxMB := plan.DiskSpaceCapMB - plan.DiskSpaceMB
+	if plan.DiskSpaceStepMB <= 0 || maxMB <= 0 {
+		return fmt.Errorf("plan %q doesn't allow additional disk space", planName)
+	}
+	if additionalMB%plan.DiskSpaceStepMB != 0 {
+		return fmt.Errorf("additional disk space of plan %q must be a multiple of %dMB, got %dMB", planName, plan.DiskSpaceStepMB, additionalMB)
+	}
+	if additionalMB > maxMB {
+		return fmt.Errorf("additional disk space of plan %q must be up to %dMB, got %dMB", planName, maxMB, additionalMB)
+	}
+	return nil
+}
+
+// declaredDiskSpaceMB returns the disk space the spec declares, zero if it is not known.
+// The disk space of the plan is taken from the status, so no request is made
+func declaredDiskSpaceMB(o serviceAdapter) int {
+	additionalMB := v1alpha1.ConvertDiscSpace(o.getAdditionalDiskSpace())
+	if additionalMB == 0 {
+		return v1alpha1.ConvertDiscSpace(o.getDiskSpace())
+	}
+
+	d := o.getServiceStatus().DiskSpace
+	if d == nil || d.Plan != o.getServiceCommonSpec().Plan {
+		return 0
+	}
+	return d.PlanMB + additionalMB
+}
+
+// updateServiceDiskSpace splits the disk space of the service into the disk space of the plan and the additional one.
+// The plan is fetched when the plan or the disk space of the service changes only
+func updateServiceDiskSpace(avn *aiven.Client, status *v1alpha1.ServiceStatus, project, serviceType string, s *aiven.Service) error {
+	if d := status.DiskSpace; d != nil && d.Plan == s.Plan && d.TotalMB == s.DiskSpaceMB {
+		return nil
+	}
+
+	plan, err := aivenAPIFor(avn).getServicePlan(project, serviceType, s.Plan)
+	if err != nil {
+		return fmt.Errorf("unable to get the disk space of plan %q: %w", s.Plan, err)
+	}
+	status.DiskSpace = newServiceDiskSpace(s.Plan, plan.DiskSpaceMB, s.DiskSpaceMB)
+	return nil
+}
+
+func newServiceDiskSpace(plan string, planMB, totalMB int) *v1alpha1.ServiceDiskSpace {
+	additionalMB := totalMB - planMB
+	if additionalMB < 0 {
+		additionalMB = 0
+	}
+	return &v1alpha1.ServiceDiskSpace{
+		Plan:         plan,
+		PlanMB:       planMB,
+		AdditionalMB: additionalMB,
+		TotalMB:      totalMB,
+	}
+}
@@ -0,0 +1,55 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+func TestCheckAdditionalDiskSpace(t *testing.T) {
+	plan := &aivenServicePlan{DiskSpaceMB: 81920, DiskSpaceStepMB: 10240, DiskSpaceCapMB: 245760}
+
+	assert.NoError(t, checkAdditionalDiskSpace("business-4", plan, 30720))
+	assert.NoError(t, checkAdditionalDiskSpace("business-4", plan, 163840))
+	assert.EqualError(t, checkAdditionalDiskSpace("business-4", plan, 5120),
+		`additional disk space of plan "business-4" must be a multiple of 10240MB, got 5120MB`)
+	assert.EqualError(t, checkAdditionalDiskSpace("business-4", plan, 174080),
+		`additional disk space of plan "business-4" must be up to 163840MB, got 174080MB`)
+
+	// The hobbyist plans have a fixed disk space
+	assert.EqualError(t, checkAdditionalDiskSpace("hobbyist", &aivenServicePlan{DiskSpaceMB: 8192, DiskSpaceCapMB: 8192}, 10240),
+		`plan "hobbyist" doesn't allow additional disk space`)
+}
+
+func TestDeclaredDiskSpaceMB(t *testing.T) {
+	kafka := &v1alpha1.Kafka{}
+	kafka.Spec.Plan = "business-4"
+	o := &kafkaAdapter{Kafka: kafka}
+	assert.Equal(t, 0, declaredDiskSpaceMB(o))
+
+	kafka.Spec.DiskSpace = "100GiB"
+	assert.Equal(t, 102400, declaredDiskSpaceMB(o))
+
+	// The disk space of the plan is not known until the status has it
+	kafka.Spec.DiskSpace = ""
+	kafka.Spec.AdditionalDiskSpace = "30GiB"
+	assert.Equal(t, 0, declaredDiskSpaceMB(o))
+
+	kafka.Status.DiskSpace = newServiceDiskSpace("business-4", 81920, 81920)
+	assert.Equal(t, 112640, declaredDiskSpaceMB(o))
+
+	kafka.Spec.Plan = "business-8"
+	assert.Equal(t, 0, declaredDiskSpaceMB(o))
+}
+
+func TestNewServiceDiskSpace(t *testing.T) {
+	assert.Equal(t, &v1alpha1.ServiceDiskSpace{Plan: "business-4", PlanMB: 81920, AdditionalMB: 30720, TotalMB: 112640},
+		newServiceDiskSpace("business-4", 81920, 112640))
+
+	// The disk space of the plan that was downsized with the service
+	assert.Equal(t, 0, newServiceDiskSpace("startup-4", 81920, 61440).AdditionalMB)
+}
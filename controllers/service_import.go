@@ -0,0 +1,97 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/aiven/aiven-go-client"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+// ImportService renders the resource of a live service with its current user config,
+// so a service tuned outside the operator can be committed to Git and adopted as is.
+func ImportService(scheme *runtime.Scheme, token, project, name string) ([]byte, error) {
+	avn, err := newAivenClient(token)
+	if err != nil {
+		return nil, fmt.Errorf("cannot initialize aiven client: %w", err)
+	}
+
+	s, err := avn.Services.Get(project, name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch service: %w", err)
+	}
+	return renderServiceManifest(scheme, project, s)
+}
+
+// renderServiceManifest returns the YAML of the service kind resource.
+// The user config goes through the typed user config of the kind,
+// which drops the options the CRD does not have.
+func renderServiceManifest(scheme *runtime.Scheme, project string, s *aiven.Service) ([]byte, error) {
+	kind, a, err := newServiceKindAdapter(scheme, s.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	spec := map[string]any{
+		"project":   project,
+		"plan":      s.Plan,
+		"cloudName": s.CloudName,
+	}
+	if s.MaintenanceWindow.DayOfWeek != "" {
+		spec["maintenanceWindowDow"] = s.MaintenanceWindow.DayOfWeek
+		spec["maintenanceWindowTime"] = s.MaintenanceWindow.TimeOfDay
+	}
+	if s.TerminationProtection {
+		spec["terminationProtection"] = true
+	}
+
+	if len(s.UserConfig) > 0 {
+		b, err := json.Marshal(s.UserConfig)
+		if err != nil {
+			return nil, err
+		}
+		userConfig := a.getUserConfig()
+		if err = json.Unmarshal(b, userConfig); err != nil {
+			return nil, fmt.Errorf("cannot convert user config to %s: %w", kind, err)
+		}
+		spec["userConfig"] = userConfig
+	}
+
+	return yaml.Marshal(map[string]any{
+		"apiVersion": v1alpha1.GroupVersion.String(),
+		"kind":       kind,
+		"metadata":   map[string]any{"name": s.Name},
+		"spec":       spec,
+	})
+}
+
+// newServiceKindAdapter returns the kind and an adapter of an empty resource for the Aiven service type
+func newServiceKindAdapter(scheme *runtime.Scheme, serviceType string) (string, serviceAdapter, error) {
+	kinds := make([]string, 0, len(serviceKindAdapters))
+	for kind := range serviceKindAdapters {
+		kinds = append(kinds, kind)
+	}
+	sort.Strings(kinds)
+
+	for _, kind := range kinds {
+		obj, err := scheme.New(v1alpha1.GroupVersion.WithKind(kind))
+		if err != nil {
+			return "", nil, fmt.Errorf("unknown service kind %q: %w", kind, err)
+		}
+		a, err := serviceKindAdapters[kind](nil, obj.(client.Object))
+		if err != nil {
+			return "", nil, err
+		}
+		if a.getServiceType() == serviceType {
+			return kind, a, nil
+		}
+	}
+	return "", nil, fmt.Errorf("service type %q has no kind", serviceType)
+}
@@ -0,0 +1,73 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"testing"
+
+	"github.com/aiven/aiven-go-client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+func TestRenderServiceManifest(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1alpha1.AddToScheme(scheme))
+
+	s := &aiven.Service{
+		Name:      "my-pg",
+		Type:      "pg",
+		Plan:      "business-4",
+		CloudName: "google-europe-west1",
+		MaintenanceWindow: aiven.MaintenanceWindow{
+			DayOfWeek: "sunday",
+			TimeOfDay: "11:00:00",
+		},
+		TerminationProtection: true,
+		UserConfig: map[string]interface{}{
+			"pg_version":      "14",
+			"ip_filter":       []interface{}{"10.20.0.0/16"},
+			"removed_option":  true,
+			"pg":              map[string]interface{}{"autovacuum_max_workers": 5},
+			"static_ips":      false,
+			"backup_hour":     3,
+			"backup_minute":   30,
+			"unknown_section": map[string]interface{}{"foo": "bar"},
+		},
+	}
+
+	b, err := renderServiceManifest(scheme, "my-project", s)
+	require.NoError(t, err)
+	assert.Equal(t, `apiVersion: aiven.io/v1alpha1
+kind: PostgreSQL
+metadata:
+  name: my-pg
+spec:
+  cloudName: google-europe-west1
+  maintenanceWindowDow: sunday
+  maintenanceWindowTime: "11:00:00"
+  plan: business-4
+  project: my-project
+  terminationProtection: true
+  userConfig:
+    backup_hour: 3
+    backup_minute: 30
+    ip_filter:
+    - network: 10.20.0.0/16
+    pg:
+      autovacuum_max_workers: 5
+    pg_version: "14"
+    static_ips: false
+`, string(b))
+}
+
+func TestRenderServiceManifestUnknownType(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1alpha1.AddToScheme(scheme))
+
+	_, err := renderServiceManifest(scheme, "my-project", &aiven.Service{Name: "my-service", Type: "unknown"})
+	assert.EqualError(t, err, `service type "unknown" has no kind`)
+}
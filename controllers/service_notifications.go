@@ -0,0 +1,103 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+const (
+	conditionTypeServiceNotifications = "ServiceNotifications"
+	eventServiceNotification          = "ServiceNotification"
+
+	serviceNotificationLevelWarning = "warning"
+)
+
+// checkServiceNotifications shows the notifications of the service in its status,
+// and emits an event for every new one, so they reach the owners of the manifest and not only the Aiven Console users
+func (h *genericServiceHandler) checkServiceNotifications(object client.Object, o serviceAdapter, notifications []aivenServiceNotification) {
+	if !featureEnabled(featureServiceNotifications) {
+		return
+	}
+
+	for _, n := range updateServiceNotifications(o.getServiceStatus(), notifications) {
+		eventType := corev1.EventTypeNormal
+		if n.Level == serviceNotificationLevelWarning {
+			eventType = corev1.EventTypeWarning
+		}
+		if h.rec != nil {
+			h.rec.Event(object, eventType, eventServiceNotification, n.Message)
+		}
+	}
+}
+
+// updateServiceNotifications sets the notifications and the ServiceNotifications condition, returns the new notifications
+func updateServiceNotifications(status *v1alpha1.ServiceStatus, notifications []aivenServiceNotification) []v1alpha1.ServiceNotification {
+	known := make(map[v1alpha1.ServiceNotification]bool, len(status.Notifications))
+	for _, n := range status.Notifications {
+		known[n] = true
+	}
+
+	current := make([]v1alpha1.ServiceNotification, 0, len(notifications))
+	added := make([]v1alpha1.ServiceNotification, 0)
+	for _, n := range notifications {
+		sn := v1alpha1.ServiceNotification{Type: n.Type, Level: n.Level, Message: n.Message}
+		current = append(current, sn)
+		if !known[sn] {
+			added = append(added, sn)
+		}
+	}
+
+	status.Notifications = nil
+	if len(current) > 0 {
+		status.Notifications = current
+	}
+	meta.SetStatusCondition(&status.Conditions, getServiceNotificationsCondition(current))
+	return added
+}
+
+// getServiceNotificationsCondition is true when the service has notifications, its reason is the type of the first warning
+func getServiceNotificationsCondition(notifications []v1alpha1.ServiceNotification) metav1.Condition {
+	if len(notifications) == 0 {
+		return metav1.Condition{
+			Type:    conditionTypeServiceNotifications,
+			Status:  metav1.ConditionFalse,
+			Reason:  "NoNotifications",
+			Message: "The service has no notifications",
+		}
+	}
+
+	first := notifications[0]
+	messages := make([]string, 0, len(notifications))
+	for _, n := range notifications {
+		if n.Level == serviceNotificationLevelWarning && first.Level != serviceNotificationLevelWarning {
+			first = n
+		}
+		messages = append(messages, n.Message)
+	}
+	return metav1.Condition{
+		Type:    conditionTypeServiceNotifications,
+		Status:  metav1.ConditionTrue,
+		Reason:  serviceNotificationReason(first.Type),
+		Message: strings.Join(messages, "; "),
+	}
+}
+
+// serviceNotificationReason converts the type to a condition reason, e.g. service_end_of_life to ServiceEndOfLife
+func serviceNotificationReason(notificationType string) string {
+	var b strings.Builder
+	for _, w := range strings.FieldsFunc(notificationType, func(r rune) bool { return r == '_' || r == '-' || r == ' ' }) {
+		b.WriteString(strings.ToUpper(w[:1]) + w[1:])
+	}
+	if b.Len() == 0 {
+		return "Notification"
+	}
+	return b.String()
+}
@@ -0,0 +1,78 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/aiven/aiven-go-client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+func TestUpdateServiceNotifications(t *testing.T) {
+	status := &v1alpha1.ServiceStatus{}
+	notice := aivenServiceNotification{Type: "service_maintenance", Level: "notice", Message: "Maintenance update is available"}
+	eol := aivenServiceNotification{Type: "service_end_of_life", Level: "warning", Message: "PostgreSQL 11 reaches its end of life on 2023-11-09"}
+
+	assert.Empty(t, updateServiceNotifications(status, nil))
+	assert.Nil(t, status.Notifications)
+	c := meta.FindStatusCondition(status.Conditions, conditionTypeServiceNotifications)
+	require.NotNil(t, c)
+	assert.Equal(t, metav1.ConditionFalse, c.Status)
+
+	// Both are new, the warning is the reason
+	added := updateServiceNotifications(status, []aivenServiceNotification{notice, eol})
+	assert.Len(t, added, 2)
+	assert.Len(t, status.Notifications, 2)
+	c = meta.FindStatusCondition(status.Conditions, conditionTypeServiceNotifications)
+	assert.Equal(t, metav1.ConditionTrue, c.Status)
+	assert.Equal(t, "ServiceEndOfLife", c.Reason)
+	assert.Equal(t, "Maintenance update is available; PostgreSQL 11 reaches its end of life on 2023-11-09", c.Message)
+
+	// The known ones are not new
+	added = updateServiceNotifications(status, []aivenServiceNotification{eol})
+	assert.Empty(t, added)
+	assert.Equal(t, []v1alpha1.ServiceNotification{{Type: "service_end_of_life", Level: "warning", Message: eol.Message}}, status.Notifications)
+
+	// The notification is gone
+	updateServiceNotifications(status, nil)
+	assert.Nil(t, status.Notifications)
+	assert.Equal(t, "NoNotifications", meta.FindStatusCondition(status.Conditions, conditionTypeServiceNotifications).Reason)
+}
+
+func TestServiceNotificationReason(t *testing.T) {
+	assert.Equal(t, "ServiceEndOfLife", serviceNotificationReason("service_end_of_life"))
+	assert.Equal(t, "ServicePoweredOffRemoval", serviceNotificationReason("service_powered_off_removal"))
+	assert.Equal(t, "Notification", serviceNotificationReason(""))
+}
+
+func TestGetServiceWithNotifications(t *testing.T) {
+	api := &staticAivenAPI{responses: map[string]interface{}{
+		"/project/foo/service/pg": map[string]interface{}{"service": map[string]interface{}{
+			"service_name":          "pg",
+			"state":                 "RUNNING",
+			"user_config":           map[string]interface{}{"pg": map[string]interface{}{"work_mem": 8}},
+			"service_notifications": []aivenServiceNotification{{Type: "service_end_of_life", Level: "warning", Message: "EOL"}},
+		}},
+	}}
+	avn := newFakeAivenClient("token", api)
+
+	s, notifications, err := aivenAPIFor(avn).getServiceWithNotifications("foo", "pg")
+	require.NoError(t, err)
+	assert.Equal(t, "RUNNING", s.State)
+	assert.Equal(t, []aivenServiceNotification{{Type: "service_end_of_life", Level: "warning", Message: "EOL"}}, notifications)
+	assert.Equal(t, []string{"GET /project/foo/service/pg"}, api.requests)
+
+	// The numbers are decoded like the client does
+	assert.Equal(t, json.Number("8"), s.UserConfig["pg"].(map[string]interface{})["work_mem"])
+
+	// The errors are the client ones
+	_, _, err = aivenAPIFor(avn).getServiceWithNotifications("foo", "missing")
+	assert.True(t, aiven.IsNotFound(err))
+}
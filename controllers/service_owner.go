@@ -0,0 +1,76 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+// serviceOwner returns the owner of the service, nil if the object is not a service or has no owner
+func serviceOwner(obj runtime.Object) *v1alpha1.ServiceOwner {
+	o, ok := obj.(client.Object)
+	if !ok {
+		return nil
+	}
+	for _, fabric := range serviceKindAdapters {
+		a, err := fabric(nil, o)
+		if err == nil {
+			return a.getServiceCommonSpec().Owner
+		}
+	}
+	return nil
+}
+
+// ownerEventRecorder adds the owner of the service to its events, so the alerts built on the events can be routed to the team:
+// the events are annotated with the owner tags prefixed with aiven.io/, and the owner is appended to the message of the warnings
+type ownerEventRecorder struct {
+	record.EventRecorder
+}
+
+// newOwnerEventRecorder wraps the recorder, nil stays nil
+func newOwnerEventRecorder(rec record.EventRecorder) record.EventRecorder {
+	if rec == nil {
+		return nil
+	}
+	if _, ok := rec.(*ownerEventRecorder); ok {
+		return rec
+	}
+	return &ownerEventRecorder{EventRecorder: rec}
+}
+
+func (r *ownerEventRecorder) Event(object runtime.Object, eventtype, reason, message string) {
+	r.AnnotatedEventf(object, nil, eventtype, reason, "%s", message)
+}
+
+func (r *ownerEventRecorder) Eventf(object runtime.Object, eventtype, reason, messageFmt string, args ...interface{}) {
+	r.AnnotatedEventf(object, nil, eventtype, reason, messageFmt, args...)
+}
+
+func (r *ownerEventRecorder) AnnotatedEventf(object runtime.Object, annotations map[string]string, eventtype, reason, messageFmt string, args ...interface{}) {
+	owner := serviceOwner(object)
+	if owner == nil {
+		r.EventRecorder.AnnotatedEventf(object, annotations, eventtype, reason, messageFmt, args...)
+		return
+	}
+
+	merged := make(map[string]string)
+	for k, v := range owner.Tags() {
+		merged["aiven.io/"+k] = v
+	}
+	for k, v := range annotations {
+		merged[k] = v
+	}
+
+	message := fmt.Sprintf(messageFmt, args...)
+	if eventtype == corev1.EventTypeWarning {
+		message = fmt.Sprintf("%s (owner: %s)", message, owner)
+	}
+	r.EventRecorder.AnnotatedEventf(object, merged, eventtype, reason, "%s", message)
+}
@@ -0,0 +1,48 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+func TestOwnerEventRecorder(t *testing.T) {
+	fake := record.NewFakeRecorder(10)
+	rec := newOwnerEventRecorder(fake)
+
+	pg := &v1alpha1.PostgreSQL{}
+	pg.Spec.Owner = &v1alpha1.ServiceOwner{Team: "payments", Slack: "#payments"}
+
+	rec.Event(pg, corev1.EventTypeNormal, eventInstanceIsRunning, "instance is in a RUNNING state")
+	assert.Equal(t, "Normal InstanceIsRunning instance is in a RUNNING state", <-fake.Events)
+
+	rec.Eventf(pg, corev1.EventTypeWarning, eventDiskPressure, "disk usage is %d%%", 90)
+	assert.Equal(t, "Warning DiskPressure disk usage is 90% (owner: team payments, slack #payments)", <-fake.Events)
+
+	// Not a service
+	rec.Event(&v1alpha1.Project{}, corev1.EventTypeWarning, eventUnableToCreateClient, "unauthorized")
+	assert.Equal(t, "Warning UnableToCreateClient unauthorized", <-fake.Events)
+
+	// Wrapped once only
+	assert.Same(t, rec, newOwnerEventRecorder(rec))
+	assert.Nil(t, newOwnerEventRecorder(nil))
+}
+
+func TestServiceTagsOwner(t *testing.T) {
+	spec := &v1alpha1.ServiceCommonSpec{
+		Tags:  map[string]string{"env": "prod", "owner-team": "someone"},
+		Owner: &v1alpha1.ServiceOwner{Team: "payments", Email: "payments@example.com"},
+	}
+	expected := map[string]string{
+		"env":         "prod",
+		"owner-team":  "payments",
+		"owner-email": "payments@example.com",
+	}
+	assert.Equal(t, expected, serviceTags(spec))
+}
@@ -0,0 +1,251 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+// ServicePlanLimitsPath validates the user config of the service kinds against the sizes of their plans
+const ServicePlanLimitsPath = "/validate-aiven-io-v1alpha1-service-planlimits"
+
+//+kubebuilder:webhook:verbs=create;update,path=/validate-aiven-io-v1alpha1-service-planlimits,mutating=false,failurePolicy=fail,groups=aiven.io,resources=cassandras;clickhouses;dragonflies;grafanas;kafkas;kafkaconnects;m3aggregators;m3dbs;mysqls;opensearches;postgresqls;redis;thanos;valkeys,versions=v1alpha1,name=vserviceplanlimits.kb.io,sideEffects=none,admissionReviewVersions=v1
+
+// ServicePlanLimitsValidator rejects the user config values that don't fit the memory or the disk of the plan,
+// and warns about the ones that take a large part of them. It also rejects the additional disk space the plan doesn't allow.
+// The plan is fetched with the token of the service, a service that can't be checked is allowed with a warning
+type ServicePlanLimitsValidator struct {
+	Client  client.Client
+	Decoder *admission.Decoder
+
+	// DefaultToken is used for the services without authSecretRef
+	DefaultToken string
+}
+
+const (
+	planResourceMemory = "memory"
+	planResourceDisk   = "disk"
+)
+
+// planLimitRule limits a user config value to the memory of a node or the disk of the plan
+type planLimitRule struct {
+	// Path of the value in the user config, e.g. pg.work_mem
+	path string
+
+	// The bytes of a unit of the value
+	unit float64
+
+	// planResourceMemory or planResourceDisk
+	resource string
+
+	// The value above the part of the resource is warned about, the one above the whole resource is rejected
+	warnFraction float64
+
+	// Why the value takes a large part of the resource, added to the warning
+	reason string
+}
+
+const (
+	bytesInKiB = 1 << 10
+	bytesInMiB = 1 << 20
+)
+
+// planLimitRules are the user config values that depend on the size of the plan, by the service type
+var planLimitRules = map[string][]planLimitRule{
+	"pg": {
+		{path: "pg.work_mem", unit: bytesInMiB, resource: planResourceMemory, warnFraction: 1.0 / 16, reason: "every sort and hash of every connection can use it"},
+		{path: "pg.temp_file_limit", unit: bytesInKiB, resource: planResourceDisk, warnFraction: 1.0 / 2, reason: "the temporary files share the disk with the data"},
+	},
+	"mysql": {
+		{path: "mysql.innodb_log_buffer_size", unit: 1, resource: planResourceMemory, warnFraction: 1.0 / 4},
+		{path: "mysql.max_heap_table_size", unit: 1, resource: planResourceMemory, warnFraction: 1.0 / 4, reason: "every connection can use it"},
+		{path: "mysql.tmp_table_size", unit: 1, resource: planResourceMemory, warnFraction: 1.0 / 4, reason: "every connection can use it"},
+	},
+	"kafka": {
+		{path: "kafka.log_retention_bytes", unit: 1, resource: planResourceDisk, warnFraction: 1.0 / 2, reason: "the limit applies to every partition"},
+	},
+	"redis": {
+		{path: "redis_pubsub_client_output_buffer_limit", unit: bytesInMiB, resource: planResourceMemory, warnFraction: 1.0 / 4, reason: "every pub/sub client can use it"},
+	},
+	"valkey": {
+		{path: "valkey_pubsub_client_output_buffer_limit", unit: bytesInMiB, resource: planResourceMemory, warnFraction: 1.0 / 4, reason: "every pub/sub client can use it"},
+	},
+	"opensearch": {
+		{path: "opensearch_dashboards.max_old_space_size", unit: bytesInMiB, resource: planResourceMemory, warnFraction: 1.0 / 2, reason: "the memory of OpenSearch Dashboards is not available for OpenSearch"},
+	},
+}
+
+// servicePlanSizes are the sizes the plan limits are checked against, zero if not known
+type servicePlanSizes struct {
+	NodeMemoryMB int
+	DiskSpaceMB  int
+}
+
+func (v *ServicePlanLimitsValidator) Handle(ctx context.Context, req admission.Request) admission.Response {
+	fabric, ok := serviceKindAdapters[req.Kind.Kind]
+	if !ok {
+		return admission.Allowed("")
+	}
+
+	spec, obj, err := decodeServiceSpec(v.Client, v.Decoder, req.Object, req.Kind.Kind, fabric)
+	if err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	o, err := fabric(nil, obj)
+	if err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	rules := planLimitRules[o.getServiceType()]
+	additionalMB := v1alpha1.ConvertDiscSpace(o.getAdditionalDiskSpace())
+	if (len(rules) == 0 && additionalMB == 0) || spec.Plan == "" {
+		return admission.Allowed("")
+	}
+
+	userConfig, err := planLimitsUserConfig(o)
+	if err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	// Validates the changes only, so a value that was accepted before doesn't block the other updates
+	if req.OldObject.Raw != nil {
+		oldSpec, oldObj, err := decodeServiceSpec(v.Client, v.Decoder, req.OldObject, req.Kind.Kind, fabric)
+		if err != nil {
+			return admission.Errored(http.StatusBadRequest, err)
+		}
+		old, err := fabric(nil, oldObj)
+		if err != nil {
+			return admission.Errored(http.StatusBadRequest, err)
+		}
+		oldUserConfig, err := planLimitsUserConfig(old)
+		if err != nil {
+			return admission.Errored(http.StatusBadRequest, err)
+		}
+		if oldSpec.Plan == spec.Plan && oldSpec.CloudName == spec.CloudName &&
+			old.getDiskSpace() == o.getDiskSpace() && old.getAdditionalDiskSpace() == o.getAdditionalDiskSpace() &&
+			reflect.DeepEqual(oldUserConfig, userConfig) {
+			return admission.Allowed("")
+		}
+	}
+
+	token, err := getAdmissionToken(ctx, v.Client, v.DefaultToken, obj.(aivenManagedObject))
+	if err != nil {
+		return admission.Allowed("").WithWarnings(fmt.Sprintf("user config is not validated against plan %q: %s", spec.Plan, err))
+	}
+
+	plan, err := newAivenAPI(token).getServicePlan(spec.Project, o.getServiceType(), spec.Plan)
+	if err != nil {
+		return admission.Allowed("").WithWarnings(fmt.Sprintf("user config is not validated against plan %q: %s", spec.Plan, err))
+	}
+
+	sizes := servicePlanSizes{
+		NodeMemoryMB: plan.Regions[spec.CloudName].NodeMemoryMB,
+		DiskSpaceMB:  plan.DiskSpaceMB,
+	}
+	if d := v1alpha1.ConvertDiscSpace(o.getDiskSpace()); d > 0 {
+		sizes.DiskSpaceMB = d
+	}
+	if additionalMB > 0 {
+		if err = checkAdditionalDiskSpace(spec.Plan, plan, additionalMB); err != nil {
+			return admission.Denied(err.Error())
+		}
+		sizes.DiskSpaceMB = plan.DiskSpaceMB + additionalMB
+	}
+
+	warnings, err := checkServicePlanLimits(spec.Plan, rules, userConfig, sizes)
+	if err != nil {
+		return admission.Denied(err.Error())
+	}
+	return admission.Allowed("").WithWarnings(warnings...)
+}
+
+// planLimitsUserConfig returns the user config of the service with the numbers as float64
+func planLimitsUserConfig(o serviceAdapter) (map[string]interface{}, error) {
+	userConfig, err := UserConfigurationToAPIV2(o.getUserConfig(), []string{"create", "update"})
+	if err != nil {
+		return nil, err
+	}
+	return normalizeJSON(userConfig)
+}
+
+// checkServicePlanLimits returns an error for the values that exceed the memory of a node or the disk of the plan,
+// and the warnings for the ones that take a large part of them. The unknown sizes are not checked
+func checkServicePlanLimits(plan string, rules []planLimitRule, userConfig map[string]interface{}, sizes servicePlanSizes) ([]string, error) {
+	warnings := make([]string, 0)
+	rejected := make([]string, 0)
+	for _, r := range rules {
+		value, ok := userConfigNumber(userConfig, r.path)
+		if !ok || value < 0 {
+			// Negative values are "unlimited" or "default"
+			continue
+		}
+
+		sizeMB := sizes.NodeMemoryMB
+		if r.resource == planResourceDisk {
+			sizeMB = sizes.DiskSpaceMB
+		}
+		if sizeMB == 0 {
+			continue
+		}
+
+		bytes := value * r.unit
+		size := float64(sizeMB) * bytesInMiB
+		switch {
+		case bytes > size:
+			rejected = append(rejected, fmt.Sprintf("%s is %s, more than the %s of plan %q (%dMB)",
+				r.path, formatBytes(bytes), r.resource, plan, sizeMB))
+		case bytes > size*r.warnFraction:
+			w := fmt.Sprintf("%s is %s, %.0f%% of the %s of plan %q (%dMB)",
+				r.path, formatBytes(bytes), 100*bytes/size, r.resource, plan, sizeMB)
+			if r.reason != "" {
+				w += ", " + r.reason
+			}
+			warnings = append(warnings, w)
+		}
+	}
+
+	if len(rejected) > 0 {
+		sort.Strings(rejected)
+		return nil, fmt.Errorf("user config doesn't fit the plan: %s", strings.Join(rejected, "; "))
+	}
+	return warnings, nil
+}
+
+// userConfigNumber returns the number at the dotted path of the user config
+func userConfigNumber(userConfig map[string]interface{}, path string) (float64, bool) {
+	keys := strings.Split(path, ".")
+	m := userConfig
+	for _, k := range keys[:len(keys)-1] {
+		next, ok := m[k].(map[string]interface{})
+		if !ok {
+			return 0, false
+		}
+		m = next
+	}
+	v, ok := m[keys[len(keys)-1]].(float64)
+	return v, ok
+}
+
+// formatBytes returns the size in the largest whole unit, e.g. 512MB
+func formatBytes(b float64) string {
+	for _, u := range []struct {
+		name string
+		size float64
+	}{{"GB", 1 << 30}, {"MB", bytesInMiB}, {"KB", bytesInKiB}} {
+		if b >= u.size {
+			return fmt.Sprintf("%.0f%s", b/u.size, u.name)
+		}
+	}
+	return fmt.Sprintf("%.0fB", b)
+}
@@ -0,0 +1,70 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckServicePlanLimits(t *testing.T) {
+	rules := planLimitRules["pg"]
+	sizes := servicePlanSizes{NodeMemoryMB: 4096, DiskSpaceMB: 81920}
+
+	// The defaults and the small values fit any plan
+	warnings, err := checkServicePlanLimits("startup-4", rules, map[string]interface{}{}, sizes)
+	require.NoError(t, err)
+	assert.Empty(t, warnings)
+
+	warnings, err = checkServicePlanLimits("startup-4", rules, map[string]interface{}{
+		"pg": map[string]interface{}{"work_mem": float64(32), "temp_file_limit": float64(-1)},
+	}, sizes)
+	require.NoError(t, err)
+	assert.Empty(t, warnings)
+
+	// A large work_mem is warned about
+	warnings, err = checkServicePlanLimits("startup-4", rules, map[string]interface{}{
+		"pg": map[string]interface{}{"work_mem": float64(640)},
+	}, sizes)
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		`pg.work_mem is 640MB, 16% of the memory of plan "startup-4" (4096MB), every sort and hash of every connection can use it`,
+	}, warnings)
+
+	// The values above the plan sizes are rejected
+	_, err = checkServicePlanLimits("startup-4", rules, map[string]interface{}{
+		"pg": map[string]interface{}{"work_mem": float64(1024), "temp_file_limit": float64(100 << 20)},
+	}, servicePlanSizes{NodeMemoryMB: 512, DiskSpaceMB: 81920})
+	assert.EqualError(t, err, `user config doesn't fit the plan: pg.temp_file_limit is 100GB, more than the disk of plan "startup-4" (81920MB); `+
+		`pg.work_mem is 1GB, more than the memory of plan "startup-4" (512MB)`)
+
+	// The memory is not known when the plan is not available in the cloud
+	warnings, err = checkServicePlanLimits("startup-4", rules, map[string]interface{}{
+		"pg": map[string]interface{}{"work_mem": float64(1024)},
+	}, servicePlanSizes{DiskSpaceMB: 81920})
+	require.NoError(t, err)
+	assert.Empty(t, warnings)
+}
+
+func TestUserConfigNumber(t *testing.T) {
+	userConfig := map[string]interface{}{
+		"kafka":                     map[string]interface{}{"log_retention_bytes": float64(1024)},
+		"redis_maxmemory_policy":    "noeviction",
+		"redis_number_of_databases": float64(16),
+	}
+
+	v, ok := userConfigNumber(userConfig, "kafka.log_retention_bytes")
+	assert.True(t, ok)
+	assert.Equal(t, float64(1024), v)
+
+	v, ok = userConfigNumber(userConfig, "redis_number_of_databases")
+	assert.True(t, ok)
+	assert.Equal(t, float64(16), v)
+
+	_, ok = userConfigNumber(userConfig, "redis_maxmemory_policy")
+	assert.False(t, ok)
+	_, ok = userConfigNumber(userConfig, "pg.work_mem")
+	assert.False(t, ok)
+}
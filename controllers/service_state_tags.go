@@ -0,0 +1,103 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+const (
+	// stateTagOwner is the Aiven tag with the namespace and the name of the resource that manages the service
+	stateTagOwner = "aiven-operator-owner"
+
+	// stateTagSpecHash is the Aiven tag with the hash of the spec the operator applied last
+	stateTagSpecHash = "aiven-operator-spec-hash"
+
+	// stateTagMaxLength is the length limit of the Aiven tag values
+	stateTagMaxLength = 64
+
+	eventAdoptedByStateTags = "AdoptedByStateTags"
+)
+
+// serviceStateTagsEnabled stores the owner and the spec hash of the services in their Aiven tags
+var serviceStateTagsEnabled bool
+
+// SetServiceStateTags stores the owner and the spec hash of the services in their Aiven tags.
+// A rebuilt cluster applying the same manifests then takes the services over without updating them.
+func SetServiceStateTags(enabled bool) {
+	serviceStateTagsEnabled = enabled
+}
+
+// stateTagOwnerValue returns the namespace and the name of the resource, hashed if they don't fit the tag value
+func stateTagOwnerValue(object client.Object) string {
+	owner := object.GetNamespace() + "/" + object.GetName()
+	if len(owner) <= stateTagMaxLength {
+		return owner
+	}
+	sum := sha256.Sum256([]byte(owner))
+	return "sha256:" + hex.EncodeToString(sum[:])[:stateTagMaxLength-len("sha256:")]
+}
+
+// serviceSpecHash returns the hash of the spec of the resource
+func serviceSpecHash(object client.Object) (string, error) {
+	b, err := json.Marshal(object)
+	if err != nil {
+		return "", err
+	}
+
+	// The keys of the structs and the maps are marshalled in the same order, so the same spec gets the same hash
+	var o struct {
+		Spec json.RawMessage `json:"spec"`
+	}
+	err = json.Unmarshal(b, &o)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(o.Spec)
+	return hex.EncodeToString(sum[:16]), nil
+}
+
+// serviceStateTags returns the state tags of the resource
+func serviceStateTags(object client.Object) (map[string]string, error) {
+	hash, err := serviceSpecHash(object)
+	if err != nil {
+		return nil, fmt.Errorf("unable to hash the spec: %w", err)
+	}
+	return map[string]string{
+		stateTagOwner:    stateTagOwnerValue(object),
+		stateTagSpecHash: hash,
+	}, nil
+}
+
+// adoptedByStateTags tells if the resource, never applied by this operator, takes over the service without updating it:
+// the service is owned by the resource, and the spec hasn't changed since it was applied.
+// Returns an error if the service is owned by another resource.
+func adoptedByStateTags(object client.Object, status *v1alpha1.ServiceStatus, tags map[string]string) (bool, error) {
+	owner, ok := tags[stateTagOwner]
+	if !ok {
+		return false, nil
+	}
+
+	if owner != stateTagOwnerValue(object) {
+		return false, fmt.Errorf("service is managed by %q, remove the %s tag of the service in Aiven to take it over", owner, stateTagOwner)
+	}
+
+	// The resources this operator has applied are updated as usual
+	if meta.FindStatusCondition(status.Conditions, conditionTypeInitialized) != nil {
+		return false, nil
+	}
+
+	hash, err := serviceSpecHash(object)
+	if err != nil {
+		return false, fmt.Errorf("unable to hash the spec: %w", err)
+	}
+	return tags[stateTagSpecHash] == hash, nil
+}
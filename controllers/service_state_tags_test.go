@@ -0,0 +1,91 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+func TestStateTagOwnerValue(t *testing.T) {
+	pg := &v1alpha1.PostgreSQL{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "pg-sample"}}
+	assert.Equal(t, "default/pg-sample", stateTagOwnerValue(pg))
+
+	// Too long for the tag value
+	pg.Namespace = strings.Repeat("n", 63)
+	owner := stateTagOwnerValue(pg)
+	assert.Len(t, owner, stateTagMaxLength)
+	assert.True(t, strings.HasPrefix(owner, "sha256:"))
+	assert.Equal(t, owner, stateTagOwnerValue(pg))
+}
+
+func TestServiceSpecHash(t *testing.T) {
+	pg := &v1alpha1.PostgreSQL{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "pg-sample", Generation: 1},
+		Spec: v1alpha1.PostgreSQLSpec{
+			ServiceCommonSpec: v1alpha1.ServiceCommonSpec{Project: "test", Plan: "startup-4", Tags: map[string]string{"env": "test", "app": "pg"}},
+		},
+	}
+	hash, err := serviceSpecHash(pg)
+	require.NoError(t, err)
+	assert.Len(t, hash, 32)
+
+	// The metadata and the status are not hashed
+	pg.Generation = 2
+	pg.Status.State = "RUNNING"
+	same, err := serviceSpecHash(pg)
+	require.NoError(t, err)
+	assert.Equal(t, hash, same)
+
+	pg.Spec.Plan = "business-4"
+	other, err := serviceSpecHash(pg)
+	require.NoError(t, err)
+	assert.NotEqual(t, hash, other)
+}
+
+func TestAdoptedByStateTags(t *testing.T) {
+	pg := &v1alpha1.PostgreSQL{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "pg-sample"},
+		Spec: v1alpha1.PostgreSQLSpec{
+			ServiceCommonSpec: v1alpha1.ServiceCommonSpec{Project: "test", Plan: "startup-4"},
+		},
+	}
+	tags, err := serviceStateTags(pg)
+	require.NoError(t, err)
+
+	// The fresh resource with the same spec is adopted
+	adopted, err := adoptedByStateTags(pg, &pg.Status.ServiceStatus, tags)
+	require.NoError(t, err)
+	assert.True(t, adopted)
+
+	// The service without the state tags is updated
+	adopted, err = adoptedByStateTags(pg, &pg.Status.ServiceStatus, map[string]string{"env": "test"})
+	require.NoError(t, err)
+	assert.False(t, adopted)
+
+	// The changed spec is applied
+	pg.Spec.Plan = "business-4"
+	adopted, err = adoptedByStateTags(pg, &pg.Status.ServiceStatus, tags)
+	require.NoError(t, err)
+	assert.False(t, adopted)
+
+	// The resource applied by this operator is updated as usual
+	pg.Spec.Plan = "startup-4"
+	meta.SetStatusCondition(&pg.Status.Conditions, getInitializedCondition("Created", ""))
+	adopted, err = adoptedByStateTags(pg, &pg.Status.ServiceStatus, tags)
+	require.NoError(t, err)
+	assert.False(t, adopted)
+
+	// The service of another resource is not taken over
+	other := pg.DeepCopy()
+	other.Namespace = "staging"
+	_, err = adoptedByStateTags(other, &other.Status.ServiceStatus, tags)
+	assert.ErrorContains(t, err, `service is managed by "default/pg-sample"`)
+}
@@ -0,0 +1,191 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+// ServiceProjectVPCPath validates the project VPC of the service kinds,
+// which needs the ProjectVPC resources the webhooks of the types can't read
+const ServiceProjectVPCPath = "/validate-aiven-io-v1alpha1-service-projectvpc"
+
+//+kubebuilder:webhook:verbs=create;update,path=/validate-aiven-io-v1alpha1-service-projectvpc,mutating=false,failurePolicy=fail,groups=aiven.io,resources=cassandras;clickhouses;dragonflies;grafanas;kafkas;kafkaconnects;m3aggregators;m3dbs;mysqls;opensearches;postgresqls;redis;thanos;valkeys,versions=v1alpha1,name=vserviceprojectvpc.kb.io,sideEffects=none,admissionReviewVersions=v1
+
+// ServiceProjectVPCValidator rejects services that can't be created in their project or organization VPC:
+// a VPC of another project or cloud, or a VPC that is being deleted.
+// Aiven rejects those, and the service would be stuck retrying the creation
+type ServiceProjectVPCValidator struct {
+	Client  client.Client
+	Decoder *admission.Decoder
+}
+
+func (v *ServiceProjectVPCValidator) Handle(ctx context.Context, req admission.Request) admission.Response {
+	fabric, ok := serviceKindAdapters[req.Kind.Kind]
+	if !ok {
+		return admission.Allowed("")
+	}
+
+	spec, obj, err := decodeServiceSpec(v.Client, v.Decoder, req.Object, req.Kind.Kind, fabric)
+	if err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	// Validates the changes only, so the updates of a service in a VPC that changed afterwards are not blocked
+	if req.OldObject.Raw != nil {
+		old, _, err := decodeServiceSpec(v.Client, v.Decoder, req.OldObject, req.Kind.Kind, fabric)
+		if err != nil {
+			return admission.Errored(http.StatusBadRequest, err)
+		}
+		if old.CloudName == spec.CloudName && old.ProjectVPCID == spec.ProjectVPCID &&
+			equalResourceReferences(old.ProjectVPCRef, spec.ProjectVPCRef) &&
+			equalResourceReferences(old.OrganizationVPCRef, spec.OrganizationVPCRef) {
+			return admission.Allowed("")
+		}
+	}
+
+	var warning string
+	if spec.OrganizationVPCRef != nil {
+		warning, err = v.checkOrganizationVPC(ctx, spec, obj.GetNamespace())
+	} else {
+		var vpc *v1alpha1.ProjectVPC
+		vpc, warning, err = v.findProjectVPC(ctx, spec, obj.GetNamespace())
+		if err != nil {
+			return admission.Errored(http.StatusInternalServerError, err)
+		}
+		if vpc != nil {
+			warning, err = checkServiceProjectVPC(spec, vpc)
+		}
+	}
+	if err != nil {
+		return admission.Denied(err.Error())
+	}
+
+	resp := admission.Allowed("")
+	if warning != "" {
+		resp = resp.WithWarnings(warning)
+	}
+	return resp
+}
+
+// decodeServiceSpec decodes the service of the admission request
+func decodeServiceSpec(c client.Client, d *admission.Decoder, raw runtime.RawExtension, kind string, fabric serviceAdapterFabric) (*v1alpha1.ServiceCommonSpec, client.Object, error) {
+	obj, err := c.Scheme().New(v1alpha1.GroupVersion.WithKind(kind))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = d.DecodeRaw(raw, obj)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	o := obj.(client.Object)
+	a, err := fabric(nil, o)
+	if err != nil {
+		return nil, nil, err
+	}
+	return a.getServiceCommonSpec(), o, nil
+}
+
+// findProjectVPC returns the referenced ProjectVPC, or the one with the projectVpcId in the namespace of the service.
+// A VPC that is not created yet is not validated, a referenced one is waited for
+func (v *ServiceProjectVPCValidator) findProjectVPC(ctx context.Context, spec *v1alpha1.ServiceCommonSpec, namespace string) (*v1alpha1.ProjectVPC, string, error) {
+	if spec.ProjectVPCRef != nil {
+		key := spec.ProjectVPCRef.ProjectVPC(namespace).NamespacedName
+		vpc := &v1alpha1.ProjectVPC{}
+		err := v.Client.Get(ctx, key, vpc)
+		if apierrors.IsNotFound(err) {
+			return nil, fmt.Sprintf("ProjectVPC %q is not found, the service waits for it", key), nil
+		}
+		if err != nil {
+			return nil, "", err
+		}
+		return vpc, "", nil
+	}
+
+	if spec.ProjectVPCID == "" {
+		return nil, "", nil
+	}
+
+	list := &v1alpha1.ProjectVPCList{}
+	err := v.Client.List(ctx, list, client.InNamespace(namespace))
+	if err != nil {
+		return nil, "", err
+	}
+	for i := range list.Items {
+		if list.Items[i].Status.ID == spec.ProjectVPCID {
+			return &list.Items[i], "", nil
+		}
+	}
+
+	// The VPC is not managed by the operator
+	return nil, "", nil
+}
+
+// checkServiceProjectVPC returns an error if the service can't be created in the VPC,
+// and a warning if the VPC is not ready yet
+func checkServiceProjectVPC(spec *v1alpha1.ServiceCommonSpec, vpc *v1alpha1.ProjectVPC) (string, error) {
+	if vpc.Spec.Project != spec.Project {
+		return "", fmt.Errorf("ProjectVPC %q belongs to project %q, but the service is in project %q", vpc.Name, vpc.Spec.Project, spec.Project)
+	}
+
+	if spec.CloudName != "" && spec.CloudName != vpc.Spec.CloudName {
+		return "", fmt.Errorf("ProjectVPC %q is in cloud %q, but cloudName is %q. The service must be in the cloud of its VPC", vpc.Name, vpc.Spec.CloudName, spec.CloudName)
+	}
+
+	switch vpc.Status.State {
+	case "ACTIVE":
+		return "", nil
+	case "DELETING", "DELETED":
+		return "", fmt.Errorf("ProjectVPC %q is %s", vpc.Name, vpc.Status.State)
+	case "":
+		return fmt.Sprintf("ProjectVPC %q is not created yet, the service waits for it to be ACTIVE", vpc.Name), nil
+	}
+	return fmt.Sprintf("ProjectVPC %q is %s, the service waits for it to be ACTIVE", vpc.Name, vpc.Status.State), nil
+}
+
+// checkOrganizationVPC returns an error if the service can't be created in the referenced OrganizationVPC,
+// and a warning if the VPC is not ready yet
+func (v *ServiceProjectVPCValidator) checkOrganizationVPC(ctx context.Context, spec *v1alpha1.ServiceCommonSpec, namespace string) (string, error) {
+	key := spec.OrganizationVPCRef.OrganizationVPC(namespace).NamespacedName
+	vpc := &v1alpha1.OrganizationVPC{}
+	err := v.Client.Get(ctx, key, vpc)
+	if apierrors.IsNotFound(err) {
+		return fmt.Sprintf("OrganizationVPC %q is not found, the service waits for it", key), nil
+	}
+	if err != nil {
+		// Not a reason to reject the service, it waits for the VPC anyway
+		return fmt.Sprintf("OrganizationVPC %q is not validated: %s", key, err), nil
+	}
+
+	if spec.CloudName != "" && spec.CloudName != vpc.Spec.CloudName {
+		return "", fmt.Errorf("OrganizationVPC %q is in cloud %q, but cloudName is %q. The service must be in the cloud of its VPC", vpc.Name, vpc.Spec.CloudName, spec.CloudName)
+	}
+
+	switch vpc.Status.State {
+	case "ACTIVE":
+		return "", nil
+	case "DELETING", "DELETED":
+		return "", fmt.Errorf("OrganizationVPC %q is %s", vpc.Name, vpc.Status.State)
+	case "":
+		return fmt.Sprintf("OrganizationVPC %q is not created yet, the service waits for it to be ACTIVE", vpc.Name), nil
+	}
+	return fmt.Sprintf("OrganizationVPC %q is %s, the service waits for it to be ACTIVE", vpc.Name, vpc.Status.State), nil
+}
+
+func equalResourceReferences(a, b *v1alpha1.ResourceReference) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
@@ -0,0 +1,51 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+func TestCheckServiceProjectVPC(t *testing.T) {
+	vpc := func(project, cloud, state string) *v1alpha1.ProjectVPC {
+		return &v1alpha1.ProjectVPC{
+			ObjectMeta: metav1.ObjectMeta{Name: "vpc"},
+			Spec:       v1alpha1.ProjectVPCSpec{Project: project, CloudName: cloud},
+			Status:     v1alpha1.ProjectVPCStatus{State: state},
+		}
+	}
+	spec := &v1alpha1.ServiceCommonSpec{Project: "dev", CloudName: "google-europe-west1"}
+
+	cases := []struct {
+		name    string
+		spec    *v1alpha1.ServiceCommonSpec
+		vpc     *v1alpha1.ProjectVPC
+		warning bool
+		err     string
+	}{
+		{name: "active", spec: spec, vpc: vpc("dev", "google-europe-west1", "ACTIVE")},
+		{name: "cloud of the vpc", spec: &v1alpha1.ServiceCommonSpec{Project: "dev"}, vpc: vpc("dev", "aws-eu-west-1", "ACTIVE")},
+		{name: "being created", spec: spec, vpc: vpc("dev", "google-europe-west1", "APPROVED"), warning: true},
+		{name: "not created yet", spec: spec, vpc: vpc("dev", "google-europe-west1", ""), warning: true},
+		{name: "another cloud", spec: spec, vpc: vpc("dev", "aws-eu-west-1", "ACTIVE"), err: `ProjectVPC "vpc" is in cloud "aws-eu-west-1", but cloudName is "google-europe-west1"`},
+		{name: "another project", spec: spec, vpc: vpc("prod", "google-europe-west1", "ACTIVE"), err: `ProjectVPC "vpc" belongs to project "prod"`},
+		{name: "deleting", spec: spec, vpc: vpc("dev", "google-europe-west1", "DELETING"), err: `ProjectVPC "vpc" is DELETING`},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			warning, err := checkServiceProjectVPC(c.spec, c.vpc)
+			if c.err != "" {
+				assert.ErrorContains(t, err, c.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, c.warning, warning != "")
+		})
+	}
+}
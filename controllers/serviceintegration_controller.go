@@ -35,6 +35,7 @@ func (r *ServiceIntegrationReconciler) Reconcile(ctx context.Context, req ctrl.R
 func (r *ServiceIntegrationReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&v1alpha1.ServiceIntegration{}).
+		WithOptions(priorityControllerOptions(&v1alpha1.ServiceIntegration{})).
 		Complete(r)
 }
 
@@ -170,6 +171,9 @@ func (h ServiceIntegrationHandler) getUserConfig(int *v1alpha1.ServiceIntegratio
 	if int.Spec.IntegrationType == "metrics" {
 		return UserConfigurationToAPI(int.Spec.MetricsUserConfig).(map[string]interface{})
 	}
+	if int.Spec.IntegrationType == "logs" {
+		return UserConfigurationToAPI(int.Spec.LogsUserConfig).(map[string]interface{})
+	}
 
 	return nil
 }
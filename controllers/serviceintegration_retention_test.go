@@ -0,0 +1,35 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+func TestServiceIntegrationRetentionUserConfig(t *testing.T) {
+	h := ServiceIntegrationHandler{}
+	zero, week := 0, 7
+
+	// The unset retention is left to the Aiven default, zero disables the metrics cleanup
+	metrics := &v1alpha1.ServiceIntegration{Spec: v1alpha1.ServiceIntegrationSpec{IntegrationType: "metrics"}}
+	assert.NotContains(t, h.getUserConfig(metrics), "retention_days")
+
+	metrics.Spec.MetricsUserConfig.RetentionDays = &zero
+	assert.Equal(t, &zero, h.getUserConfig(metrics)["retention_days"])
+
+	logs := &v1alpha1.ServiceIntegration{Spec: v1alpha1.ServiceIntegrationSpec{IntegrationType: "logs"}}
+	assert.Empty(t, h.getUserConfig(logs))
+
+	logs.Spec.LogsUserConfig = v1alpha1.ServiceIntegrationLogsUserConfig{
+		ElasticsearchIndexDaysMax: &week,
+		ElasticsearchIndexPrefix:  "k8s-logs",
+	}
+	assert.Equal(t, map[string]interface{}{
+		"elasticsearch_index_days_max": &week,
+		"elasticsearch_index_prefix":   "k8s-logs",
+	}, h.getUserConfig(logs))
+}
@@ -0,0 +1,188 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/aiven/aiven-go-client"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+// ServiceIntegrationEndpointReconciler reconciles a ServiceIntegrationEndpoint object
+type ServiceIntegrationEndpointReconciler struct {
+	Controller
+}
+
+type ServiceIntegrationEndpointHandler struct {
+	k8s client.Client
+}
+
+// +kubebuilder:rbac:groups=aiven.io,resources=serviceintegrationendpoints,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=aiven.io,resources=serviceintegrationendpoints/status,verbs=get;update;patch
+
+func (r *ServiceIntegrationEndpointReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	return r.reconcileInstance(ctx, req, ServiceIntegrationEndpointHandler{k8s: r.Client}, &v1alpha1.ServiceIntegrationEndpoint{})
+}
+
+func (r *ServiceIntegrationEndpointReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&v1alpha1.ServiceIntegrationEndpoint{}).
+		WithOptions(priorityControllerOptions(&v1alpha1.ServiceIntegrationEndpoint{})).
+		Complete(r)
+}
+
+func (h ServiceIntegrationEndpointHandler) createOrUpdate(avn *aiven.Client, i client.Object, refs []client.Object) error {
+	si, err := h.convert(i)
+	if err != nil {
+		return err
+	}
+
+	userConfig, err := h.getUserConfig(si)
+	if err != nil {
+		return err
+	}
+
+	var endpoint *aiven.ServiceIntegrationEndpoint
+	var reason string
+	if si.Status.ID == "" {
+		endpoint, err = avn.ServiceIntegrationEndpoints.Create(
+			si.Spec.Project,
+			aiven.CreateServiceIntegrationEndpointRequest{
+				EndpointName: si.Spec.EndpointName,
+				EndpointType: si.Spec.EndpointType,
+				UserConfig:   userConfig,
+			},
+		)
+		if err != nil {
+			return fmt.Errorf("cannot create service integration endpoint: %w", err)
+		}
+
+		reason = "Created"
+	} else {
+		endpoint, err = avn.ServiceIntegrationEndpoints.Update(
+			si.Spec.Project,
+			si.Status.ID,
+			aiven.UpdateServiceIntegrationEndpointRequest{
+				UserConfig: userConfig,
+			},
+		)
+		if err != nil {
+			return fmt.Errorf("cannot update service integration endpoint: %w", err)
+		}
+
+		reason = "Updated"
+	}
+
+	si.Status.ID = endpoint.EndpointID
+
+	meta.SetStatusCondition(&si.Status.Conditions,
+		getInitializedCondition(reason,
+			"Instance was created or update on Aiven side"))
+
+	meta.SetStatusCondition(&si.Status.Conditions,
+		getRunningCondition(metav1.ConditionUnknown, reason,
+			"Instance was created or update on Aiven side, status remains unknown"))
+
+	metav1.SetMetaDataAnnotation(&si.ObjectMeta,
+		processedGenerationAnnotation, strconv.FormatInt(si.GetGeneration(), formatIntBaseDecimal))
+
+	return nil
+}
+
+// getUserConfig returns the user config with the fields resolved from the secrets.
+// The values are read on every update and never written to the resource
+func (h ServiceIntegrationEndpointHandler) getUserConfig(si *v1alpha1.ServiceIntegrationEndpoint) (map[string]interface{}, error) {
+	userConfig, err := si.Spec.GetUserConfig()
+	if err != nil {
+		return nil, err
+	}
+	if userConfig == nil {
+		userConfig = make(map[string]interface{})
+	}
+
+	for _, s := range si.Spec.UserConfigSecrets {
+		ref := s.ValueFrom.SecretKeyRef
+		secret := &corev1.Secret{}
+		err := h.k8s.Get(context.Background(), types.NamespacedName{Namespace: si.GetNamespace(), Name: ref.Name}, secret)
+		if err != nil {
+			return nil, fmt.Errorf("unable to get secret %q for field %q: %w", ref.Name, s.Name, err)
+		}
+
+		v, ok := secret.Data[ref.Key]
+		if !ok {
+			return nil, fmt.Errorf("no key %q in secret %q for field %q", ref.Key, ref.Name, s.Name)
+		}
+		userConfig[s.Name] = string(v)
+	}
+	return userConfig, nil
+}
+
+func (h ServiceIntegrationEndpointHandler) delete(avn *aiven.Client, i client.Object) (bool, error) {
+	si, err := h.convert(i)
+	if err != nil {
+		return false, err
+	}
+
+	// Nothing was created
+	if si.Status.ID == "" {
+		return true, nil
+	}
+
+	err = avn.ServiceIntegrationEndpoints.Delete(si.Spec.Project, si.Status.ID)
+	if err != nil && !aiven.IsNotFound(err) {
+		return false, fmt.Errorf("aiven client delete service integration endpoint error: %w", err)
+	}
+
+	return true, nil
+}
+
+func (h ServiceIntegrationEndpointHandler) get(avn *aiven.Client, i client.Object) (*corev1.Secret, error) {
+	si, err := h.convert(i)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = avn.ServiceIntegrationEndpoints.Get(si.Spec.Project, si.Status.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	meta.SetStatusCondition(&si.Status.Conditions,
+		getRunningCondition(metav1.ConditionTrue, "CheckRunning",
+			"Instance is running on Aiven side"))
+
+	metav1.SetMetaDataAnnotation(&si.ObjectMeta, instanceIsRunningAnnotation, "true")
+
+	return nil, nil
+}
+
+func (h ServiceIntegrationEndpointHandler) checkPreconditions(_ *aiven.Client, i client.Object) (bool, error) {
+	si, err := h.convert(i)
+	if err != nil {
+		return false, err
+	}
+
+	meta.SetStatusCondition(&si.Status.Conditions,
+		getInitializedCondition("Preconditions", "Checking preconditions"))
+
+	return true, nil
+}
+
+func (h ServiceIntegrationEndpointHandler) convert(i client.Object) (*v1alpha1.ServiceIntegrationEndpoint, error) {
+	si, ok := i.(*v1alpha1.ServiceIntegrationEndpoint)
+	if !ok {
+		return nil, fmt.Errorf("cannot convert object to ServiceIntegrationEndpoint")
+	}
+
+	return si, nil
+}
@@ -0,0 +1,130 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"os"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+var _ = Describe("ServiceIntegrationEndpoint Controller", func() {
+	// Define utility constants for object names and testing timeouts/durations and intervals.
+	const (
+		namespace = "default"
+
+		timeout  = time.Minute * 5
+		interval = time.Second * 10
+	)
+
+	var (
+		endpoint     *v1alpha1.ServiceIntegrationEndpoint
+		secret       *corev1.Secret
+		endpointName string
+		ctx          context.Context
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		endpointName = "k8s-test-endpoint-acc-" + generateRandomID()
+
+		By("Creating the Secret with the Datadog API key")
+		secret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      endpointName,
+				Namespace: namespace,
+			},
+			StringData: map[string]string{
+				"api-key": "0123456789abcdef0123456789abcdef",
+			},
+		}
+		Expect(k8sClient.Create(ctx, secret)).Should(Succeed())
+
+		By("Creating a new ServiceIntegrationEndpoint CR instance")
+		endpoint = serviceIntegrationEndpointSpec(endpointName, namespace)
+		Expect(k8sClient.Create(ctx, endpoint)).Should(Succeed())
+
+		By("by waiting ServiceIntegrationEndpoint to become RUNNING")
+		Eventually(func() bool {
+			lookupKey := types.NamespacedName{Name: endpointName, Namespace: namespace}
+			created := &v1alpha1.ServiceIntegrationEndpoint{}
+			err := k8sClient.Get(ctx, lookupKey, created)
+			if err == nil {
+				return meta.IsStatusConditionTrue(created.Status.Conditions, conditionTypeRunning)
+			}
+			return false
+		}, timeout, interval).Should(BeTrue())
+	})
+
+	Context("Validating ServiceIntegrationEndpoint reconciler behaviour", func() {
+		It("should create the endpoint with the credentials from the secret", func() {
+			created := &v1alpha1.ServiceIntegrationEndpoint{}
+			lookupKey := types.NamespacedName{Name: endpointName, Namespace: namespace}
+
+			Expect(k8sClient.Get(ctx, lookupKey, created)).Should(Succeed())
+
+			By("by checking that after creation ServiceIntegrationEndpoint status fields were properly populated")
+			Expect(created.Status.ID).ShouldNot(BeEmpty())
+
+			By("by checking the endpoint on Aiven side")
+			e, err := aivenClient.ServiceIntegrationEndpoints.Get(created.Spec.Project, created.Status.ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(e.EndpointName).Should(Equal(endpointName))
+
+			By("by checking finalizers")
+			Expect(created.GetFinalizers()).ToNot(BeEmpty())
+		})
+	})
+
+	AfterEach(func() {
+		By("Ensures that ServiceIntegrationEndpoint instance was deleted")
+		ensureDelete(ctx, endpoint)
+
+		By("Ensures that the Secret was deleted")
+		Expect(k8sClient.Delete(ctx, secret)).Should(Succeed())
+	})
+})
+
+func serviceIntegrationEndpointSpec(name, namespace string) *v1alpha1.ServiceIntegrationEndpoint {
+	return &v1alpha1.ServiceIntegrationEndpoint{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "aiven.io/v1alpha1",
+			Kind:       "ServiceIntegrationEndpoint",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: v1alpha1.ServiceIntegrationEndpointSpec{
+			Project:      os.Getenv("AIVEN_PROJECT_NAME"),
+			EndpointName: name,
+			EndpointType: "datadog",
+			UserConfig:   &runtime.RawExtension{Raw: []byte(`{"site": "datadoghq.eu"}`)},
+			UserConfigSecrets: []v1alpha1.EndpointUserConfigSecret{
+				{
+					Name: "datadog_api_key",
+					ValueFrom: v1alpha1.EndpointUserConfigSecretSource{
+						SecretKeyRef: v1alpha1.SecretKeyReference{
+							Name: name,
+							Key:  "api-key",
+						},
+					},
+				},
+			},
+			AuthSecretRef: v1alpha1.AuthSecretReference{
+				Name: secretRefName,
+				Key:  secretRefKey,
+			},
+		},
+	}
+}
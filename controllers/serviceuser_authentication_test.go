@@ -0,0 +1,43 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"testing"
+
+	"github.com/aiven/aiven-go-client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+func TestServiceUserCredentials(t *testing.T) {
+	u := &aiven.ServiceUser{Username: "crab", Password: "secret", AccessCert: "cert", AccessKey: "key"}
+
+	// Both credentials are kept for the users created before the authentication type
+	data, err := serviceUserCredentials(u, "")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"USERNAME": "crab", "PASSWORD": "secret", "ACCESS_CERT": "cert", "ACCESS_KEY": "key"}, data)
+
+	data, err = serviceUserCredentials(u, serviceUserAuthenticationPassword)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"USERNAME": "crab", "PASSWORD": "secret"}, data)
+
+	data, err = serviceUserCredentials(u, serviceUserAuthenticationMTLS)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"USERNAME": "crab", "ACCESS_CERT": "cert", "ACCESS_KEY": "key"}, data)
+
+	// The services other than Kafka don't issue the certificates
+	_, err = serviceUserCredentials(&aiven.ServiceUser{Username: "crab", Password: "secret"}, serviceUserAuthenticationMTLS)
+	assert.Error(t, err)
+}
+
+func TestServiceUserPasswordAuthenticationFormat(t *testing.T) {
+	user := &v1alpha1.ServiceUser{Spec: v1alpha1.ServiceUserSpec{AuthenticationType: serviceUserAuthenticationPassword}}
+	assert.NoError(t, ServiceUserHandler{}.checkAuthenticationType(nil, user))
+
+	// The Kafka client configurations use the certificate
+	user.Spec.ConnInfoSecretTarget.Format = connInfoFormatClientProperties
+	assert.Error(t, ServiceUserHandler{}.checkAuthenticationType(nil, user))
+}
@@ -5,12 +5,15 @@ package controllers
 import (
 	"context"
 	"fmt"
+	"reflect"
 	"strconv"
+	"strings"
 
 	"github.com/aiven/aiven-go-client"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -22,18 +25,37 @@ type ServiceUserReconciler struct {
 	Controller
 }
 
-type ServiceUserHandler struct{}
+type ServiceUserHandler struct {
+	rec record.EventRecorder
+}
+
+const (
+	eventUnableToRevokeCredentials = "UnableToRevokeCredentials"
+	eventServiceUserOffboarded     = "ServiceUserOffboarded"
+	eventCredentialsRotated        = "CredentialsRotated"
+
+	// rotateCredentialsAnnotation is an RFC3339 time, a new value resets the password of the user
+	// the next time the user is updated, e.g. along with the aiven.io/reconcile-now annotation
+	rotateCredentialsAnnotation = "aiven.io/rotate-credentials"
+
+	// rotateCredentialsHandledAnnotation is the last rotateCredentialsAnnotation value handled
+	rotateCredentialsHandledAnnotation = "controllers.aiven.io/rotate-credentials-handled"
+
+	serviceUserAuthenticationPassword = "password"
+	serviceUserAuthenticationMTLS     = "mtls"
+)
 
-// +kubebuilder:rbac:groups=aiven.io,resources=serviceusers,verbs=update;get;list;watch;create;delete
+// +kubebuilder:rbac:groups=aiven.io,resources=serviceusers,verbs=update;patch;get;list;watch;create;delete
 // +kubebuilder:rbac:groups=aiven.io,resources=serviceusers/status,verbs=get;update
 
 func (r *ServiceUserReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
-	return r.reconcileInstance(ctx, req, ServiceUserHandler{}, &v1alpha1.ServiceUser{})
+	return r.reconcileInstance(ctx, req, ServiceUserHandler{rec: r.Recorder}, &v1alpha1.ServiceUser{})
 }
 
 func (r *ServiceUserReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&v1alpha1.ServiceUser{}).
+		WithOptions(priorityControllerOptions(&v1alpha1.ServiceUser{})).
 		Complete(r)
 }
 
@@ -43,6 +65,11 @@ func (h ServiceUserHandler) createOrUpdate(avn *aiven.Client, i client.Object, r
 		return err
 	}
 
+	err = h.checkAuthenticationType(avn, user)
+	if err != nil {
+		return err
+	}
+
 	u, err := avn.ServiceUsers.Create(user.Spec.Project, user.Spec.ServiceName,
 		aiven.CreateServiceUserRequest{
 			Username: user.Name,
@@ -61,6 +88,26 @@ func (h ServiceUserHandler) createOrUpdate(avn *aiven.Client, i client.Object, r
 		user.Status.Type = u.Type
 	}
 
+	err = h.rotateCredentials(avn, user)
+	if err != nil {
+		return err
+	}
+
+	err = h.updateOpenSearchACLs(avn, user, user.Spec.OpenSearchACLRules)
+	if err != nil {
+		return err
+	}
+
+	err = h.updateKafkaACLs(avn, user, user.Spec.KafkaTopicAccess)
+	if err != nil {
+		return err
+	}
+
+	err = h.updateMySQLGrants(avn, user, user.Spec.MySQLGrants)
+	if err != nil {
+		return err
+	}
+
 	meta.SetStatusCondition(&user.Status.Conditions,
 		getInitializedCondition("Created",
 			"Instance was created or update on Aiven side"))
@@ -81,11 +128,33 @@ func (h ServiceUserHandler) delete(avn *aiven.Client, i client.Object) (bool, er
 		return false, err
 	}
 
+	err = h.updateOpenSearchACLs(avn, user, nil)
+	if err != nil && !aiven.IsNotFound(err) {
+		return false, err
+	}
+
+	if len(user.Status.KafkaACLs) > 0 {
+		err = h.updateKafkaACLs(avn, user, nil)
+		if err != nil && !aiven.IsNotFound(err) {
+			return false, err
+		}
+	}
+
+	// Resets the credentials first, so the copies of the secret stop working even if the deletion is delayed
+	operation := "reset-credentials"
+	_, err = avn.ServiceUsers.Update(user.Spec.Project, user.Spec.ServiceName, user.Name,
+		aiven.ModifyServiceUserRequest{Operation: &operation})
+	if err != nil && !aiven.IsNotFound(err) {
+		// Not every service type supports the reset, the deletion revokes the access anyway
+		h.rec.Eventf(user, corev1.EventTypeWarning, eventUnableToRevokeCredentials, "unable to reset the credentials: %s", err)
+	}
+
 	err = avn.ServiceUsers.Delete(user.Spec.Project, user.Spec.ServiceName, user.Name)
-	if !aiven.IsNotFound(err) {
+	if err != nil && !aiven.IsNotFound(err) {
 		return false, err
 	}
 
+	h.rec.Event(user, corev1.EventTypeNormal, eventServiceUserOffboarded, "the credentials are revoked and the user is deleted on Aiven side")
 	return true, nil
 }
 
@@ -107,9 +176,34 @@ func (h ServiceUserHandler) get(avn *aiven.Client, i client.Object) (*corev1.Sec
 
 	params := s.URIParams
 
-	caCert, err := avn.CA.Get(user.Spec.Project)
+	stringData, err := serviceUserCredentials(u, user.Spec.AuthenticationType)
 	if err != nil {
-		return nil, fmt.Errorf("aiven client error %w", err)
+		return nil, err
+	}
+	stringData["HOST"] = params["host"]
+	stringData["PORT"] = params["port"]
+
+	if !user.Spec.ConnInfoSecretTarget.OmitCACert {
+		caCert, err := avn.CA.Get(user.Spec.Project)
+		if err != nil {
+			return nil, fmt.Errorf("aiven client error %w", err)
+		}
+		stringData["CA_CERT"] = caCert
+	}
+
+	if user.Spec.ConnInfoSecretTarget.TLSKeys {
+		addTLSKeys(stringData)
+	}
+
+	if err = addConnInfoFormat(stringData, user.Spec.ConnInfoSecretTarget.Format, s.Type); err != nil {
+		return nil, err
+	}
+
+	// Tells the preview environments and the CI jobs when the credentials stop working
+	user.Status.ExpiresAt = nil
+	if expiresAt, err := objectExpiresAt(user); err == nil && !expiresAt.IsZero() {
+		t := metav1.NewTime(expiresAt)
+		user.Status.ExpiresAt = &t
 	}
 
 	meta.SetStatusCondition(&user.Status.Conditions,
@@ -123,18 +217,359 @@ func (h ServiceUserHandler) get(avn *aiven.Client, i client.Object) (*corev1.Sec
 			Name:      h.getSecretName(user),
 			Namespace: user.Namespace,
 		},
-		StringData: map[string]string{
-			"HOST":        params["host"],
-			"PORT":        params["port"],
-			"USERNAME":    u.Username,
-			"PASSWORD":    u.Password,
-			"ACCESS_CERT": u.AccessCert,
-			"ACCESS_KEY":  u.AccessKey,
-			"CA_CERT":     caCert,
-		},
+		StringData: stringData,
 	}, nil
 }
 
+// checkAuthenticationType checks that the service supports the authentication type of the user
+func (h ServiceUserHandler) checkAuthenticationType(avn *aiven.Client, user *v1alpha1.ServiceUser) error {
+	authType := user.Spec.AuthenticationType
+	format := user.Spec.ConnInfoSecretTarget.Format
+	if authType == serviceUserAuthenticationPassword && (format == connInfoFormatClientProperties || format == connInfoFormatLibrdkafka) {
+		return fmt.Errorf("connection info format %q needs the access certificate, which the %q authentication type leaves out", format, authType)
+	}
+
+	if authType != serviceUserAuthenticationMTLS {
+		return nil
+	}
+
+	s, err := avn.Services.Get(user.Spec.Project, user.Spec.ServiceName)
+	if err != nil {
+		return err
+	}
+	if s.Type != "kafka" {
+		return fmt.Errorf("%q authentication type can be used with Kafka services only, got %q service type", authType, s.Type)
+	}
+
+	// The certificate authentication is enabled by default
+	if methods, ok := s.UserConfig["kafka_authentication_methods"].(map[string]interface{}); ok {
+		if enabled, ok := methods["certificate"].(bool); ok && !enabled {
+			return fmt.Errorf("%q authentication type needs the certificate authentication enabled in kafka_authentication_methods of the service", authType)
+		}
+	}
+	return nil
+}
+
+// serviceUserCredentials returns the secret keys of the user credentials of the authentication type
+func serviceUserCredentials(u *aiven.ServiceUser, authType string) (map[string]string, error) {
+	stringData := map[string]string{"USERNAME": u.Username}
+	if authType != serviceUserAuthenticationMTLS {
+		stringData["PASSWORD"] = u.Password
+	}
+
+	if authType != serviceUserAuthenticationPassword {
+		if authType == serviceUserAuthenticationMTLS && (u.AccessCert == "" || u.AccessKey == "") {
+			return nil, fmt.Errorf("user %q has no access certificate for the %q authentication type", u.Username, authType)
+		}
+		stringData["ACCESS_CERT"] = u.AccessCert
+		stringData["ACCESS_KEY"] = u.AccessKey
+	}
+	return stringData, nil
+}
+
+// rotateCredentials resets the password of the user once per rotateCredentialsAnnotation value,
+// the new password is written to the secret when the user is read next
+func (h ServiceUserHandler) rotateCredentials(avn *aiven.Client, user *v1alpha1.ServiceUser) error {
+	value, ok := user.GetAnnotations()[rotateCredentialsAnnotation]
+	if !ok || value == user.GetAnnotations()[rotateCredentialsHandledAnnotation] {
+		return nil
+	}
+
+	operation := aiven.UpdateOperationResetCredentials
+	_, err := avn.ServiceUsers.Update(user.Spec.Project, user.Spec.ServiceName, user.Name,
+		aiven.ModifyServiceUserRequest{Operation: &operation})
+	if err != nil {
+		return fmt.Errorf("cannot reset the credentials of the service user: %w", err)
+	}
+
+	metav1.SetMetaDataAnnotation(&user.ObjectMeta, rotateCredentialsHandledAnnotation, value)
+	h.rec.Eventf(user, corev1.EventTypeNormal, eventCredentialsRotated, "the credentials are reset as requested at %s", value)
+	return nil
+}
+
+// updateOpenSearchACLs replaces the user's rules in the service ACL config, empty rules remove the user from it.
+// Doesn't call the API when the user has no rules and none were applied before
+func (h ServiceUserHandler) updateOpenSearchACLs(avn *aiven.Client, user *v1alpha1.ServiceUser, rules []v1alpha1.OpenSearchACLRule) error {
+	if len(rules) == 0 && len(user.Status.OpenSearchACLRules) == 0 {
+		return nil
+	}
+
+	s, err := avn.Services.Get(user.Spec.Project, user.Spec.ServiceName)
+	if err != nil {
+		return err
+	}
+
+	if s.Type != "opensearch" {
+		if len(rules) > 0 {
+			return fmt.Errorf("openSearchAclRules can be used with OpenSearch services only, got %q service type", s.Type)
+		}
+		user.Status.OpenSearchACLRules = nil
+		return nil
+	}
+
+	// The client names the ACL endpoints after Elasticsearch, Aiven serves them for OpenSearch services too
+	r, err := avn.ElasticsearchACLs.Get(user.Spec.Project, user.Spec.ServiceName)
+	if err != nil {
+		return fmt.Errorf("cannot get OpenSearch ACLs: %w", err)
+	}
+
+	config := r.ElasticSearchACLConfig
+	acls, changed := mergeOpenSearchACLs(config.ACLs, user.Name, rules)
+	if changed {
+		config.ACLs = acls
+		if len(rules) > 0 {
+			config.Enabled = true
+		}
+		_, err = avn.ElasticsearchACLs.Update(user.Spec.Project, user.Spec.ServiceName, aiven.ElasticsearchACLRequest{
+			ElasticSearchACLConfig: config,
+		})
+		if err != nil {
+			return fmt.Errorf("cannot update OpenSearch ACLs: %w", err)
+		}
+	}
+
+	user.Status.OpenSearchACLRules = rules
+	return nil
+}
+
+// mergeOpenSearchACLs replaces the ACL of the username with the rules, keeps the ACLs of the other users.
+// Returns false if the ACLs already have the rules
+func mergeOpenSearchACLs(acls []aiven.ElasticSearchACL, username string, rules []v1alpha1.OpenSearchACLRule) ([]aiven.ElasticSearchACL, bool) {
+	var want *aiven.ElasticSearchACL
+	if len(rules) > 0 {
+		want = &aiven.ElasticSearchACL{Username: username}
+		for _, rule := range rules {
+			want.Rules = append(want.Rules, aiven.ElasticsearchACLRule{
+				Index:      rule.Index,
+				Permission: rule.Permission,
+			})
+		}
+	}
+
+	merged := make([]aiven.ElasticSearchACL, 0, len(acls)+1)
+	var cur *aiven.ElasticSearchACL
+	for i, acl := range acls {
+		if acl.Username == username {
+			cur = &acls[i]
+			continue
+		}
+		merged = append(merged, acl)
+	}
+
+	switch {
+	case cur == nil && want == nil:
+		return acls, false
+	case cur != nil && want != nil && reflect.DeepEqual(cur.Rules, want.Rules):
+		return acls, false
+	}
+
+	if want != nil {
+		merged = append(merged, *want)
+	}
+	return merged, true
+}
+
+// updateKafkaACLs creates the Kafka ACLs of the topic access entries, and deletes the ones of the removed entries.
+// The created ACLs are kept in the status, so the ACLs of the same user made with KafkaACL resources are left as they are
+func (h ServiceUserHandler) updateKafkaACLs(avn *aiven.Client, user *v1alpha1.ServiceUser, access []v1alpha1.KafkaTopicAccess) error {
+	// Nothing to add or to remove
+	if len(access) == 0 && len(user.Status.KafkaACLs) == 0 {
+		return nil
+	}
+
+	s, err := avn.Services.Get(user.Spec.Project, user.Spec.ServiceName)
+	if err != nil {
+		return err
+	}
+
+	if s.Type != "kafka" {
+		if len(access) > 0 {
+			return fmt.Errorf("kafkaTopicAccess can be used with Kafka services only, got %q service type", s.Type)
+		}
+		return nil
+	}
+
+	existing, err := avn.KafkaACLs.List(user.Spec.Project, user.Spec.ServiceName)
+	if err != nil {
+		return fmt.Errorf("cannot list Kafka ACLs: %w", err)
+	}
+
+	keep, create, remove := planKafkaACLs(user.Name, access, user.Status.KafkaACLs, existing)
+	for _, id := range remove {
+		err = avn.KafkaACLs.Delete(user.Spec.Project, user.Spec.ServiceName, id)
+		if err != nil && !aiven.IsNotFound(err) {
+			return fmt.Errorf("cannot delete Kafka ACL: %w", err)
+		}
+	}
+
+	// If a creation fails, the next attempt adopts the ACLs created so far
+	user.Status.KafkaACLs = keep
+	for _, a := range create {
+		r, err := avn.KafkaACLs.Create(user.Spec.Project, user.Spec.ServiceName, aiven.CreateKafkaACLRequest{
+			Permission: a.Permission,
+			Topic:      a.Topic,
+			Username:   user.Name,
+		})
+		if err != nil {
+			return fmt.Errorf("cannot create Kafka ACL: %w", err)
+		}
+		user.Status.KafkaACLs = append(user.Status.KafkaACLs, v1alpha1.ServiceUserKafkaACL{
+			ID:         r.ID,
+			Topic:      r.Topic,
+			Permission: r.Permission,
+		})
+	}
+	return nil
+}
+
+// planKafkaACLs returns the recorded ACLs to keep, the topic access entries to create ACLs for, and the ACL ids to delete.
+// An ACL that already exists on Aiven side for the entry is adopted instead of creating a duplicate
+func planKafkaACLs(username string, access []v1alpha1.KafkaTopicAccess, recorded []v1alpha1.ServiceUserKafkaACL, existing []*aiven.KafkaACL) ([]v1alpha1.ServiceUserKafkaACL, []v1alpha1.KafkaTopicAccess, []string) {
+	existingIDs := make(map[string]bool, len(existing))
+	existingACLs := make(map[v1alpha1.KafkaTopicAccess]string)
+	for _, a := range existing {
+		existingIDs[a.ID] = true
+		if a.Username == username {
+			existingACLs[v1alpha1.KafkaTopicAccess{Topic: a.Topic, Permission: a.Permission}] = a.ID
+		}
+	}
+
+	declared := make(map[v1alpha1.KafkaTopicAccess]bool, len(access))
+	for _, a := range access {
+		declared[a] = true
+	}
+
+	keep := make([]v1alpha1.ServiceUserKafkaACL, 0, len(access))
+	kept := make(map[v1alpha1.KafkaTopicAccess]bool, len(access))
+	remove := make([]string, 0)
+	for _, r := range recorded {
+		key := v1alpha1.KafkaTopicAccess{Topic: r.Topic, Permission: r.Permission}
+		switch {
+		case !existingIDs[r.ID]:
+			// Deleted on Aiven side, is created again if still declared
+		case declared[key] && !kept[key]:
+			keep = append(keep, r)
+			kept[key] = true
+		default:
+			remove = append(remove, r.ID)
+		}
+	}
+
+	create := make([]v1alpha1.KafkaTopicAccess, 0)
+	for _, a := range access {
+		if kept[a] {
+			continue
+		}
+		kept[a] = true
+		if id, ok := existingACLs[a]; ok {
+			keep = append(keep, v1alpha1.ServiceUserKafkaACL{ID: id, Topic: a.Topic, Permission: a.Permission})
+			continue
+		}
+		create = append(create, a)
+	}
+	return keep, create, remove
+}
+
+// updateMySQLGrants grants the privileges of the entries, and revokes the ones granted before that are removed.
+// The privileges granted otherwise, like with SQL, are left as they are
+func (h ServiceUserHandler) updateMySQLGrants(avn *aiven.Client, user *v1alpha1.ServiceUser, grants []v1alpha1.MySQLGrant) error {
+	// Nothing to grant or to revoke
+	if len(grants) == 0 && len(user.Status.MySQLGrants) == 0 {
+		return nil
+	}
+
+	s, err := avn.Services.Get(user.Spec.Project, user.Spec.ServiceName)
+	if err != nil {
+		return err
+	}
+
+	if s.Type != "mysql" {
+		if len(grants) > 0 {
+			return fmt.Errorf("mysqlGrants can be used with MySQL services only, got %q service type", s.Type)
+		}
+		return nil
+	}
+
+	c, err := newMySQLClient(avn, user.Spec.Project, user.Spec.ServiceName)
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+
+	revoke, grant := mysqlGrantStatements(user.Name, grants, user.Status.MySQLGrants)
+	for _, statement := range revoke {
+		err = c.exec(statement)
+		if err != nil && !isMySQLNoSuchGrant(err) {
+			return fmt.Errorf("cannot revoke MySQL privileges: %w", err)
+		}
+	}
+
+	for _, statement := range grant {
+		err = c.exec(statement)
+		if err != nil {
+			return fmt.Errorf("cannot grant MySQL privileges: %w", err)
+		}
+	}
+
+	user.Status.MySQLGrants = grants
+	return nil
+}
+
+// mysqlGrantStatements returns the REVOKE statements of the recorded privileges that are not declared anymore,
+// and the GRANT statements of the declared ones. Granting is idempotent, so all of them are granted again
+func mysqlGrantStatements(username string, grants, recorded []v1alpha1.MySQLGrant) ([]string, []string) {
+	account := quoteMySQLString(username) + "@'%'"
+
+	declared := make(map[string]map[v1alpha1.MySQLPrivilege]bool, len(grants))
+	for _, g := range grants {
+		privileges := make(map[v1alpha1.MySQLPrivilege]bool, len(g.Privileges))
+		for _, p := range g.Privileges {
+			privileges[p] = true
+		}
+		declared[mysqlGrantObject(g)] = privileges
+	}
+
+	revoke := make([]string, 0)
+	for _, r := range recorded {
+		on := mysqlGrantObject(r)
+		removed := make([]string, 0, len(r.Privileges))
+		for _, p := range r.Privileges {
+			if !declared[on][p] {
+				removed = append(removed, string(p))
+			}
+		}
+		if len(removed) > 0 {
+			revoke = append(revoke, fmt.Sprintf("REVOKE %s ON %s FROM %s", strings.Join(removed, ", "), on, account))
+		}
+	}
+
+	grant := make([]string, 0, len(grants))
+	for _, g := range grants {
+		grant = append(grant, fmt.Sprintf("GRANT %s ON %s TO %s", mysqlPrivilegeList(g.Privileges), mysqlGrantObject(g), account))
+	}
+	return revoke, grant
+}
+
+// mysqlPrivilegeList returns the privileges separated by commas, the privileges are validated by the CRD
+func mysqlPrivilegeList(privileges []v1alpha1.MySQLPrivilege) string {
+	list := make([]string, 0, len(privileges))
+	for _, p := range privileges {
+		list = append(list, string(p))
+	}
+	return strings.Join(list, ", ")
+}
+
+// mysqlGrantObject returns the database and the table of the grant, like `db`.* or *.*
+func mysqlGrantObject(g v1alpha1.MySQLGrant) string {
+	on := func(name string) string {
+		if name == "*" || name == "" {
+			return "*"
+		}
+		return quoteMySQLIdentifier(name)
+	}
+	return on(g.Database) + "." + on(g.Table)
+}
+
 func (h ServiceUserHandler) getSecretName(user *v1alpha1.ServiceUser) string {
 	if user.Spec.ConnInfoSecretTarget.Name != "" {
 		return user.Spec.ConnInfoSecretTarget.Name
@@ -7,6 +7,7 @@ import (
 	"os"
 	"time"
 
+	"github.com/aiven/aiven-go-client"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -83,6 +84,10 @@ var _ = Describe("ServiceUser Controller", func() {
 		By("Ensures that ServiceUser instance was deleted")
 		ensureDelete(ctx, su)
 
+		By("Ensures that the user is offboarded on Aiven side")
+		_, err := aivenClient.ServiceUsers.Get(os.Getenv("AIVEN_PROJECT_NAME"), serviceName, userName)
+		Expect(aiven.IsNotFound(err)).Should(BeTrue())
+
 		By("Ensures that PostgreSQL instance was deleted")
 		ensureDelete(ctx, pg)
 	})
@@ -0,0 +1,52 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"testing"
+
+	"github.com/aiven/aiven-go-client"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+func TestPlanKafkaACLs(t *testing.T) {
+	existing := []*aiven.KafkaACL{
+		{ID: "acl1", Username: "alice", Topic: "orders", Permission: "read"},
+		{ID: "acl2", Username: "alice", Topic: "payments-*", Permission: "write"},
+		{ID: "acl3", Username: "alice", Topic: "audit", Permission: "read"},
+		{ID: "acl4", Username: "bob", Topic: "logs", Permission: "read"},
+	}
+	recorded := []v1alpha1.ServiceUserKafkaACL{
+		{ID: "acl1", Topic: "orders", Permission: "read"},
+		{ID: "acl2", Topic: "payments-*", Permission: "write"},
+		{ID: "gone", Topic: "events", Permission: "admin"},
+	}
+	access := []v1alpha1.KafkaTopicAccess{
+		{Topic: "orders", Permission: "read"},
+		{Topic: "events", Permission: "admin"},
+		{Topic: "audit", Permission: "read"},
+		{Topic: "logs", Permission: "read"},
+	}
+
+	keep, create, remove := planKafkaACLs("alice", access, recorded, existing)
+	assert.Equal(t, []v1alpha1.ServiceUserKafkaACL{
+		{ID: "acl1", Topic: "orders", Permission: "read"},
+		// Adopted, e.g. created by a failed attempt
+		{ID: "acl3", Topic: "audit", Permission: "read"},
+	}, keep)
+	assert.Equal(t, []v1alpha1.KafkaTopicAccess{
+		// Deleted on Aiven side
+		{Topic: "events", Permission: "admin"},
+		// Other user's ACL is not adopted
+		{Topic: "logs", Permission: "read"},
+	}, create)
+	assert.Equal(t, []string{"acl2"}, remove)
+
+	// Removes only the recorded ACLs
+	keep, create, remove = planKafkaACLs("alice", nil, recorded, existing)
+	assert.Empty(t, keep)
+	assert.Empty(t, create)
+	assert.Equal(t, []string{"acl1", "acl2"}, remove)
+}
@@ -0,0 +1,92 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aiven/aiven-go-client"
+	"github.com/go-sql-driver/mysql"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+func TestMySQLGrantStatements(t *testing.T) {
+	recorded := []v1alpha1.MySQLGrant{
+		{Database: "shop", Table: "*", Privileges: []v1alpha1.MySQLPrivilege{"SELECT", "INSERT", "DELETE"}},
+		{Database: "audit", Table: "events", Privileges: []v1alpha1.MySQLPrivilege{"ALL PRIVILEGES"}},
+		{Database: "*", Table: "*", Privileges: []v1alpha1.MySQLPrivilege{"SHOW VIEW"}},
+	}
+	grants := []v1alpha1.MySQLGrant{
+		{Database: "shop", Table: "*", Privileges: []v1alpha1.MySQLPrivilege{"SELECT", "INSERT"}},
+		{Database: "audit", Table: "events", Privileges: []v1alpha1.MySQLPrivilege{"SELECT"}},
+		{Database: "report`s", Table: "daily", Privileges: []v1alpha1.MySQLPrivilege{"SELECT"}},
+	}
+
+	revoke, grant := mysqlGrantStatements("o'neil", grants, recorded)
+	assert.Equal(t, []string{
+		"REVOKE DELETE ON `shop`.* FROM 'o''neil'@'%'",
+		"REVOKE ALL PRIVILEGES ON `audit`.`events` FROM 'o''neil'@'%'",
+		"REVOKE SHOW VIEW ON *.* FROM 'o''neil'@'%'",
+	}, revoke)
+	assert.Equal(t, []string{
+		"GRANT SELECT, INSERT ON `shop`.* TO 'o''neil'@'%'",
+		"GRANT SELECT ON `audit`.`events` TO 'o''neil'@'%'",
+		"GRANT SELECT ON `report``s`.`daily` TO 'o''neil'@'%'",
+	}, grant)
+}
+
+func TestMySQLGrantStatementsNoChanges(t *testing.T) {
+	grants := []v1alpha1.MySQLGrant{{Database: "shop", Privileges: []v1alpha1.MySQLPrivilege{"SELECT"}}}
+
+	revoke, grant := mysqlGrantStatements("alice", grants, grants)
+	assert.Empty(t, revoke)
+	assert.Equal(t, []string{"GRANT SELECT ON `shop`.* TO 'alice'@'%'"}, grant)
+
+	revoke, grant = mysqlGrantStatements("alice", nil, grants)
+	assert.Equal(t, []string{"REVOKE SELECT ON `shop`.* FROM 'alice'@'%'"}, revoke)
+	assert.Empty(t, grant)
+}
+
+func TestMySQLAlterDatabaseStatement(t *testing.T) {
+	statement, err := mysqlAlterDatabaseStatement("shop", "utf8mb4", "utf8mb4_0900_ai_ci")
+	assert.NoError(t, err)
+	assert.Equal(t, "ALTER DATABASE `shop` CHARACTER SET utf8mb4 COLLATE utf8mb4_0900_ai_ci", statement)
+
+	statement, err = mysqlAlterDatabaseStatement("shop", "", "utf8mb4_bin")
+	assert.NoError(t, err)
+	assert.Equal(t, "ALTER DATABASE `shop` COLLATE utf8mb4_bin", statement)
+
+	_, err = mysqlAlterDatabaseStatement("shop", "utf8mb4; DROP DATABASE shop", "")
+	assert.ErrorContains(t, err, "invalid charset")
+	_, err = mysqlAlterDatabaseStatement("shop", "", "utf8mb4_bin'")
+	assert.ErrorContains(t, err, "invalid collation")
+}
+
+func TestMySQLAddresses(t *testing.T) {
+	s := &aiven.Service{
+		URIParams: map[string]string{"host": "mysql.aivencloud.com", "port": "12691"},
+		Components: []*aiven.ServiceComponents{
+			{Component: "mysql", Host: "mysql.aivencloud.com", Port: 12691, Route: "dynamic", Usage: "primary"},
+			{Component: "mysql", Host: "replica-mysql.aivencloud.com", Port: 12691, Route: "dynamic", Usage: "replica"},
+			{Component: "mysqlx", Host: "mysql.aivencloud.com", Port: 12692, Route: "public", Usage: "primary"},
+		},
+	}
+	assert.Equal(t, []string{"mysql.aivencloud.com:12691"}, mysqlAddresses(s))
+
+	// The private address of the service in a VPC is tried first
+	s.URIParams["host"] = "private-mysql.aivencloud.com"
+	s.Components = append(s.Components,
+		&aiven.ServiceComponents{Component: "mysql", Host: "public-mysql.aivencloud.com", Port: 12693, Route: "public", Usage: "primary"})
+	assert.Equal(t, []string{"private-mysql.aivencloud.com:12691", "public-mysql.aivencloud.com:12693"}, mysqlAddresses(s))
+}
+
+func TestIsMySQLNoSuchGrant(t *testing.T) {
+	assert.True(t, isMySQLNoSuchGrant(&mysql.MySQLError{Number: 1141}))
+	assert.True(t, isMySQLNoSuchGrant(fmt.Errorf("revoke: %w", &mysql.MySQLError{Number: 1147})))
+	assert.False(t, isMySQLNoSuchGrant(&mysql.MySQLError{Number: 1045}))
+	assert.False(t, isMySQLNoSuchGrant(errors.New("connection refused")))
+}
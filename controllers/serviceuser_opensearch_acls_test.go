@@ -0,0 +1,85 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"testing"
+
+	"github.com/aiven/aiven-go-client"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+func TestMergeOpenSearchACLs(t *testing.T) {
+	other := aiven.ElasticSearchACL{
+		Username: "other",
+		Rules:    []aiven.ElasticsearchACLRule{{Index: "logs-*", Permission: "read"}},
+	}
+	alice := aiven.ElasticSearchACL{
+		Username: "alice",
+		Rules:    []aiven.ElasticsearchACLRule{{Index: "orders", Permission: "readwrite"}},
+	}
+
+	cases := []struct {
+		name    string
+		acls    []aiven.ElasticSearchACL
+		rules   []v1alpha1.OpenSearchACLRule
+		want    []aiven.ElasticSearchACL
+		changed bool
+	}{
+		{
+			name:    "adds the user",
+			acls:    []aiven.ElasticSearchACL{other},
+			rules:   []v1alpha1.OpenSearchACLRule{{Index: "orders", Permission: "readwrite"}},
+			want:    []aiven.ElasticSearchACL{other, alice},
+			changed: true,
+		},
+		{
+			name:    "keeps the same rules",
+			acls:    []aiven.ElasticSearchACL{alice, other},
+			rules:   []v1alpha1.OpenSearchACLRule{{Index: "orders", Permission: "readwrite"}},
+			want:    []aiven.ElasticSearchACL{alice, other},
+			changed: false,
+		},
+		{
+			name:  "replaces the rules of the user",
+			acls:  []aiven.ElasticSearchACL{alice, other},
+			rules: []v1alpha1.OpenSearchACLRule{{Index: "orders", Permission: "read"}, {Index: "logs-*", Permission: "read"}},
+			want: []aiven.ElasticSearchACL{other, {
+				Username: "alice",
+				Rules:    []aiven.ElasticsearchACLRule{{Index: "orders", Permission: "read"}, {Index: "logs-*", Permission: "read"}},
+			}},
+			changed: true,
+		},
+		{
+			name:    "removes the user",
+			acls:    []aiven.ElasticSearchACL{alice, other},
+			rules:   nil,
+			want:    []aiven.ElasticSearchACL{other},
+			changed: true,
+		},
+		{
+			name:    "nothing to remove",
+			acls:    []aiven.ElasticSearchACL{other},
+			rules:   nil,
+			want:    []aiven.ElasticSearchACL{other},
+			changed: false,
+		},
+		{
+			name:    "no ACLs at all",
+			acls:    nil,
+			rules:   nil,
+			want:    nil,
+			changed: false,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got, changed := mergeOpenSearchACLs(c.acls, "alice", c.rules)
+			assert.Equal(t, c.changed, changed)
+			assert.Equal(t, c.want, got)
+		})
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/aiven/aiven-go-client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+// fakeServiceUserResetAPI counts the credential resets of the user app of the service bar
+type fakeServiceUserResetAPI struct {
+	resets int
+}
+
+func (f *fakeServiceUserResetAPI) RoundTrip(r *http.Request) (*http.Response, error) {
+	rsp := &http.Response{StatusCode: http.StatusOK, Header: make(http.Header), Request: r}
+	var out interface{}
+	switch r.Method + " " + r.URL.Path {
+	case "PUT /v1/project/foo/service/bar/user/app":
+		f.resets++
+		out = map[string]interface{}{"service": aiven.Service{Name: "bar", Users: []*aiven.ServiceUser{{Username: "app"}}}}
+	default:
+		rsp.StatusCode = http.StatusNotFound
+		out = map[string]string{"message": "Not found"}
+	}
+	b, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	rsp.Body = io.NopCloser(bytes.NewReader(b))
+	return rsp, nil
+}
+
+func TestServiceUserRotateCredentials(t *testing.T) {
+	api := &fakeServiceUserResetAPI{}
+	avn := newFakeAivenClient("token", api)
+	h := ServiceUserHandler{rec: record.NewFakeRecorder(10)}
+	user := &v1alpha1.ServiceUser{
+		ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default"},
+		Spec:       v1alpha1.ServiceUserSpec{Project: "foo", ServiceName: "bar"},
+	}
+
+	// No annotation
+	require.NoError(t, h.rotateCredentials(avn, user))
+	assert.Equal(t, 0, api.resets)
+
+	// A new value resets the credentials once
+	user.Annotations = map[string]string{rotateCredentialsAnnotation: "2023-02-01T12:00:00Z"}
+	require.NoError(t, h.rotateCredentials(avn, user))
+	require.NoError(t, h.rotateCredentials(avn, user))
+	assert.Equal(t, 1, api.resets)
+	assert.Equal(t, "2023-02-01T12:00:00Z", user.Annotations[rotateCredentialsHandledAnnotation])
+
+	user.Annotations[rotateCredentialsAnnotation] = "2023-02-02T12:00:00Z"
+	require.NoError(t, h.rotateCredentials(avn, user))
+	assert.Equal(t, 2, api.resets)
+}
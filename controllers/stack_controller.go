@@ -0,0 +1,329 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+const (
+	stackStateCreating = "CREATING"
+	stackStateRunning  = "RUNNING"
+	stackStateDeleting = "DELETING"
+)
+
+// stackKindTiers is the order the stack resources are created in.
+// A tier is created when all the previous ones are running, and deleted when all the next ones are gone.
+var stackKindTiers = map[string]int{
+	"Project":                      0,
+	"OrganizationPermission":       1,
+	"OrganizationVPC":              1,
+	"ProjectVPC":                   1,
+	"ServiceIntegrationEndpoint":   1,
+	"AzureVNetPeeringConnection":   2,
+	"Cassandra":                    2,
+	"Clickhouse":                   2,
+	"Dragonfly":                    2,
+	"GCPVPCPeeringConnection":      2,
+	"Grafana":                      2,
+	"Kafka":                        2,
+	"KafkaConnect":                 2,
+	"M3Aggregator":                 2,
+	"M3DB":                         2,
+	"MySQL":                        2,
+	"OpenSearch":                   2,
+	"PostgreSQL":                   2,
+	"Redis":                        2,
+	"Thanos":                       2,
+	"Valkey":                       2,
+	"AWSPrivateLink":               3,
+	"AzurePrivateLink":             3,
+	"ClickhouseRole":               3,
+	"ClickhouseUser":               3,
+	"Database":                     3,
+	"FlinkApplication":             3,
+	"KafkaACL":                     3,
+	"KafkaNativeACL":               3,
+	"KafkaSchema":                  3,
+	"KafkaTopic":                   3,
+	"OpenSearchSnapshotRepository": 3,
+	"ServiceUser":                  3,
+	"ClickhouseGrant":              4,
+	"ConnectionPool":               4,
+	"FlinkApplicationDeployment":   4,
+	"KafkaConnector":               4,
+	"OpenSearchACL":                4,
+	"OpenSearchSnapshotRestore":    4,
+	"ServiceIntegration":           4,
+}
+
+// StackReconciler reconciles a Stack object
+type StackReconciler struct {
+	Controller
+}
+
+// +kubebuilder:rbac:groups=aiven.io,resources=stacks,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=aiven.io,resources=stacks/status,verbs=get;update;patch
+
+func (r *StackReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	start := time.Now()
+	stack := &v1alpha1.Stack{}
+	if err := r.Get(ctx, req.NamespacedName, stack); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	expired, expiresIn, err := r.checkExpiry(ctx, stack)
+	if err != nil || expired {
+		return ctrl.Result{}, err
+	}
+
+	if isMarkedForDeletion(stack) {
+		if !controllerutil.ContainsFinalizer(stack, instanceDeletionFinalizer) {
+			return ctrl.Result{}, nil
+		}
+
+		remaining, err := r.deleteResources(ctx, stack, stackResourceKeys(stack))
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+
+		if len(remaining) > 0 {
+			stack.Status.State = stackStateDeleting
+			return ctrl.Result{RequeueAfter: requeueTimeout}, r.Status().Update(ctx, stack)
+		}
+
+		return ctrl.Result{}, removeFinalizer(ctx, r.Client, stack, instanceDeletionFinalizer)
+	}
+
+	if !controllerutil.ContainsFinalizer(stack, instanceDeletionFinalizer) {
+		return ctrl.Result{}, addFinalizer(ctx, r.Client, stack, instanceDeletionFinalizer)
+	}
+
+	// Removes the resources that are not in the spec anymore
+	declared := make(map[stackResourceKey]bool)
+	for _, res := range stack.Spec.Resources {
+		declared[stackResourceKey{Kind: res.Kind, Name: res.Name}] = true
+	}
+
+	removed := make([]stackResourceKey, 0)
+	for _, res := range stack.Status.Resources {
+		key := stackResourceKey{Kind: res.Kind, Name: res.Name}
+		if !declared[key] {
+			removed = append(removed, key)
+		}
+	}
+
+	removed, err = r.deleteResources(ctx, stack, removed)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	statuses, err := r.applyResources(ctx, stack)
+	if err != nil {
+		meta.SetStatusCondition(&stack.Status.Conditions,
+			getRunningCondition(metav1.ConditionFalse, "CreateOrUpdate", err.Error()))
+		if statusErr := r.Status().Update(ctx, stack); statusErr != nil {
+			r.Log.Error(statusErr, "unable to update stack status")
+		}
+		return ctrl.Result{}, err
+	}
+
+	ready := true
+	for _, s := range statuses {
+		ready = ready && s.Ready
+	}
+
+	meta.SetStatusCondition(&stack.Status.Conditions,
+		getInitializedCondition("Created", "Stack resources are created"))
+
+	// Keeps the removed resources until they are gone, so they are deleted in the dependency order
+	for _, key := range removed {
+		statuses = append(statuses, v1alpha1.StackResourceStatus{Kind: key.Kind, Name: key.Name})
+	}
+	stack.Status.Resources = statuses
+
+	if ready && len(removed) == 0 {
+		stack.Status.State = stackStateRunning
+		meta.SetStatusCondition(&stack.Status.Conditions,
+			getRunningCondition(metav1.ConditionTrue, "CheckRunning", "All stack resources are running"))
+		updateSyncStatus(stack, start)
+	} else {
+		stack.Status.State = stackStateCreating
+		meta.SetStatusCondition(&stack.Status.Conditions,
+			getRunningCondition(metav1.ConditionUnknown, "CheckRunning", "Waiting for the stack resources to be running"))
+	}
+
+	if err = r.Status().Update(ctx, stack); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// The owned resources trigger the reconciliation when they change,
+	// the requeue only picks up the removed resources that are already gone
+	if len(removed) > 0 {
+		return ctrl.Result{RequeueAfter: requeueTimeout}, nil
+	}
+	return requeueBeforeExpiry(ctrl.Result{}, expiresIn), nil
+}
+
+func (r *StackReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	b := ctrl.NewControllerManagedBy(mgr).
+		For(&v1alpha1.Stack{})
+
+	for kind := range stackKindTiers {
+		o, err := r.Scheme.New(v1alpha1.GroupVersion.WithKind(kind))
+		if err != nil {
+			return err
+		}
+		b = b.Owns(o.(client.Object))
+	}
+
+	return b.Complete(r)
+}
+
+type stackResourceKey struct {
+	Kind string
+	Name string
+}
+
+// stackResourceKeys returns both declared and created resources of the stack
+func stackResourceKeys(stack *v1alpha1.Stack) []stackResourceKey {
+	seen := make(map[stackResourceKey]bool)
+	keys := make([]stackResourceKey, 0, len(stack.Spec.Resources))
+	for _, res := range stack.Spec.Resources {
+		key := stackResourceKey{Kind: res.Kind, Name: res.Name}
+		if !seen[key] {
+			seen[key] = true
+			keys = append(keys, key)
+		}
+	}
+	for _, res := range stack.Status.Resources {
+		key := stackResourceKey{Kind: res.Kind, Name: res.Name}
+		if !seen[key] {
+			seen[key] = true
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+// applyResources creates or updates the stack resources tier by tier.
+// The next tier is not touched until all resources of the current one are running.
+func (r *StackReconciler) applyResources(ctx context.Context, stack *v1alpha1.Stack) ([]v1alpha1.StackResourceStatus, error) {
+	resources := make([]v1alpha1.StackResource, len(stack.Spec.Resources))
+	copy(resources, stack.Spec.Resources)
+	sort.SliceStable(resources, func(i, j int) bool {
+		return stackKindTiers[resources[i].Kind] < stackKindTiers[resources[j].Kind]
+	})
+
+	statuses := make([]v1alpha1.StackResourceStatus, 0, len(resources))
+	blockedTier := -1
+	for _, res := range resources {
+		status := v1alpha1.StackResourceStatus{Kind: res.Kind, Name: res.Name}
+		tier := stackKindTiers[res.Kind]
+		if blockedTier >= 0 && tier > blockedTier {
+			statuses = append(statuses, status)
+			continue
+		}
+
+		o, err := r.applyResource(ctx, stack, res)
+		if err != nil {
+			return nil, fmt.Errorf("unable to create or update %s %q: %w", res.Kind, res.Name, err)
+		}
+
+		status.Ready = isAlreadyProcessed(o) && isAlreadyRunning(o)
+		if !status.Ready {
+			blockedTier = tier
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses, nil
+}
+
+func (r *StackReconciler) applyResource(ctx context.Context, stack *v1alpha1.Stack, res v1alpha1.StackResource) (client.Object, error) {
+	spec := make(map[string]interface{})
+	if len(res.Spec.Raw) > 0 {
+		if err := json.Unmarshal(res.Spec.Raw, &spec); err != nil {
+			return nil, fmt.Errorf("invalid spec: %w", err)
+		}
+	}
+
+	if _, ok := spec["authSecretRef"]; !ok && stack.Spec.AuthSecretRef.IsValid() {
+		spec["authSecretRef"] = map[string]interface{}{
+			"name": stack.Spec.AuthSecretRef.Name,
+			"key":  stack.Spec.AuthSecretRef.Key,
+		}
+	}
+
+	o := &unstructured.Unstructured{}
+	o.SetGroupVersionKind(v1alpha1.GroupVersion.WithKind(res.Kind))
+	o.SetName(res.Name)
+	o.SetNamespace(stack.Namespace)
+
+	_, err := controllerutil.CreateOrUpdate(ctx, r.Client, o, func() error {
+		o.Object["spec"] = spec
+		return ctrl.SetControllerReference(stack, o, r.Scheme)
+	})
+	return o, err
+}
+
+// deleteResources deletes the given resources tier by tier in the reverse order.
+// Returns the resources that are not gone yet.
+func (r *StackReconciler) deleteResources(ctx context.Context, stack *v1alpha1.Stack, keys []stackResourceKey) ([]stackResourceKey, error) {
+	sorted := make([]stackResourceKey, len(keys))
+	copy(sorted, keys)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return stackKindTiers[sorted[i].Kind] > stackKindTiers[sorted[j].Kind]
+	})
+
+	remaining := make([]stackResourceKey, 0)
+	blockedTier := -1
+	for _, key := range sorted {
+		tier := stackKindTiers[key.Kind]
+		if blockedTier >= 0 && tier < blockedTier {
+			remaining = append(remaining, key)
+			continue
+		}
+
+		o := &unstructured.Unstructured{}
+		o.SetGroupVersionKind(v1alpha1.GroupVersion.WithKind(key.Kind))
+		err := r.Get(ctx, types.NamespacedName{Name: key.Name, Namespace: stack.Namespace}, o)
+		if apierrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		// Never deletes what the stack doesn't own
+		if !metav1.IsControlledBy(o, stack) {
+			continue
+		}
+
+		blockedTier = tier
+		remaining = append(remaining, key)
+		if isMarkedForDeletion(o) {
+			continue
+		}
+
+		r.Log.Info("deleting stack resource", "stack", stack.Name, "kind", key.Kind, "name", key.Name)
+		if err = r.Delete(ctx, o); client.IgnoreNotFound(err) != nil {
+			return nil, fmt.Errorf("unable to delete %s %q: %w", key.Kind, key.Name, err)
+		}
+	}
+	return remaining, nil
+}
@@ -0,0 +1,128 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+var _ = Describe("Stack Controller", func() {
+	// Define utility constants for object names and testing timeouts/durations and intervals.
+	const (
+		namespace = "default"
+
+		timeout  = time.Minute * 20
+		interval = time.Second * 10
+	)
+
+	var (
+		stack       *v1alpha1.Stack
+		stackName   string
+		serviceName string
+		topicName   string
+		ctx         = context.Background()
+	)
+
+	BeforeEach(func() {
+		stackName = "k8s-test-stack-acc-" + generateRandomID()
+		serviceName = "k8s-test-stack-kafka-acc-" + generateRandomID()
+		topicName = "k8s-test-stack-topic-acc-" + generateRandomID()
+		stack = stackSpec(stackName, serviceName, topicName, namespace)
+
+		By("Creating a new Stack instance")
+		Expect(k8sClient.Create(ctx, stack)).Should(Succeed())
+
+		lookupKey := types.NamespacedName{Name: stackName, Namespace: namespace}
+		createdStack := &v1alpha1.Stack{}
+
+		By("by waiting Stack to become RUNNING")
+		Eventually(func() bool {
+			err := k8sClient.Get(ctx, lookupKey, createdStack)
+			if err == nil {
+				return meta.IsStatusConditionTrue(createdStack.Status.Conditions, conditionTypeRunning)
+			}
+			return false
+		}, timeout, interval).Should(BeTrue())
+
+		By("by checking finalizers")
+		Expect(createdStack.GetFinalizers()).ToNot(BeEmpty())
+	})
+
+	Context("Validating Stack reconciler behaviour", func() {
+		It("should create the stack resources in the dependency order", func() {
+			createdStack := &v1alpha1.Stack{}
+			lookupKey := types.NamespacedName{Name: stackName, Namespace: namespace}
+
+			Expect(k8sClient.Get(ctx, lookupKey, createdStack)).Should(Succeed())
+
+			By("by checking that after Stack was created")
+			Expect(createdStack.Status.State).Should(Equal("RUNNING"))
+			Expect(createdStack.Status.Resources).Should(HaveLen(2))
+
+			By("by checking that the topic is owned by the stack")
+			createdTopic := &v1alpha1.KafkaTopic{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: topicName, Namespace: namespace}, createdTopic)).Should(Succeed())
+			Expect(metav1.IsControlledBy(createdTopic, createdStack)).Should(BeTrue())
+			Expect(meta.IsStatusConditionTrue(createdTopic.Status.Conditions, conditionTypeRunning)).Should(BeTrue())
+		})
+	})
+
+	AfterEach(func() {
+		By("Ensures that Stack instance was deleted")
+		ensureDelete(ctx, stack)
+
+		By("Ensures that the stack resources were deleted")
+		err := k8sClient.Get(ctx, types.NamespacedName{Name: serviceName, Namespace: namespace}, &v1alpha1.Kafka{})
+		Expect(apierrors.IsNotFound(err)).Should(BeTrue())
+	})
+})
+
+func stackSpec(name, serviceName, topicName, namespace string) *v1alpha1.Stack {
+	kafka, err := json.Marshal(kafkaSpec(serviceName, namespace).Spec)
+	Expect(err).NotTo(HaveOccurred())
+
+	topic, err := json.Marshal(kafkaTopicSpec(serviceName, topicName, namespace).Spec)
+	Expect(err).NotTo(HaveOccurred())
+
+	return &v1alpha1.Stack{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "aiven.io/v1alpha1",
+			Kind:       "Stack",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: v1alpha1.StackSpec{
+			// The topic is declared first to check that the order is resolved by the kind
+			Resources: []v1alpha1.StackResource{
+				{
+					Kind: "KafkaTopic",
+					Name: topicName,
+					Spec: runtime.RawExtension{Raw: topic},
+				},
+				{
+					Kind: "Kafka",
+					Name: serviceName,
+					Spec: runtime.RawExtension{Raw: kafka},
+				},
+			},
+			AuthSecretRef: v1alpha1.AuthSecretReference{
+				Name: secretRefName,
+				Key:  secretRefKey,
+			},
+		},
+	}
+}
@@ -0,0 +1,126 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+// stateVersionAnnotation is the number of the state migrations applied to the object
+const stateVersionAnnotation = "controllers.aiven.io/state-version"
+
+// stateMigration brings the objects the older operator versions left up to date,
+// e.g. renames a finalizer, moves a secret key or fills in a new status field.
+// The steps must be idempotent: they run again if the update of the object fails,
+// and the objects created by the current version go through them too.
+type stateMigration struct {
+	description string
+
+	// migrate changes the object, which is updated after all the pending steps.
+	// The status and the other objects, like secrets, must be updated by the step itself
+	migrate func(ctx context.Context, c client.Client, o client.Object) error
+}
+
+// stateMigrations are applied in order, the state version of the object is the number of the applied steps.
+// Append the new steps to the end, never remove nor reorder them.
+var stateMigrations = []stateMigration{
+	{
+		description: "record the state version",
+		migrate:     func(context.Context, client.Client, client.Object) error { return nil },
+	},
+}
+
+// objectStateVersion returns the number of the state migrations applied to the object, zero for the objects older than them
+func objectStateVersion(o client.Object) int {
+	v, err := strconv.Atoi(o.GetAnnotations()[stateVersionAnnotation])
+	if err != nil || v < 0 {
+		return 0
+	}
+	return v
+}
+
+// migrateState applies the pending state migrations to the object, and records its state version.
+// The objects of a newer operator version are left intact, in case of a rollback.
+// Returns true if the object was updated
+func migrateState(ctx context.Context, c client.Client, o client.Object) (bool, error) {
+	version := objectStateVersion(o)
+	if version >= len(stateMigrations) {
+		return false, nil
+	}
+
+	for i := version; i < len(stateMigrations); i++ {
+		m := stateMigrations[i]
+		if err := m.migrate(ctx, c, o); err != nil {
+			return false, fmt.Errorf("state migration %d (%s) failed: %w", i+1, m.description, err)
+		}
+	}
+
+	a := o.GetAnnotations()
+	if a == nil {
+		a = make(map[string]string)
+	}
+	a[stateVersionAnnotation] = strconv.Itoa(len(stateMigrations))
+	o.SetAnnotations(a)
+	return true, c.Update(ctx, o)
+}
+
+// StateMigrator applies the pending state migrations to the objects of the kinds on the operator start,
+// so the ones that are not reconciled soon are brought up to date too
+type StateMigrator struct {
+	Client client.Client
+	Log    logr.Logger
+
+	// Kinds are the migrated kinds
+	Kinds []string
+}
+
+// Start migrates the objects, the failed ones are migrated on their next reconciliation
+func (m *StateMigrator) Start(ctx context.Context) error {
+	migrated := 0
+	for _, kind := range m.Kinds {
+		obj, err := m.Client.Scheme().New(v1alpha1.GroupVersion.WithKind(kind + "List"))
+		if err != nil {
+			m.Log.Error(err, "unknown kind, skipping state migration", "kind", kind)
+			continue
+		}
+
+		list := obj.(client.ObjectList)
+		if err := m.Client.List(ctx, list); err != nil {
+			m.Log.Error(err, "unable to list objects for state migration", "kind", kind)
+			continue
+		}
+
+		items, err := meta.ExtractList(list)
+		if err != nil {
+			return err
+		}
+
+		for _, item := range items {
+			o := item.(client.Object)
+			ok, err := migrateState(ctx, m.Client, o)
+			if err != nil {
+				m.Log.Error(err, "unable to migrate object state", "kind", kind, "namespace", o.GetNamespace(), "name", o.GetName())
+				continue
+			}
+			if ok {
+				migrated++
+			}
+		}
+	}
+
+	m.Log.Info("state migrations applied", "version", len(stateMigrations), "migrated", migrated)
+	return nil
+}
+
+// NeedLeaderElection makes only the leader migrate the objects
+func (m *StateMigrator) NeedLeaderElection() bool {
+	return true
+}
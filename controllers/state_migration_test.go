@@ -0,0 +1,87 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+// updateRecorder counts the updates, the other client methods are not used
+type updateRecorder struct {
+	client.Client
+	updates int
+}
+
+func (r *updateRecorder) Update(context.Context, client.Object, ...client.UpdateOption) error {
+	r.updates++
+	return nil
+}
+
+func TestMigrateState(t *testing.T) {
+	defer func(m []stateMigration) { stateMigrations = m }(stateMigrations)
+
+	applied := make([]string, 0)
+	step := func(name string) stateMigration {
+		return stateMigration{
+			description: name,
+			migrate: func(_ context.Context, _ client.Client, o client.Object) error {
+				applied = append(applied, name)
+				return nil
+			},
+		}
+	}
+	stateMigrations = []stateMigration{step("first"), step("second")}
+
+	ctx := context.Background()
+	c := &updateRecorder{}
+
+	// An object older than the migrations goes through all of them
+	kafka := &v1alpha1.Kafka{}
+	migrated, err := migrateState(ctx, c, kafka)
+	require.NoError(t, err)
+	assert.True(t, migrated)
+	assert.Equal(t, []string{"first", "second"}, applied)
+	assert.Equal(t, 2, objectStateVersion(kafka))
+	assert.Equal(t, 1, c.updates)
+
+	// Up to date
+	migrated, err = migrateState(ctx, c, kafka)
+	require.NoError(t, err)
+	assert.False(t, migrated)
+	assert.Equal(t, 1, c.updates)
+
+	// The pending step only
+	applied = applied[:0]
+	stateMigrations = append(stateMigrations, step("third"))
+	migrated, err = migrateState(ctx, c, kafka)
+	require.NoError(t, err)
+	assert.True(t, migrated)
+	assert.Equal(t, []string{"third"}, applied)
+	assert.Equal(t, 3, objectStateVersion(kafka))
+
+	// A newer operator version has migrated the object
+	kafka.Annotations[stateVersionAnnotation] = "10"
+	migrated, err = migrateState(ctx, c, kafka)
+	require.NoError(t, err)
+	assert.False(t, migrated)
+
+	// The version is not recorded if a step fails
+	stateMigrations = append(stateMigrations, stateMigration{
+		description: "failing",
+		migrate: func(context.Context, client.Client, client.Object) error {
+			return fmt.Errorf("boom")
+		},
+	})
+	kafka.Annotations[stateVersionAnnotation] = "3"
+	_, err = migrateState(ctx, c, kafka)
+	assert.EqualError(t, err, "state migration 4 (failing) failed: boom")
+	assert.Equal(t, 3, objectStateVersion(kafka))
+}
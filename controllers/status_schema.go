@@ -0,0 +1,64 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"errors"
+	"regexp"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+)
+
+const eventStatusSchemaRejected = "StatusSchemaRejected"
+
+// reportStatusSchemaRejection tells that the status has values the CRD schema doesn't allow,
+// e.g. a state Aiven added after the types were generated.
+// The status is not updated until the operator has the new types, so the gap is logged, counted and shown as an event
+func (i instanceReconcilerHelper) reportStatusSchemaRejection(o client.Object, err error) {
+	kind := "Unknown"
+	if gvk, gvkErr := apiutil.GVKForObject(o, i.k8s.Scheme()); gvkErr == nil {
+		kind = gvk.Kind
+	}
+
+	fields := statusSchemaRejectedFields(err)
+	for _, f := range fields {
+		statusSchemaRejections.WithLabelValues(kind, f).Inc()
+	}
+
+	i.log.Error(err, "the CRD schema rejected the status, the Aiven data doesn't fit the status types", "fields", fields)
+	i.rec.Eventf(o, corev1.EventTypeWarning, eventStatusSchemaRejected,
+		"the status is not updated, the CRD schema rejects the data returned by Aiven. "+
+			"Upgrade the operator, or report the issue if the latest version has it: %s", err)
+}
+
+// fieldIndexPattern matches the list indexes of the field paths, which would make a metric label per item
+var fieldIndexPattern = regexp.MustCompile(`\[[0-9]+\]`)
+
+// statusSchemaRejectedFields returns the fields of the invalid error without the list indexes, e.g. status.peeringConnections[*].state
+func statusSchemaRejectedFields(err error) []string {
+	var statusErr *apierrors.StatusError
+	if !errors.As(err, &statusErr) || statusErr.ErrStatus.Details == nil {
+		return []string{"status"}
+	}
+
+	unique := make(map[string]bool)
+	for _, c := range statusErr.ErrStatus.Details.Causes {
+		if c.Field != "" {
+			unique[fieldIndexPattern.ReplaceAllString(c.Field, "[*]")] = true
+		}
+	}
+	if len(unique) == 0 {
+		return []string{"status"}
+	}
+
+	fields := make([]string, 0, len(unique))
+	for f := range unique {
+		fields = append(fields, f)
+	}
+	sort.Strings(fields)
+	return fields
+}
@@ -0,0 +1,29 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func TestStatusSchemaRejectedFields(t *testing.T) {
+	err := apierrors.NewInvalid(schema.GroupKind{Group: "aiven.io", Kind: "ProjectVPC"}, "vpc", field.ErrorList{
+		field.NotSupported(field.NewPath("status", "state"), "MIGRATING", []string{"ACTIVE", "APPROVED"}),
+		field.Invalid(field.NewPath("status", "peeringConnections").Index(0).Child("state"), "MIGRATING", "unsupported"),
+		field.Invalid(field.NewPath("status", "peeringConnections").Index(1).Child("state"), "MIGRATING", "unsupported"),
+		field.NotSupported(field.NewPath("status", "state"), "MIGRATING", []string{"ACTIVE"}),
+	})
+	assert.True(t, apierrors.IsInvalid(err))
+	expected := []string{"status.peeringConnections[*].state", "status.state"}
+	assert.Equal(t, expected, statusSchemaRejectedFields(err))
+	assert.Equal(t, expected, statusSchemaRejectedFields(fmt.Errorf("update: %w", err)))
+
+	// Not an API error
+	assert.Equal(t, []string{"status"}, statusSchemaRejectedFields(fmt.Errorf("no details")))
+}
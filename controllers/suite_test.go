@@ -120,6 +120,13 @@ var _ = BeforeSuite(func() {
 	}).SetupWithManager(k8sManager)
 	Expect(err).ToNot(HaveOccurred())
 
+	// set-up OperatorConfig reconciler
+	err = (&OperatorConfigReconciler{
+		Client: k8sManager.GetClient(),
+		Log:    ctrl.Log.WithName("controllers").WithName("OperatorConfig"),
+	}).SetupWithManager(k8sManager)
+	Expect(err).ToNot(HaveOccurred())
+
 	// set-up ProjectVPC reconciler
 	err = (&ProjectVPCReconciler{
 		Controller: Controller{
@@ -131,6 +138,72 @@ var _ = BeforeSuite(func() {
 	}).SetupWithManager(k8sManager)
 	Expect(err).ToNot(HaveOccurred())
 
+	// set-up OrganizationVPC reconciler
+	err = (&OrganizationVPCReconciler{
+		Controller: Controller{
+			Client:   k8sManager.GetClient(),
+			Log:      ctrl.Log.WithName("controllers").WithName("OrganizationVPC"),
+			Scheme:   k8sManager.GetScheme(),
+			Recorder: k8sManager.GetEventRecorderFor("organizationvpc-reconciler"),
+		},
+	}).SetupWithManager(k8sManager)
+	Expect(err).ToNot(HaveOccurred())
+
+	// set-up OrganizationPermission reconciler
+	err = (&OrganizationPermissionReconciler{
+		Controller: Controller{
+			Client:   k8sManager.GetClient(),
+			Log:      ctrl.Log.WithName("controllers").WithName("OrganizationPermission"),
+			Scheme:   k8sManager.GetScheme(),
+			Recorder: k8sManager.GetEventRecorderFor("organizationpermission-reconciler"),
+		},
+	}).SetupWithManager(k8sManager)
+	Expect(err).ToNot(HaveOccurred())
+
+	// set-up GCPVPCPeeringConnection reconciler
+	err = (&GCPVPCPeeringConnectionReconciler{
+		Controller: Controller{
+			Client:   k8sManager.GetClient(),
+			Log:      ctrl.Log.WithName("controllers").WithName("GCPVPCPeeringConnection"),
+			Scheme:   k8sManager.GetScheme(),
+			Recorder: k8sManager.GetEventRecorderFor("gcp-vpc-peering-connection-reconciler"),
+		},
+	}).SetupWithManager(k8sManager)
+	Expect(err).ToNot(HaveOccurred())
+
+	// set-up AzureVNetPeeringConnection reconciler
+	err = (&AzureVNetPeeringConnectionReconciler{
+		Controller: Controller{
+			Client:   k8sManager.GetClient(),
+			Log:      ctrl.Log.WithName("controllers").WithName("AzureVNetPeeringConnection"),
+			Scheme:   k8sManager.GetScheme(),
+			Recorder: k8sManager.GetEventRecorderFor("azure-vnet-peering-connection-reconciler"),
+		},
+	}).SetupWithManager(k8sManager)
+	Expect(err).ToNot(HaveOccurred())
+
+	// set-up AWSPrivateLink reconciler
+	err = (&AWSPrivateLinkReconciler{
+		Controller: Controller{
+			Client:   k8sManager.GetClient(),
+			Log:      ctrl.Log.WithName("controllers").WithName("AWSPrivateLink"),
+			Scheme:   k8sManager.GetScheme(),
+			Recorder: k8sManager.GetEventRecorderFor("aws-privatelink-reconciler"),
+		},
+	}).SetupWithManager(k8sManager)
+	Expect(err).ToNot(HaveOccurred())
+
+	// set-up AzurePrivateLink reconciler
+	err = (&AzurePrivateLinkReconciler{
+		Controller: Controller{
+			Client:   k8sManager.GetClient(),
+			Log:      ctrl.Log.WithName("controllers").WithName("AzurePrivateLink"),
+			Scheme:   k8sManager.GetScheme(),
+			Recorder: k8sManager.GetEventRecorderFor("azure-privatelink-reconciler"),
+		},
+	}).SetupWithManager(k8sManager)
+	Expect(err).ToNot(HaveOccurred())
+
 	// set-up Kafka reconciler
 	err = (&KafkaReconciler{
 		Controller{
@@ -219,6 +292,17 @@ var _ = BeforeSuite(func() {
 	}).SetupWithManager(k8sManager)
 	Expect(err).ToNot(HaveOccurred())
 
+	// set-up KafkaNativeACL reconciler
+	err = (&KafkaNativeACLReconciler{
+		Controller{
+			Client:   k8sManager.GetClient(),
+			Log:      ctrl.Log.WithName("controllers").WithName("KafkaNativeACL"),
+			Scheme:   k8sManager.GetScheme(),
+			Recorder: k8sManager.GetEventRecorderFor("kafka-native-acl-reconciler"),
+		},
+	}).SetupWithManager(k8sManager)
+	Expect(err).ToNot(HaveOccurred())
+
 	// set-up KafkaSchema reconciler
 	err = (&KafkaSchemaReconciler{
 		Controller{
@@ -284,6 +368,26 @@ var _ = BeforeSuite(func() {
 		},
 	}).SetupWithManager(k8sManager)).To(Succeed())
 
+	// set-up ClickhouseGrant reconciler
+	Expect((&ClickhouseGrantReconciler{
+		Controller{
+			Client:   k8sManager.GetClient(),
+			Log:      ctrl.Log.WithName("controllers").WithName("ClickhouseGrant"),
+			Scheme:   k8sManager.GetScheme(),
+			Recorder: k8sManager.GetEventRecorderFor("clickhouse-grant-reconciler"),
+		},
+	}).SetupWithManager(k8sManager)).To(Succeed())
+
+	// set-up ClickhouseRole reconciler
+	Expect((&ClickhouseRoleReconciler{
+		Controller{
+			Client:   k8sManager.GetClient(),
+			Log:      ctrl.Log.WithName("controllers").WithName("ClickhouseRole"),
+			Scheme:   k8sManager.GetScheme(),
+			Recorder: k8sManager.GetEventRecorderFor("clickhouse-role-reconciler"),
+		},
+	}).SetupWithManager(k8sManager)).To(Succeed())
+
 	// set-up ClickhouseUser reconciler
 	Expect((&ClickhouseUserReconciler{
 		Controller{
@@ -294,6 +398,26 @@ var _ = BeforeSuite(func() {
 		},
 	}).SetupWithManager(k8sManager)).To(Succeed())
 
+	// set-up FlinkApplication reconciler
+	Expect((&FlinkApplicationReconciler{
+		Controller{
+			Client:   k8sManager.GetClient(),
+			Log:      ctrl.Log.WithName("controllers").WithName("FlinkApplication"),
+			Scheme:   k8sManager.GetScheme(),
+			Recorder: k8sManager.GetEventRecorderFor("flink-application-reconciler"),
+		},
+	}).SetupWithManager(k8sManager)).To(Succeed())
+
+	// set-up FlinkApplicationDeployment reconciler
+	Expect((&FlinkApplicationDeploymentReconciler{
+		Controller{
+			Client:   k8sManager.GetClient(),
+			Log:      ctrl.Log.WithName("controllers").WithName("FlinkApplicationDeployment"),
+			Scheme:   k8sManager.GetScheme(),
+			Recorder: k8sManager.GetEventRecorderFor("flink-application-deployment-reconciler"),
+		},
+	}).SetupWithManager(k8sManager)).To(Succeed())
+
 	// set-up MySQL reconciler
 	Expect((&MySQLReconciler{
 		Controller{
@@ -324,6 +448,116 @@ var _ = BeforeSuite(func() {
 		},
 	}).SetupWithManager(k8sManager)).To(Succeed())
 
+	// set-up M3DB reconciler
+	Expect((&M3DBReconciler{
+		Controller{
+			Client:   k8sManager.GetClient(),
+			Log:      ctrl.Log.WithName("controllers").WithName("M3DB"),
+			Scheme:   k8sManager.GetScheme(),
+			Recorder: k8sManager.GetEventRecorderFor("m3db-reconciler"),
+		},
+	}).SetupWithManager(k8sManager)).To(Succeed())
+
+	// set-up M3Aggregator reconciler
+	Expect((&M3AggregatorReconciler{
+		Controller{
+			Client:   k8sManager.GetClient(),
+			Log:      ctrl.Log.WithName("controllers").WithName("M3Aggregator"),
+			Scheme:   k8sManager.GetScheme(),
+			Recorder: k8sManager.GetEventRecorderFor("m3aggregator-reconciler"),
+		},
+	}).SetupWithManager(k8sManager)).To(Succeed())
+
+	// set-up Dragonfly reconciler
+	Expect((&DragonflyReconciler{
+		Controller{
+			Client:   k8sManager.GetClient(),
+			Log:      ctrl.Log.WithName("controllers").WithName("Dragonfly"),
+			Scheme:   k8sManager.GetScheme(),
+			Recorder: k8sManager.GetEventRecorderFor("dragonfly-reconciler"),
+		},
+	}).SetupWithManager(k8sManager)).To(Succeed())
+
+	// set-up Valkey reconciler
+	Expect((&ValkeyReconciler{
+		Controller{
+			Client:   k8sManager.GetClient(),
+			Log:      ctrl.Log.WithName("controllers").WithName("Valkey"),
+			Scheme:   k8sManager.GetScheme(),
+			Recorder: k8sManager.GetEventRecorderFor("valkey-reconciler"),
+		},
+	}).SetupWithManager(k8sManager)).To(Succeed())
+
+	// set-up Thanos reconciler
+	Expect((&ThanosReconciler{
+		Controller{
+			Client:   k8sManager.GetClient(),
+			Log:      ctrl.Log.WithName("controllers").WithName("Thanos"),
+			Scheme:   k8sManager.GetScheme(),
+			Recorder: k8sManager.GetEventRecorderFor("thanos-reconciler"),
+		},
+	}).SetupWithManager(k8sManager)).To(Succeed())
+
+	// set-up Stack reconciler
+	Expect((&StackReconciler{
+		Controller{
+			Client:   k8sManager.GetClient(),
+			Log:      ctrl.Log.WithName("controllers").WithName("Stack"),
+			Scheme:   k8sManager.GetScheme(),
+			Recorder: k8sManager.GetEventRecorderFor("stack-reconciler"),
+		},
+	}).SetupWithManager(k8sManager)).To(Succeed())
+
+	// set-up ApplicationUserToken reconciler
+	Expect((&ApplicationUserTokenReconciler{
+		Controller{
+			Client:   k8sManager.GetClient(),
+			Log:      ctrl.Log.WithName("controllers").WithName("ApplicationUserToken"),
+			Scheme:   k8sManager.GetScheme(),
+			Recorder: k8sManager.GetEventRecorderFor("applicationusertoken-reconciler"),
+		},
+	}).SetupWithManager(k8sManager)).To(Succeed())
+
+	// set-up ServiceIntegrationEndpoint reconciler
+	Expect((&ServiceIntegrationEndpointReconciler{
+		Controller{
+			Client:   k8sManager.GetClient(),
+			Log:      ctrl.Log.WithName("controllers").WithName("ServiceIntegrationEndpoint"),
+			Scheme:   k8sManager.GetScheme(),
+			Recorder: k8sManager.GetEventRecorderFor("service-integration-endpoint-reconciler"),
+		},
+	}).SetupWithManager(k8sManager)).To(Succeed())
+
+	// set-up OpenSearchACL reconciler
+	Expect((&OpenSearchACLReconciler{
+		Controller{
+			Client:   k8sManager.GetClient(),
+			Log:      ctrl.Log.WithName("controllers").WithName("OpenSearchACL"),
+			Scheme:   k8sManager.GetScheme(),
+			Recorder: k8sManager.GetEventRecorderFor("opensearch-acl-reconciler"),
+		},
+	}).SetupWithManager(k8sManager)).To(Succeed())
+
+	// set-up OpenSearchSnapshotRepository reconciler
+	Expect((&OpenSearchSnapshotRepositoryReconciler{
+		Controller{
+			Client:   k8sManager.GetClient(),
+			Log:      ctrl.Log.WithName("controllers").WithName("OpenSearchSnapshotRepository"),
+			Scheme:   k8sManager.GetScheme(),
+			Recorder: k8sManager.GetEventRecorderFor("opensearch-snapshot-repository-reconciler"),
+		},
+	}).SetupWithManager(k8sManager)).To(Succeed())
+
+	// set-up OpenSearchSnapshotRestore reconciler
+	Expect((&OpenSearchSnapshotRestoreReconciler{
+		Controller{
+			Client:   k8sManager.GetClient(),
+			Log:      ctrl.Log.WithName("controllers").WithName("OpenSearchSnapshotRestore"),
+			Scheme:   k8sManager.GetScheme(),
+			Recorder: k8sManager.GetEventRecorderFor("opensearch-snapshot-restore-reconciler"),
+		},
+	}).SetupWithManager(k8sManager)).To(Succeed())
+
 	go func() {
 		Expect(k8sManager.Start(ctrl.SetupSignalHandler())).To(Succeed())
 	}()
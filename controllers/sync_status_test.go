@@ -0,0 +1,32 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+func TestUpdateSyncStatus(t *testing.T) {
+	kafka := &v1alpha1.Kafka{}
+	start := time.Now().Add(-1500 * time.Millisecond)
+	updateSyncStatus(kafka, start)
+
+	require.NotNil(t, kafka.Status.LastSyncTime)
+	assert.False(t, kafka.Status.LastSyncTime.Time.Before(start))
+	assert.Equal(t, operatorVersion, kafka.Status.OperatorVersion)
+
+	d, err := time.ParseDuration(kafka.Status.LastSyncDuration)
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, d, 1500*time.Millisecond)
+	assert.Equal(t, d.Round(time.Millisecond), d, "the duration is rounded to milliseconds")
+
+	// Objects without the sync status are ignored
+	assert.NotPanics(t, func() { updateSyncStatus(&corev1.Secret{}, start) })
+}
@@ -0,0 +1,21 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestThanosConnectionURI(t *testing.T) {
+	info := map[string]interface{}{
+		"query_uri":                 "https://query.example.com",
+		"receiver_remote_write_uri": []interface{}{"https://receiver.example.com/api/v1/receive"},
+		"query_frontend_uri":        []interface{}{},
+	}
+	assert.Equal(t, "https://query.example.com", thanosConnectionURI(info, "query_uri"))
+	assert.Equal(t, "https://receiver.example.com/api/v1/receive", thanosConnectionURI(info, "receiver_remote_write_uri"))
+	assert.Empty(t, thanosConnectionURI(info, "query_frontend_uri"))
+	assert.Empty(t, thanosConnectionURI(info, "store_uri"))
+}
@@ -0,0 +1,130 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aiven/aiven-go-client"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+// ThanosReconciler reconciles a Thanos object
+type ThanosReconciler struct {
+	Controller
+}
+
+//+kubebuilder:rbac:groups=aiven.io,resources=thanos,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=aiven.io,resources=thanos/status,verbs=get;update;patch
+
+func (r *ThanosReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	return r.reconcileInstance(ctx, req, newGenericServiceHandler(newThanosAdapter, r.Recorder), &v1alpha1.Thanos{})
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *ThanosReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&v1alpha1.Thanos{}).
+		WithOptions(priorityControllerOptions(&v1alpha1.Thanos{})).
+		Owns(&corev1.Secret{}).
+		Complete(r)
+}
+
+func newThanosAdapter(avn *aiven.Client, object client.Object) (serviceAdapter, error) {
+	thanos, ok := object.(*v1alpha1.Thanos)
+	if !ok {
+		return nil, fmt.Errorf("object is not of type v1alpha1.Thanos")
+	}
+	return &thanosAdapter{Thanos: thanos, avn: avn}, nil
+}
+
+// thanosAdapter handles an Aiven Thanos service
+type thanosAdapter struct {
+	*v1alpha1.Thanos
+
+	// avn gets the connection info, which has the endpoints of the Thanos components
+	avn *aiven.Client
+}
+
+func (a *thanosAdapter) getObjectMeta() *metav1.ObjectMeta {
+	return &a.ObjectMeta
+}
+
+func (a *thanosAdapter) getServiceStatus() *v1alpha1.ServiceStatus {
+	return &a.Status
+}
+
+func (a *thanosAdapter) getServiceCommonSpec() *v1alpha1.ServiceCommonSpec {
+	return &a.Spec.ServiceCommonSpec
+}
+
+func (a *thanosAdapter) getUserConfig() any {
+	return &a.Spec.UserConfig
+}
+
+func (a *thanosAdapter) newSecret(s *aiven.Service) (*corev1.Secret, error) {
+	name := a.Spec.ConnInfoSecretTarget.Name
+	if name == "" {
+		name = a.Name
+	}
+
+	info, err := aivenAPIFor(a.avn).getServiceConnectionInfo(a.Spec.Project, a.Name)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get the connection info of the service: %w", err)
+	}
+
+	stringData := map[string]string{
+		"THANOS_HOST":               s.URIParams["host"],
+		"THANOS_PORT":               s.URIParams["port"],
+		"THANOS_USER":               s.URIParams["user"],
+		"THANOS_PASSWORD":           s.URIParams["password"],
+		"THANOS_URI":                s.URI,
+		"THANOS_QUERY_URI":          thanosConnectionURI(info, "query_uri"),
+		"THANOS_QUERY_FRONTEND_URI": thanosConnectionURI(info, "query_frontend_uri"),
+		"THANOS_RECEIVER_URI":       thanosConnectionURI(info, "receiver_remote_write_uri"),
+	}
+
+	// Removes empties
+	for k, v := range stringData {
+		if v == "" {
+			delete(stringData, k)
+		}
+	}
+
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: a.Namespace},
+		StringData: stringData,
+	}, nil
+}
+
+// thanosConnectionURI returns the URI of the connection info key, which is either a string or a list of them
+func thanosConnectionURI(info map[string]interface{}, key string) string {
+	switch v := info[key].(type) {
+	case string:
+		return v
+	case []interface{}:
+		if len(v) > 0 {
+			s, _ := v[0].(string)
+			return s
+		}
+	}
+	return ""
+}
+
+func (a *thanosAdapter) getServiceType() string {
+	return "thanos"
+}
+
+func (a *thanosAdapter) getDiskSpace() string {
+	return a.Spec.DiskSpace
+}
+
+func (a *thanosAdapter) getAdditionalDiskSpace() string {
+	return a.Spec.AdditionalDiskSpace
+}
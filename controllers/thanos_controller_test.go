@@ -0,0 +1,118 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"os"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+	thanosuserconfig "github.com/aiven/aiven-operator/api/v1alpha1/userconfigs/thanos"
+)
+
+var _ = Describe("Thanos Controller", func() {
+	// Define utility constants for object names and testing timeouts/durations and intervals.
+	const (
+		namespace = "default"
+
+		timeout  = time.Minute * 20
+		interval = time.Second * 10
+	)
+
+	var (
+		thanos      *v1alpha1.Thanos
+		serviceName string
+		ctx         context.Context
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		serviceName = "k8s-test-thanos-acc-" + generateRandomID()
+		thanos = thanosSpec(serviceName, namespace)
+
+		By("Creating a new Thanos CR instance")
+		Expect(k8sClient.Create(ctx, thanos)).Should(Succeed())
+
+		lookupKey := types.NamespacedName{Name: serviceName, Namespace: namespace}
+		created := &v1alpha1.Thanos{}
+
+		By("by waiting Thanos service status to become RUNNING")
+		Eventually(func() bool {
+			err := k8sClient.Get(ctx, lookupKey, created)
+			if err == nil {
+				return meta.IsStatusConditionTrue(created.Status.Conditions, conditionTypeRunning)
+			}
+			return false
+		}, timeout, interval).Should(BeTrue())
+
+		By("by checking finalizers")
+		Expect(created.GetFinalizers()).ToNot(BeEmpty())
+	})
+
+	Context("Validating Thanos reconciler behaviour", func() {
+		It("should createOrUpdate a new Thanos service", func() {
+			created := &v1alpha1.Thanos{}
+			lookupKey := types.NamespacedName{Name: serviceName, Namespace: namespace}
+
+			Expect(k8sClient.Get(ctx, lookupKey, created)).Should(Succeed())
+			Expect(created.Status.State).Should(Equal("RUNNING"))
+
+			By("by checking that after creation of a Thanos service secret is created")
+			createdSecret := &corev1.Secret{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: serviceName, Namespace: namespace}, createdSecret)).Should(Succeed())
+
+			Expect(createdSecret.Data["THANOS_HOST"]).NotTo(BeEmpty())
+			Expect(createdSecret.Data["THANOS_PORT"]).NotTo(BeEmpty())
+			Expect(string(createdSecret.Data["THANOS_QUERY_URI"])).To(HavePrefix("https://"))
+			Expect(string(createdSecret.Data["THANOS_QUERY_FRONTEND_URI"])).To(HavePrefix("https://"))
+			Expect(string(createdSecret.Data["THANOS_RECEIVER_URI"])).To(HavePrefix("https://"))
+
+			// Userconfig test
+			Expect(created.Spec.UserConfig.IpFilter).Should(Equal([]*thanosuserconfig.IpFilter{{Network: "10.20.0.0/16"}}))
+		})
+	})
+
+	AfterEach(func() {
+		By("Ensures that Thanos instance was deleted")
+		ensureDelete(ctx, thanos)
+	})
+})
+
+func thanosSpec(serviceName, namespace string) *v1alpha1.Thanos {
+	return &v1alpha1.Thanos{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "aiven.io/v1alpha1",
+			Kind:       "Thanos",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      serviceName,
+			Namespace: namespace,
+		},
+		Spec: v1alpha1.ThanosSpec{
+			ServiceCommonSpec: v1alpha1.ServiceCommonSpec{
+				Project:   os.Getenv("AIVEN_PROJECT_NAME"),
+				Plan:      "startup-4",
+				CloudName: "google-europe-west1",
+			},
+			UserConfig: &thanosuserconfig.ThanosUserConfig{
+				IpFilter: []*thanosuserconfig.IpFilter{
+					{
+						Network: "10.20.0.0/16",
+					},
+				},
+			},
+			AuthSecretRef: v1alpha1.AuthSecretReference{
+				Name: secretRefName,
+				Key:  secretRefKey,
+			},
+		},
+	}
+}
@@ -0,0 +1,135 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+
+	"github.com/aiven/aiven-go-client"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+// ValkeyReconciler reconciles a Valkey object
+type ValkeyReconciler struct {
+	Controller
+}
+
+//+kubebuilder:rbac:groups=aiven.io,resources=valkeys,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=aiven.io,resources=valkeys/status,verbs=get;update;patch
+
+func (r *ValkeyReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	return r.reconcileInstance(ctx, req, newGenericServiceHandler(newValkeyAdapter, r.Recorder), &v1alpha1.Valkey{})
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *ValkeyReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&v1alpha1.Valkey{}).
+		WithOptions(priorityControllerOptions(&v1alpha1.Valkey{})).
+		Owns(&corev1.Secret{}).
+		Complete(r)
+}
+
+func newValkeyAdapter(_ *aiven.Client, object client.Object) (serviceAdapter, error) {
+	valkey, ok := object.(*v1alpha1.Valkey)
+	if !ok {
+		return nil, fmt.Errorf("object is not of type v1alpha1.Valkey")
+	}
+	return &valkeyAdapter{valkey}, nil
+}
+
+// valkeyAdapter handles an Aiven Valkey service
+type valkeyAdapter struct {
+	*v1alpha1.Valkey
+}
+
+func (a *valkeyAdapter) getObjectMeta() *metav1.ObjectMeta {
+	return &a.ObjectMeta
+}
+
+func (a *valkeyAdapter) getServiceStatus() *v1alpha1.ServiceStatus {
+	return &a.Status
+}
+
+func (a *valkeyAdapter) getServiceCommonSpec() *v1alpha1.ServiceCommonSpec {
+	return &a.Spec.ServiceCommonSpec
+}
+
+func (a *valkeyAdapter) getUserConfig() any {
+	return &a.Spec.UserConfig
+}
+
+func (a *valkeyAdapter) newSecret(s *aiven.Service) (*corev1.Secret, error) {
+	name := a.Spec.ConnInfoSecretTarget.Name
+	if name == "" {
+		name = a.Name
+	}
+
+	stringData := map[string]string{
+		"HOST":     s.URIParams["host"],
+		"PASSWORD": s.URIParams["password"],
+		"PORT":     s.URIParams["port"],
+		"SSL":      s.URIParams["ssl"],
+		"USER":     s.URIParams["user"],
+		"DB":       redisDefaultDB,
+	}
+
+	if s.URIParams["host"] != "" {
+		scheme := "rediss"
+		if !a.sslEnforced() {
+			scheme = "redis"
+		}
+
+		u := url.URL{
+			Scheme: scheme,
+			User:   url.UserPassword(s.URIParams["user"], s.URIParams["password"]),
+			Host:   net.JoinHostPort(s.URIParams["host"], s.URIParams["port"]),
+			Path:   "/" + redisDefaultDB,
+		}
+		stringData["URI"] = u.String()
+		stringData["VALKEY_URI"] = u.String()
+
+		// Valkey speaks the Redis protocol, the Redis clients and buildpacks look for the name
+		stringData["REDIS_URI"] = u.String()
+	}
+
+	// Removes empties
+	for k, v := range stringData {
+		if v == "" {
+			delete(stringData, k)
+		}
+	}
+
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: a.Namespace},
+		StringData: stringData,
+	}, nil
+}
+
+// sslEnforced returns false if SSL is disabled in the user config, Aiven enforces it by default
+func (a *valkeyAdapter) sslEnforced() bool {
+	if a.Spec.UserConfig == nil || a.Spec.UserConfig.ValkeySsl == nil {
+		return true
+	}
+	return *a.Spec.UserConfig.ValkeySsl
+}
+
+func (a *valkeyAdapter) getServiceType() string {
+	return "valkey"
+}
+
+func (a *valkeyAdapter) getDiskSpace() string {
+	return a.Spec.DiskSpace
+}
+
+func (a *valkeyAdapter) getAdditionalDiskSpace() string {
+	return a.Spec.AdditionalDiskSpace
+}
@@ -0,0 +1,121 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"os"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+	valkeyuserconfig "github.com/aiven/aiven-operator/api/v1alpha1/userconfigs/valkey"
+)
+
+var _ = Describe("Valkey Controller", func() {
+	// Define utility constants for object names and testing timeouts/durations and intervals.
+	const (
+		namespace = "default"
+
+		timeout  = time.Minute * 20
+		interval = time.Second * 10
+	)
+
+	var (
+		valkey      *v1alpha1.Valkey
+		serviceName string
+		ctx         context.Context
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		serviceName = "k8s-test-valkey-acc-" + generateRandomID()
+		valkey = valkeySpec(serviceName, namespace)
+
+		By("Creating a new Valkey CR instance")
+		Expect(k8sClient.Create(ctx, valkey)).Should(Succeed())
+
+		lookupKey := types.NamespacedName{Name: serviceName, Namespace: namespace}
+		created := &v1alpha1.Valkey{}
+
+		By("by waiting Valkey service status to become RUNNING")
+		Eventually(func() bool {
+			err := k8sClient.Get(ctx, lookupKey, created)
+			if err == nil {
+				return meta.IsStatusConditionTrue(created.Status.Conditions, conditionTypeRunning)
+			}
+			return false
+		}, timeout, interval).Should(BeTrue())
+
+		By("by checking finalizers")
+		Expect(created.GetFinalizers()).ToNot(BeEmpty())
+	})
+
+	Context("Validating Valkey reconciler behaviour", func() {
+		It("should createOrUpdate a new Valkey service", func() {
+			created := &v1alpha1.Valkey{}
+			lookupKey := types.NamespacedName{Name: serviceName, Namespace: namespace}
+
+			Expect(k8sClient.Get(ctx, lookupKey, created)).Should(Succeed())
+			Expect(created.Status.State).Should(Equal("RUNNING"))
+
+			By("by checking that after creation of a Valkey service secret is created")
+			createdSecret := &corev1.Secret{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: serviceName, Namespace: namespace}, createdSecret)).Should(Succeed())
+
+			Expect(createdSecret.Data["HOST"]).NotTo(BeEmpty())
+			Expect(createdSecret.Data["PORT"]).NotTo(BeEmpty())
+			Expect(createdSecret.Data["USER"]).NotTo(BeEmpty())
+			Expect(createdSecret.Data["PASSWORD"]).NotTo(BeEmpty())
+			Expect(createdSecret.Data["DB"]).To(Equal([]byte("0")))
+			Expect(string(createdSecret.Data["URI"])).To(HavePrefix("rediss://"))
+			Expect(createdSecret.Data["VALKEY_URI"]).To(Equal(createdSecret.Data["URI"]))
+			Expect(createdSecret.Data["REDIS_URI"]).To(Equal(createdSecret.Data["URI"]))
+
+			// Userconfig test
+			Expect(created.Spec.UserConfig.IpFilter).Should(Equal([]*valkeyuserconfig.IpFilter{{Network: "10.20.0.0/16"}}))
+		})
+	})
+
+	AfterEach(func() {
+		By("Ensures that Valkey instance was deleted")
+		ensureDelete(ctx, valkey)
+	})
+})
+
+func valkeySpec(serviceName, namespace string) *v1alpha1.Valkey {
+	return &v1alpha1.Valkey{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "aiven.io/v1alpha1",
+			Kind:       "Valkey",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      serviceName,
+			Namespace: namespace,
+		},
+		Spec: v1alpha1.ValkeySpec{
+			ServiceCommonSpec: v1alpha1.ServiceCommonSpec{
+				Project:   os.Getenv("AIVEN_PROJECT_NAME"),
+				Plan:      "startup-4",
+				CloudName: "google-europe-west1",
+			},
+			UserConfig: &valkeyuserconfig.ValkeyUserConfig{
+				IpFilter: []*valkeyuserconfig.IpFilter{
+					{
+						Network: "10.20.0.0/16",
+					},
+				},
+			},
+			AuthSecretRef: v1alpha1.AuthSecretReference{
+				Name: secretRefName,
+				Key:  secretRefKey,
+			},
+		},
+	}
+}
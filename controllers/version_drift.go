@@ -0,0 +1,92 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const conditionTypeVersionDrift = "VersionDrift"
+
+// serviceVersionKeys are the user config fields of the service major versions
+var serviceVersionKeys = []string{
+	"cassandra_version",
+	"kafka_version",
+	"mysql_version",
+	"opensearch_version",
+	"pg_version",
+}
+
+// findVersionDrift returns the version fields that are newer on Aiven side than declared,
+// e.g. after an emergency upgrade in the console, with the description of both values.
+// A declared version that is newer than the actual one is an upgrade request, not a drift.
+func findVersionDrift(declared, actual map[string]interface{}) map[string]string {
+	drift := make(map[string]string)
+	for _, k := range serviceVersionKeys {
+		d, ok := declared[k]
+		if !ok || d == nil {
+			continue
+		}
+
+		a, ok := actual[k]
+		if !ok || a == nil {
+			continue
+		}
+
+		ds, as := fmt.Sprint(d), fmt.Sprint(a)
+		if compareVersions(as, ds) > 0 {
+			drift[k] = fmt.Sprintf("%s is %s, but %s is declared", k, as, ds)
+		}
+	}
+	return drift
+}
+
+// compareVersions compares dotted numeric versions like "3.4" and "3.10"
+func compareVersions(a, b string) int {
+	as, bs := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < len(as) || i < len(bs); i++ {
+		var x, y int
+		if i < len(as) {
+			x, _ = strconv.Atoi(as[i])
+		}
+		if i < len(bs) {
+			y, _ = strconv.Atoi(bs[i])
+		}
+		if x != y {
+			if x > y {
+				return 1
+			}
+			return -1
+		}
+	}
+	return 0
+}
+
+func getVersionDriftCondition(drift map[string]string) metav1.Condition {
+	if len(drift) == 0 {
+		return metav1.Condition{
+			Type:    conditionTypeVersionDrift,
+			Status:  metav1.ConditionFalse,
+			Reason:  "VersionMatches",
+			Message: "The service version matches the declared one",
+		}
+	}
+
+	messages := make([]string, 0, len(drift))
+	for _, m := range drift {
+		messages = append(messages, m)
+	}
+	sort.Strings(messages)
+
+	return metav1.Condition{
+		Type:    conditionTypeVersionDrift,
+		Status:  metav1.ConditionTrue,
+		Reason:  "ServiceVersionIsNewer",
+		Message: strings.Join(messages, "; ") + ". Update the spec to the actual version",
+	}
+}
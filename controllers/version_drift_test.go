@@ -0,0 +1,31 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestFindVersionDrift(t *testing.T) {
+	actual := map[string]interface{}{"pg_version": "14", "kafka_version": "3.10"}
+
+	// Upgraded in the console
+	drift := findVersionDrift(map[string]interface{}{"pg_version": "13"}, actual)
+	assert.Equal(t, map[string]string{"pg_version": "pg_version is 14, but 13 is declared"}, drift)
+	assert.Equal(t, metav1.ConditionTrue, getVersionDriftCondition(drift).Status)
+
+	// Upgrade requested in the spec
+	assert.Empty(t, findVersionDrift(map[string]interface{}{"pg_version": "15"}, actual))
+
+	// Minor versions are compared as numbers
+	assert.Len(t, findVersionDrift(map[string]interface{}{"kafka_version": "3.4"}, actual), 1)
+	assert.Empty(t, findVersionDrift(map[string]interface{}{"kafka_version": "3.10"}, actual))
+
+	// Not declared
+	drift = findVersionDrift(map[string]interface{}{}, actual)
+	assert.Empty(t, drift)
+	assert.Equal(t, metav1.ConditionFalse, getVersionDriftCondition(drift).Status)
+}
@@ -0,0 +1,47 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"time"
+
+	"github.com/aiven/aiven-go-client"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+// peeringVPCID returns the ID of the project VPC of a peering connection, from the spec or the referenced ProjectVPC
+func peeringVPCID(vpcID string, refs []client.Object) string {
+	if p := v1alpha1.FindProjectVPC(refs); p != nil {
+		return p.Status.ID
+	}
+	return vpcID
+}
+
+// getVPCPeeringConnection returns the VPC and its peering connection that matches, nil if there is none.
+// The API has no call for a single peering connection, they are listed with the VPC
+func getVPCPeeringConnection(avn *aiven.Client, project, vpcID string, match func(*aiven.VPCPeeringConnection) bool) (*aiven.VPC, *aiven.VPCPeeringConnection, error) {
+	vpc, err := avn.VPCs.Get(project, vpcID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, pc := range vpc.PeeringConnections {
+		if match(pc) {
+			return vpc, pc, nil
+		}
+	}
+	return vpc, nil, nil
+}
+
+// vpcPeeringResyncAfter refreshes the state of a peering connection, which is accepted or deleted on the peer side
+func vpcPeeringResyncAfter(vpcID, state string) time.Duration {
+	if vpcID == "" {
+		return 0
+	}
+	if state != "ACTIVE" {
+		return jitter(projectVPCPendingPeeringRefreshInterval)
+	}
+	return jitter(projectVPCPeeringRefreshInterval)
+}
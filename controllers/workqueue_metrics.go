@@ -0,0 +1,89 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+// workqueueKindMetrics are the controller-runtime workqueue metrics re-exported with the kind label.
+// The originals are labeled with the controller name, which is the lowercase kind
+var workqueueKindMetrics = map[string]string{
+	"workqueue_depth":                             "aiven_operator_workqueue_depth",
+	"workqueue_adds_total":                        "aiven_operator_workqueue_adds_total",
+	"workqueue_retries_total":                     "aiven_operator_workqueue_retries_total",
+	"workqueue_longest_running_processor_seconds": "aiven_operator_workqueue_longest_running_processor_seconds",
+}
+
+const workqueueNameLabel = "name"
+
+// NewKindMetricsRegistry wraps the registry, so it also serves the workqueue metrics labeled with the kinds.
+// Replace the metrics.Registry with it before the manager is created.
+func NewKindMetricsRegistry(registry metrics.RegistererGatherer, kinds []string) metrics.RegistererGatherer {
+	controllerKinds := make(map[string]string, len(kinds))
+	for _, kind := range kinds {
+		controllerKinds[strings.ToLower(kind)] = kind
+	}
+	return &kindMetricsRegistry{Registerer: registry, gatherer: registry, controllerKinds: controllerKinds}
+}
+
+type kindMetricsRegistry struct {
+	prometheus.Registerer
+	gatherer        prometheus.Gatherer
+	controllerKinds map[string]string
+}
+
+func (r *kindMetricsRegistry) Gather() ([]*dto.MetricFamily, error) {
+	families, err := r.gatherer.Gather()
+	for _, f := range families {
+		name, ok := workqueueKindMetrics[f.GetName()]
+		if !ok {
+			continue
+		}
+		families = append(families, r.relabel(f, name))
+	}
+
+	sort.Slice(families, func(i, j int) bool {
+		return families[i].GetName() < families[j].GetName()
+	})
+	return families, err
+}
+
+// relabel copies the metric family with the name label replaced with the kind
+func (r *kindMetricsRegistry) relabel(f *dto.MetricFamily, name string) *dto.MetricFamily {
+	help := f.GetHelp() + ", by kind"
+	relabeled := &dto.MetricFamily{Name: &name, Help: &help, Type: f.Type}
+	for _, m := range f.GetMetric() {
+		labels := make([]*dto.LabelPair, 0, len(m.GetLabel()))
+		for _, l := range m.GetLabel() {
+			if l.GetName() != workqueueNameLabel {
+				labels = append(labels, l)
+				continue
+			}
+
+			// Keeps the name of the controllers that are not for a kind, e.g. "project-events"
+			kind, ok := r.controllerKinds[l.GetValue()]
+			if !ok {
+				kind = l.GetValue()
+			}
+			labelName := "kind"
+			labels = append(labels, &dto.LabelPair{Name: &labelName, Value: &kind})
+		}
+
+		relabeled.Metric = append(relabeled.Metric, &dto.Metric{
+			Label:       labels,
+			Gauge:       m.Gauge,
+			Counter:     m.Counter,
+			Summary:     m.Summary,
+			Untyped:     m.Untyped,
+			Histogram:   m.Histogram,
+			TimestampMs: m.TimestampMs,
+		})
+	}
+	return relabeled
+}
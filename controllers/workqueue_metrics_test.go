@@ -0,0 +1,36 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKindMetricsRegistry(t *testing.T) {
+	depth := prometheus.NewGaugeVec(prometheus.GaugeOpts{Name: "workqueue_depth", Help: "Current depth of workqueue"}, []string{"name"})
+	depth.WithLabelValues("kafkatopic").Set(3)
+	depth.WithLabelValues("project-events").Set(1)
+
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(depth)
+
+	families, err := NewKindMetricsRegistry(registry, []string{"KafkaTopic", "PostgreSQL"}).Gather()
+	require.NoError(t, err)
+	require.Len(t, families, 2)
+
+	// The original metric is kept
+	assert.Equal(t, "aiven_operator_workqueue_depth", families[0].GetName())
+	assert.Equal(t, "workqueue_depth", families[1].GetName())
+
+	actual := make(map[string]float64)
+	for _, m := range families[0].GetMetric() {
+		require.Len(t, m.GetLabel(), 1)
+		assert.Equal(t, "kind", m.GetLabel()[0].GetName())
+		actual[m.GetLabel()[0].GetValue()] = m.GetGauge().GetValue()
+	}
+	assert.Equal(t, map[string]float64{"KafkaTopic": 3, "project-events": 1}, actual)
+}
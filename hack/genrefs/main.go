@@ -2,6 +2,7 @@ package main
 
 import (
 	"bytes"
+	"flag"
 	"fmt"
 	"io"
 	"log"
@@ -19,6 +20,18 @@ func main() {
 	const (
 		apiReferenceTargetFile = "docs/content/en/docs/api-reference/_index.md"
 	)
+
+	var openAPIDir string
+	flag.StringVar(&openAPIDir, "openapi-dir", "", "Writes the OpenAPI document and JSON schemas of the CRDs to the directory instead of the API reference")
+	flag.Parse()
+
+	if openAPIDir != "" {
+		if err := generateOpenAPI(openAPIDir); err != nil {
+			log.Fatal("unable to generate openapi schemas: ", err)
+		}
+		return
+	}
+
 	f, err := os.OpenFile(apiReferenceTargetFile, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0755)
 	if err != nil {
 		log.Fatal("unable to open target file: ", err)
@@ -0,0 +1,133 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	crdBasesDir         = "config/crd/bases"
+	openAPIDocumentFile = "openapi.json"
+	jsonSchemaDraft     = "http://json-schema.org/draft-07/schema#"
+)
+
+// crdDocument is the part of the CustomResourceDefinition the schemas are taken from
+type crdDocument struct {
+	Spec struct {
+		Group string `yaml:"group"`
+		Names struct {
+			Kind string `yaml:"kind"`
+		} `yaml:"names"`
+		Versions []struct {
+			Name   string `yaml:"name"`
+			Schema struct {
+				OpenAPIV3Schema map[string]interface{} `yaml:"openAPIV3Schema"`
+			} `yaml:"schema"`
+		} `yaml:"versions"`
+	} `yaml:"spec"`
+}
+
+// generateOpenAPI writes the schemas of all CRDs, user configs included, to the directory:
+// a consolidated OpenAPI document, and a JSON Schema file per kind.
+// The schemas are the ones the API server validates the resources with,
+// including the x-kubernetes-validations rules, so a form rendered from them accepts what the cluster accepts.
+func generateOpenAPI(outDir string) error {
+	files, err := filepath.Glob(filepath.Join(crdBasesDir, "aiven.io_*.yaml"))
+	if err != nil {
+		return err
+	}
+	sort.Strings(files)
+
+	if err = os.MkdirAll(outDir, 0755); err != nil {
+		return err
+	}
+
+	schemas := make(map[string]interface{})
+	for _, name := range files {
+		// The consolidated CRD file duplicates the others
+		if strings.HasSuffix(name, ".gen.yaml") {
+			continue
+		}
+
+		b, err := os.ReadFile(name)
+		if err != nil {
+			return err
+		}
+
+		crd := new(crdDocument)
+		if err = yaml.Unmarshal(b, crd); err != nil {
+			return fmt.Errorf("unable to parse %s: %w", name, err)
+		}
+
+		kind := crd.Spec.Names.Kind
+		for _, v := range crd.Spec.Versions {
+			schema := v.Schema.OpenAPIV3Schema
+			if schema == nil {
+				return fmt.Errorf("%s has no schema for version %s", name, v.Name)
+			}
+			constrainTypeMeta(schema, crd.Spec.Group+"/"+v.Name, kind)
+			schema["x-kubernetes-group-version-kind"] = []map[string]string{
+				{"group": crd.Spec.Group, "version": v.Name, "kind": kind},
+			}
+			schemas[openAPISchemaName(crd.Spec.Group, v.Name, kind)] = schema
+
+			jsonSchemaFile := fmt.Sprintf("%s_%s.json", strings.ToLower(kind), v.Name)
+			jsonSchema := map[string]interface{}{"$schema": jsonSchemaDraft, "$id": jsonSchemaFile}
+			for k, val := range schema {
+				jsonSchema[k] = val
+			}
+			if err = writeJSON(filepath.Join(outDir, jsonSchemaFile), jsonSchema); err != nil {
+				return err
+			}
+		}
+	}
+
+	document := map[string]interface{}{
+		"openapi": "3.0.3",
+		"info": map[string]string{
+			"title":   "Aiven Operator",
+			"version": apiVersionShort,
+		},
+		"paths": map[string]interface{}{},
+		"components": map[string]interface{}{
+			"schemas": schemas,
+		},
+	}
+	return writeJSON(filepath.Join(outDir, openAPIDocumentFile), document)
+}
+
+// openAPISchemaName returns the name in the Kubernetes OpenAPI style, e.g. "io.aiven.v1alpha1.Kafka"
+func openAPISchemaName(group, version, kind string) string {
+	parts := strings.Split(group, ".")
+	for i, j := 0, len(parts)-1; i < j; i, j = i+1, j-1 {
+		parts[i], parts[j] = parts[j], parts[i]
+	}
+	return strings.Join(append(parts, version, kind), ".")
+}
+
+// constrainTypeMeta limits apiVersion and kind to the only valid values, which CRDs leave as free strings
+func constrainTypeMeta(schema map[string]interface{}, apiVersion, kind string) {
+	properties, ok := schema["properties"].(map[string]interface{})
+	if !ok {
+		return
+	}
+	for name, value := range map[string]string{"apiVersion": apiVersion, "kind": kind} {
+		if p, ok := properties[name].(map[string]interface{}); ok {
+			p["enum"] = []string{value}
+		}
+	}
+}
+
+func writeJSON(name string, v interface{}) error {
+	b, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(name, append(b, '\n'), 0644)
+}
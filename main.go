@@ -4,23 +4,31 @@ package main
 
 import (
 	"flag"
+	"fmt"
 	"os"
+	"strings"
+	"time"
 
 	_ "k8s.io/client-go/plugin/pkg/client/auth"
 
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	"github.com/aiven/aiven-operator/api/v1alpha1"
 	"github.com/aiven/aiven-operator/controllers"
 	//+kubebuilder:scaffold:imports
 )
 
-//go:generate go run ./userconfigs_generator/... --services mysql,cassandra,grafana,pg,kafka,redis,clickhouse,opensearch,kafka_connect
+//go:generate go run ./userconfigs_generator/... --services mysql,cassandra,grafana,pg,kafka,redis,clickhouse,opensearch,kafka_connect,m3db,m3aggregator,dragonfly,valkey,thanos
 
 var (
 	scheme   = runtime.NewScheme()
@@ -36,26 +44,99 @@ func init() {
 }
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "import" {
+		os.Exit(runImport(os.Args[2:]))
+	}
+
 	var metricsAddr string
 	var enableLeaderElection bool
 	var probeAddr string
 	var development bool
+	var projectEvents bool
+	var apiRateLimit int
+	var startupResyncSpread time.Duration
+	var diskPressureThresholds string
+	var cacheAllSecrets bool
+	var enableKinds string
+	var enableDryRun bool
+	var egressEndpointsConfigMap string
+	var chaosErrorRate float64
+	var chaosLatency time.Duration
+	var serviceStateTags bool
 	flag.StringVar(&metricsAddr, "metrics-bind-address", ":8080", "The address the metric endpoint binds to.")
 	flag.StringVar(&probeAddr, "health-probe-bind-address", ":8081", "The address the probe endpoint binds to.")
 	flag.BoolVar(&enableLeaderElection, "leader-elect", false,
 		"Enable leader election for controller manager. "+
 			"Enabling this will ensure there is only one active controller manager.")
 	flag.BoolVar(&development, "development", true, "Configures the logger to use a development config (stacktraces on warnings, no sampling)")
+	flag.BoolVar(&projectEvents, "project-events", false, "Polls Aiven project event logs and emits them as Kubernetes Events on the Project and service resources")
+	flag.IntVar(&apiRateLimit, "aiven-api-rate-limit", 0, "Reconciliations per minute all controllers share. "+
+		"When the limit is close, instances that are not ready yet take priority over the periodic resyncs of the ready ones. "+
+		"Zero disables the limit.")
+	flag.DurationVar(&startupResyncSpread, "startup-resync-spread", 5*time.Minute, "Spreads the first reconciliations of the ready instances "+
+		"after the operator start across this interval, to avoid hitting the Aiven API with all of them at once.")
+	flag.StringVar(&diskPressureThresholds, "disk-pressure-thresholds", "80,90", "Comma separated service disk usage percents. "+
+		"Crossing one sets the DiskPressure condition and emits a warning Event. Empty value disables the disk usage check.")
+	flag.BoolVar(&cacheAllSecrets, "cache-all-secrets", false, "Caches every Secret of the cluster. "+
+		"By default, only the connection secrets the operator creates and the auth secrets the resources refer to are cached.")
+	flag.StringVar(&enableKinds, "enable-kinds", "", "Comma separated kinds to run the controllers for, e.g. PostgreSQL,Kafka,KafkaTopic. "+
+		"The other kinds are not watched nor cached. Empty value enables all kinds.")
+	flag.BoolVar(&enableDryRun, "enable-dry-run", false, "Serves the "+controllers.DryRunPath+" endpoint on the webhook server, "+
+		"which returns the Aiven API request of a posted service resource without applying it")
+	flag.StringVar(&egressEndpointsConfigMap, "egress-endpoints-configmap", "", "Writes a ConfigMap of this name to every namespace with services, "+
+		"which lists the hosts and ports of the services for the NetworkPolicy and egress firewall automation. Empty value disables it.")
+	flag.Float64Var(&chaosErrorRate, "chaos-aiven-error-rate", 0, "Developer mode: the share of the Aiven API requests, from 0 to 1, "+
+		"that fail with an injected 503 error without reaching the API. Never use it in production. Zero disables it.")
+	flag.DurationVar(&chaosLatency, "chaos-aiven-latency", 0, "Developer mode: adds a random delay up to this duration to the Aiven API requests. "+
+		"Never use it in production. Zero disables it.")
+	flag.BoolVar(&serviceStateTags, "service-state-tags", false, "Stores the owner resource and the spec hash of the services in their Aiven tags, "+
+		"so a rebuilt cluster applying the same manifests takes the services over without updating them.")
 	opts := zap.Options{
 		Development: development,
 	}
 	opts.BindFlags(flag.CommandLine)
+
+	// The command line flags override the environment variables
+	if err := setFlagsFromEnv(flag.CommandLine, flagEnvPrefix); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
 	flag.Parse()
 
 	ctrl.SetLogger(zap.New(zap.UseFlagOptions(&opts)))
+	controllers.SetAivenAPIRateLimit(apiRateLimit)
+	controllers.SetStartupResyncSpread(startupResyncSpread)
+	controllers.SetServiceStateTags(serviceStateTags)
+	if err := controllers.SetDiskPressureThresholds(diskPressureThresholds); err != nil {
+		setupLog.Error(err, "invalid disk pressure thresholds")
+		os.Exit(1)
+	}
+
+	if err := controllers.SetAivenChaos(chaosErrorRate, chaosLatency); err != nil {
+		setupLog.Error(err, "invalid chaos mode settings")
+		os.Exit(1)
+	}
+	if chaosErrorRate > 0 || chaosLatency > 0 {
+		setupLog.Info("WARNING: chaos mode is on, the Aiven API requests fail and slow down on purpose", "errorRate", chaosErrorRate, "latency", chaosLatency)
+	}
+
+	enabledKinds, err := parseEnabledKinds(enableKinds)
+	if err != nil {
+		setupLog.Error(err, "invalid enabled kinds")
+		os.Exit(1)
+	}
+
+	metrics.Registry = controllers.NewKindMetricsRegistry(metrics.Registry, enabledKinds.List())
+
+	cacheOptions := cache.Options{}
+	if !cacheAllSecrets {
+		cacheOptions.SelectorsByObject = controllers.SecretCacheSelectors()
+	}
 
 	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), ctrl.Options{
 		Scheme:                 scheme,
+		NewCache:               cache.BuilderWithOptions(cacheOptions),
+		NewClient:              controllers.NewSecretFallbackClient,
 		MetricsBindAddress:     metricsAddr,
 		Port:                   port,
 		HealthProbeBindAddress: probeAddr,
@@ -88,265 +169,691 @@ func main() {
 		os.Exit(1)
 	}
 
-	if err = (&controllers.ProjectReconciler{
-		Controller: controllers.Controller{
-			Client:       mgr.GetClient(),
-			Log:          ctrl.Log.WithName("controllers").WithName("Project"),
-			Scheme:       mgr.GetScheme(),
-			Recorder:     mgr.GetEventRecorderFor("project-reconciler"),
-			DefaultToken: defaultToken,
-		},
+	// The OperatorConfig tunes the other controllers, so it is watched whatever kinds are enabled
+	if err = (&controllers.OperatorConfigReconciler{
+		Client:              mgr.GetClient(),
+		Log:                 ctrl.Log.WithName("controllers").WithName("OperatorConfig"),
+		DefaultAPIRateLimit: apiRateLimit,
 	}).SetupWithManager(mgr); err != nil {
-		setupLog.Error(err, "unable to create controller", "controller", "Project")
+		setupLog.Error(err, "unable to create controller", "controller", "OperatorConfig")
 		os.Exit(1)
 	}
 
-	if err = (&controllers.PostgreSQLReconciler{
-		Controller: controllers.Controller{
-			Client:       mgr.GetClient(),
-			Log:          ctrl.Log.WithName("controllers").WithName("PostgreSQL"),
-			Scheme:       mgr.GetScheme(),
-			Recorder:     mgr.GetEventRecorderFor("postgresql-reconciler"),
-			DefaultToken: defaultToken,
-		},
-	}).SetupWithManager(mgr); err != nil {
-		setupLog.Error(err, "unable to create controller", "controller", "PostgreSQL")
-		os.Exit(1)
+	if enabledKinds.Has("Operation") {
+		if err = (&controllers.OperationReconciler{
+			Client:   mgr.GetClient(),
+			Log:      ctrl.Log.WithName("controllers").WithName("Operation"),
+			Recorder: mgr.GetEventRecorderFor("operation-reconciler"),
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "Operation")
+			os.Exit(1)
+		}
 	}
 
-	if err = (&controllers.ConnectionPoolReconciler{
-		Controller: controllers.Controller{
-			Client:       mgr.GetClient(),
-			Log:          ctrl.Log.WithName("controllers").WithName("ConnectionPool"),
-			Scheme:       mgr.GetScheme(),
-			Recorder:     mgr.GetEventRecorderFor("connection-pool-reconciler"),
-			DefaultToken: defaultToken,
-		},
-	}).SetupWithManager(mgr); err != nil {
-		setupLog.Error(err, "unable to create controller", "controller", "ConnectionPool")
-		os.Exit(1)
+	if enabledKinds.Has("Project") {
+		if err = (&controllers.ProjectReconciler{
+			Controller: controllers.Controller{
+				Client:       mgr.GetClient(),
+				Log:          ctrl.Log.WithName("controllers").WithName("Project"),
+				Scheme:       mgr.GetScheme(),
+				Recorder:     mgr.GetEventRecorderFor("project-reconciler"),
+				DefaultToken: defaultToken,
+			},
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "Project")
+			os.Exit(1)
+		}
 	}
 
-	if err = (&controllers.DatabaseReconciler{
-		Controller: controllers.Controller{
-			Client:       mgr.GetClient(),
-			Log:          ctrl.Log.WithName("controllers").WithName("Database"),
-			Scheme:       mgr.GetScheme(),
-			Recorder:     mgr.GetEventRecorderFor("database-reconciler"),
-			DefaultToken: defaultToken,
-		},
-	}).SetupWithManager(mgr); err != nil {
-		setupLog.Error(err, "unable to create controller", "controller", "Database")
-		os.Exit(1)
+	if enabledKinds.Has("PostgreSQL") {
+		if err = (&controllers.PostgreSQLReconciler{
+			Controller: controllers.Controller{
+				Client:       mgr.GetClient(),
+				Log:          ctrl.Log.WithName("controllers").WithName("PostgreSQL"),
+				Scheme:       mgr.GetScheme(),
+				Recorder:     mgr.GetEventRecorderFor("postgresql-reconciler"),
+				DefaultToken: defaultToken,
+			},
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "PostgreSQL")
+			os.Exit(1)
+		}
 	}
 
-	if err = (&controllers.KafkaReconciler{
-		Controller: controllers.Controller{
-			Client:       mgr.GetClient(),
-			Log:          ctrl.Log.WithName("controllers").WithName("Kafka"),
-			Scheme:       mgr.GetScheme(),
-			Recorder:     mgr.GetEventRecorderFor("kafka-reconciler"),
-			DefaultToken: defaultToken,
-		},
-	}).SetupWithManager(mgr); err != nil {
-		setupLog.Error(err, "unable to create controller", "controller", "Kafka")
-		os.Exit(1)
+	if enabledKinds.Has("ConnectionPool") {
+		if err = (&controllers.ConnectionPoolReconciler{
+			Controller: controllers.Controller{
+				Client:       mgr.GetClient(),
+				Log:          ctrl.Log.WithName("controllers").WithName("ConnectionPool"),
+				Scheme:       mgr.GetScheme(),
+				Recorder:     mgr.GetEventRecorderFor("connection-pool-reconciler"),
+				DefaultToken: defaultToken,
+			},
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "ConnectionPool")
+			os.Exit(1)
+		}
 	}
 
-	if err = (&controllers.ProjectVPCReconciler{
-		Controller: controllers.Controller{
-			Client:       mgr.GetClient(),
-			Log:          ctrl.Log.WithName("controllers").WithName("ProjectVPC"),
-			Scheme:       mgr.GetScheme(),
-			Recorder:     mgr.GetEventRecorderFor("project-vpc-reconciler"),
-			DefaultToken: defaultToken,
-		},
-	}).SetupWithManager(mgr); err != nil {
-		setupLog.Error(err, "unable to create controller", "controller", "ProjectVPC")
-		os.Exit(1)
+	if enabledKinds.Has("Database") {
+		if err = (&controllers.DatabaseReconciler{
+			Controller: controllers.Controller{
+				Client:       mgr.GetClient(),
+				Log:          ctrl.Log.WithName("controllers").WithName("Database"),
+				Scheme:       mgr.GetScheme(),
+				Recorder:     mgr.GetEventRecorderFor("database-reconciler"),
+				DefaultToken: defaultToken,
+			},
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "Database")
+			os.Exit(1)
+		}
 	}
 
-	if err = (&controllers.KafkaTopicReconciler{
-		Controller: controllers.Controller{
-			Client:       mgr.GetClient(),
-			Log:          ctrl.Log.WithName("controllers").WithName("KafkaTopic"),
-			Scheme:       mgr.GetScheme(),
-			Recorder:     mgr.GetEventRecorderFor("kafka-topic-reconciler"),
-			DefaultToken: defaultToken,
-		},
-	}).SetupWithManager(mgr); err != nil {
-		setupLog.Error(err, "unable to create controller", "controller", "KafkaTopic")
-		os.Exit(1)
+	if enabledKinds.Has("Kafka") {
+		if err = (&controllers.KafkaReconciler{
+			Controller: controllers.Controller{
+				Client:       mgr.GetClient(),
+				Log:          ctrl.Log.WithName("controllers").WithName("Kafka"),
+				Scheme:       mgr.GetScheme(),
+				Recorder:     mgr.GetEventRecorderFor("kafka-reconciler"),
+				DefaultToken: defaultToken,
+			},
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "Kafka")
+			os.Exit(1)
+		}
 	}
 
-	if err = (&controllers.KafkaACLReconciler{
-		Controller: controllers.Controller{
-			Client:       mgr.GetClient(),
-			Log:          ctrl.Log.WithName("controllers").WithName("KafkaACL"),
-			Scheme:       mgr.GetScheme(),
-			Recorder:     mgr.GetEventRecorderFor("kafka-acl-reconciler"),
-			DefaultToken: defaultToken,
-		},
-	}).SetupWithManager(mgr); err != nil {
-		setupLog.Error(err, "unable to create controller", "controller", "KafkaACL")
-		os.Exit(1)
+	if enabledKinds.Has("ProjectVPC") {
+		if err = (&controllers.ProjectVPCReconciler{
+			Controller: controllers.Controller{
+				Client:       mgr.GetClient(),
+				Log:          ctrl.Log.WithName("controllers").WithName("ProjectVPC"),
+				Scheme:       mgr.GetScheme(),
+				Recorder:     mgr.GetEventRecorderFor("project-vpc-reconciler"),
+				DefaultToken: defaultToken,
+			},
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "ProjectVPC")
+			os.Exit(1)
+		}
 	}
 
-	if err = (&controllers.KafkaConnectReconciler{
-		Controller: controllers.Controller{
-			Client:       mgr.GetClient(),
-			Log:          ctrl.Log.WithName("controllers").WithName("KafkaConnect"),
-			Recorder:     mgr.GetEventRecorderFor("kafka-connect-reconciler"),
-			Scheme:       mgr.GetScheme(),
-			DefaultToken: defaultToken,
-		},
-	}).SetupWithManager(mgr); err != nil {
-		setupLog.Error(err, "unable to create controller", "controller", "KafkaConnect")
-		os.Exit(1)
+	if enabledKinds.Has("OrganizationVPC") {
+		if err = (&controllers.OrganizationVPCReconciler{
+			Controller: controllers.Controller{
+				Client:       mgr.GetClient(),
+				Log:          ctrl.Log.WithName("controllers").WithName("OrganizationVPC"),
+				Scheme:       mgr.GetScheme(),
+				Recorder:     mgr.GetEventRecorderFor("organization-vpc-reconciler"),
+				DefaultToken: defaultToken,
+			},
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "OrganizationVPC")
+			os.Exit(1)
+		}
 	}
 
-	if err = (&controllers.ServiceUserReconciler{
-		Controller: controllers.Controller{
-			Client:       mgr.GetClient(),
-			Log:          ctrl.Log.WithName("controllers").WithName("ServiceUser"),
-			Scheme:       mgr.GetScheme(),
-			Recorder:     mgr.GetEventRecorderFor("service-user-reconciler"),
-			DefaultToken: defaultToken,
-		},
-	}).SetupWithManager(mgr); err != nil {
-		setupLog.Error(err, "unable to create controller", "controller", "ServiceUser")
-		os.Exit(1)
+	if enabledKinds.Has("OrganizationPermission") {
+		if err = (&controllers.OrganizationPermissionReconciler{
+			Controller: controllers.Controller{
+				Client:       mgr.GetClient(),
+				Log:          ctrl.Log.WithName("controllers").WithName("OrganizationPermission"),
+				Scheme:       mgr.GetScheme(),
+				Recorder:     mgr.GetEventRecorderFor("organization-permission-reconciler"),
+				DefaultToken: defaultToken,
+			},
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "OrganizationPermission")
+			os.Exit(1)
+		}
 	}
 
-	if err = (&controllers.KafkaSchemaReconciler{
-		Controller: controllers.Controller{
-			Client:       mgr.GetClient(),
-			Log:          ctrl.Log.WithName("controllers").WithName("KafkaSchema"),
-			Scheme:       mgr.GetScheme(),
-			Recorder:     mgr.GetEventRecorderFor("kafka-schema-reconciler"),
-			DefaultToken: defaultToken,
-		},
-	}).SetupWithManager(mgr); err != nil {
-		setupLog.Error(err, "unable to create controller", "controller", "KafkaSchema")
-		os.Exit(1)
+	if enabledKinds.Has("GCPVPCPeeringConnection") {
+		if err = (&controllers.GCPVPCPeeringConnectionReconciler{
+			Controller: controllers.Controller{
+				Client:       mgr.GetClient(),
+				Log:          ctrl.Log.WithName("controllers").WithName("GCPVPCPeeringConnection"),
+				Scheme:       mgr.GetScheme(),
+				Recorder:     mgr.GetEventRecorderFor("gcp-vpc-peering-connection-reconciler"),
+				DefaultToken: defaultToken,
+			},
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "GCPVPCPeeringConnection")
+			os.Exit(1)
+		}
 	}
 
-	if err = (&controllers.ServiceIntegrationReconciler{
-		Controller: controllers.Controller{
-			Client:       mgr.GetClient(),
-			Log:          ctrl.Log.WithName("controllers").WithName("ServiceIntegration"),
-			Scheme:       mgr.GetScheme(),
-			Recorder:     mgr.GetEventRecorderFor("service-integration-reconciler"),
-			DefaultToken: defaultToken,
-		},
-	}).SetupWithManager(mgr); err != nil {
-		setupLog.Error(err, "unable to create controller", "controller", "ServiceIntegration")
-		os.Exit(1)
+	if enabledKinds.Has("AzureVNetPeeringConnection") {
+		if err = (&controllers.AzureVNetPeeringConnectionReconciler{
+			Controller: controllers.Controller{
+				Client:       mgr.GetClient(),
+				Log:          ctrl.Log.WithName("controllers").WithName("AzureVNetPeeringConnection"),
+				Scheme:       mgr.GetScheme(),
+				Recorder:     mgr.GetEventRecorderFor("azure-vnet-peering-connection-reconciler"),
+				DefaultToken: defaultToken,
+			},
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "AzureVNetPeeringConnection")
+			os.Exit(1)
+		}
 	}
-	if err = (&controllers.KafkaConnectorReconciler{
-		Controller: controllers.Controller{
-			Client:       mgr.GetClient(),
-			Log:          ctrl.Log.WithName("controllers").WithName("KafkaConnector"),
-			Scheme:       mgr.GetScheme(),
-			Recorder:     mgr.GetEventRecorderFor("kafka-connector-reconciler"),
-			DefaultToken: defaultToken,
-		},
-	}).SetupWithManager(mgr); err != nil {
-		setupLog.Error(err, "unable to create controller", "controller", "KafkaConnector")
-		os.Exit(1)
+
+	if enabledKinds.Has("AWSPrivateLink") {
+		if err = (&controllers.AWSPrivateLinkReconciler{
+			Controller: controllers.Controller{
+				Client:       mgr.GetClient(),
+				Log:          ctrl.Log.WithName("controllers").WithName("AWSPrivateLink"),
+				Scheme:       mgr.GetScheme(),
+				Recorder:     mgr.GetEventRecorderFor("aws-privatelink-reconciler"),
+				DefaultToken: defaultToken,
+			},
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "AWSPrivateLink")
+			os.Exit(1)
+		}
 	}
 
-	if err = (&controllers.RedisReconciler{
-		Controller: controllers.Controller{
-			Client:       mgr.GetClient(),
-			Log:          ctrl.Log.WithName("controllers").WithName("Redis"),
-			Scheme:       mgr.GetScheme(),
-			Recorder:     mgr.GetEventRecorderFor("redis-reconciler"),
-			DefaultToken: defaultToken,
-		},
-	}).SetupWithManager(mgr); err != nil {
-		setupLog.Error(err, "unable to create controller", "controller", "Redis")
-		os.Exit(1)
+	if enabledKinds.Has("AzurePrivateLink") {
+		if err = (&controllers.AzurePrivateLinkReconciler{
+			Controller: controllers.Controller{
+				Client:       mgr.GetClient(),
+				Log:          ctrl.Log.WithName("controllers").WithName("AzurePrivateLink"),
+				Scheme:       mgr.GetScheme(),
+				Recorder:     mgr.GetEventRecorderFor("azure-privatelink-reconciler"),
+				DefaultToken: defaultToken,
+			},
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "AzurePrivateLink")
+			os.Exit(1)
+		}
 	}
 
-	if err = (&controllers.OpenSearchReconciler{
-		Controller: controllers.Controller{
-			Client:       mgr.GetClient(),
-			Log:          ctrl.Log.WithName("controllers").WithName("OpenSearch"),
-			Scheme:       mgr.GetScheme(),
-			Recorder:     mgr.GetEventRecorderFor("opensearch-reconciler"),
-			DefaultToken: defaultToken,
-		},
-	}).SetupWithManager(mgr); err != nil {
-		setupLog.Error(err, "unable to create controller", "controller", "OpenSearch")
-		os.Exit(1)
+	if enabledKinds.Has("KafkaTopic") {
+		if err = (&controllers.KafkaTopicReconciler{
+			Controller: controllers.Controller{
+				Client:       mgr.GetClient(),
+				Log:          ctrl.Log.WithName("controllers").WithName("KafkaTopic"),
+				Scheme:       mgr.GetScheme(),
+				Recorder:     mgr.GetEventRecorderFor("kafka-topic-reconciler"),
+				DefaultToken: defaultToken,
+			},
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "KafkaTopic")
+			os.Exit(1)
+		}
 	}
 
-	if err = (&controllers.ClickhouseReconciler{
-		Controller: controllers.Controller{
-			Client:       mgr.GetClient(),
-			Log:          ctrl.Log.WithName("controllers").WithName("Clickhouse"),
-			Scheme:       mgr.GetScheme(),
-			Recorder:     mgr.GetEventRecorderFor("clickhouse-reconciler"),
-			DefaultToken: defaultToken,
-		},
-	}).SetupWithManager(mgr); err != nil {
-		setupLog.Error(err, "unable to create controller", "controller", "Clickhouse")
-		os.Exit(1)
+	if enabledKinds.Has("KafkaACL") {
+		if err = (&controllers.KafkaACLReconciler{
+			Controller: controllers.Controller{
+				Client:       mgr.GetClient(),
+				Log:          ctrl.Log.WithName("controllers").WithName("KafkaACL"),
+				Scheme:       mgr.GetScheme(),
+				Recorder:     mgr.GetEventRecorderFor("kafka-acl-reconciler"),
+				DefaultToken: defaultToken,
+			},
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "KafkaACL")
+			os.Exit(1)
+		}
 	}
 
-	if err = (&controllers.ClickhouseUserReconciler{
-		Controller: controllers.Controller{
-			Client:       mgr.GetClient(),
-			Log:          ctrl.Log.WithName("controllers").WithName("ClickhouseUser"),
-			Scheme:       mgr.GetScheme(),
-			Recorder:     mgr.GetEventRecorderFor("clickhouse-reconciler"),
-			DefaultToken: defaultToken,
-		},
-	}).SetupWithManager(mgr); err != nil {
-		setupLog.Error(err, "unable to create controller", "controller", "ClickhouseUser")
-		os.Exit(1)
+	if enabledKinds.Has("KafkaNativeACL") {
+		if err = (&controllers.KafkaNativeACLReconciler{
+			Controller: controllers.Controller{
+				Client:       mgr.GetClient(),
+				Log:          ctrl.Log.WithName("controllers").WithName("KafkaNativeACL"),
+				Scheme:       mgr.GetScheme(),
+				Recorder:     mgr.GetEventRecorderFor("kafka-native-acl-reconciler"),
+				DefaultToken: defaultToken,
+			},
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "KafkaNativeACL")
+			os.Exit(1)
+		}
 	}
 
-	if err = (&controllers.MySQLReconciler{
-		Controller: controllers.Controller{
-			Client:       mgr.GetClient(),
-			Log:          ctrl.Log.WithName("controllers").WithName("MySQL"),
-			Scheme:       mgr.GetScheme(),
-			Recorder:     mgr.GetEventRecorderFor("mysql-reconciler"),
-			DefaultToken: defaultToken,
-		},
-	}).SetupWithManager(mgr); err != nil {
-		setupLog.Error(err, "unable to create controller", "controller", "MySQL")
-		os.Exit(1)
+	if enabledKinds.Has("KafkaConnect") {
+		if err = (&controllers.KafkaConnectReconciler{
+			Controller: controllers.Controller{
+				Client:       mgr.GetClient(),
+				Log:          ctrl.Log.WithName("controllers").WithName("KafkaConnect"),
+				Recorder:     mgr.GetEventRecorderFor("kafka-connect-reconciler"),
+				Scheme:       mgr.GetScheme(),
+				DefaultToken: defaultToken,
+			},
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "KafkaConnect")
+			os.Exit(1)
+		}
 	}
 
-	if err = (&controllers.CassandraReconciler{
-		Controller: controllers.Controller{
-			Client:       mgr.GetClient(),
-			Log:          ctrl.Log.WithName("controllers").WithName("Cassandra"),
-			Scheme:       mgr.GetScheme(),
-			Recorder:     mgr.GetEventRecorderFor("cassandra-reconciler"),
-			DefaultToken: defaultToken,
-		},
-	}).SetupWithManager(mgr); err != nil {
-		setupLog.Error(err, "unable to create controller", "controller", "Cassandra")
-		os.Exit(1)
+	if enabledKinds.Has("ServiceUser") {
+		if err = (&controllers.ServiceUserReconciler{
+			Controller: controllers.Controller{
+				Client:       mgr.GetClient(),
+				Log:          ctrl.Log.WithName("controllers").WithName("ServiceUser"),
+				Scheme:       mgr.GetScheme(),
+				Recorder:     mgr.GetEventRecorderFor("service-user-reconciler"),
+				DefaultToken: defaultToken,
+			},
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "ServiceUser")
+			os.Exit(1)
+		}
 	}
 
-	if err = (&controllers.GrafanaReconciler{
-		Controller: controllers.Controller{
-			Client:       mgr.GetClient(),
-			Log:          ctrl.Log.WithName("controllers").WithName("Grafana"),
-			Scheme:       mgr.GetScheme(),
-			Recorder:     mgr.GetEventRecorderFor("grafana-reconciler"),
-			DefaultToken: defaultToken,
-		},
-	}).SetupWithManager(mgr); err != nil {
-		setupLog.Error(err, "unable to create controller", "controller", "Grafana")
+	if enabledKinds.Has("KafkaSchema") {
+		if err = (&controllers.KafkaSchemaReconciler{
+			Controller: controllers.Controller{
+				Client:       mgr.GetClient(),
+				Log:          ctrl.Log.WithName("controllers").WithName("KafkaSchema"),
+				Scheme:       mgr.GetScheme(),
+				Recorder:     mgr.GetEventRecorderFor("kafka-schema-reconciler"),
+				DefaultToken: defaultToken,
+			},
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "KafkaSchema")
+			os.Exit(1)
+		}
+	}
+
+	if enabledKinds.Has("ServiceIntegration") {
+		if err = (&controllers.ServiceIntegrationReconciler{
+			Controller: controllers.Controller{
+				Client:       mgr.GetClient(),
+				Log:          ctrl.Log.WithName("controllers").WithName("ServiceIntegration"),
+				Scheme:       mgr.GetScheme(),
+				Recorder:     mgr.GetEventRecorderFor("service-integration-reconciler"),
+				DefaultToken: defaultToken,
+			},
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "ServiceIntegration")
+			os.Exit(1)
+		}
+	}
+	if enabledKinds.Has("KafkaConnector") {
+		if err = (&controllers.KafkaConnectorReconciler{
+			Controller: controllers.Controller{
+				Client:       mgr.GetClient(),
+				Log:          ctrl.Log.WithName("controllers").WithName("KafkaConnector"),
+				Scheme:       mgr.GetScheme(),
+				Recorder:     mgr.GetEventRecorderFor("kafka-connector-reconciler"),
+				DefaultToken: defaultToken,
+			},
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "KafkaConnector")
+			os.Exit(1)
+		}
+	}
+
+	if enabledKinds.Has("Redis") {
+		if err = (&controllers.RedisReconciler{
+			Controller: controllers.Controller{
+				Client:       mgr.GetClient(),
+				Log:          ctrl.Log.WithName("controllers").WithName("Redis"),
+				Scheme:       mgr.GetScheme(),
+				Recorder:     mgr.GetEventRecorderFor("redis-reconciler"),
+				DefaultToken: defaultToken,
+			},
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "Redis")
+			os.Exit(1)
+		}
+	}
+
+	if enabledKinds.Has("OpenSearch") {
+		if err = (&controllers.OpenSearchReconciler{
+			Controller: controllers.Controller{
+				Client:       mgr.GetClient(),
+				Log:          ctrl.Log.WithName("controllers").WithName("OpenSearch"),
+				Scheme:       mgr.GetScheme(),
+				Recorder:     mgr.GetEventRecorderFor("opensearch-reconciler"),
+				DefaultToken: defaultToken,
+			},
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "OpenSearch")
+			os.Exit(1)
+		}
+	}
+
+	if enabledKinds.Has("Clickhouse") {
+		if err = (&controllers.ClickhouseReconciler{
+			Controller: controllers.Controller{
+				Client:       mgr.GetClient(),
+				Log:          ctrl.Log.WithName("controllers").WithName("Clickhouse"),
+				Scheme:       mgr.GetScheme(),
+				Recorder:     mgr.GetEventRecorderFor("clickhouse-reconciler"),
+				DefaultToken: defaultToken,
+			},
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "Clickhouse")
+			os.Exit(1)
+		}
+	}
+
+	if enabledKinds.Has("ClickhouseGrant") {
+		if err = (&controllers.ClickhouseGrantReconciler{
+			Controller: controllers.Controller{
+				Client:       mgr.GetClient(),
+				Log:          ctrl.Log.WithName("controllers").WithName("ClickhouseGrant"),
+				Scheme:       mgr.GetScheme(),
+				Recorder:     mgr.GetEventRecorderFor("clickhouse-grant-reconciler"),
+				DefaultToken: defaultToken,
+			},
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "ClickhouseGrant")
+			os.Exit(1)
+		}
+	}
+
+	if enabledKinds.Has("ClickhouseRole") {
+		if err = (&controllers.ClickhouseRoleReconciler{
+			Controller: controllers.Controller{
+				Client:       mgr.GetClient(),
+				Log:          ctrl.Log.WithName("controllers").WithName("ClickhouseRole"),
+				Scheme:       mgr.GetScheme(),
+				Recorder:     mgr.GetEventRecorderFor("clickhouse-role-reconciler"),
+				DefaultToken: defaultToken,
+			},
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "ClickhouseRole")
+			os.Exit(1)
+		}
+	}
+
+	if enabledKinds.Has("ClickhouseUser") {
+		if err = (&controllers.ClickhouseUserReconciler{
+			Controller: controllers.Controller{
+				Client:       mgr.GetClient(),
+				Log:          ctrl.Log.WithName("controllers").WithName("ClickhouseUser"),
+				Scheme:       mgr.GetScheme(),
+				Recorder:     mgr.GetEventRecorderFor("clickhouse-reconciler"),
+				DefaultToken: defaultToken,
+			},
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "ClickhouseUser")
+			os.Exit(1)
+		}
+	}
+
+	if enabledKinds.Has("FlinkApplication") {
+		if err = (&controllers.FlinkApplicationReconciler{
+			Controller: controllers.Controller{
+				Client:       mgr.GetClient(),
+				Log:          ctrl.Log.WithName("controllers").WithName("FlinkApplication"),
+				Scheme:       mgr.GetScheme(),
+				Recorder:     mgr.GetEventRecorderFor("flink-application-reconciler"),
+				DefaultToken: defaultToken,
+			},
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "FlinkApplication")
+			os.Exit(1)
+		}
+	}
+
+	if enabledKinds.Has("FlinkApplicationDeployment") {
+		if err = (&controllers.FlinkApplicationDeploymentReconciler{
+			Controller: controllers.Controller{
+				Client:       mgr.GetClient(),
+				Log:          ctrl.Log.WithName("controllers").WithName("FlinkApplicationDeployment"),
+				Scheme:       mgr.GetScheme(),
+				Recorder:     mgr.GetEventRecorderFor("flink-application-deployment-reconciler"),
+				DefaultToken: defaultToken,
+			},
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "FlinkApplicationDeployment")
+			os.Exit(1)
+		}
+	}
+
+	if enabledKinds.Has("MySQL") {
+		if err = (&controllers.MySQLReconciler{
+			Controller: controllers.Controller{
+				Client:       mgr.GetClient(),
+				Log:          ctrl.Log.WithName("controllers").WithName("MySQL"),
+				Scheme:       mgr.GetScheme(),
+				Recorder:     mgr.GetEventRecorderFor("mysql-reconciler"),
+				DefaultToken: defaultToken,
+			},
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "MySQL")
+			os.Exit(1)
+		}
+	}
+
+	if enabledKinds.Has("Cassandra") {
+		if err = (&controllers.CassandraReconciler{
+			Controller: controllers.Controller{
+				Client:       mgr.GetClient(),
+				Log:          ctrl.Log.WithName("controllers").WithName("Cassandra"),
+				Scheme:       mgr.GetScheme(),
+				Recorder:     mgr.GetEventRecorderFor("cassandra-reconciler"),
+				DefaultToken: defaultToken,
+			},
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "Cassandra")
+			os.Exit(1)
+		}
+	}
+
+	if enabledKinds.Has("Grafana") {
+		if err = (&controllers.GrafanaReconciler{
+			Controller: controllers.Controller{
+				Client:       mgr.GetClient(),
+				Log:          ctrl.Log.WithName("controllers").WithName("Grafana"),
+				Scheme:       mgr.GetScheme(),
+				Recorder:     mgr.GetEventRecorderFor("grafana-reconciler"),
+				DefaultToken: defaultToken,
+			},
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "Grafana")
+			os.Exit(1)
+		}
+	}
+
+	if enabledKinds.Has("M3DB") {
+		if err = (&controllers.M3DBReconciler{
+			Controller: controllers.Controller{
+				Client:       mgr.GetClient(),
+				Log:          ctrl.Log.WithName("controllers").WithName("M3DB"),
+				Scheme:       mgr.GetScheme(),
+				Recorder:     mgr.GetEventRecorderFor("m3db-reconciler"),
+				DefaultToken: defaultToken,
+			},
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "M3DB")
+			os.Exit(1)
+		}
+	}
+
+	if enabledKinds.Has("M3Aggregator") {
+		if err = (&controllers.M3AggregatorReconciler{
+			Controller: controllers.Controller{
+				Client:       mgr.GetClient(),
+				Log:          ctrl.Log.WithName("controllers").WithName("M3Aggregator"),
+				Scheme:       mgr.GetScheme(),
+				Recorder:     mgr.GetEventRecorderFor("m3aggregator-reconciler"),
+				DefaultToken: defaultToken,
+			},
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "M3Aggregator")
+			os.Exit(1)
+		}
+	}
+
+	if enabledKinds.Has("Dragonfly") {
+		if err = (&controllers.DragonflyReconciler{
+			Controller: controllers.Controller{
+				Client:       mgr.GetClient(),
+				Log:          ctrl.Log.WithName("controllers").WithName("Dragonfly"),
+				Scheme:       mgr.GetScheme(),
+				Recorder:     mgr.GetEventRecorderFor("dragonfly-reconciler"),
+				DefaultToken: defaultToken,
+			},
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "Dragonfly")
+			os.Exit(1)
+		}
+	}
+
+	if enabledKinds.Has("Valkey") {
+		if err = (&controllers.ValkeyReconciler{
+			Controller: controllers.Controller{
+				Client:       mgr.GetClient(),
+				Log:          ctrl.Log.WithName("controllers").WithName("Valkey"),
+				Scheme:       mgr.GetScheme(),
+				Recorder:     mgr.GetEventRecorderFor("valkey-reconciler"),
+				DefaultToken: defaultToken,
+			},
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "Valkey")
+			os.Exit(1)
+		}
+	}
+
+	if enabledKinds.Has("Thanos") {
+		if err = (&controllers.ThanosReconciler{
+			Controller: controllers.Controller{
+				Client:       mgr.GetClient(),
+				Log:          ctrl.Log.WithName("controllers").WithName("Thanos"),
+				Scheme:       mgr.GetScheme(),
+				Recorder:     mgr.GetEventRecorderFor("thanos-reconciler"),
+				DefaultToken: defaultToken,
+			},
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "Thanos")
+			os.Exit(1)
+		}
+	}
+
+	if enabledKinds.Has("Stack") {
+		if err = (&controllers.StackReconciler{
+			Controller: controllers.Controller{
+				Client:       mgr.GetClient(),
+				Log:          ctrl.Log.WithName("controllers").WithName("Stack"),
+				Scheme:       mgr.GetScheme(),
+				Recorder:     mgr.GetEventRecorderFor("stack-reconciler"),
+				DefaultToken: defaultToken,
+			},
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "Stack")
+			os.Exit(1)
+		}
+	}
+
+	if enabledKinds.Has("ApplicationUserToken") {
+		if err = (&controllers.ApplicationUserTokenReconciler{
+			Controller: controllers.Controller{
+				Client:       mgr.GetClient(),
+				Log:          ctrl.Log.WithName("controllers").WithName("ApplicationUserToken"),
+				Scheme:       mgr.GetScheme(),
+				Recorder:     mgr.GetEventRecorderFor("applicationusertoken-reconciler"),
+				DefaultToken: defaultToken,
+			},
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "ApplicationUserToken")
+			os.Exit(1)
+		}
+	}
+
+	if enabledKinds.Has("ServiceIntegrationEndpoint") {
+		if err = (&controllers.ServiceIntegrationEndpointReconciler{
+			Controller: controllers.Controller{
+				Client:       mgr.GetClient(),
+				Log:          ctrl.Log.WithName("controllers").WithName("ServiceIntegrationEndpoint"),
+				Scheme:       mgr.GetScheme(),
+				Recorder:     mgr.GetEventRecorderFor("service-integration-endpoint-reconciler"),
+				DefaultToken: defaultToken,
+			},
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "ServiceIntegrationEndpoint")
+			os.Exit(1)
+		}
+	}
+
+	if enabledKinds.Has("OpenSearchACL") {
+		if err = (&controllers.OpenSearchACLReconciler{
+			Controller: controllers.Controller{
+				Client:       mgr.GetClient(),
+				Log:          ctrl.Log.WithName("controllers").WithName("OpenSearchACL"),
+				Scheme:       mgr.GetScheme(),
+				Recorder:     mgr.GetEventRecorderFor("opensearch-acl-reconciler"),
+				DefaultToken: defaultToken,
+			},
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "OpenSearchACL")
+			os.Exit(1)
+		}
+	}
+
+	if enabledKinds.Has("OpenSearchSnapshotRepository") {
+		if err = (&controllers.OpenSearchSnapshotRepositoryReconciler{
+			Controller: controllers.Controller{
+				Client:       mgr.GetClient(),
+				Log:          ctrl.Log.WithName("controllers").WithName("OpenSearchSnapshotRepository"),
+				Scheme:       mgr.GetScheme(),
+				Recorder:     mgr.GetEventRecorderFor("opensearch-snapshot-repository-reconciler"),
+				DefaultToken: defaultToken,
+			},
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "OpenSearchSnapshotRepository")
+			os.Exit(1)
+		}
+	}
+
+	if enabledKinds.Has("OpenSearchSnapshotRestore") {
+		if err = (&controllers.OpenSearchSnapshotRestoreReconciler{
+			Controller: controllers.Controller{
+				Client:       mgr.GetClient(),
+				Log:          ctrl.Log.WithName("controllers").WithName("OpenSearchSnapshotRestore"),
+				Scheme:       mgr.GetScheme(),
+				Recorder:     mgr.GetEventRecorderFor("opensearch-snapshot-restore-reconciler"),
+				DefaultToken: defaultToken,
+			},
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "OpenSearchSnapshotRestore")
+			os.Exit(1)
+		}
+	}
+
+	if projectEvents {
+		if err = (&controllers.ProjectEventsReconciler{
+			Controller: controllers.Controller{
+				Client:       mgr.GetClient(),
+				Log:          ctrl.Log.WithName("controllers").WithName("ProjectEvents"),
+				Scheme:       mgr.GetScheme(),
+				Recorder:     mgr.GetEventRecorderFor("project-events-reconciler"),
+				DefaultToken: defaultToken,
+			},
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "ProjectEvents")
+			os.Exit(1)
+		}
+	}
+
+	if err = mgr.Add(&controllers.StateMigrator{
+		Client: mgr.GetClient(),
+		Log:    ctrl.Log.WithName("state-migrator"),
+		Kinds:  enabledKinds.List(),
+	}); err != nil {
+		setupLog.Error(err, "unable to set up state migrations")
 		os.Exit(1)
 	}
 
+	if egressEndpointsConfigMap != "" {
+		if err = (&controllers.EgressEndpointsReconciler{
+			Client:        mgr.GetClient(),
+			Log:           ctrl.Log.WithName("controllers").WithName("EgressEndpoints"),
+			ConfigMapName: egressEndpointsConfigMap,
+		}).SetupWithManager(mgr, enabledKinds.List()); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "EgressEndpoints")
+			os.Exit(1)
+		}
+	}
+
 	if os.Getenv("ENABLE_WEBHOOKS") != "false" {
 		if err = (&v1alpha1.Project{}).SetupWebhookWithManager(mgr); err != nil {
 			setupLog.Error(err, "unable to create webhook", "webhook", "Project")
@@ -391,6 +898,11 @@ func main() {
 			os.Exit(1)
 		}
 
+		if err = (&v1alpha1.KafkaNativeACL{}).SetupWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "KafkaNativeACL")
+			os.Exit(1)
+		}
+
 		if err = (&v1alpha1.KafkaSchema{}).SetupWebhookWithManager(mgr); err != nil {
 			setupLog.Error(err, "unable to create webhook", "webhook", "KafkaSchema")
 			os.Exit(1)
@@ -437,6 +949,72 @@ func main() {
 			setupLog.Error(err, "unable to create webhook", "webhook", "Grafana")
 			os.Exit(1)
 		}
+		if err = (&v1alpha1.M3DB{}).SetupWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "M3DB")
+			os.Exit(1)
+		}
+		if err = (&v1alpha1.M3Aggregator{}).SetupWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "M3Aggregator")
+			os.Exit(1)
+		}
+		if err = (&v1alpha1.Dragonfly{}).SetupWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "Dragonfly")
+			os.Exit(1)
+		}
+		if err = (&v1alpha1.Valkey{}).SetupWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "Valkey")
+			os.Exit(1)
+		}
+		if err = (&v1alpha1.Thanos{}).SetupWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "Thanos")
+			os.Exit(1)
+		}
+		if err = (&v1alpha1.Stack{}).SetupWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "Stack")
+			os.Exit(1)
+		}
+		if err = (&v1alpha1.ApplicationUserToken{}).SetupWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "ApplicationUserToken")
+			os.Exit(1)
+		}
+		if err = (&v1alpha1.ServiceIntegrationEndpoint{}).SetupWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "ServiceIntegrationEndpoint")
+			os.Exit(1)
+		}
+		if err = (&v1alpha1.OpenSearchSnapshotRepository{}).SetupWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "OpenSearchSnapshotRepository")
+			os.Exit(1)
+		}
+		if err = (&v1alpha1.OpenSearchSnapshotRestore{}).SetupWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "OpenSearchSnapshotRestore")
+			os.Exit(1)
+		}
+
+		decoder, err := admission.NewDecoder(mgr.GetScheme())
+		if err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "ServiceProjectVPC")
+			os.Exit(1)
+		}
+		mgr.GetWebhookServer().Register(controllers.ServiceProjectVPCPath, &webhook.Admission{
+			Handler: &controllers.ServiceProjectVPCValidator{Client: mgr.GetClient(), Decoder: decoder},
+		})
+		mgr.GetWebhookServer().Register(controllers.ServiceCustomCloudPath, &webhook.Admission{
+			Handler: &controllers.ServiceCustomCloudValidator{Client: mgr.GetClient(), Decoder: decoder, DefaultToken: defaultToken},
+		})
+		mgr.GetWebhookServer().Register(controllers.ServicePlanLimitsPath, &webhook.Admission{
+			Handler: &controllers.ServicePlanLimitsValidator{Client: mgr.GetClient(), Decoder: decoder, DefaultToken: defaultToken},
+		})
+		mgr.GetWebhookServer().Register(controllers.ServiceCloudPolicyPath, &webhook.Admission{
+			Handler: &controllers.ServiceCloudPolicyValidator{Client: mgr.GetClient(), Decoder: decoder},
+		})
+	}
+
+	if enableDryRun {
+		mgr.GetWebhookServer().Register(controllers.DryRunPath, &controllers.DryRunHandler{
+			Client:       mgr.GetClient(),
+			Log:          ctrl.Log.WithName("dry-run"),
+			DefaultToken: defaultToken,
+		})
 	}
 
 	//+kubebuilder:scaffold:builder
@@ -456,3 +1034,89 @@ func main() {
 		os.Exit(1)
 	}
 }
+
+// flagEnvPrefix is the prefix of the environment variables of the flags
+const flagEnvPrefix = "AIVEN_OPERATOR_"
+
+// flagEnvName returns the environment variable of the flag, e.g. AIVEN_OPERATOR_ENABLE_KINDS for --enable-kinds
+func flagEnvName(prefix, name string) string {
+	return prefix + strings.ToUpper(strings.NewReplacer("-", "_", ".", "_").Replace(name))
+}
+
+// setFlagsFromEnv sets the flags of the set environment variables,
+// so the operator is configured without the command line arguments too.
+// The usage of the flags tells their environment variables
+func setFlagsFromEnv(fs *flag.FlagSet, prefix string) error {
+	var err error
+	fs.VisitAll(func(f *flag.Flag) {
+		name := flagEnvName(prefix, f.Name)
+		f.Usage = fmt.Sprintf("%s [env %s]", f.Usage, name)
+
+		value, ok := os.LookupEnv(name)
+		if !ok || err != nil {
+			return
+		}
+		if e := fs.Set(f.Name, value); e != nil {
+			err = fmt.Errorf("invalid value %q of %s: %w", value, name, e)
+		}
+	})
+	return err
+}
+
+// parseEnabledKinds returns the kinds of the given comma separated list, all kinds if the list is empty.
+// The kinds are case-insensitive.
+func parseEnabledKinds(value string) (sets.String, error) {
+	known := make(map[string]string)
+	types := scheme.KnownTypes(v1alpha1.GroupVersion)
+	for kind := range types {
+		// Skips the meta types, like WatchEvent, that are registered for every group
+		if _, ok := types[kind+"List"]; ok {
+			known[strings.ToLower(kind)] = kind
+		}
+	}
+
+	enabled := sets.NewString()
+	if strings.TrimSpace(value) == "" {
+		for _, kind := range known {
+			enabled.Insert(kind)
+		}
+		return enabled, nil
+	}
+
+	for _, v := range strings.Split(value, ",") {
+		kind, ok := known[strings.ToLower(strings.TrimSpace(v))]
+		if !ok {
+			return nil, fmt.Errorf("unknown kind %q", strings.TrimSpace(v))
+		}
+		enabled.Insert(kind)
+	}
+	return enabled, nil
+}
+
+// runImport prints the resource of a live service with its current user config.
+// The token is read from the environment, so it does not end up in the shell history.
+func runImport(args []string) int {
+	fs := flag.NewFlagSet("import", flag.ExitOnError)
+	project := fs.String("project", "", "The project of the service")
+	service := fs.String("service", "", "The name of the service")
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage: %s import --project PROJECT --service SERVICE\n\n", os.Args[0])
+		fmt.Fprintln(fs.Output(), "Prints the resource of the service with its current user config. The token is read from AIVEN_TOKEN.")
+		fs.PrintDefaults()
+	}
+	_ = fs.Parse(args)
+
+	token := os.Getenv("AIVEN_TOKEN")
+	if *project == "" || *service == "" || token == "" {
+		fs.Usage()
+		return 2
+	}
+
+	b, err := controllers.ImportService(scheme, token, *project, *service)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	_, _ = os.Stdout.Write(b)
+	return 0
+}
@@ -0,0 +1,61 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+//go:build e2e
+
+package e2e
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+	"github.com/aiven/aiven-operator/test/harness"
+)
+
+func TestRedisLifecycle(t *testing.T) {
+	t.Parallel()
+
+	config, err := harness.ConfigFromEnv()
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := harness.New(t, config)
+
+	redis := &v1alpha1.Redis{
+		ObjectMeta: metav1.ObjectMeta{Name: "e2e-redis"},
+		Spec: v1alpha1.RedisSpec{
+			ServiceCommonSpec: v1alpha1.ServiceCommonSpec{
+				Project:               h.Project,
+				Plan:                  "startup-4",
+				CloudName:             "google-europe-west1",
+				MaintenanceWindowDow:  "friday",
+				MaintenanceWindowTime: "23:00:00",
+			},
+			AuthSecretRef: h.AuthSecretRef(),
+		},
+	}
+
+	h.RunLifecycle(t, harness.Lifecycle{
+		Object: redis,
+		Update: func() {
+			redis.Spec.MaintenanceWindowDow = "sunday"
+		},
+		Check: func(ctx context.Context, h *harness.Harness) error {
+			s, err := h.Aiven.Services.Get(h.Project, redis.Name)
+			if err != nil {
+				return err
+			}
+			if s.MaintenanceWindow.DayOfWeek != redis.Spec.MaintenanceWindowDow {
+				return fmt.Errorf("maintenance window is %q, expected %q", s.MaintenanceWindow.DayOfWeek, redis.Spec.MaintenanceWindowDow)
+			}
+
+			secret := &corev1.Secret{}
+			return h.Client.Get(ctx, types.NamespacedName{Name: redis.Name, Namespace: h.Namespace}, secret)
+		},
+	})
+}
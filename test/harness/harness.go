@@ -0,0 +1,230 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+// Package harness runs the end-to-end tests of the operator against ephemeral Aiven projects.
+// Every test gets its own project and namespace, which are deleted when the test ends, even if it fails.
+// The operator must be running against the cluster of the current kubeconfig.
+package harness
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aiven/aiven-go-client"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+const (
+	// TokenSecretName is the Secret with the Aiven token in the namespace of the test
+	TokenSecretName = "aiven-token"
+
+	// TokenSecretKey is the key of the token in the TokenSecretName Secret
+	TokenSecretKey = "token"
+
+	// ephemeralTag marks the projects the harness creates, the others are never deleted
+	ephemeralTag = "k8s-e2e-ephemeral"
+
+	// expiresTag tells when the sweep deletes the project that was left behind
+	expiresTag = "k8s-e2e-expires-at"
+
+	userAgent = "k8s-operator-e2e"
+)
+
+// Config of the harness
+type Config struct {
+	// Token is the Aiven token, which can create projects
+	Token string
+
+	// TemplateProject is the project the ephemeral projects copy the billing group and the settings from
+	TemplateProject string
+
+	// ProjectPrefix starts the names of the ephemeral projects
+	ProjectPrefix string
+
+	// TTL is how long the ephemeral project lives at most, the sweep deletes it after that
+	TTL time.Duration
+
+	// Timeout is the default time to wait for a resource to be running or deleted
+	Timeout time.Duration
+}
+
+// ConfigFromEnv reads AIVEN_TOKEN, AIVEN_PROJECT_NAME (the template project),
+// E2E_PROJECT_PREFIX and E2E_PROJECT_TTL
+func ConfigFromEnv() (*Config, error) {
+	c := &Config{
+		Token:           os.Getenv("AIVEN_TOKEN"),
+		TemplateProject: os.Getenv("AIVEN_PROJECT_NAME"),
+		ProjectPrefix:   os.Getenv("E2E_PROJECT_PREFIX"),
+		TTL:             3 * time.Hour,
+		Timeout:         20 * time.Minute,
+	}
+
+	if c.Token == "" {
+		return nil, fmt.Errorf("AIVEN_TOKEN is required")
+	}
+	if c.TemplateProject == "" {
+		return nil, fmt.Errorf("AIVEN_PROJECT_NAME is required")
+	}
+	if c.ProjectPrefix == "" {
+		c.ProjectPrefix = "k8s-e2e-"
+	}
+	if v := os.Getenv("E2E_PROJECT_TTL"); v != "" {
+		ttl, err := time.ParseDuration(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid E2E_PROJECT_TTL %q: %w", v, err)
+		}
+		c.TTL = ttl
+	}
+	return c, nil
+}
+
+// Harness is the environment of a single test
+type Harness struct {
+	// Aiven is the client of the Aiven API
+	Aiven *aiven.Client
+
+	// Client is the client of the cluster the operator runs against
+	Client client.Client
+
+	// Project is the ephemeral Aiven project of the test
+	Project string
+
+	// Namespace is the namespace of the test, with the TokenSecretName Secret
+	Namespace string
+
+	config  *Config
+	created []client.Object
+}
+
+// New creates the ephemeral project and the namespace of the test.
+// The teardown is registered to t.Cleanup, so it runs when the test fails or panics too.
+// The projects that outlive their TTL, e.g. because the test binary was killed, are deleted by Sweep.
+func New(t testing.TB, config *Config) *Harness {
+	t.Helper()
+
+	avn, err := aiven.NewTokenClient(config.Token, userAgent)
+	if err != nil {
+		t.Fatalf("cannot initialize aiven client: %s", err)
+	}
+
+	k8s, err := newClient()
+	if err != nil {
+		t.Fatalf("cannot initialize kubernetes client: %s", err)
+	}
+
+	h := &Harness{
+		Aiven:     avn,
+		Client:    k8s,
+		Project:   config.ProjectPrefix + randomID(),
+		Namespace: "e2e-" + randomID(),
+		config:    config,
+	}
+
+	// Registered before anything is created, so a partial setup is cleaned up as well
+	t.Cleanup(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), config.Timeout)
+		defer cancel()
+		if err := h.teardown(ctx); err != nil {
+			t.Errorf("teardown failed, the sweep deletes project %q after its TTL: %s", h.Project, err)
+		}
+	})
+
+	_, err = avn.Projects.Create(aiven.CreateProjectRequest{
+		Project:                      h.Project,
+		CopyFromProject:              config.TemplateProject,
+		UseSourceProjectBillingGroup: true,
+		Tags: map[string]string{
+			ephemeralTag: "true",
+			expiresTag:   time.Now().Add(config.TTL).UTC().Format(time.RFC3339),
+		},
+	})
+	if err != nil {
+		t.Fatalf("cannot create project %q: %s", h.Project, err)
+	}
+
+	ctx := context.Background()
+	err = k8s.Create(ctx, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: h.Namespace}})
+	if err != nil {
+		t.Fatalf("cannot create namespace %q: %s", h.Namespace, err)
+	}
+
+	err = k8s.Create(ctx, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: TokenSecretName, Namespace: h.Namespace},
+		StringData: map[string]string{TokenSecretKey: config.Token},
+	})
+	if err != nil {
+		t.Fatalf("cannot create the token secret: %s", err)
+	}
+	return h
+}
+
+// AuthSecretRef refers to the token Secret of the test namespace
+func (h *Harness) AuthSecretRef() v1alpha1.AuthSecretReference {
+	return v1alpha1.AuthSecretReference{Name: TokenSecretName, Key: TokenSecretKey}
+}
+
+// teardown deletes the resources in the reverse order, then whatever is left in the project, and the project itself
+func (h *Harness) teardown(ctx context.Context) error {
+	errs := make([]string, 0)
+	for i := len(h.created) - 1; i >= 0; i-- {
+		err := h.Delete(ctx, h.created[i])
+		if err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+
+	err := h.Client.Delete(ctx, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: h.Namespace}})
+	if client.IgnoreNotFound(err) != nil {
+		errs = append(errs, fmt.Sprintf("cannot delete namespace %q: %s", h.Namespace, err))
+	}
+
+	err = deleteProject(ctx, h.Aiven, h.Project)
+	if err != nil {
+		errs = append(errs, err.Error())
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("%s", strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+func newClient() (client.Client, error) {
+	cfg, err := ctrl.GetConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		return nil, err
+	}
+	if err := v1alpha1.AddToScheme(scheme); err != nil {
+		return nil, err
+	}
+	return client.New(cfg, client.Options{Scheme: scheme})
+}
+
+func randomID() string {
+	const letters = "abcdefghijklmnopqrstuvwxyz0123456789"
+	b := make([]byte, 8)
+	for i := range b {
+		b[i] = letters[rand.Intn(len(letters))]
+	}
+	return string(b)
+}
+
+func init() {
+	rand.Seed(time.Now().UnixNano())
+}
@@ -0,0 +1,131 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package harness
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aiven/aiven-go-client"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// projectDeleteTimeout is how long the services and VPCs of the project may take to be deleted
+const projectDeleteTimeout = 30 * time.Minute
+
+// deleteProject deletes the services and the VPCs the operator left behind, then the project.
+// Only the projects the harness created are deleted.
+func deleteProject(ctx context.Context, avn *aiven.Client, project string) error {
+	p, err := avn.Projects.Get(project)
+	if aiven.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("cannot get project %q: %w", project, err)
+	}
+	if p.Tags[ephemeralTag] != "true" {
+		return fmt.Errorf("project %q is not ephemeral, refusing to delete it", project)
+	}
+
+	services, err := avn.Services.List(project)
+	if err != nil {
+		return fmt.Errorf("cannot list the services of project %q: %w", project, err)
+	}
+	for _, s := range services {
+		if s.TerminationProtection {
+			_, err = avn.Services.Update(project, s.Name, aiven.UpdateServiceRequest{
+				Powered:               s.Powered,
+				ProjectVPCID:          s.ProjectVPCID,
+				TerminationProtection: false,
+			})
+			if err != nil {
+				return fmt.Errorf("cannot disable the termination protection of service %q: %w", s.Name, err)
+			}
+		}
+		err = avn.Services.Delete(project, s.Name)
+		if err != nil && !aiven.IsNotFound(err) {
+			return fmt.Errorf("cannot delete service %q: %w", s.Name, err)
+		}
+	}
+
+	// VPCs can't be deleted while they have services, the project can't be deleted while it has VPCs
+	return wait.PollImmediateWithContext(ctx, pollInterval, projectDeleteTimeout, func(ctx context.Context) (bool, error) {
+		services, err := avn.Services.List(project)
+		if err != nil || len(services) > 0 {
+			return false, err
+		}
+
+		vpcs, err := avn.VPCs.List(project)
+		if err != nil {
+			return false, err
+		}
+		for _, v := range vpcs {
+			if v.State == "DELETING" || v.State == "DELETED" {
+				continue
+			}
+			err = avn.VPCs.Delete(project, v.ProjectVPCID)
+			if err != nil && !aiven.IsNotFound(err) {
+				return false, err
+			}
+		}
+		if len(vpcs) > 0 {
+			return false, nil
+		}
+
+		err = avn.Projects.Delete(project)
+		if aiven.IsNotFound(err) {
+			return true, nil
+		}
+		return err == nil, err
+	})
+}
+
+// Sweep deletes the ephemeral projects that have outlived their TTL, e.g. because the test binary was killed.
+// Returns the deleted projects.
+func Sweep(ctx context.Context, config *Config) ([]string, error) {
+	avn, err := aiven.NewTokenClient(config.Token, userAgent)
+	if err != nil {
+		return nil, fmt.Errorf("cannot initialize aiven client: %w", err)
+	}
+
+	projects, err := avn.Projects.List()
+	if err != nil {
+		return nil, fmt.Errorf("cannot list projects: %w", err)
+	}
+
+	deleted := make([]string, 0)
+	errs := make([]string, 0)
+	for _, p := range projects {
+		if !isExpired(p, config.ProjectPrefix, time.Now()) {
+			continue
+		}
+
+		err = deleteProject(ctx, avn, p.Name)
+		if err != nil {
+			errs = append(errs, err.Error())
+			continue
+		}
+		deleted = append(deleted, p.Name)
+	}
+
+	if len(errs) > 0 {
+		return deleted, fmt.Errorf("%s", strings.Join(errs, "; "))
+	}
+	return deleted, nil
+}
+
+// isExpired returns true for the ephemeral projects past their expiry time
+func isExpired(p *aiven.Project, prefix string, now time.Time) bool {
+	if !strings.HasPrefix(p.Name, prefix) || p.Tags[ephemeralTag] != "true" {
+		return false
+	}
+
+	expires, err := time.Parse(time.RFC3339, p.Tags[expiresTag])
+	if err != nil {
+		// The tag was changed by hand, the project is kept
+		return false
+	}
+	return now.After(expires)
+}
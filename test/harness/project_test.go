@@ -0,0 +1,27 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package harness
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aiven/aiven-go-client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsExpired(t *testing.T) {
+	now := time.Date(2023, 1, 20, 12, 0, 0, 0, time.UTC)
+	project := func(name string, tags map[string]string) *aiven.Project {
+		return &aiven.Project{Name: name, Tags: tags}
+	}
+	ephemeral := func(expires string) map[string]string {
+		return map[string]string{ephemeralTag: "true", expiresTag: expires}
+	}
+
+	assert.True(t, isExpired(project("k8s-e2e-abc", ephemeral("2023-01-20T11:00:00Z")), "k8s-e2e-", now))
+	assert.False(t, isExpired(project("k8s-e2e-abc", ephemeral("2023-01-20T13:00:00Z")), "k8s-e2e-", now), "not expired yet")
+	assert.False(t, isExpired(project("k8s-e2e-abc", ephemeral("tomorrow")), "k8s-e2e-", now), "invalid expiry time")
+	assert.False(t, isExpired(project("production", ephemeral("2023-01-20T11:00:00Z")), "k8s-e2e-", now), "another prefix")
+	assert.False(t, isExpired(project("k8s-e2e-abc", map[string]string{expiresTag: "2023-01-20T11:00:00Z"}), "k8s-e2e-", now), "not ephemeral")
+}
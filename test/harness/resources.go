@@ -0,0 +1,145 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+package harness
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// The annotations the operator sets, see the controllers package
+const (
+	processedGenerationAnnotation = "controllers.aiven.io/generation-was-processed"
+	instanceIsRunningAnnotation   = "controllers.aiven.io/instance-is-running"
+)
+
+// pollInterval is how often the resources are checked
+const pollInterval = 10 * time.Second
+
+// Create creates the resource in the test namespace, the teardown deletes it
+func (h *Harness) Create(ctx context.Context, o client.Object) error {
+	o.SetNamespace(h.Namespace)
+	err := h.Client.Create(ctx, o)
+	if err != nil {
+		return fmt.Errorf("cannot create %s: %w", describe(o), err)
+	}
+	h.created = append(h.created, o)
+	return nil
+}
+
+// Update applies the mutation to the latest version of the resource, retrying on conflicts
+func (h *Harness) Update(ctx context.Context, o client.Object, mutate func()) error {
+	return wait.PollImmediateWithContext(ctx, time.Second, h.config.Timeout, func(ctx context.Context) (bool, error) {
+		err := h.Client.Get(ctx, client.ObjectKeyFromObject(o), o)
+		if err != nil {
+			return false, err
+		}
+
+		mutate()
+		err = h.Client.Update(ctx, o)
+		if apierrors.IsConflict(err) {
+			return false, nil
+		}
+		return err == nil, err
+	})
+}
+
+// WaitRunning waits until the operator has processed the latest generation of the resource and it is running
+func (h *Harness) WaitRunning(ctx context.Context, o client.Object) error {
+	err := wait.PollImmediateWithContext(ctx, pollInterval, h.config.Timeout, func(ctx context.Context) (bool, error) {
+		err := h.Client.Get(ctx, client.ObjectKeyFromObject(o), o)
+		if err != nil {
+			return false, client.IgnoreNotFound(err)
+		}
+		return isRunning(o), nil
+	})
+	if err != nil {
+		return fmt.Errorf("%s is not running: %w", describe(o), err)
+	}
+	return nil
+}
+
+// Delete deletes the resource and waits until the operator has removed its finalizers
+func (h *Harness) Delete(ctx context.Context, o client.Object) error {
+	err := h.Client.Delete(ctx, o)
+	if client.IgnoreNotFound(err) != nil {
+		return fmt.Errorf("cannot delete %s: %w", describe(o), err)
+	}
+
+	err = wait.PollImmediateWithContext(ctx, pollInterval, h.config.Timeout, func(ctx context.Context) (bool, error) {
+		err := h.Client.Get(ctx, client.ObjectKeyFromObject(o), o)
+		if apierrors.IsNotFound(err) {
+			return true, nil
+		}
+		return false, err
+	})
+	if err != nil {
+		return fmt.Errorf("%s is not deleted: %w", describe(o), err)
+	}
+	return nil
+}
+
+// Lifecycle is a create, update and delete scenario of a resource
+type Lifecycle struct {
+	// Object is created in the test namespace
+	Object client.Object
+
+	// Update changes the running Object, optional
+	Update func()
+
+	// Check is called every time the Object is running, optional
+	Check func(ctx context.Context, h *Harness) error
+}
+
+// RunLifecycle creates the resource, checks it, updates it, checks it again, and deletes it.
+// The resource is deleted by the teardown too, if the scenario fails halfway.
+func (h *Harness) RunLifecycle(t *testing.T, l Lifecycle) {
+	t.Helper()
+
+	ctx := context.Background()
+	check := func(step string) {
+		t.Helper()
+		if err := h.WaitRunning(ctx, l.Object); err != nil {
+			t.Fatalf("%s: %s", step, err)
+		}
+		if l.Check == nil {
+			return
+		}
+		if err := l.Check(ctx, h); err != nil {
+			t.Fatalf("%s: %s", step, err)
+		}
+	}
+
+	if err := h.Create(ctx, l.Object); err != nil {
+		t.Fatal(err)
+	}
+	check("create")
+
+	if l.Update != nil {
+		if err := h.Update(ctx, l.Object, l.Update); err != nil {
+			t.Fatalf("cannot update %s: %s", describe(l.Object), err)
+		}
+		check("update")
+	}
+
+	if err := h.Delete(ctx, l.Object); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func isRunning(o client.Object) bool {
+	a := o.GetAnnotations()
+	_, running := a[instanceIsRunningAnnotation]
+	return running && a[processedGenerationAnnotation] == strconv.FormatInt(o.GetGeneration(), 10)
+}
+
+func describe(o client.Object) string {
+	return fmt.Sprintf("%T %s/%s", o, o.GetNamespace(), o.GetName())
+}
@@ -0,0 +1,29 @@
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+// Sweep deletes the ephemeral Aiven projects the end-to-end tests left behind
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/aiven/aiven-operator/test/harness"
+)
+
+func main() {
+	config, err := harness.ConfigFromEnv()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	deleted, err := harness.Sweep(context.Background(), config)
+	for _, p := range deleted {
+		fmt.Printf("deleted project %q\n", p)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"os"
 	"path/filepath"
 	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/dave/jennifer/jen"
@@ -105,6 +106,9 @@ type objectInternal struct {
 	jsonName   string // original name from json spec
 	structName string // go struct name in CamelCase
 	index      int    // field order in object.Properties
+
+	// requiresRestart the service is restarted when the field or any of its fields changes
+	requiresRestart bool
 }
 
 // object represents OpenApi object
@@ -115,7 +119,8 @@ type object struct {
 	// https://pkg.go.dev/encoding/json#Unmarshal
 	// Go returns float64 for JSON numbers
 	Enum []*struct {
-		Value string `yaml:"value"`
+		Value        string `yaml:"value"`
+		IsDeprecated bool   `yaml:"is_deprecated"`
 	} `yaml:"enum"`
 	Pattern   string   `yaml:"pattern"`
 	Minimum   *float64 `yaml:"minimum"`
@@ -158,6 +163,11 @@ type object struct {
 
 }
 
+// restartRe finds fields that restart the service on change.
+// The spec has no flag for that, but the descriptions mention it, e.g.:
+// "Changing this parameter will lead to a restart of the MySQL service."
+var restartRe = regexp.MustCompile(`(?i)(cause|lead to)[^.]*\brestart`)
+
 // init initiates object after it gets values from OpenAPI spec
 func (o *object) init(name string) {
 	o.jsonName = name
@@ -180,6 +190,7 @@ func (o *object) init(name string) {
 		child.index = i
 		child.Required = required[k]
 		child.init(k)
+		o.requiresRestart = o.requiresRestart || child.requiresRestart
 	}
 
 	if o.ArrayItems != nil {
@@ -188,6 +199,11 @@ func (o *object) init(name string) {
 		o.ArrayItems.Required = o.ArrayItems.Type != objectTypeObject
 		// Slice items can't be null, if so it is invalid spec
 		o.ArrayItems.Nullable = false
+		o.requiresRestart = o.requiresRestart || o.ArrayItems.requiresRestart
+	}
+
+	if restartRe.MatchString(o.Description) {
+		o.requiresRestart = true
 	}
 
 	// Types can be list of strings, or a string
@@ -290,7 +306,8 @@ func addFieldType(file *jen.File, s *jen.Statement, obj *object) (*jen.Statement
 }
 
 // addFieldTags adds tags for marshal/unmarshal
-// with `groups` tag it is possible to mark "create only" fields, like `admin_password`
+// with `groups` tag it is possible to mark "create only" fields, like `admin_password`,
+// and fields that restart the service on change
 func addFieldTags(s *jen.Statement, obj *object) *jen.Statement {
 	tags := map[string]string{
 		"json":   obj.jsonName,
@@ -305,6 +322,10 @@ func addFieldTags(s *jen.Statement, obj *object) *jen.Statement {
 	if !obj.CreateOnly {
 		tags["groups"] += ",update"
 	}
+
+	if obj.requiresRestart {
+		tags["groups"] += ",restart"
+	}
 	return s.Tag(tags)
 }
 
@@ -346,6 +367,16 @@ func addFieldComments(s *jen.Statement, obj *object) *jen.Statement {
 			enum[i] = safeEnum(s.Value)
 		}
 		c = append(c, fmt.Sprintf("// +kubebuilder:validation:Enum=%s", strings.Join(enum, ";")))
+
+		// Deprecated values stay valid for the existing resources, but can't be switched to.
+		// Transition rules are not evaluated on create
+		for _, s := range obj.Enum {
+			if s.IsDeprecated {
+				v := celString(s.Value)
+				rule := strconv.Quote(fmt.Sprintf("oldSelf == %s || self != %s", v, v))
+				c = append(c, fmt.Sprintf(`// +kubebuilder:validation:XValidation:rule=%s,message="Deprecated value can't be set"`, rule))
+			}
+		}
 	}
 	if obj.CreateOnly {
 		c = append(c, `// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"`)
@@ -396,6 +427,11 @@ func safeEnum(s string) string {
 	return s
 }
 
+// celString returns CEL single-quoted string literal
+func celString(s string) string {
+	return "'" + strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(s) + "'"
+}
+
 // objMaximum validates obj maximum
 func objMaximum(obj *object) string {
 	if obj.Maximum == nil || obj.Type != objectTypeInteger {
@@ -114,3 +114,9 @@ func TestIpFilterEmpty(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Len(t, c.IpFilter, 0)
 }
+
+func TestCelString(t *testing.T) {
+	assert.Equal(t, `'10'`, celString("10"))
+	assert.Equal(t, `'%t [%p]: user=\'%u\''`, celString(`%t [%p]: user='%u'`))
+	assert.Equal(t, `'a\\b'`, celString(`a\b`))
+}
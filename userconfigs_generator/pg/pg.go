@@ -91,7 +91,7 @@ type Pg struct {
 	// +kubebuilder:validation:Minimum=200000000
 	// +kubebuilder:validation:Maximum=1500000000
 	// Specifies the maximum age (in transactions) that a table's pg_class.relfrozenxid field can attain before a VACUUM operation is forced to prevent transaction ID wraparound within the table. Note that the system will launch autovacuum processes to prevent wraparound even when autovacuum is otherwise disabled. This parameter will cause the server to be restarted.
-	AutovacuumFreezeMaxAge *int `groups:"create,update" json:"autovacuum_freeze_max_age,omitempty"`
+	AutovacuumFreezeMaxAge *int `groups:"create,update,restart" json:"autovacuum_freeze_max_age,omitempty"`
 
 	// +kubebuilder:validation:Minimum=1
 	// +kubebuilder:validation:Maximum=20
@@ -439,7 +439,7 @@ type PgTestUserConfig struct {
 	Migration *Migration `groups:"create,update" json:"migration,omitempty"`
 
 	// postgresql.conf configuration values
-	Pg *Pg `groups:"create,update" json:"pg,omitempty"`
+	Pg *Pg `groups:"create,update,restart" json:"pg,omitempty"`
 
 	// Should the service which is being forked be a read replica (deprecated, use read_replica service integration instead).
 	PgReadReplica *bool `groups:"create,update" json:"pg_read_replica,omitempty"`
@@ -450,9 +450,10 @@ type PgTestUserConfig struct {
 	PgServiceToForkFrom *string `groups:"create" json:"pg_service_to_fork_from,omitempty"`
 
 	// Enable the pg_stat_monitor extension. Enabling this extension will cause the cluster to be restarted.When this extension is enabled, pg_stat_statements results for utility commands are unreliable
-	PgStatMonitorEnable *bool `groups:"create,update" json:"pg_stat_monitor_enable,omitempty"`
+	PgStatMonitorEnable *bool `groups:"create,update,restart" json:"pg_stat_monitor_enable,omitempty"`
 
 	// +kubebuilder:validation:Enum=10;11;12;13;14
+	// +kubebuilder:validation:XValidation:rule="oldSelf == '10' || self != '10'",message="Deprecated value can't be set"
 	// PostgreSQL major version
 	PgVersion *string `groups:"create,update" json:"pg_version,omitempty"`
 